@@ -0,0 +1,132 @@
+package kittycad
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// errShortBSON is returned when a BSON document ends early.
+var errShortBSON = errors.New("unexpected end of BSON document")
+
+// decodeBSON decodes a BSON document into the values encoding/json would
+// decode its JSON form into, except that binary values are decoded as
+// []byte, which encode to JSON as base64 like Base64, and UUIDs as strings.
+// It handles the types the engine sends: doubles, strings, documents,
+// arrays, binary, booleans, nulls and integers.
+func decodeBSON(data []byte) (map[string]any, error) {
+	doc, rest, err := decodeBSONDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%d bytes after the BSON document", len(rest))
+	}
+	return doc, nil
+}
+
+// decodeBSONDocument decodes the document at the start of data, and returns
+// the bytes after it.
+func decodeBSONDocument(data []byte) (map[string]any, []byte, error) {
+	if len(data) < 5 {
+		return nil, nil, errShortBSON
+	}
+	size := int(int32(binary.LittleEndian.Uint32(data)))
+	if size < 5 || size > len(data) || data[size-1] != 0 {
+		return nil, nil, fmt.Errorf("invalid BSON document size %d", size)
+	}
+	elements, rest := data[4:size-1], data[size:]
+
+	doc := map[string]any{}
+	for len(elements) > 0 {
+		kind := elements[0]
+		end := 1
+		for end < len(elements) && elements[end] != 0 {
+			end++
+		}
+		if end == len(elements) {
+			return nil, nil, errShortBSON
+		}
+		name := string(elements[1:end])
+
+		value, remaining, err := decodeBSONValue(kind, elements[end+1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding BSON element %q failed: %v", name, err)
+		}
+		doc[name] = value
+		elements = remaining
+	}
+
+	return doc, rest, nil
+}
+
+// decodeBSONValue decodes the value of the kind at the start of data, and
+// returns the bytes after it.
+func decodeBSONValue(kind byte, data []byte) (any, []byte, error) {
+	switch kind {
+	case 0x01: // double
+		if len(data) < 8 {
+			return nil, nil, errShortBSON
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), data[8:], nil
+	case 0x02: // string
+		if len(data) < 4 {
+			return nil, nil, errShortBSON
+		}
+		size := int(int32(binary.LittleEndian.Uint32(data)))
+		if size < 1 || 4+size > len(data) || data[4+size-1] != 0 {
+			return nil, nil, fmt.Errorf("invalid BSON string size %d", size)
+		}
+		return string(data[4 : 4+size-1]), data[4+size:], nil
+	case 0x03: // document
+		return decodeBSONDocument(data)
+	case 0x04: // array, a document keyed by the indexes
+		doc, rest, err := decodeBSONDocument(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		values := make([]any, len(doc))
+		for i := range values {
+			value, ok := doc[fmt.Sprint(i)]
+			if !ok {
+				return nil, nil, fmt.Errorf("BSON array is missing index %d", i)
+			}
+			values[i] = value
+		}
+		return values, rest, nil
+	case 0x05: // binary
+		if len(data) < 5 {
+			return nil, nil, errShortBSON
+		}
+		size := int(int32(binary.LittleEndian.Uint32(data)))
+		if size < 0 || 5+size > len(data) {
+			return nil, nil, fmt.Errorf("invalid BSON binary size %d", size)
+		}
+		subtype, value := data[4], data[5:5+size]
+		if subtype == 0x04 && size == 16 {
+			return uuid.UUID(value).String(), data[5+size:], nil
+		}
+		return append([]byte{}, value...), data[5+size:], nil
+	case 0x08: // boolean
+		if len(data) < 1 {
+			return nil, nil, errShortBSON
+		}
+		return data[0] != 0, data[1:], nil
+	case 0x0A: // null
+		return nil, data, nil
+	case 0x10: // int32
+		if len(data) < 4 {
+			return nil, nil, errShortBSON
+		}
+		return float64(int32(binary.LittleEndian.Uint32(data))), data[4:], nil
+	case 0x12: // int64
+		if len(data) < 8 {
+			return nil, nil, errShortBSON
+		}
+		return float64(int64(binary.LittleEndian.Uint64(data))), data[8:], nil
+	}
+	return nil, nil, fmt.Errorf("unsupported BSON type 0x%02x", kind)
+}
@@ -0,0 +1,133 @@
+package kittycad
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// encodeBSON encodes the document as BSON, like the engine encodes its
+// binary messages. UUIDs are encoded as binary UUIDs, and ints as int32s.
+func encodeBSON(doc map[string]any) []byte {
+	keys := []string{}
+	for k := range doc {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	elements := []byte{}
+	for _, k := range keys {
+		elements = appendBSONElement(elements, k, doc[k])
+	}
+	out := binary.LittleEndian.AppendUint32(nil, uint32(4+len(elements)+1))
+	out = append(out, elements...)
+	return append(out, 0)
+}
+
+func appendBSONElement(out []byte, name string, value any) []byte {
+	element := func(kind byte) {
+		out = append(out, kind)
+		out = append(out, name...)
+		out = append(out, 0)
+	}
+
+	switch v := value.(type) {
+	case float64:
+		element(0x01)
+		out = binary.LittleEndian.AppendUint64(out, math.Float64bits(v))
+	case string:
+		element(0x02)
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(v)+1))
+		out = append(append(out, v...), 0)
+	case map[string]any:
+		element(0x03)
+		out = append(out, encodeBSON(v)...)
+	case []any:
+		element(0x04)
+		doc := map[string]any{}
+		for i, item := range v {
+			doc[strconv.Itoa(i)] = item
+		}
+		out = append(out, encodeBSON(doc)...)
+	case []byte:
+		element(0x05)
+		out = binary.LittleEndian.AppendUint32(out, uint32(len(v)))
+		out = append(append(out, 0x00), v...)
+	case uuid.UUID:
+		element(0x05)
+		out = binary.LittleEndian.AppendUint32(out, 16)
+		out = append(append(out, 0x04), v[:]...)
+	case bool:
+		element(0x08)
+		if v {
+			out = append(out, 1)
+		} else {
+			out = append(out, 0)
+		}
+	case nil:
+		element(0x0A)
+	case int:
+		element(0x10)
+		out = binary.LittleEndian.AppendUint32(out, uint32(int32(v)))
+	default:
+		panic("cannot encode " + reflect.TypeOf(value).String() + " as BSON")
+	}
+	return out
+}
+
+func TestDecodeBSON(t *testing.T) {
+	id := uuid.MustParse("11111111-1111-4111-8111-111111111111")
+	doc, err := decodeBSON(encodeBSON(map[string]any{
+		"success":    true,
+		"request_id": id,
+		"resp": map[string]any{
+			"type": "export",
+			"data": map[string]any{"files": []any{
+				map[string]any{"name": "part.stl", "contents": []byte{0, 1, 2}},
+				map[string]any{"name": "part.obj", "contents": []any{3, 4}},
+			}},
+		},
+		"scale": 1.5,
+		"none":  nil,
+	}))
+	if err != nil {
+		t.Fatalf("decoding failed: %v", err)
+	}
+
+	want := map[string]any{
+		"success":    true,
+		"request_id": id.String(),
+		"resp": map[string]any{
+			"type": "export",
+			"data": map[string]any{"files": []any{
+				map[string]any{"name": "part.stl", "contents": []byte{0, 1, 2}},
+				map[string]any{"name": "part.obj", "contents": []any{3.0, 4.0}},
+			}},
+		},
+		"scale": 1.5,
+		"none":  nil,
+	}
+	if !reflect.DeepEqual(doc, want) {
+		t.Fatalf("got %#v, want %#v", doc, want)
+	}
+}
+
+func TestDecodeBSONRefusesBadDocuments(t *testing.T) {
+	valid := encodeBSON(map[string]any{"name": "part.stl"})
+	for name, data := range map[string][]byte{
+		"empty":     {},
+		"truncated": valid[:len(valid)-2],
+		"trailing":  append(append([]byte{}, valid...), 0),
+		"not bson":  []byte{0xff, 0x00},
+		"bad size":  {0xff, 0xff, 0xff, 0x7f, 0x00},
+	} {
+		if _, err := decodeBSON(data); err == nil {
+			t.Errorf("expected the %s document to be refused", name)
+		}
+	}
+}
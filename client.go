@@ -2,7 +2,11 @@
 
 package kittycad
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
 
 // Client which conforms to the OpenAPI v3 specification for this service.
 type Client struct {
@@ -16,6 +20,13 @@ type Client struct {
 	// token is the API token used for authentication.
 	token string
 
+	// dialer is the *websocket.Dialer for opening websockets. If nil,
+	// websocket.DefaultDialer is used.
+	dialer *websocket.Dialer
+
+	// strictEnums makes decoding a response fail on unknown enum values.
+	strictEnums bool
+
 	// APICall: API calls that have been performed by users can be queried by the API. This is helpful for debugging as well as billing.
 	APICall *APICallService
 	// APIToken: API tokens allow users to call the API outside of their session token that is used as a cookie in the user interface. Users can create, delete, and list their API tokens. But, of course, you need an API token to do this, so first be sure to generate one in the account UI.
@@ -101,6 +101,23 @@ func run() error {
 		return err
 	}
 
+	// Generate the interfaces.go file and the mock package.
+	logrus.Info("Generating interfaces...")
+	if err := generateInterfaces(data); err != nil {
+		return err
+	}
+
+	// Generate the route table of the test server. Generating the types
+	// modifies the doc, so we load it again.
+	logrus.Info("Generating test server...")
+	testDoc, err := openapi3.NewLoader().LoadFromFile(p)
+	if err != nil {
+		return fmt.Errorf("error loading openAPI spec: %v", err)
+	}
+	if err := data.generateTestServer(testDoc); err != nil {
+		return err
+	}
+
 	// Generate the examples.go file.
 	logrus.Info("Generating examples...")
 	if err := generateExamplesFile(doc, data); err != nil {
@@ -158,6 +175,19 @@ func generateClient(doc *openapi3.T, data Data) error {
 	return nil
 }
 
+// Generate the interfaces.go file and the mock package implementing them.
+func generateInterfaces(data Data) error {
+	if err := processTemplate("interfaces.tmpl", "interfaces.go", data); err != nil {
+		return err
+	}
+
+	if err := processTemplate("mock.tmpl", filepath.Join(data.PackageName+"mock", data.PackageName+"mock.go"), data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func generateExamplesFile(doc *openapi3.T, data Data) error {
 	// Generate the example template.
 	// All examples lack output because:
@@ -83,6 +83,10 @@ func (data *Data) generatePath(doc *openapi3.T, pathName string, path *openapi3.
 	return nil
 }
 
+// maxPositionalParams is the number of parameters an operation can have
+// before its query parameters are moved into a params struct.
+const maxPositionalParams = 4
+
 // Path holds what we need for generating our functions.
 type Path struct {
 	Name        string
@@ -92,8 +96,60 @@ type Path struct {
 	Description string
 	RequestBody *RequestBody
 	Args        []Arg
+	Params      *Params
 	Response    *Response
 	PackageName string
+	IsWebsocket bool
+	IsMultipart bool
+}
+
+// Signature returns the parameters and results of the function. If pkg is
+// set, the types defined in the generated package are qualified with it.
+func (function Path) Signature(pkg string) string {
+	params := []string{}
+	if function.IsWebsocket {
+		params = append(params, "ctx context.Context")
+	}
+	for _, arg := range function.Args {
+		params = append(params, fmt.Sprintf("%s %s", arg.Name, qualifyType(arg.Type, pkg)))
+	}
+	if function.Params != nil {
+		params = append(params, fmt.Sprintf("params %s", qualifyType(function.Params.Name, pkg)))
+	}
+	if function.IsMultipart {
+		params = append(params, fmt.Sprintf("body %s", qualifyType("*MultipartForm", pkg)))
+	} else if function.RequestBody != nil {
+		params = append(params, fmt.Sprintf("body %s", qualifyType(function.RequestBody.Type, pkg)))
+	}
+
+	results := "error"
+	if function.IsWebsocket {
+		results = "(*websocket.Conn, error)"
+	} else if function.Response != nil {
+		results = fmt.Sprintf("(*%s, error)", qualifyType(function.Response.Type, pkg))
+	}
+
+	return fmt.Sprintf("(%s) %s", strings.Join(params, ", "), results)
+}
+
+// ArgNames returns the names of the parameters of the function, separated by
+// commas, for passing them on to another function.
+func (function Path) ArgNames() string {
+	names := []string{}
+	if function.IsWebsocket {
+		names = append(names, "ctx")
+	}
+	for _, arg := range function.Args {
+		names = append(names, arg.Name)
+	}
+	if function.Params != nil {
+		names = append(names, "params")
+	}
+	if function.IsMultipart || function.RequestBody != nil {
+		names = append(names, "body")
+	}
+
+	return strings.Join(names, ", ")
 }
 
 func (function Path) getDescription(operation *openapi3.Operation) string {
@@ -108,7 +164,7 @@ func (function Path) getDescription(operation *openapi3.Operation) string {
 	if operation.Description != "" {
 		description = fmt.Sprintf("%s\n%s\n", description, operation.Description)
 	}
-	if len(function.Args) > 0 || function.RequestBody != nil {
+	if len(function.Args) > 0 || function.Params != nil || function.RequestBody != nil {
 		description = fmt.Sprintf("%s\n\nParameters\n\n", description)
 	}
 
@@ -119,6 +175,9 @@ func (function Path) getDescription(operation *openapi3.Operation) string {
 			description = fmt.Sprintf("%s\t- `%s`\n", description, arg.Name)
 		}
 	}
+	if function.Params != nil {
+		description = fmt.Sprintf("%s\t- `params`: The query parameters, see %s.\n", description, function.Params.Name)
+	}
 	if function.RequestBody != nil {
 		if function.RequestBody.Description != "" {
 			description = fmt.Sprintf("%s\t- `body`: %s\n", description, strings.ReplaceAll(function.RequestBody.Description, "\n", "\n\t\t"))
@@ -133,14 +192,23 @@ func (function Path) getDescription(operation *openapi3.Operation) string {
 // Arg is an argument to a path function.
 type Arg struct {
 	Name        string
+	Field       string
 	Description string
 	Property    string
+	In          string
 	Type        string
 	ToString    string
 	Required    bool
 	Example     string
 }
 
+// Params is a struct holding the query parameters of a path function.
+type Params struct {
+	Name    string
+	Fields  []Arg
+	Example string
+}
+
 // RequestBody is a request body for a path function.
 type RequestBody struct {
 	Type        string
@@ -205,31 +273,64 @@ func (data *Data) generateMethod(_ *openapi3.T, method string, pathName string,
 		// Ready ourselves for adding our arg.
 		arg := Arg{
 			Name:        printPropertyLower(p.Value.Name),
+			Field:       printProperty(p.Value.Name),
 			Property:    p.Value.Name,
 			Description: description,
+			In:          p.Value.In,
 			Type:        typeName,
 			Required:    p.Value.Required,
 			Example:     example,
 		}
-
-		if typeName == "string" {
-			arg.ToString = arg.Name
-		} else if typeName == "bool" {
-			arg.ToString = fmt.Sprintf("strconv.FormatBool(%s)", arg.Name)
-		} else if typeName == "int" {
-			arg.ToString = fmt.Sprintf("strconv.Itoa(%s)", arg.Name)
-		} else if typeName == "float64" {
-			arg.ToString = fmt.Sprintf("fmt.Sprintf(\"%%f\", %s)", arg.Name)
-		} else if isTypeToString(typeName) {
-			arg.ToString = fmt.Sprintf("%s.String()", arg.Name)
-		} else {
-			arg.ToString = fmt.Sprintf("string(%s)", arg.Name)
-		}
+		arg.ToString = argToString(arg.Name, typeName)
 
 		// Add our arg to the function.
 		function.Args = append(function.Args, arg)
 	}
 
+	// Operations with optional query parameters, or too many parameters to
+	// pass positionally, take their query parameters as a struct. That way
+	// new optional parameters do not break existing callers.
+	hasOptionalQuery := false
+	for _, arg := range function.Args {
+		if arg.In == openapi3.ParameterInQuery && !arg.Required {
+			hasOptionalQuery = true
+		}
+	}
+	if hasOptionalQuery || len(function.Args) > maxPositionalParams {
+		params := &Params{
+			Name: function.Tag + function.Name + "Params",
+		}
+		args := []Arg{}
+		for _, arg := range function.Args {
+			if arg.In != openapi3.ParameterInQuery {
+				args = append(args, arg)
+				continue
+			}
+
+			field := arg
+			field.Type = printPropertyType(arg.Type, arg.Required, false)
+			if strings.HasPrefix(field.Type, "*") {
+				field.ToString = argToString("*params."+arg.Field, arg.Type)
+				field.Example = data.pointerExample(arg.Type, arg.Example)
+			} else {
+				field.ToString = argToString("params."+arg.Field, arg.Type)
+			}
+			if field.Description != "" {
+				field.Description = strings.ReplaceAll(field.Description, "\n", "\n// ")
+			}
+			params.Fields = append(params.Fields, field)
+		}
+
+		example := fmt.Sprintf("%s.%s{", data.PackageName, params.Name)
+		for _, field := range params.Fields {
+			example += fmt.Sprintf("%s: %s, ", field.Field, field.Example)
+		}
+		params.Example = example + "}"
+
+		function.Args = args
+		function.Params = params
+	}
+
 	// Parse the request body.
 	if operation.RequestBody != nil {
 		if operation.RequestBody.Ref != "" {
@@ -270,21 +371,23 @@ func (data *Data) generateMethod(_ *openapi3.T, method string, pathName string,
 	// Now we can get the description since we have filled in everything else.
 	function.Description = function.getDescription(operation)
 
-	isMultiPart := false
 	if operation.RequestBody != nil {
 		for mediaType := range operation.RequestBody.Value.Content {
 			if strings.Contains(mediaType, "multipart/form-data") {
-				isMultiPart = true
+				function.IsMultipart = true
 				break
 			}
 		}
 	}
+	if _, ok := operation.Extensions["x-dropshot-websocket"]; ok {
+		function.IsWebsocket = true
+	}
 
 	exampleTemplatePath := "function-example.tmpl"
-	if _, ok := operation.Extensions["x-dropshot-websocket"]; ok {
+	if function.IsWebsocket {
 		exampleTemplatePath = "function-example-ws.tmpl"
 	}
-	if isMultiPart {
+	if function.IsMultipart {
 		exampleTemplatePath = "function-example-multipart.tmpl"
 	}
 
@@ -296,11 +399,11 @@ func (data *Data) generateMethod(_ *openapi3.T, method string, pathName string,
 	data.Examples = append(data.Examples, example)
 
 	templatePath := "path.tmpl"
-	if _, ok := operation.Extensions["x-dropshot-websocket"]; ok {
+	if function.IsWebsocket {
 		templatePath = "websocket.tmpl"
 	}
 	// If its a multipart request, we need to use a different template.
-	if isMultiPart {
+	if function.IsMultipart {
 		templatePath = "multipart.tmpl"
 	}
 
@@ -312,6 +415,7 @@ func (data *Data) generateMethod(_ *openapi3.T, method string, pathName string,
 
 	// Add the function to our list of functions.
 	data.Paths = append(data.Paths, f)
+	data.Functions = append(data.Functions, function)
 
 	// Add it to our docs.
 	docInfo := map[string]string{
@@ -326,6 +430,45 @@ func (data *Data) generateMethod(_ *openapi3.T, method string, pathName string,
 	return nil
 }
 
+// qualifyType qualifies the types defined in the generated package in the
+// given Go type with pkg, so the type can be used from another package.
+func qualifyType(typeName string, pkg string) string {
+	if pkg == "" {
+		return typeName
+	}
+
+	for _, prefix := range []string{"*", "[]", "map[string]"} {
+		if strings.HasPrefix(typeName, prefix) {
+			return prefix + qualifyType(strings.TrimPrefix(typeName, prefix), pkg)
+		}
+	}
+
+	switch typeName {
+	case "string", "int", "float64", "bool", "byte", "any":
+		return typeName
+	}
+
+	return fmt.Sprintf("%s.%s", pkg, typeName)
+}
+
+// argToString returns the expression converting the given argument to a string.
+func argToString(name string, typeName string) string {
+	if typeName == "string" {
+		return name
+	} else if typeName == "bool" {
+		return fmt.Sprintf("strconv.FormatBool(%s)", name)
+	} else if typeName == "int" {
+		return fmt.Sprintf("strconv.Itoa(%s)", name)
+	} else if typeName == "float64" {
+		return fmt.Sprintf("fmt.Sprintf(\"%%f\", %s)", name)
+	} else if isTypeToString(typeName) {
+		// Methods can be called on pointers without dereferencing them.
+		return fmt.Sprintf("%s.String()", strings.TrimPrefix(name, "*"))
+	}
+
+	return fmt.Sprintf("string(%s)", name)
+}
+
 func getSuccessResponseType(o *openapi3.Operation, isGetAllPages bool, spec *openapi3.T) (string, string, error) {
 	for name, response := range responseRefs(o.Responses) {
 		if name == "default" {
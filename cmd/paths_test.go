@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestQualifyType(t *testing.T) {
+	tests := map[string]string{
+		"UUID":                   "kittycad.UUID",
+		"*MultipartForm":         "*kittycad.MultipartForm",
+		"[]byte":                 "[]byte",
+		"[]FileExportFormat":     "[]kittycad.FileExportFormat",
+		"map[string]Base64":      "map[string]kittycad.Base64",
+		"*map[string]Base64":     "*map[string]kittycad.Base64",
+		"any":                    "any",
+		"FileCreateMassParams":   "kittycad.FileCreateMassParams",
+		"map[string][]OrgMember": "map[string][]kittycad.OrgMember",
+	}
+
+	for in, want := range tests {
+		if got := qualifyType(in, "kittycad"); got != want {
+			t.Errorf("qualifyType(%q) = %q, expected %q", in, got, want)
+		}
+	}
+
+	if got := qualifyType("UUID", ""); got != "UUID" {
+		t.Errorf("expected an unqualified type without a package, got %q", got)
+	}
+}
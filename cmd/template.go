@@ -8,6 +8,7 @@ import (
 	"go/format"
 	"os"
 	"path/filepath"
+	"sort"
 	"text/template"
 
 	"github.com/sirupsen/logrus"
@@ -27,6 +28,7 @@ type Data struct {
 	WorkingDirectory string
 	Examples         []string
 	Paths            []string
+	Functions        []Path
 	Types            map[string]string
 }
 
@@ -36,8 +38,25 @@ type Tag struct {
 	Description string
 }
 
+// FunctionsForTag returns the functions of the service with the given tag,
+// sorted by name.
+func (data Data) FunctionsForTag(tag string) []Path {
+	functions := []Path{}
+	for _, function := range data.Functions {
+		if function.Tag == tag {
+			functions = append(functions, function)
+		}
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	return functions
+}
+
 func templateToString(templateName string, data any) (string, error) {
-	tmpl := template.Must(template.New("").ParseFS(templateFiles, filepath.Join("tmpl", templateName)))
+	// Parse all the templates, so they can include each other.
+	tmpl := template.Must(template.New("").ParseFS(templateFiles, filepath.Join("tmpl", "*.tmpl")))
 	var processed bytes.Buffer
 	err := tmpl.ExecuteTemplate(&processed, templateName, data)
 	if err != nil {
@@ -63,6 +82,9 @@ func processTemplate(templateName string, outputFile string, data any) error {
 		return fmt.Errorf("error getting current working directory: %v", err)
 	}
 	outputPath := filepath.Join(wd, outputFile)
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return fmt.Errorf("error creating directory for %q: %v", outputPath, err)
+	}
 
 	logrus.Debugf("Writing file: %s", outputPath)
 
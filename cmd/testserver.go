@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/iancoleman/strcase"
+)
+
+// maxExampleDepth is how deep we go into nested schemas when building the
+// example responses of the test server, recursive schemas are cut off with null.
+const maxExampleDepth = 8
+
+// Route is an operation served by the fake API server of the test package.
+type Route struct {
+	Method      string
+	Pattern     string
+	OperationID string
+	Status      int
+	AsyncType   string
+	Example     string
+}
+
+// Generate the route table of the test server.
+func (data *Data) generateTestServer(doc *openapi3.T) error {
+	asyncTypes := getAsyncTypes(doc)
+
+	paths := pathItems(doc.Paths)
+	keys := make([]string, 0)
+	for k := range paths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	routes := []Route{}
+	for _, pathName := range keys {
+		path := paths[pathName]
+		if path.Ref != "" {
+			continue
+		}
+
+		operations := []struct {
+			method    string
+			operation *openapi3.Operation
+		}{
+			{http.MethodGet, path.Get},
+			{http.MethodPost, path.Post},
+			{http.MethodPut, path.Put},
+			{http.MethodDelete, path.Delete},
+			{http.MethodPatch, path.Patch},
+			{http.MethodHead, path.Head},
+		}
+		for _, o := range operations {
+			if o.operation == nil {
+				continue
+			}
+			if _, ok := o.operation.Extensions["x-dropshot-websocket"]; ok {
+				// Websockets can not be answered with an example.
+				continue
+			}
+
+			route, err := getRoute(o.method, pathName, o.operation, asyncTypes, doc)
+			if err != nil {
+				return err
+			}
+			routes = append(routes, route)
+		}
+	}
+
+	testData := struct {
+		PackageName string
+		Routes      []Route
+	}{
+		PackageName: data.PackageName,
+		Routes:      routes,
+	}
+	if err := processTemplate("testserver.tmpl", filepath.Join(data.PackageName+"test", "routes.go"), testData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getRoute returns the route for an operation, with the example of its
+// successful response.
+func getRoute(method string, pathName string, operation *openapi3.Operation, asyncTypes map[string]bool, spec *openapi3.T) (Route, error) {
+	route := Route{
+		Method:      method,
+		Pattern:     pathName,
+		OperationID: operation.OperationID,
+		Status:      http.StatusOK,
+	}
+
+	codes := []string{}
+	for name := range responseRefs(operation.Responses) {
+		codes = append(codes, name)
+	}
+	sort.Strings(codes)
+
+	for _, name := range codes {
+		response := responseRefs(operation.Responses)[name]
+		statusCode := http.StatusOK
+		if name != "default" {
+			code, err := strconv.Atoi(strings.ReplaceAll(name, "XX", "00"))
+			if err != nil {
+				return route, fmt.Errorf("converting %q to an integer failed: %v", name, err)
+			}
+			if code < 200 || code >= 300 {
+				continue
+			}
+			statusCode = code
+		}
+		route.Status = statusCode
+
+		if response.Value == nil {
+			break
+		}
+		content := response.Value.Content.Get("application/json")
+		if content == nil || content.Schema == nil {
+			break
+		}
+
+		ref := strings.TrimPrefix(content.Schema.Ref, "#/components/schemas/")
+		if asyncTypes[strcase.ToSnake(ref)] {
+			route.AsyncType = strcase.ToSnake(ref)
+		}
+
+		example := exampleValue(content.Schema, 0)
+		if example != nil {
+			b, err := json.Marshal(example)
+			if err != nil {
+				return route, fmt.Errorf("marshalling the example for %q failed: %v", operation.OperationID, err)
+			}
+			route.Example = string(b)
+		}
+		break
+	}
+
+	return route, nil
+}
+
+// getAsyncTypes returns the types of async operations, from the variants of
+// the async API call output.
+func getAsyncTypes(spec *openapi3.T) map[string]bool {
+	asyncTypes := map[string]bool{}
+	s, ok := spec.Components.Schemas["AsyncApiCallOutput"]
+	if !ok || s.Value == nil {
+		return asyncTypes
+	}
+
+	for _, variant := range s.Value.OneOf {
+		if variant.Value == nil {
+			continue
+		}
+		if t, ok := variant.Value.Properties["type"]; ok && t.Value != nil {
+			for _, e := range t.Value.Enum {
+				if str, ok := e.(string); ok {
+					asyncTypes[str] = true
+				}
+			}
+		}
+	}
+
+	return asyncTypes
+}
+
+// exampleValue builds a JSON value matching the schema. Only required
+// properties are filled in, and for a oneOf or anyOf the first variant that
+// is not null is used.
+func exampleValue(r *openapi3.SchemaRef, depth int) any {
+	if r == nil || r.Value == nil || depth > maxExampleDepth {
+		return nil
+	}
+	s := r.Value
+
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+
+	if len(s.OneOf) > 0 {
+		return exampleValue(firstNotNull(s.OneOf), depth+1)
+	}
+
+	if len(s.AnyOf) > 0 {
+		return exampleValue(firstNotNull(s.AnyOf), depth+1)
+	}
+
+	if len(s.AllOf) > 0 {
+		if len(s.AllOf) == 1 {
+			return exampleValue(s.AllOf[0], depth+1)
+		}
+		merged := map[string]any{}
+		for _, a := range s.AllOf {
+			if m, ok := exampleValue(a, depth+1).(map[string]any); ok {
+				for k, v := range m {
+					merged[k] = v
+				}
+			}
+		}
+		return merged
+	}
+
+	switch {
+	case schemaTypeIncludes(s, "object") || len(s.Properties) > 0:
+		object := map[string]any{}
+		for _, name := range s.Required {
+			object[name] = exampleValue(s.Properties[name], depth+1)
+		}
+		return object
+	case schemaTypeIncludes(s, "array"):
+		if item := exampleValue(s.Items, depth+1); item != nil {
+			return []any{item}
+		}
+		return []any{}
+	case schemaTypeIncludes(s, "string"):
+		return exampleString(s)
+	case schemaTypeIncludes(s, "integer"), schemaTypeIncludes(s, "number"):
+		if s.Min != nil {
+			return *s.Min
+		}
+		return 0
+	case schemaTypeIncludes(s, "boolean"):
+		return false
+	}
+
+	return nil
+}
+
+// exampleString returns an example for a string schema, in its format.
+func exampleString(s *openapi3.Schema) string {
+	switch formatStringType(s) {
+	case "Time":
+		return "2025-01-01T00:00:00Z"
+	case "IP":
+		return "127.0.0.1"
+	case "URL":
+		return "https://example.com"
+	case "UUID":
+		return "00000000-0000-4000-8000-000000000000"
+	case "Base64", "[]byte":
+		return ""
+	}
+
+	if s.Format == "email" {
+		return "user@example.com"
+	}
+
+	return "string"
+}
+
+// firstNotNull returns the first of the schemas which is not null.
+func firstNotNull(schemas openapi3.SchemaRefs) *openapi3.SchemaRef {
+	for _, s := range schemas {
+		if s.Value != nil && !schemaTypeIncludes(s.Value, "null") {
+			return s
+		}
+	}
+
+	return schemas[0]
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestExampleValue(t *testing.T) {
+	schema := &openapi3.Schema{
+		Type:     schemaTypes("object"),
+		Required: []string{"id", "status", "items", "child"},
+		Properties: openapi3.Schemas{
+			"id":       openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("string"), Format: "uuid"}),
+			"status":   openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("string"), Enum: []any{"queued", "completed"}}),
+			"items":    openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("array"), Items: openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("integer")})}),
+			"optional": openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("string")}),
+		},
+	}
+	// The child refers back to the schema itself, so the recursion has to stop.
+	schema.Properties["child"] = openapi3.NewSchemaRef("", &openapi3.Schema{
+		AnyOf: openapi3.SchemaRefs{
+			openapi3.NewSchemaRef("", &openapi3.Schema{Type: schemaTypes("null")}),
+			openapi3.NewSchemaRef("#/components/schemas/Self", schema),
+		},
+	})
+
+	b, err := json.Marshal(exampleValue(openapi3.NewSchemaRef("", schema), 0))
+	if err != nil {
+		t.Fatalf("marshalling the example failed: %v", err)
+	}
+
+	var example map[string]any
+	if err := json.Unmarshal(b, &example); err != nil {
+		t.Fatalf("unmarshalling the example failed: %v", err)
+	}
+	if example["id"] != "00000000-0000-4000-8000-000000000000" || example["status"] != "queued" {
+		t.Errorf("unexpected example: %s", b)
+	}
+	if _, ok := example["optional"]; ok {
+		t.Errorf("expected only the required properties, got %s", b)
+	}
+	if items, ok := example["items"].([]any); !ok || len(items) != 1 {
+		t.Errorf("expected one item, got %s", b)
+	}
+	if _, ok := example["child"].(map[string]any); !ok {
+		t.Errorf("expected the child to use the first variant which is not null, got %s", b)
+	}
+}
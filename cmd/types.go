@@ -125,6 +125,7 @@ func (data *Data) generateResponseType(name string, r *openapi3.Response, spec *
 // Enum holds the information for an enum.
 type Enum struct {
 	Name        string
+	Plural      string
 	Description string
 	Values      []EnumValue
 }
@@ -144,6 +145,7 @@ func (data *Data) generateEnumType(name string, s *openapi3.Schema, additionalDo
 	}
 	enum := Enum{
 		Name:        enumName,
+		Plural:      makePlural(enumName),
 		Description: getTypeDescription(enumName, s),
 		Values:      []EnumValue{},
 	}
@@ -189,6 +191,11 @@ func (data *Data) generateEnumType(name string, s *openapi3.Schema, additionalDo
 	return nil
 }
 
+// errorTypes are the objects which implement the error interface.
+var errorTypes = map[string]bool{
+	"APIError": true,
+}
+
 // Object holds the information for an object.
 type Object struct {
 	Name        string
@@ -203,6 +210,7 @@ type ObjectValue struct {
 	Type        string
 	Property    string
 	Required    bool
+	Nullable    bool
 }
 
 func (data *Data) generateObjectType(name string, s *openapi3.Schema, spec *openapi3.T) error {
@@ -226,11 +234,13 @@ func (data *Data) generateObjectType(name string, s *openapi3.Schema, spec *open
 			return err
 		}
 
+		required := contains(s.Required, k)
 		objectValue := ObjectValue{
 			Name:     printProperty(k),
-			Type:     typeName,
+			Type:     printPropertyType(typeName, required, v.Value.Nullable),
 			Property: k,
-			Required: contains(s.Required, k),
+			Required: required,
+			Nullable: v.Value.Nullable,
 		}
 
 		if v.Value.Description != "" {
@@ -267,12 +277,25 @@ func (data *Data) generateObjectType(name string, s *openapi3.Schema, spec *open
 		}
 	}
 
+	if len(s.Required) < len(s.Properties) && isUpdateBody(name, spec) {
+		object.Description += "\n//\n// " + updateBodyNote
+	}
+
 	// Print the template for the struct.
 	objectString, err := templateToString("struct.tmpl", object)
 	if err != nil {
 		return err
 	}
 
+	// Errors returned by the engine are reported as Go errors.
+	if errorTypes[object.Name] {
+		errorString, err := templateToString("error.tmpl", object)
+		if err != nil {
+			return err
+		}
+		objectString += "\n" + errorString
+	}
+
 	// Add the type to our types.
 	data.Types[object.Name] = objectString
 
@@ -577,6 +600,45 @@ func printType(property string, r *openapi3.SchemaRef, spec *openapi3.T) (string
 	return "any", nil
 }
 
+// updateBodyNote documents the optional fields of the request bodies of
+// updates, which omitempty leaves out when they are unset.
+const updateBodyNote = "Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. " +
+	"There is no way to send an explicit null or empty list to clear a field."
+
+// isUpdateBody returns whether the schema with the given name is the JSON
+// request body of a PUT or PATCH operation.
+func isUpdateBody(name string, spec *openapi3.T) bool {
+	for _, path := range pathItems(spec.Paths) {
+		for _, operation := range []*openapi3.Operation{path.Put, path.Patch} {
+			if operation == nil || operation.RequestBody == nil || operation.RequestBody.Value == nil {
+				continue
+			}
+			content := operation.RequestBody.Value.Content.Get("application/json")
+			if content != nil && content.Schema != nil && content.Schema.Ref == "#/components/schemas/"+name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// printPropertyType returns the Go type for an object property of the given
+// type. Properties that are optional or nullable become pointers, so that an
+// unset value can be told apart from the zero value. Slices, maps and
+// interfaces already have a nil value, so they are left as is. Unset values
+// are omitted, so an explicit null cannot be sent, see updateBodyNote.
+func printPropertyType(typeName string, required, nullable bool) string {
+	if required && !nullable {
+		return typeName
+	}
+
+	if typeName == "any" || strings.HasPrefix(typeName, "[]") || strings.HasPrefix(typeName, "map[") {
+		return typeName
+	}
+
+	return "*" + typeName
+}
+
 func printTagName(tag string) string {
 	return printProperty(makeSingular(tag))
 }
@@ -625,6 +687,20 @@ func makeSingular(s string) string {
 	return strings.TrimSuffix(s, "s")
 }
 
+// makePlural returns the given string but plural.
+func makePlural(s string) string {
+	if strings.HasSuffix(s, "Status") {
+		return s + "es"
+	}
+	if strings.HasSuffix(s, "s") {
+		return s
+	}
+	if strings.HasSuffix(s, "y") && !strings.ContainsAny(s[len(s)-2:len(s)-1], "aeiou") {
+		return strings.TrimSuffix(s, "y") + "ies"
+	}
+	return s + "s"
+}
+
 // Check if a slice of strings contains a value.
 func contains(s []string, e string) bool {
 	for _, a := range s {
@@ -823,10 +899,21 @@ func (data Data) generateExampleValue(name string, s *openapi3.SchemaRef, spec *
 		for _, k := range keys {
 			p := schema.Properties[k]
 			// Get an example for the property.
-			example, err := data.generateExampleValue(printProperty(k), p, spec, required)
+			example, err := data.generateExampleValue(printProperty(k), p, spec, true)
+			if err != nil {
+				return "", err
+			}
+
+			// Optional and nullable properties are pointers, so take the
+			// address of the example.
+			propType, err := printType(k, p, spec)
 			if err != nil {
 				return "", err
 			}
+			if strings.HasPrefix(printPropertyType(propType, contains(schema.Required, k), p.Value.Nullable), "*") {
+				example = data.pointerExample(propType, example)
+			}
+
 			object += fmt.Sprintf("%s: %s, ", printProperty(k), example)
 		}
 		// Close the object.
@@ -845,6 +932,22 @@ func (data Data) generateExampleValue(name string, s *openapi3.SchemaRef, spec *
 	return `""`, nil
 }
 
+// pointerExample returns an expression for a pointer to the given example.
+// Untyped constants are converted to the named type first, since otherwise
+// they would take their default type.
+func (data Data) pointerExample(typeName string, example string) string {
+	switch typeName {
+	case "string", "int", "float64", "bool":
+		return fmt.Sprintf("new(%s)", example)
+	}
+
+	if strings.HasPrefix(example, `"`) {
+		return fmt.Sprintf("new(%s.%s(%s))", data.PackageName, typeName, example)
+	}
+
+	return fmt.Sprintf("new(%s)", example)
+}
+
 func (data *Data) generateAnyOfType(name string, s *openapi3.Schema, spec *openapi3.T) error {
 	// Check if this is an enum with descriptions.
 	isEnumWithDocs := false
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func TestPrintPropertyType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		required bool
+		nullable bool
+		want     string
+	}{
+		{"string", true, false, "string"},
+		{"string", false, false, "*string"},
+		{"bool", true, true, "*bool"},
+		{"UUID", false, true, "*UUID"},
+		{"[]string", false, false, "[]string"},
+		{"map[string]Base64", false, true, "map[string]Base64"},
+		{"any", false, false, "any"},
+	}
+
+	for _, test := range tests {
+		got := printPropertyType(test.typeName, test.required, test.nullable)
+		if got != test.want {
+			t.Errorf("printPropertyType(%q, %t, %t) = %q, expected %q", test.typeName, test.required, test.nullable, got, test.want)
+		}
+	}
+}
+
+func TestMakePlural(t *testing.T) {
+	tests := map[string]string{
+		"FileImportFormat": "FileImportFormats",
+		"APICallStatus":    "APICallStatuses",
+		"UnitDensity":      "UnitDensities",
+		"UnitMas":          "UnitMas",
+		"Axis":             "Axis",
+		"ZooTool":          "ZooTools",
+	}
+
+	for in, want := range tests {
+		if got := makePlural(in); got != want {
+			t.Errorf("makePlural(%q) = %q, expected %q", in, got, want)
+		}
+	}
+}
+
+func TestIsUpdateBody(t *testing.T) {
+	body := func(name string) *openapi3.Operation {
+		return &openapi3.Operation{
+			RequestBody: &openapi3.RequestBodyRef{
+				Value: openapi3.NewRequestBody().WithJSONSchemaRef(openapi3.NewSchemaRef("#/components/schemas/"+name, nil)),
+			},
+		}
+	}
+	spec := &openapi3.T{Paths: openapi3.NewPaths(
+		openapi3.WithPath("/user", &openapi3.PathItem{Put: body("UpdateUser"), Post: body("CreateUser")}),
+		openapi3.WithPath("/org", &openapi3.PathItem{Patch: body("OrgDetails")}),
+	)}
+
+	tests := map[string]bool{
+		"UpdateUser": true,
+		"OrgDetails": true,
+		"CreateUser": false,
+		"User":       false,
+	}
+	for name, want := range tests {
+		if got := isUpdateBody(name, spec); got != want {
+			t.Errorf("isUpdateBody(%q) = %t, expected %t", name, got, want)
+		}
+	}
+}
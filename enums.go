@@ -0,0 +1,93 @@
+package kittycad
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// enum is implemented by the enum types, like UnitLength.
+type enum interface {
+	IsValid() bool
+}
+
+// ValidateEnums returns an error for the first enum value in v which is not
+// one of the known values of its type, looking through the fields of
+// structs, pointers, interfaces, slices and maps, like values decoded from
+// JSON. Empty values are accepted, as they stand for unset fields.
+//
+// Decoding accepts unknown enum values, so that new values added to the API
+// do not break older clients. Validate decoded values to reject them, for
+// example in config files. Client.WithStrictEnums does it for responses.
+func ValidateEnums(v any) error {
+	return validateEnums(reflect.ValueOf(v), "")
+}
+
+func validateEnums(v reflect.Value, path string) error {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if !v.CanInterface() {
+			return nil
+		}
+		if e, ok := v.Interface().(enum); ok && v.Len() > 0 && !e.IsValid() {
+			if path == "" {
+				return fmt.Errorf("invalid %s %q", v.Type().Name(), v.String())
+			}
+			return fmt.Errorf("invalid %s %q at %s", v.Type().Name(), v.String(), path)
+		}
+	case reflect.Pointer, reflect.Interface:
+		return validateEnums(v.Elem(), path)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			if err := validateEnums(v.Field(i), name); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		// Bytes, like the contents of files, hold no enums.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := range v.Len() {
+			if err := validateEnums(v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		// Check the keys in order, so the same value reports the same error.
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		})
+		for _, key := range keys {
+			name := fmt.Sprintf("%s[%v]", path, key)
+			if err := validateEnums(key, name); err != nil {
+				return err
+			}
+			if err := validateEnums(v.MapIndex(key), name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
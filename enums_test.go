@@ -0,0 +1,41 @@
+package kittycad
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateEnums(t *testing.T) {
+	for _, test := range []struct {
+		json string
+		err  string
+	}{
+		{json: `{"unit":"mm","formats":["step"],"outputs":{"stl":"mm"}}`},
+		{json: `{"unit":""}`},
+		{json: `{"unit":"parsecs"}`, err: `invalid UnitLength "parsecs" at unit`},
+		{json: `{"formats":["step","dwg"]}`, err: `invalid FileImportFormat "dwg" at formats[1]`},
+		{json: `{"outputs":{"stl":"mm","dwg":"mm"}}`, err: `invalid FileExportFormat "dwg" at outputs[dwg]`},
+		{json: `{"outputs":{"stl":"parsecs"}}`, err: `invalid UnitLength "parsecs" at outputs[stl]`},
+	} {
+		var v struct {
+			Unit    *UnitLength                     `json:"unit"`
+			Formats []FileImportFormat              `json:"formats"`
+			Outputs map[FileExportFormat]UnitLength `json:"outputs"`
+		}
+		if err := json.Unmarshal([]byte(test.json), &v); err != nil {
+			t.Fatalf("decoding %s failed: %v", test.json, err)
+		}
+
+		err := ValidateEnums(&v)
+		if test.err == "" && err != nil {
+			t.Errorf("expected %s to be valid, got %v", test.json, err)
+		}
+		if test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("expected %s to fail with %q, got %v", test.json, test.err, err)
+		}
+	}
+
+	if err := ValidateEnums(UnitLength("parsecs")); err == nil || err.Error() != `invalid UnitLength "parsecs"` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -3,6 +3,7 @@
 package kittycad_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -90,7 +91,7 @@ func ExampleMetaService_GetIpinfo() {
 // Parameters
 //
 //   - `outputFormat`: The valid types of output file formats.
-//   - `kcl`
+//   - `params`: The query parameters, see MlCreateTextToCadParams.
 //   - `body`: Body for generating parts from text.
 func ExampleMlService_CreateTextToCad() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -98,7 +99,7 @@ func ExampleMlService_CreateTextToCad() {
 		panic(err)
 	}
 
-	result, err := client.Ml.CreateTextToCad("", true, kittycad.TextToCadCreateBody{KclVersion: "some-string", ModelVersion: "some-string", ProjectName: "some-string", Prompt: "some-string"})
+	result, err := client.Ml.CreateTextToCad("", kittycad.MlCreateTextToCadParams{Kcl: new(true)}, kittycad.TextToCadCreateBody{KclVersion: new("some-string"), ModelVersion: new("some-string"), ProjectName: new("some-string"), Prompt: "some-string"})
 	if err != nil {
 		panic(err)
 	}
@@ -260,7 +261,7 @@ func ExampleHiddenService_AuthEmail() {
 		panic(err)
 	}
 
-	result, err := client.Hidden.AuthEmail(kittycad.EmailAuthenticationForm{CallbackUrl: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, Email: "example@example.com"})
+	result, err := client.Hidden.AuthEmail(kittycad.EmailAuthenticationForm{CallbackUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}), Email: "example@example.com"})
 	if err != nil {
 		panic(err)
 	}
@@ -288,16 +289,14 @@ func ExampleHiddenService_AuthEmailMarketingConfirmCreate() {
 // AuthEmailCallback: Listen for callbacks for email authentication for users.
 // Parameters
 //
-//   - `callbackUrl`
-//   - `token`
-//   - `email`
+//   - `params`: The query parameters, see HiddenAuthEmailCallbackParams.
 func ExampleHiddenService_AuthEmailCallback() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Hidden.AuthEmailCallback(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, "some-string", "example@example.com"); err != nil {
+	if err := client.Hidden.AuthEmailCallback(kittycad.HiddenAuthEmailCallbackParams{CallbackUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}), Token: "some-string", Email: "example@example.com"}); err != nil {
 		panic(err)
 	}
 
@@ -309,14 +308,14 @@ func ExampleHiddenService_AuthEmailCallback() {
 // Parameters
 //
 //   - `orgId`: A UUID usually v4 or v7
-//   - `callbackUrl`
+//   - `params`: The query parameters, see HiddenGetAuthSamlByOrgParams.
 func ExampleHiddenService_GetAuthSamlByOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Hidden.GetAuthSamlByOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}); err != nil {
+	if err := client.Hidden.GetAuthSamlByOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.HiddenGetAuthSamlByOrgParams{CallbackUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}})}); err != nil {
 		panic(err)
 	}
 
@@ -328,14 +327,14 @@ func ExampleHiddenService_GetAuthSamlByOrg() {
 // Parameters
 //
 //   - `providerId`: A UUID usually v4 or v7
-//   - `callbackUrl`
+//   - `params`: The query parameters, see HiddenGetAuthSamlParams.
 func ExampleHiddenService_GetAuthSaml() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Hidden.GetAuthSaml(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}); err != nil {
+	if err := client.Hidden.GetAuthSaml(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.HiddenGetAuthSamlParams{CallbackUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}})}); err != nil {
 		panic(err)
 	}
 
@@ -388,8 +387,7 @@ func ExampleMetaService_CommunitySso() {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of length units.
+//   - `params`: The query parameters, see FileCreateCenterOfMassParams.
 //   - `body`
 func ExampleFileService_CreateCenterOfMass() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -397,7 +395,7 @@ func ExampleFileService_CreateCenterOfMass() {
 		panic(err)
 	}
 
-	result, err := client.File.CreateCenterOfMass("", "", []byte("some-binary"))
+	result, err := client.File.CreateCenterOfMass(kittycad.FileCreateCenterOfMassParams{SrcFormat: "", OutputUnit: new(kittycad.UnitLength(""))}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -475,10 +473,7 @@ func ExampleFileService_CreateConversion() {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `materialMass`
-//   - `materialMassUnit`: The valid types of mass units.
-//   - `outputUnit`: The valid types for density units.
+//   - `params`: The query parameters, see FileCreateDensityParams.
 //   - `body`
 func ExampleFileService_CreateDensity() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -486,7 +481,7 @@ func ExampleFileService_CreateDensity() {
 		panic(err)
 	}
 
-	result, err := client.File.CreateDensity("", 123.45, "", "", []byte("some-binary"))
+	result, err := client.File.CreateDensity(kittycad.FileCreateDensityParams{SrcFormat: "", MaterialMass: 123.45, MaterialMassUnit: new(kittycad.UnitMas("")), OutputUnit: new(kittycad.UnitDensity(""))}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -504,7 +499,7 @@ func ExampleFileService_CreateDensity() {
 //
 //     ```json { "description": "The language code is written in.", "oneOf": [ { "description": "The `go` programming language.", "type": "string", "enum": [ "go" ] }, { "description": "The `python` programming language.", "type": "string", "enum": [ "python" ] }, { "description": "The `node` programming language.", "type": "string", "enum": [ "node" ] } ] } ``` </details>
 //
-//   - `output`
+//   - `params`: The query parameters, see ExecutorCreateFileExecutionParams.
 //
 //   - `body`
 func ExampleExecutorService_CreateFileExecution() {
@@ -513,7 +508,7 @@ func ExampleExecutorService_CreateFileExecution() {
 		panic(err)
 	}
 
-	result, err := client.Executor.CreateFileExecution("", "some-string", []byte("some-binary"))
+	result, err := client.Executor.CreateFileExecution("", kittycad.ExecutorCreateFileExecutionParams{Output: new("some-string")}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -535,10 +530,7 @@ func ExampleExecutorService_CreateFileExecution() {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `materialDensity`
-//   - `materialDensityUnit`: The valid types for density units.
-//   - `outputUnit`: The valid types of mass units.
+//   - `params`: The query parameters, see FileCreateMassParams.
 //   - `body`
 func ExampleFileService_CreateMass() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -546,7 +538,7 @@ func ExampleFileService_CreateMass() {
 		panic(err)
 	}
 
-	result, err := client.File.CreateMass("", 123.45, "", "", []byte("some-binary"))
+	result, err := client.File.CreateMass(kittycad.FileCreateMassParams{SrcFormat: "", MaterialDensity: 123.45, MaterialDensityUnit: new(kittycad.UnitDensity("")), OutputUnit: new(kittycad.UnitMas(""))}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -568,8 +560,7 @@ func ExampleFileService_CreateMass() {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of area units.
+//   - `params`: The query parameters, see FileCreateSurfaceAreaParams.
 //   - `body`
 func ExampleFileService_CreateSurfaceArea() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -577,7 +568,7 @@ func ExampleFileService_CreateSurfaceArea() {
 		panic(err)
 	}
 
-	result, err := client.File.CreateSurfaceArea("", "", []byte("some-binary"))
+	result, err := client.File.CreateSurfaceArea(kittycad.FileCreateSurfaceAreaParams{SrcFormat: "", OutputUnit: new(kittycad.UnitArea(""))}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -599,8 +590,7 @@ func ExampleFileService_CreateSurfaceArea() {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of volume units.
+//   - `params`: The query parameters, see FileCreateVolumeParams.
 //   - `body`
 func ExampleFileService_CreateVolume() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -608,7 +598,7 @@ func ExampleFileService_CreateVolume() {
 		panic(err)
 	}
 
-	result, err := client.File.CreateVolume("", "", []byte("some-binary"))
+	result, err := client.File.CreateVolume(kittycad.FileCreateVolumeParams{SrcFormat: "", OutputUnit: new(kittycad.UnitVolume(""))}, []byte("some-binary"))
 	if err != nil {
 		panic(err)
 	}
@@ -661,20 +651,14 @@ func ExampleHiddenService_Logout() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see MlListConversationsForUserParams.
 func ExampleMlService_ListConversationsForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Ml.ListConversationsForUser(123, "some-string", "")
+	result, err := client.Ml.ListConversationsForUser(kittycad.MlListConversationsForUserParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -700,12 +684,7 @@ func ExampleMlService_ListConversationsForUser() {
 //
 // Parameters
 //
-//   - `codeOption`: `CodeOption`
-//
-//     <details><summary>JSON schema</summary>
-//
-//     ```json { "type": "string", "enum": [ "parse", "mock_execute", "execute" ] } ``` </details>
-//
+//   - `params`: The query parameters, see MlCreateProprietaryToKclParams.
 //   - `body`
 func ExampleMlService_CreateProprietaryToKcl() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -715,7 +694,7 @@ func ExampleMlService_CreateProprietaryToKcl() {
 
 	form := kittycad.NewMultipartForm()
 
-	result, err := client.Ml.CreateProprietaryToKcl(kittycad.CodeOptionParse, form)
+	result, err := client.Ml.CreateProprietaryToKcl(kittycad.MlCreateProprietaryToKclParams{CodeOption: new(kittycad.CodeOptionParse)}, form)
 	if err != nil {
 		panic(err)
 	}
@@ -736,7 +715,7 @@ func ExampleMlService_CreateCustomModel() {
 		panic(err)
 	}
 
-	result, err := client.Ml.CreateCustomModel(kittycad.CreateCustomModel{DatasetIds: []kittycad.UUID{}, Name: "some-string", SystemPrompt: "some-string"})
+	result, err := client.Ml.CreateCustomModel(kittycad.CreateCustomModel{DatasetIds: []kittycad.UUID{}, Name: "some-string", SystemPrompt: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -775,7 +754,7 @@ func ExampleMlService_UpdateCustomModel() {
 		panic(err)
 	}
 
-	result, err := client.Ml.UpdateCustomModel(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateCustomModel{Name: "some-string", SystemPrompt: "some-string"})
+	result, err := client.Ml.UpdateCustomModel(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateCustomModel{Name: new("some-string"), SystemPrompt: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -813,7 +792,7 @@ func ExampleMlService_CreateKclCodeCompletions() {
 		panic(err)
 	}
 
-	result, err := client.Ml.CreateKclCodeCompletions(kittycad.KclCodeCompletionRequest{Extra: kittycad.KclCodeCompletionParams{Language: "some-string", NextIndent: 123, PromptTokens: 123, SuffixTokens: 123, TrimByIndentation: true}, MaxTokens: 123, ModelVersion: "some-string", N: 123, Nwo: "some-string", Prompt: "some-string", Stop: []string{}, Stream: true, Suffix: "some-string", Temperature: 123.45, TopP: 123.45})
+	result, err := client.Ml.CreateKclCodeCompletions(kittycad.KclCodeCompletionRequest{Extra: new(kittycad.KclCodeCompletionParams{Language: new("some-string"), NextIndent: new(123), PromptTokens: new(123), SuffixTokens: new(123), TrimByIndentation: new(true)}), MaxTokens: new(123), ModelVersion: new("some-string"), N: new(123), Nwo: new("some-string"), Prompt: new("some-string"), Stop: []string{}, Stream: new(true), Suffix: new("some-string"), Temperature: new(123.45), TopP: new(123.45)})
 	if err != nil {
 		panic(err)
 	}
@@ -842,7 +821,7 @@ func ExampleMlService_CreateTextToCadIteration() {
 		panic(err)
 	}
 
-	result, err := client.Ml.CreateTextToCadIteration(kittycad.TextToCadIterationBody{KclVersion: "some-string", OriginalSourceCode: "some-string", ProjectName: "some-string", Prompt: "some-string", SourceRanges: []kittycad.SourceRangePrompt{}})
+	result, err := client.Ml.CreateTextToCadIteration(kittycad.TextToCadIterationBody{KclVersion: new("some-string"), OriginalSourceCode: "some-string", ProjectName: new("some-string"), Prompt: new("some-string"), SourceRanges: []kittycad.SourceRangePrompt{}})
 	if err != nil {
 		panic(err)
 	}
@@ -944,20 +923,14 @@ func ExampleOauth2Service_DenyAuthorizationRequest() {
 // Authorize: Start an OAuth 2.0 authorization code flow with PKCE.
 // Parameters
 //
-//   - `responseType`: The OAuth 2.0 authorization response type.
-//   - `clientId`
-//   - `redirectUri`
-//   - `state`
-//   - `scope`: OAuth 2.0 scopes encoded as a space-delimited string.
-//   - `codeChallenge`
-//   - `codeChallengeMethod`: The PKCE code challenge method.
+//   - `params`: The query parameters, see Oauth2AuthorizeParams.
 func ExampleOauth2Service_Authorize() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Oauth2.Authorize("", kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, "some-string", "some-string", "some-string", ""); err != nil {
+	if err := client.Oauth2.Authorize(kittycad.Oauth2AuthorizeParams{ResponseType: "", ClientID: kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), RedirectUri: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, State: "some-string", Scope: new("some-string"), CodeChallenge: "some-string", CodeChallengeMethod: ""}); err != nil {
 		panic(err)
 	}
 
@@ -1022,15 +995,14 @@ func ExampleOauth2Service_DeviceAccessToken() {
 //
 // Parameters
 //
-//   - `userCode`
-//   - `appName`
+//   - `params`: The query parameters, see Oauth2DeviceAuthVerifyParams.
 func ExampleOauth2Service_DeviceAuthVerify() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Oauth2.DeviceAuthVerify("some-string", "some-string"); err != nil {
+	if err := client.Oauth2.DeviceAuthVerify(kittycad.Oauth2DeviceAuthVerifyParams{UserCode: "some-string", AppName: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1040,17 +1012,14 @@ func ExampleOauth2Service_DeviceAuthVerify() {
 // Parameters
 //
 //   - `provider`: An account provider.
-//   - `code`
-//   - `state`
-//   - `idToken`
-//   - `user`
+//   - `params`: The query parameters, see Oauth2ProviderCallbackParams.
 func ExampleOauth2Service_ProviderCallback() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Oauth2.ProviderCallback("", "some-string", "some-string", "some-string", "some-string"); err != nil {
+	if err := client.Oauth2.ProviderCallback("", kittycad.Oauth2ProviderCallbackParams{Code: new("some-string"), State: new("some-string"), IdToken: new("some-string"), User: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1069,7 +1038,7 @@ func ExampleOauth2Service_ProviderCallbackCreate() {
 		panic(err)
 	}
 
-	if err := client.Oauth2.ProviderCallbackCreate("", kittycad.AuthCallback{Code: "some-string", IdToken: "some-string", State: "some-string", User: "some-string"}); err != nil {
+	if err := client.Oauth2.ProviderCallbackCreate("", kittycad.AuthCallback{Code: new("some-string"), IdToken: new("some-string"), State: new("some-string"), User: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1079,14 +1048,14 @@ func ExampleOauth2Service_ProviderCallbackCreate() {
 // Parameters
 //
 //   - `provider`: An account provider.
-//   - `callbackUrl`
+//   - `params`: The query parameters, see Oauth2ProviderConsentParams.
 func ExampleOauth2Service_ProviderConsent() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.ProviderConsent("", "some-string")
+	result, err := client.Oauth2.ProviderConsent("", kittycad.Oauth2ProviderConsentParams{CallbackUrl: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -1105,7 +1074,7 @@ func ExampleOauth2Service_Token() {
 		panic(err)
 	}
 
-	if err := client.Oauth2.Token(kittycad.Oauth2TokenRequestForm{ClientID: kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), Code: "some-string", CodeVerifier: "some-string", GrantType: "", RedirectUri: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, RefreshToken: "some-string"}); err != nil {
+	if err := client.Oauth2.Token(kittycad.Oauth2TokenRequestForm{ClientID: kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), Code: new("some-string"), CodeVerifier: new("some-string"), GrantType: "", RedirectUri: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}), RefreshToken: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1123,7 +1092,7 @@ func ExampleOauth2Service_TokenRevoke() {
 		panic(err)
 	}
 
-	if err := client.Oauth2.TokenRevoke(kittycad.TokenRevokeRequestForm{ClientID: kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ClientSecret: "some-string", Token: "some-string"}); err != nil {
+	if err := client.Oauth2.TokenRevoke(kittycad.TokenRevokeRequestForm{ClientID: kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ClientSecret: new("some-string"), Token: "some-string"}); err != nil {
 		panic(err)
 	}
 
@@ -1134,15 +1103,14 @@ func ExampleOauth2Service_TokenRevoke() {
 //
 // Parameters
 //
-//   - `token`
-//   - `callbackUrl`
+//   - `params`: The query parameters, see Oauth2VerifyOauthAccountLinkingParams.
 func ExampleOauth2Service_VerifyOauthAccountLinking() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Oauth2.VerifyOauthAccountLinking("some-string", "some-string"); err != nil {
+	if err := client.Oauth2.VerifyOauthAccountLinking(kittycad.Oauth2VerifyOauthAccountLinkingParams{Token: "some-string", CallbackUrl: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1177,7 +1145,7 @@ func ExampleOrgService_Create() {
 		panic(err)
 	}
 
-	result, err := client.Org.Create(kittycad.OrgDetails{AllowUsersInDomainToAutoJoin: true, BillingEmail: "example@example.com", Domain: "some-string", Image: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Org.Create(kittycad.OrgDetails{AllowUsersInDomainToAutoJoin: new(true), BillingEmail: new("example@example.com"), Domain: new("some-string"), Image: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -1198,7 +1166,7 @@ func ExampleOrgService_Update() {
 		panic(err)
 	}
 
-	result, err := client.Org.Update(kittycad.OrgDetails{AllowUsersInDomainToAutoJoin: true, BillingEmail: "example@example.com", Domain: "some-string", Image: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Org.Update(kittycad.OrgDetails{AllowUsersInDomainToAutoJoin: new(true), BillingEmail: new("example@example.com"), Domain: new("some-string"), Image: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -1234,20 +1202,14 @@ func ExampleOrgService_Delete() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see APICallOrgListParams.
 func ExampleAPICallService_OrgList() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.APICall.OrgList(123, "some-string", "")
+	result, err := client.APICall.OrgList(kittycad.APICallOrgListParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -1354,20 +1316,14 @@ func ExampleOrgService_DatasetS3Policies() {
 // ListDatasets: List every dataset that belongs to the caller's organization.
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see OrgListDatasetsParams.
 func ExampleOrgService_ListDatasets() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.ListDatasets(123, "some-string", "")
+	result, err := client.Org.ListDatasets(kittycad.OrgListDatasetsParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -1388,7 +1344,7 @@ func ExampleOrgService_CreateDataset() {
 		panic(err)
 	}
 
-	result, err := client.Org.CreateDataset(kittycad.CreateOrgDataset{Description: "some-string", Name: "some-string", RequireRawKclSimilarityScoreForSuccess: true, Source: kittycad.OrgDatasetSource{AccessRoleArn: "some-string", Provider: "", Uri: "some-string"}})
+	result, err := client.Org.CreateDataset(kittycad.CreateOrgDataset{Description: new("some-string"), Name: "some-string", RequireRawKclSimilarityScoreForSuccess: new(true), Source: kittycad.OrgDatasetSource{AccessRoleArn: new("some-string"), Provider: "", Uri: new("some-string")}})
 	if err != nil {
 		panic(err)
 	}
@@ -1429,7 +1385,7 @@ func ExampleOrgService_UpdateDataset() {
 		panic(err)
 	}
 
-	result, err := client.Org.UpdateDataset(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOrgDataset{Description: "some-string", Name: "some-string", RequireRawKclSimilarityScoreForSuccess: true, Source: kittycad.UpdateOrgDatasetSource{AccessRoleArn: "some-string", Provider: "", Uri: "some-string"}})
+	result, err := client.Org.UpdateDataset(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOrgDataset{Description: new("some-string"), Name: new("some-string"), RequireRawKclSimilarityScoreForSuccess: new(true), Source: new(kittycad.UpdateOrgDatasetSource{AccessRoleArn: new("some-string"), Provider: new(kittycad.StorageProvider("")), Uri: new("some-string")})})
 	if err != nil {
 		panic(err)
 	}
@@ -1480,19 +1436,14 @@ func ExampleOrgService_DownloadDatasetSuccessfulKclBulk() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `limit`
-//   - `pageToken`
-//   - `sortBy`: Supported sort modes for org dataset conversions.
-//   - `filter`
-//   - `q`
-//   - `phase`
+//   - `params`: The query parameters, see OrgListDatasetConversionsParams.
 func ExampleOrgService_ListDatasetConversions() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.ListDatasetConversions(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), 123, "some-string", "", "some-string", "some-string", "some-string")
+	result, err := client.Org.ListDatasetConversions(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.OrgListDatasetConversionsParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.ConversionSortMode("")), Filter: new("some-string"), Q: new("some-string"), Phase: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -1561,14 +1512,14 @@ func ExampleOrgService_RetriggerDatasetConversion() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `statuses`
+//   - `params`: The query parameters, see OrgRetriggerDatasetParams.
 func ExampleOrgService_RetriggerDataset() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Org.RetriggerDataset(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "some-string"); err != nil {
+	if err := client.Org.RetriggerDataset(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.OrgRetriggerDatasetParams{Statuses: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -1580,19 +1531,14 @@ func ExampleOrgService_RetriggerDataset() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `limit`
-//   - `pageToken`
-//   - `q`
-//   - `sortBy`: Supported sort modes for org dataset conversions.
-//   - `filter`
-//   - `phase`
+//   - `params`: The query parameters, see OrgSearchDatasetConversionsParams.
 func ExampleOrgService_SearchDatasetConversions() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.SearchDatasetConversions(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), 123, "some-string", "some-string", "", "some-string", "some-string")
+	result, err := client.Org.SearchDatasetConversions(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.OrgSearchDatasetConversionsParams{Limit: new(123), PageToken: new("some-string"), Q: new("some-string"), SortBy: new(kittycad.ConversionSortMode("")), Filter: new("some-string"), Phase: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -1607,15 +1553,14 @@ func ExampleOrgService_SearchDatasetConversions() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `q`
-//   - `limit`
+//   - `params`: The query parameters, see OrgSearchDatasetSemanticParams.
 func ExampleOrgService_SearchDatasetSemantic() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.SearchDatasetSemantic(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "some-string", 123)
+	result, err := client.Org.SearchDatasetSemantic(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.OrgSearchDatasetSemanticParams{Q: "some-string", Limit: new(123)})
 	if err != nil {
 		panic(err)
 	}
@@ -1672,22 +1617,14 @@ func ExampleOrgService_UploadDatasetFiles() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-//
-//   - `role`: The roles for users in an organization.
+//   - `params`: The query parameters, see OrgListMembersParams.
 func ExampleOrgService_ListMembers() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.ListMembers(123, "some-string", "", "")
+	result, err := client.Org.ListMembers(kittycad.OrgListMembersParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode("")), Role: new(kittycad.UserOrgRole(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -1791,20 +1728,14 @@ func ExampleOrgService_DeleteMember() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see Oauth2ListOrgAppsParams.
 func ExampleOauth2Service_ListOrgApps() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.ListOrgApps(123, "some-string", "")
+	result, err := client.Oauth2.ListOrgApps(kittycad.Oauth2ListOrgAppsParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -1825,7 +1756,7 @@ func ExampleOauth2Service_CreateOrgApp() {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.CreateOrgApp(kittycad.CreateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: "", Name: "some-string", RedirectUris: []kittycad.URL{}})
+	result, err := client.Oauth2.CreateOrgApp(kittycad.CreateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: new(kittycad.Oauth2AppMode("")), Name: "some-string", RedirectUris: []kittycad.URL{}})
 	if err != nil {
 		panic(err)
 	}
@@ -1868,7 +1799,7 @@ func ExampleOauth2Service_UpdateOrgApp() {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.UpdateOrgApp(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: "", Name: "some-string", RedirectUris: []kittycad.URL{}})
+	result, err := client.Oauth2.UpdateOrgApp(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: new(kittycad.Oauth2AppMode("")), Name: new("some-string"), RedirectUris: []kittycad.URL{}})
 	if err != nil {
 		panic(err)
 	}
@@ -1928,7 +1859,7 @@ func ExamplePaymentService_CreateInformationForOrg() {
 		panic(err)
 	}
 
-	result, err := client.Payment.CreateInformationForOrg(kittycad.BillingInfo{Address: kittycad.AddressDetails{City: "some-string", Country: "some-string", State: "some-string", Street1: "some-string", Street2: "some-string", Zip: "some-string"}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Payment.CreateInformationForOrg(kittycad.BillingInfo{Address: new(kittycad.AddressDetails{City: new("some-string"), Country: "some-string", State: new("some-string"), Street1: new("some-string"), Street2: new("some-string"), Zip: new("some-string")}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -1951,7 +1882,7 @@ func ExamplePaymentService_UpdateInformationForOrg() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateInformationForOrg(kittycad.BillingInfo{Address: kittycad.AddressDetails{City: "some-string", Country: "some-string", State: "some-string", Street1: "some-string", Street2: "some-string", Zip: "some-string"}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Payment.UpdateInformationForOrg(kittycad.BillingInfo{Address: new(kittycad.AddressDetails{City: new("some-string"), Country: "some-string", State: new("some-string"), Street1: new("some-string"), Street2: new("some-string"), Zip: new("some-string")}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -1981,14 +1912,14 @@ func ExamplePaymentService_DeleteInformationForOrg() {
 //
 // Parameters
 //
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentGetBalanceForOrgParams.
 func ExamplePaymentService_GetBalanceForOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.GetBalanceForOrg(true)
+	result, err := client.Payment.GetBalanceForOrg(kittycad.PaymentGetBalanceForOrgParams{IncludeTotalDue: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -2019,15 +1950,14 @@ func ExamplePaymentService_CreateIntentForOrg() {
 //
 // Parameters
 //
-//   - `limit`
-//   - `pageToken`
+//   - `params`: The query parameters, see PaymentListInvoicesForOrgParams.
 func ExamplePaymentService_ListInvoicesForOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.ListInvoicesForOrg(123, "some-string")
+	result, err := client.Payment.ListInvoicesForOrg(kittycad.PaymentListInvoicesForOrgParams{Limit: new(123), PageToken: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -2041,14 +1971,14 @@ func ExamplePaymentService_ListInvoicesForOrg() {
 //
 // Parameters
 //
-//   - `returnUrl`
+//   - `params`: The query parameters, see PaymentRedirectMethodPortalLinkForOrgParams.
 func ExamplePaymentService_RedirectMethodPortalLinkForOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Payment.RedirectMethodPortalLinkForOrg(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}); err != nil {
+	if err := client.Payment.RedirectMethodPortalLinkForOrg(kittycad.PaymentRedirectMethodPortalLinkForOrgParams{ReturnUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}})}); err != nil {
 		panic(err)
 	}
 
@@ -2118,7 +2048,7 @@ func ExamplePaymentService_CreateOrgSubscription() {
 		panic(err)
 	}
 
-	result, err := client.Payment.CreateOrgSubscription(kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.Payment.CreateOrgSubscription(kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -2139,7 +2069,7 @@ func ExamplePaymentService_UpdateOrgSubscription() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateOrgSubscription(kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.Payment.UpdateOrgSubscription(kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -2229,7 +2159,7 @@ func ExampleOrgService_CreateSamlIdp() {
 		panic(err)
 	}
 
-	result, err := client.Org.CreateSamlIdp(kittycad.SamlIdentityProviderCreate{IdpEntityID: "some-string", IdpMetadataSource: "", SigningKeypair: kittycad.DerEncodedKeyPair{PrivateKey: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}, PublicCert: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}}, TechnicalContactEmail: "example@example.com"})
+	result, err := client.Org.CreateSamlIdp(kittycad.SamlIdentityProviderCreate{IdpEntityID: new("some-string"), IdpMetadataSource: "", SigningKeypair: new(kittycad.DerEncodedKeyPair{PrivateKey: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}, PublicCert: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}}), TechnicalContactEmail: new("example@example.com")})
 	if err != nil {
 		panic(err)
 	}
@@ -2250,7 +2180,7 @@ func ExampleOrgService_UpdateSamlIdp() {
 		panic(err)
 	}
 
-	result, err := client.Org.UpdateSamlIdp(kittycad.SamlIdentityProviderCreate{IdpEntityID: "some-string", IdpMetadataSource: "", SigningKeypair: kittycad.DerEncodedKeyPair{PrivateKey: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}, PublicCert: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}}, TechnicalContactEmail: "example@example.com"})
+	result, err := client.Org.UpdateSamlIdp(kittycad.SamlIdentityProviderCreate{IdpEntityID: new("some-string"), IdpMetadataSource: "", SigningKeypair: new(kittycad.DerEncodedKeyPair{PrivateKey: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}, PublicCert: kittycad.Base64{Inner: []byte("aGVsbG8gd29ybGQK")}}), TechnicalContactEmail: new("example@example.com")})
 	if err != nil {
 		panic(err)
 	}
@@ -2280,20 +2210,14 @@ func ExampleOrgService_DeleteSamlIdp() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see ServiceAccountListForOrgParams.
 func ExampleServiceAccountService_ListForOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.ServiceAccount.ListForOrg(123, "some-string", "")
+	result, err := client.ServiceAccount.ListForOrg(kittycad.ServiceAccountListForOrgParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -2307,14 +2231,14 @@ func ExampleServiceAccountService_ListForOrg() {
 //
 // Parameters
 //
-//   - `label`
+//   - `params`: The query parameters, see ServiceAccountCreateForOrgParams.
 func ExampleServiceAccountService_CreateForOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.ServiceAccount.CreateForOrg("some-string")
+	result, err := client.ServiceAccount.CreateForOrg(kittycad.ServiceAccountCreateForOrgParams{Label: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -2371,20 +2295,14 @@ func ExampleServiceAccountService_DeleteForOrg() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see OrgGetShortlinksParams.
 func ExampleOrgService_GetShortlinks() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Org.GetShortlinks(123, "some-string", "")
+	result, err := client.Org.GetShortlinks(kittycad.OrgGetShortlinksParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -2443,7 +2361,7 @@ func ExampleOrgService_UpsertBillingContractForAny() {
 		panic(err)
 	}
 
-	result, err := client.Org.UpsertBillingContractForAny(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.BillingContractUpsert{BillingCadence: "", CommitmentScope: "", Currency: "some-string", DiscountDescription: "some-string", EffectiveAt: kittycad.TimeNow(), ExternalCustomerID: "some-string", Items: []kittycad.BillingContractItemInput{}, Name: "some-string", Notes: "some-string", Periods: []kittycad.BillingPeriodInput{}, Provider: "", RolloverPolicy: "", Status: "", TermEndAt: kittycad.TimeNow()})
+	result, err := client.Org.UpsertBillingContractForAny(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.BillingContractUpsert{BillingCadence: "", CommitmentScope: "", Currency: "some-string", DiscountDescription: new("some-string"), EffectiveAt: kittycad.TimeNow(), ExternalCustomerID: new("some-string"), Items: []kittycad.BillingContractItemInput{}, Name: "some-string", Notes: new("some-string"), Periods: []kittycad.BillingPeriodInput{}, Provider: "", RolloverPolicy: "", Status: "", TermEndAt: kittycad.TimeNow()})
 	if err != nil {
 		panic(err)
 	}
@@ -2458,21 +2376,14 @@ func ExampleOrgService_UpsertBillingContractForAny() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see Oauth2ListAppsForAnyOrgParams.
 func ExampleOauth2Service_ListAppsForAnyOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.ListAppsForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), 123, "some-string", "")
+	result, err := client.Oauth2.ListAppsForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.Oauth2ListAppsForAnyOrgParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -2486,15 +2397,15 @@ func ExampleOauth2Service_ListAppsForAnyOrg() {
 //
 // Parameters
 //
-//   - `includeTotalDue`
 //   - `id`: A UUID usually v4 or v7
+//   - `params`: The query parameters, see PaymentGetBalanceForAnyOrgParams.
 func ExamplePaymentService_GetBalanceForAnyOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.GetBalanceForAnyOrg(true, kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	result, err := client.Payment.GetBalanceForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.PaymentGetBalanceForAnyOrgParams{IncludeTotalDue: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -2509,7 +2420,7 @@ func ExamplePaymentService_GetBalanceForAnyOrg() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentUpdateBalanceForAnyOrgParams.
 //   - `body`: Payload for updating a user's balance.
 func ExamplePaymentService_UpdateBalanceForAnyOrg() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -2517,7 +2428,7 @@ func ExamplePaymentService_UpdateBalanceForAnyOrg() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateBalanceForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), true, kittycad.UpdatePaymentBalance{MonthlyAPICreditsRemainingMonetaryValue: 123.45, StableAPICreditsRemainingMonetaryValue: 123.45})
+	result, err := client.Payment.UpdateBalanceForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.PaymentUpdateBalanceForAnyOrgParams{IncludeTotalDue: new(true)}, kittycad.UpdatePaymentBalance{MonthlyAPICreditsRemainingMonetaryValue: new(123.45), StableAPICreditsRemainingMonetaryValue: new(123.45)})
 	if err != nil {
 		panic(err)
 	}
@@ -2539,7 +2450,7 @@ func ExamplePaymentService_UpdateOrgSubscriptionForAnyOrg() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateOrgSubscriptionForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.Payment.UpdateOrgSubscriptionForAnyOrg(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.ZooProductSubscriptionsOrgRequest{ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -2636,14 +2547,14 @@ func ExampleProjectService_GetPublic() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `format`: Archive formats supported by project download endpoints.
+//   - `params`: The query parameters, see ProjectDownloadPublicParams.
 func ExampleProjectService_DownloadPublic() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Project.DownloadPublic(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ""); err != nil {
+	if err := client.Project.DownloadPublic(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.ProjectDownloadPublicParams{Format: new(kittycad.ProjectArchiveFormat(""))}); err != nil {
 		panic(err)
 	}
 
@@ -2707,14 +2618,14 @@ func ExampleProjectService_DeletePublicVote() {
 // Parameters
 //
 //   - `key`
-//   - `format`: Archive formats supported by project download endpoints.
+//   - `params`: The query parameters, see HiddenDownloadSharedProjectParams.
 func ExampleHiddenService_DownloadSharedProject() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Hidden.DownloadSharedProject("some-string", ""); err != nil {
+	if err := client.Hidden.DownloadSharedProject("some-string", kittycad.HiddenDownloadSharedProjectParams{Format: new(kittycad.ProjectArchiveFormat(""))}); err != nil {
 		panic(err)
 	}
 
@@ -2754,7 +2665,7 @@ func ExamplePaymentService_UpsertSubscriptionPlanPrice() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpsertSubscriptionPlanPrice("some-string", kittycad.PriceUpsertRequest{Active: true, BillingModel: "", Cadence: "", UnitAmount: 123.45})
+	result, err := client.Payment.UpsertSubscriptionPlanPrice("some-string", kittycad.PriceUpsertRequest{Active: new(true), BillingModel: "", Cadence: "", UnitAmount: 123.45})
 	if err != nil {
 		panic(err)
 	}
@@ -3093,7 +3004,7 @@ func ExampleUserService_UpdateSelf() {
 		panic(err)
 	}
 
-	result, err := client.User.UpdateSelf(kittycad.UpdateUser{AllowPayAsYouGo: true, Company: "some-string", Discord: "some-string", FirstName: "some-string", Github: "some-string", Image: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, IsOnboarded: true, LastName: "some-string", Phone: "+1-555-555-555", Username: "some-string"})
+	result, err := client.User.UpdateSelf(kittycad.UpdateUser{AllowPayAsYouGo: new(true), Company: new("some-string"), Discord: new("some-string"), FirstName: new("some-string"), Github: new("some-string"), Image: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}, IsOnboarded: new(true), LastName: new("some-string"), Phone: new("+1-555-555-555"), Username: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -3125,20 +3036,14 @@ func ExampleUserService_DeleteSelf() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see APICallUserListParams.
 func ExampleAPICallService_UserList() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.APICall.UserList(123, "some-string", "")
+	result, err := client.APICall.UserList(kittycad.APICallUserListParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -3175,20 +3080,14 @@ func ExampleAPICallService_GetForUser() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see APITokenListForUserParams.
 func ExampleAPITokenService_ListForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.APIToken.ListForUser(123, "some-string", "")
+	result, err := client.APIToken.ListForUser(kittycad.APITokenListForUserParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -3202,14 +3101,14 @@ func ExampleAPITokenService_ListForUser() {
 //
 // Parameters
 //
-//   - `label`
+//   - `params`: The query parameters, see APITokenCreateForUserParams.
 func ExampleAPITokenService_CreateForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.APIToken.CreateForUser("some-string")
+	result, err := client.APIToken.CreateForUser(kittycad.APITokenCreateForUserParams{Label: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -3344,7 +3243,7 @@ func ExampleUserService_ReportClientError() {
 		panic(err)
 	}
 
-	result, err := client.User.ReportClientError(kittycad.ClientErrorReport{Client: "some-string", Code: "some-string", ErrorName: "some-string", Message: "some-string", Release: "some-string", Route: "some-string", Stack: "some-string"})
+	result, err := client.User.ReportClientError(kittycad.ClientErrorReport{Client: "some-string", Code: new("some-string"), ErrorName: new("some-string"), Message: "some-string", Release: "some-string", Route: new("some-string"), Stack: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -3516,20 +3415,14 @@ func ExampleUserService_FeaturesList() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see Oauth2ListUserAppsParams.
 func ExampleOauth2Service_ListUserApps() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.ListUserApps(123, "some-string", "")
+	result, err := client.Oauth2.ListUserApps(kittycad.Oauth2ListUserAppsParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -3550,7 +3443,7 @@ func ExampleOauth2Service_CreateUserApp() {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.CreateUserApp(kittycad.CreateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: "", Name: "some-string", RedirectUris: []kittycad.URL{}})
+	result, err := client.Oauth2.CreateUserApp(kittycad.CreateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: new(kittycad.Oauth2AppMode("")), Name: "some-string", RedirectUris: []kittycad.URL{}})
 	if err != nil {
 		panic(err)
 	}
@@ -3593,7 +3486,7 @@ func ExampleOauth2Service_UpdateUserApp() {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.UpdateUserApp(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: "", Name: "some-string", RedirectUris: []kittycad.URL{}})
+	result, err := client.Oauth2.UpdateUserApp(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.UpdateOauth2AppRequest{GrantTypes: []kittycad.Oauth2AppGrantType{}, Mode: new(kittycad.Oauth2AppMode("")), Name: new("some-string"), RedirectUris: []kittycad.URL{}})
 	if err != nil {
 		panic(err)
 	}
@@ -3691,7 +3584,7 @@ func ExamplePaymentService_CreateInformationForUser() {
 		panic(err)
 	}
 
-	result, err := client.Payment.CreateInformationForUser(kittycad.BillingInfo{Address: kittycad.AddressDetails{City: "some-string", Country: "some-string", State: "some-string", Street1: "some-string", Street2: "some-string", Zip: "some-string"}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Payment.CreateInformationForUser(kittycad.BillingInfo{Address: new(kittycad.AddressDetails{City: new("some-string"), Country: "some-string", State: new("some-string"), Street1: new("some-string"), Street2: new("some-string"), Zip: new("some-string")}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -3714,7 +3607,7 @@ func ExamplePaymentService_UpdateInformationForUser() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateInformationForUser(kittycad.BillingInfo{Address: kittycad.AddressDetails{City: "some-string", Country: "some-string", State: "some-string", Street1: "some-string", Street2: "some-string", Zip: "some-string"}, Name: "some-string", Phone: "+1-555-555-555"})
+	result, err := client.Payment.UpdateInformationForUser(kittycad.BillingInfo{Address: new(kittycad.AddressDetails{City: new("some-string"), Country: "some-string", State: new("some-string"), Street1: new("some-string"), Street2: new("some-string"), Zip: new("some-string")}), Name: new("some-string"), Phone: new("+1-555-555-555")})
 	if err != nil {
 		panic(err)
 	}
@@ -3744,14 +3637,14 @@ func ExamplePaymentService_DeleteInformationForUser() {
 //
 // Parameters
 //
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentGetBalanceForUserParams.
 func ExamplePaymentService_GetBalanceForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.GetBalanceForUser(true)
+	result, err := client.Payment.GetBalanceForUser(kittycad.PaymentGetBalanceForUserParams{IncludeTotalDue: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -3782,15 +3675,14 @@ func ExamplePaymentService_CreateIntentForUser() {
 //
 // Parameters
 //
-//   - `limit`
-//   - `pageToken`
+//   - `params`: The query parameters, see PaymentListInvoicesForUserParams.
 func ExamplePaymentService_ListInvoicesForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.ListInvoicesForUser(123, "some-string")
+	result, err := client.Payment.ListInvoicesForUser(kittycad.PaymentListInvoicesForUserParams{Limit: new(123), PageToken: new("some-string")})
 	if err != nil {
 		panic(err)
 	}
@@ -3804,14 +3696,14 @@ func ExamplePaymentService_ListInvoicesForUser() {
 //
 // Parameters
 //
-//   - `returnUrl`
+//   - `params`: The query parameters, see PaymentRedirectMethodPortalLinkForUserParams.
 func ExamplePaymentService_RedirectMethodPortalLinkForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Payment.RedirectMethodPortalLinkForUser(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}); err != nil {
+	if err := client.Payment.RedirectMethodPortalLinkForUser(kittycad.PaymentRedirectMethodPortalLinkForUserParams{ReturnUrl: new(kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}})}); err != nil {
 		panic(err)
 	}
 
@@ -3899,7 +3791,7 @@ func ExamplePaymentService_CreateUserSubscription() {
 		panic(err)
 	}
 
-	result, err := client.Payment.CreateUserSubscription(kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: "", DowngradeReasonText: "some-string", ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.Payment.CreateUserSubscription(kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: new(kittycad.ZooProductSubscriptionDowngradeReason("")), DowngradeReasonText: new("some-string"), ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -3920,7 +3812,7 @@ func ExamplePaymentService_UpdateUserSubscription() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateUserSubscription(kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: "", DowngradeReasonText: "some-string", ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.Payment.UpdateUserSubscription(kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: new(kittycad.ZooProductSubscriptionDowngradeReason("")), DowngradeReasonText: new("some-string"), ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -4079,14 +3971,14 @@ func ExampleProjectService_Delete() {
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `format`: Archive formats supported by project download endpoints.
+//   - `params`: The query parameters, see ProjectDownloadParams.
 func ExampleProjectService_Download() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	if err := client.Project.Download(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ""); err != nil {
+	if err := client.Project.Download(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.ProjectDownloadParams{Format: new(kittycad.ProjectArchiveFormat(""))}); err != nil {
 		panic(err)
 	}
 
@@ -4141,7 +4033,7 @@ func ExampleProjectService_CreateShareLink() {
 		panic(err)
 	}
 
-	result, err := client.Project.CreateShareLink(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.CreateProjectShareLinkRequest{AccessMode: ""})
+	result, err := client.Project.CreateShareLink(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), kittycad.CreateProjectShareLinkRequest{AccessMode: new(kittycad.KclProjectShareLinkAccessMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -4209,20 +4101,14 @@ func ExampleUserService_GetSessionFor() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see UserGetShortlinksParams.
 func ExampleUserService_GetShortlinks() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.User.GetShortlinks(123, "some-string", "")
+	result, err := client.User.GetShortlinks(kittycad.UserGetShortlinksParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -4243,7 +4129,7 @@ func ExampleUserService_CreateShortlink() {
 		panic(err)
 	}
 
-	result, err := client.User.CreateShortlink(kittycad.CreateShortlinkRequest{Password: "some-string", RestrictToOrg: true, Url: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}})
+	result, err := client.User.CreateShortlink(kittycad.CreateShortlinkRequest{Password: new("some-string"), RestrictToOrg: new(true), Url: kittycad.URL{&url.URL{Scheme: "https", Host: "example.com"}}})
 	if err != nil {
 		panic(err)
 	}
@@ -4285,7 +4171,7 @@ func ExampleUserService_UpdateShortlink() {
 		panic(err)
 	}
 
-	if err := client.User.UpdateShortlink("some-string", kittycad.UpdateShortlinkRequest{Password: "some-string", RestrictToOrg: true}); err != nil {
+	if err := client.User.UpdateShortlink("some-string", kittycad.UpdateShortlinkRequest{Password: new("some-string"), RestrictToOrg: true}); err != nil {
 		panic(err)
 	}
 
@@ -4318,26 +4204,14 @@ func ExampleUserService_DeleteShortlink() {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-//
-//   - `noModels`
-//
-//   - `noParts`
-//
-//   - `conversationId`: A UUID usually v4 or v7
+//   - `params`: The query parameters, see MlListTextToCadPartsForUserParams.
 func ExampleMlService_ListTextToCadPartsForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Ml.ListTextToCadPartsForUser(123, "some-string", "", true, true, kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	result, err := client.Ml.ListTextToCadPartsForUser(kittycad.MlListTextToCadPartsForUserParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode("")), NoModels: new(true), NoParts: new(true), ConversationID: new(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))})
 	if err != nil {
 		panic(err)
 	}
@@ -4467,21 +4341,14 @@ func ExampleUserService_AdminDetailsList() {
 // Parameters
 //
 //   - `id`
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see APICallListForUserParams.
 func ExampleAPICallService_ListForUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.APICall.ListForUser("some-string", 123, "some-string", "")
+	result, err := client.APICall.ListForUser("some-string", kittycad.APICallListForUserParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -4496,21 +4363,14 @@ func ExampleAPICallService_ListForUser() {
 // Parameters
 //
 //   - `id`
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
+//   - `params`: The query parameters, see Oauth2ListAppsForAnyUserParams.
 func ExampleOauth2Service_ListAppsForAnyUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Oauth2.ListAppsForAnyUser("some-string", 123, "some-string", "")
+	result, err := client.Oauth2.ListAppsForAnyUser("some-string", kittycad.Oauth2ListAppsForAnyUserParams{Limit: new(123), PageToken: new("some-string"), SortBy: new(kittycad.CreatedAtSortMode(""))})
 	if err != nil {
 		panic(err)
 	}
@@ -4525,14 +4385,14 @@ func ExampleOauth2Service_ListAppsForAnyUser() {
 // Parameters
 //
 //   - `id`
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentGetBalanceForAnyUserParams.
 func ExamplePaymentService_GetBalanceForAnyUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
 	if err != nil {
 		panic(err)
 	}
 
-	result, err := client.Payment.GetBalanceForAnyUser("some-string", true)
+	result, err := client.Payment.GetBalanceForAnyUser("some-string", kittycad.PaymentGetBalanceForAnyUserParams{IncludeTotalDue: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -4547,7 +4407,7 @@ func ExamplePaymentService_GetBalanceForAnyUser() {
 // Parameters
 //
 //   - `id`
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentUpdateBalanceForAnyUserParams.
 //   - `body`: Payload for updating a user's balance.
 func ExamplePaymentService_UpdateBalanceForAnyUser() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -4555,7 +4415,7 @@ func ExamplePaymentService_UpdateBalanceForAnyUser() {
 		panic(err)
 	}
 
-	result, err := client.Payment.UpdateBalanceForAnyUser("some-string", true, kittycad.UpdatePaymentBalance{MonthlyAPICreditsRemainingMonetaryValue: 123.45, StableAPICreditsRemainingMonetaryValue: 123.45})
+	result, err := client.Payment.UpdateBalanceForAnyUser("some-string", kittycad.PaymentUpdateBalanceForAnyUserParams{IncludeTotalDue: new(true)}, kittycad.UpdatePaymentBalance{MonthlyAPICreditsRemainingMonetaryValue: new(123.45), StableAPICreditsRemainingMonetaryValue: new(123.45)})
 	if err != nil {
 		panic(err)
 	}
@@ -4577,7 +4437,7 @@ func ExampleUserService_UpdateSubscriptionFor() {
 		panic(err)
 	}
 
-	result, err := client.User.UpdateSubscriptionFor("some-string", kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: "", DowngradeReasonText: "some-string", ModelingApp: "some-string", PayAnnually: true})
+	result, err := client.User.UpdateSubscriptionFor("some-string", kittycad.ZooProductSubscriptionsUserRequest{DowngradeReason: new(kittycad.ZooProductSubscriptionDowngradeReason("")), DowngradeReasonText: new("some-string"), ModelingApp: "some-string", PayAnnually: new(true)})
 	if err != nil {
 		panic(err)
 	}
@@ -4646,7 +4506,7 @@ func ExampleUserService_PutCadInfoForm() {
 		panic(err)
 	}
 
-	if err := client.User.PutCadInfoForm(kittycad.WebsiteCadUserInfoForm{CadExperienceLevel: "", CadIndustry: "", CadUserType: "", CompanySize: "", DesignWorkflow: "", HasUsedZooDesignStudioOrAPIBefore: true, HowDidYouFindUs: "", HowDidYouFindUsOther: "some-string", LocationCity: "some-string", LocationCountry: "some-string", LocationState: "some-string", NumberOfCadUsers: "some-string", WhatAreYouBuilding: "some-string"}); err != nil {
+	if err := client.User.PutCadInfoForm(kittycad.WebsiteCadUserInfoForm{CadExperienceLevel: new(kittycad.CadExperienceLevel("")), CadIndustry: new(kittycad.CadIndustry("")), CadUserType: new(kittycad.CadUserType("")), CompanySize: new(kittycad.CompanySize("")), DesignWorkflow: new(kittycad.CadDesignWorkflow("")), HasUsedZooDesignStudioOrAPIBefore: new(true), HowDidYouFindUs: new(kittycad.CadDiscoverySource("")), HowDidYouFindUsOther: new("some-string"), LocationCity: new("some-string"), LocationCountry: new("some-string"), LocationState: new("some-string"), NumberOfCadUsers: new("some-string"), WhatAreYouBuilding: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -4664,7 +4524,7 @@ func ExampleUserService_PutPublicSalesForm() {
 		panic(err)
 	}
 
-	if err := client.User.PutPublicSalesForm(kittycad.WebsiteSalesForm{CadPlatforms: []string{}, Company: "some-string", Email: "example@example.com", FirstName: "some-string", Industry: "some-string", InquiryType: "", JobTitle: "some-string", LastName: "some-string", Message: "some-string", NumCadUsers: "some-string", Phone: "some-string"}); err != nil {
+	if err := client.User.PutPublicSalesForm(kittycad.WebsiteSalesForm{CadPlatforms: []string{}, Company: new("some-string"), Email: "example@example.com", FirstName: "some-string", Industry: new("some-string"), InquiryType: "", JobTitle: new("some-string"), LastName: "some-string", Message: "some-string", NumCadUsers: new("some-string"), Phone: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -4682,7 +4542,7 @@ func ExampleUserService_PutPublicSupportForm() {
 		panic(err)
 	}
 
-	if err := client.User.PutPublicSupportForm(kittycad.WebsiteSupportForm{Company: "some-string", Email: "example@example.com", FirstName: "some-string", InquiryType: "", LastName: "some-string", Message: "some-string", Phone: "some-string"}); err != nil {
+	if err := client.User.PutPublicSupportForm(kittycad.WebsiteSupportForm{Company: new("some-string"), Email: "example@example.com", FirstName: "some-string", InquiryType: "", LastName: "some-string", Message: "some-string", Phone: new("some-string")}); err != nil {
 		panic(err)
 	}
 
@@ -4697,7 +4557,7 @@ func ExampleExecutorService_CreateTerm() {
 	}
 
 	// Create the websocket connection.
-	ws, err := client.Executor.CreateTerm()
+	ws, err := client.Executor.CreateTerm(context.Background())
 	if err != nil {
 		panic(err)
 	}
@@ -4759,9 +4619,7 @@ func ExampleExecutorService_CreateTerm() {
 //
 // Parameters
 //
-//   - `replay`
-//   - `conversationId`
-//   - `pr`
+//   - `params`: The query parameters, see MlCopilotWsParams.
 //   - `body`: The types of messages that can be sent by the client to the server.
 func ExampleMlService_CopilotWs() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -4770,7 +4628,7 @@ func ExampleMlService_CopilotWs() {
 	}
 
 	// Create the websocket connection.
-	ws, err := client.Ml.CopilotWs(true, kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), 123, "")
+	ws, err := client.Ml.CopilotWs(context.Background(), kittycad.MlCopilotWsParams{Replay: new(true), ConversationID: new(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")), Pr: new(123)}, "")
 	if err != nil {
 		panic(err)
 	}
@@ -4839,7 +4697,7 @@ func ExampleMlService_ReasoningWs() {
 	}
 
 	// Create the websocket connection.
-	ws, err := client.Ml.ReasoningWs(kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "")
+	ws, err := client.Ml.ReasoningWs(context.Background(), kittycad.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), "")
 	if err != nil {
 		panic(err)
 	}
@@ -4901,18 +4759,7 @@ func ExampleMlService_ReasoningWs() {
 //
 // Parameters
 //
-//   - `videoResWidth`
-//   - `videoResHeight`
-//   - `fps`
-//   - `unlockedFramerate`
-//   - `postEffect`: Post effect type
-//   - `webrtc`
-//   - `pool`
-//   - `showGrid`
-//   - `replay`
-//   - `apicallId`
-//   - `orderIndependentTransparency`
-//   - `pr`
+//   - `params`: The query parameters, see ModelingCommandsWsParams.
 //   - `body`: The websocket messages the server receives.
 func ExampleModelingService_CommandsWs() {
 	client, err := kittycad.NewClientFromEnv("your apps user agent")
@@ -4921,7 +4768,7 @@ func ExampleModelingService_CommandsWs() {
 	}
 
 	// Create the websocket connection.
-	ws, err := client.Modeling.CommandsWs(123, 123, 123, true, kittycad.PostEffectTypePhosphor, true, "some-string", true, "some-string", "some-string", true, 123, "")
+	ws, err := client.Modeling.CommandsWs(context.Background(), kittycad.ModelingCommandsWsParams{VideoResWidth: new(123), VideoResHeight: new(123), Fps: new(123), UnlockedFramerate: new(true), PostEffect: new(kittycad.PostEffectTypePhosphor), Webrtc: new(true), Pool: new("some-string"), ShowGrid: new(true), Replay: new("some-string"), APICallID: new("some-string"), OrderIndependentTransparency: new(true), Pr: new(123)}, "")
 	if err != nil {
 		panic(err)
 	}
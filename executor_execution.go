@@ -0,0 +1,353 @@
+package kittycad
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Execution is a program to run with ExecutorService.Execute.
+type Execution struct {
+	// Language is the language of the program.
+	Language CodeLanguage
+	// Source is the source code of the program.
+	Source []byte
+	// InputFiles are files to create before the program runs, by path
+	// relative to where it runs.
+	InputFiles map[string][]byte
+	// Outputs are the paths of the files to return, relative to where the
+	// program runs.
+	Outputs []string
+	// AllowStderr stops output on stderr from being reported as an
+	// *ExecutionError, for programs logging to it.
+	AllowStderr bool
+}
+
+// ExecutionResult is the result of an Execution.
+type ExecutionResult struct {
+	// Stdout is the output of the program.
+	Stdout string
+	// Stderr is the error output of the program.
+	Stderr string
+	// OutputFiles are the contents of the requested outputs, by path.
+	OutputFiles map[string][]byte
+}
+
+// ExecutionError is returned when a program fails, which the executor
+// reports by output on stderr.
+type ExecutionError struct {
+	// Language is the language of the program.
+	Language CodeLanguage
+	// Result is the result of the program.
+	Result *ExecutionResult
+}
+
+// Error converts the ExecutionError to a readable string.
+func (err *ExecutionError) Error() string {
+	return fmt.Sprintf("%s program failed: %s", err.Language, strings.TrimSpace(err.Result.Stderr))
+}
+
+// Execute runs the program, staging its input files, and returns its
+// output and the requested files. If the program fails, the error is an
+// *ExecutionError, holding the result.
+//
+// The executor only takes the source of the program, so the input files are
+// staged by code added to it, before the program for Python and Node, and as
+// the first variable after the imports for Go, so the files exist when the
+// variables of the program are initialized.
+func (s *ExecutorService) Execute(exec Execution) (*ExecutionResult, error) {
+	source, err := stageInputFiles(exec.Language, exec.Source, exec.InputFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	params := ExecutorCreateFileExecutionParams{}
+	outputs := []string{}
+	for _, output := range exec.Outputs {
+		name, err := localFileName(output)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(name, ",") {
+			return nil, fmt.Errorf("output %q cannot contain a comma", output)
+		}
+		outputs = append(outputs, name)
+	}
+	if len(outputs) > 0 {
+		params.Output = new(strings.Join(outputs, ","))
+	}
+
+	output, err := s.CreateFileExecution(exec.Language, params, source)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutionResult{OutputFiles: map[string][]byte{}}
+	if output.Stdout != nil {
+		result.Stdout = *output.Stdout
+	}
+	if output.Stderr != nil {
+		result.Stderr = *output.Stderr
+	}
+	for _, file := range output.OutputFiles {
+		if file.Name == nil {
+			continue
+		}
+		var contents []byte
+		if file.Contents != nil {
+			contents, err = decodeBase64(*file.Contents)
+			if err != nil {
+				return nil, fmt.Errorf("decoding output %q failed: %v", *file.Name, err)
+			}
+		}
+		result.OutputFiles[*file.Name] = contents
+	}
+
+	if result.Stderr != "" && !exec.AllowStderr {
+		return result, &ExecutionError{Language: exec.Language, Result: result}
+	}
+	for _, name := range outputs {
+		if _, ok := result.OutputFiles[name]; !ok {
+			return result, fmt.Errorf("output %q was not returned by the %s program", name, exec.Language)
+		}
+	}
+	return result, nil
+}
+
+// ExecuteToDir runs the program like Execute, and writes the requested
+// files into dir.
+func (s *ExecutorService) ExecuteToDir(exec Execution, dir string) (*ExecutionResult, error) {
+	result, err := s.Execute(exec)
+	if err != nil {
+		return result, err
+	}
+	if _, err := result.WriteOutputFiles(dir); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// WriteOutputFiles writes the output files into dir, like
+// WriteExportFiles, and returns the paths of the files written.
+func (r *ExecutionResult) WriteOutputFiles(dir string) ([]string, error) {
+	files := []ExportFile{}
+	for _, name := range slices.Sorted(maps.Keys(r.OutputFiles)) {
+		files = append(files, ExportFile{Name: name, Contents: Base64{Inner: r.OutputFiles[name]}})
+	}
+	return WriteExportFiles(dir, files)
+}
+
+// decodeBase64 decodes base64 with or without padding, in the standard or
+// URL alphabet.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// stageInputFiles adds code writing the files to the source of the program.
+func stageInputFiles(lang CodeLanguage, source []byte, files map[string][]byte) ([]byte, error) {
+	if len(files) == 0 {
+		return source, nil
+	}
+
+	names := slices.Sorted(maps.Keys(files))
+	for _, name := range names {
+		if _, err := localFileName(name); err != nil {
+			return nil, err
+		}
+	}
+
+	switch lang {
+	case CodeLanguagePython:
+		var b strings.Builder
+		b.WriteString("import base64 as _zoo_base64, os as _zoo_os\n")
+		b.WriteString("for _zoo_name, _zoo_data in [\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "    (%s, %q),\n", jsonString(name), base64.StdEncoding.EncodeToString(files[name]))
+		}
+		b.WriteString("]:\n")
+		b.WriteString("    if _zoo_os.path.dirname(_zoo_name):\n")
+		b.WriteString("        _zoo_os.makedirs(_zoo_os.path.dirname(_zoo_name), exist_ok=True)\n")
+		b.WriteString("    with open(_zoo_name, \"wb\") as _zoo_file:\n")
+		b.WriteString("        _zoo_file.write(_zoo_base64.b64decode(_zoo_data))\n")
+		return insertSource(source, pythonHeaderEnd(string(source)), b.String()), nil
+
+	case CodeLanguageNode:
+		var b strings.Builder
+		b.WriteString("{\n")
+		b.WriteString("  const _zooRequire = typeof require === \"function\" ? require : process.getBuiltinModule;\n")
+		b.WriteString("  const _zooFs = _zooRequire(\"fs\"), _zooPath = _zooRequire(\"path\");\n")
+		b.WriteString("  for (const [name, data] of [\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "    [%s, %q],\n", jsonString(name), base64.StdEncoding.EncodeToString(files[name]))
+		}
+		b.WriteString("  ]) {\n")
+		b.WriteString("    _zooFs.mkdirSync(_zooPath.dirname(name), { recursive: true });\n")
+		b.WriteString("    _zooFs.writeFileSync(name, Buffer.from(data, \"base64\"));\n")
+		b.WriteString("  }\n")
+		b.WriteString("}\n")
+		return insertSource(source, nodeHeaderEnd(string(source)), b.String()), nil
+
+	case CodeLanguageGo:
+		file, err := parser.ParseFile(token.NewFileSet(), "main.go", source, parser.ImportsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the Go program failed: %v", err)
+		}
+		// The staging goes right after the imports, as the first variable,
+		// which is initialized before the other variables and the init
+		// functions of the program.
+		end := int(file.Name.End()) - 1
+		if len(file.Decls) > 0 {
+			end = int(file.Decls[len(file.Decls)-1].End()) - 1
+		}
+
+		var b strings.Builder
+		b.Write(source[:end])
+		b.WriteString("\n\nimport (\n\t_zoo_base64 \"encoding/base64\"\n\t_zoo_os \"os\"\n\t_zoo_filepath \"path/filepath\"\n)\n")
+		b.WriteString("\nvar _ = func() bool {\n")
+		b.WriteString("\tfor _, file := range []struct{ name, data string }{\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "\t\t{%s, %q},\n", strconv.Quote(name), base64.StdEncoding.EncodeToString(files[name]))
+		}
+		b.WriteString("\t} {\n")
+		b.WriteString("\t\tdata, _ := _zoo_base64.StdEncoding.DecodeString(file.data)\n")
+		b.WriteString("\t\t_zoo_os.MkdirAll(_zoo_filepath.Dir(file.name), 0o755)\n")
+		b.WriteString("\t\tif err := _zoo_os.WriteFile(file.name, data, 0o644); err != nil {\n")
+		b.WriteString("\t\t\tpanic(err)\n")
+		b.WriteString("\t\t}\n")
+		b.WriteString("\t}\n")
+		b.WriteString("\treturn true\n")
+		b.WriteString("}()\n")
+		b.Write(source[end:])
+		return []byte(b.String()), nil
+	}
+
+	return nil, fmt.Errorf("staging input files for %s programs is not supported", lang)
+}
+
+// insertSource inserts the code into the source at the offset.
+func insertSource(source []byte, offset int, code string) []byte {
+	head, rest := string(source[:offset]), string(source[offset:])
+	if head != "" && !strings.HasSuffix(head, "\n") {
+		head += "\n"
+	}
+	return []byte(head + code + rest)
+}
+
+// sourceLines calls line with every line of s and the offset after it,
+// newline included, until it returns false.
+func sourceLines(s string, line func(text string, end int) bool) {
+	offset := 0
+	for offset < len(s) {
+		text, _, found := strings.Cut(s[offset:], "\n")
+		end := offset + len(text)
+		if found {
+			end++
+		}
+		if !line(text, end) {
+			return
+		}
+		offset = end
+	}
+}
+
+// pythonHeaderEnd returns the offset after the lines which have to stay at
+// the start of a Python program: the shebang and encoding lines with the
+// other leading comments, the docstring of the module and the __future__
+// imports.
+func pythonHeaderEnd(s string) int {
+	header := 0
+	// closing ends the statement spanning lines being skipped.
+	closing := ""
+	statements := 0
+	sourceLines(s, func(text string, end int) bool {
+		trimmed := strings.TrimSpace(text)
+		literal := strings.TrimLeft(trimmed, "rRuU")
+		switch {
+		case closing == "\\":
+			if !strings.HasSuffix(trimmed, "\\") {
+				closing = ""
+			}
+		case closing != "":
+			if strings.Contains(trimmed, closing) {
+				closing = ""
+			}
+		case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+		case strings.HasPrefix(literal, `"`) || strings.HasPrefix(literal, "'"):
+			// Only the first statement is the docstring.
+			if statements > 0 {
+				return false
+			}
+			statements++
+			if quote := literal[:min(3, len(literal))]; (quote == `"""` || quote == "'''") && !strings.Contains(literal[3:], quote) {
+				closing = quote
+			}
+		case strings.HasPrefix(trimmed, "from __future__ import"):
+			statements++
+			if strings.Contains(trimmed, "(") && !strings.Contains(trimmed, ")") {
+				closing = ")"
+			} else if strings.HasSuffix(trimmed, "\\") {
+				closing = "\\"
+			}
+		default:
+			return false
+		}
+		header = end
+		return true
+	})
+	return header
+}
+
+// nodeHeaderEnd returns the offset after the lines which have to stay at
+// the start of a JavaScript program: the shebang line, the leading comments
+// and the directives, like "use strict".
+func nodeHeaderEnd(s string) int {
+	header := 0
+	inComment := false
+	sourceLines(s, func(text string, end int) bool {
+		trimmed := strings.TrimSpace(text)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(trimmed, "*/")
+		case trimmed == "" || strings.HasPrefix(trimmed, "//") || (header == 0 && strings.HasPrefix(trimmed, "#!")):
+		case strings.HasPrefix(trimmed, "/*"):
+			inComment = !strings.Contains(trimmed[2:], "*/")
+		case isDirective(trimmed):
+		default:
+			return false
+		}
+		header = end
+		return true
+	})
+	return header
+}
+
+// isDirective returns whether the line is a directive, a statement made of
+// a string literal like "use strict".
+func isDirective(line string) bool {
+	line = strings.TrimSuffix(line, ";")
+	if len(line) < 2 {
+		return false
+	}
+	quote := line[0]
+	if quote != '"' && quote != '\'' || line[len(line)-1] != quote {
+		return false
+	}
+	return !strings.ContainsRune(line[1:len(line)-1], rune(quote))
+}
+
+// jsonString quotes s as a JSON string, which is a valid Python and
+// JavaScript string.
+func jsonString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
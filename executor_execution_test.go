@@ -0,0 +1,240 @@
+package kittycad
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newExecutorStandIn starts a server running programs like the executor,
+// with the local interpreters.
+func newExecutorStandIn(t *testing.T) *Client {
+	t.Helper()
+
+	return newStandInClient(t, func(w http.ResponseWriter, r *http.Request) {
+		lang := strings.TrimPrefix(r.URL.Path, "/file/execute/")
+		source, _ := io.ReadAll(r.Body)
+
+		dir := t.TempDir()
+		var cmd *exec.Cmd
+		switch CodeLanguage(lang) {
+		case CodeLanguagePython:
+			os.WriteFile(filepath.Join(dir, "main.py"), source, 0o644)
+			cmd = exec.Command("python3", "main.py")
+		case CodeLanguageNode:
+			os.WriteFile(filepath.Join(dir, "main.js"), source, 0o644)
+			cmd = exec.Command("node", "main.js")
+		case CodeLanguageGo:
+			os.WriteFile(filepath.Join(dir, "main.go"), source, 0o644)
+			cmd = exec.Command("go", "run", "main.go")
+		}
+		cmd.Dir = dir
+		var stdout, stderr strings.Builder
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+		cmd.Run()
+
+		output := CodeOutput{Stdout: new(stdout.String()), Stderr: new(stderr.String())}
+		if outputs := r.URL.Query().Get("output"); outputs != "" {
+			for _, name := range strings.Split(outputs, ",") {
+				contents, err := os.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					continue
+				}
+				output.OutputFiles = append(output.OutputFiles, OutputFile{Name: new(name), Contents: new(base64.StdEncoding.EncodeToString(contents))})
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(output)
+	})
+}
+
+func TestExecutorExecuteStagesFiles(t *testing.T) {
+	for i, test := range []struct {
+		lang   CodeLanguage
+		tool   string
+		source string
+	}{
+		{
+			lang: CodeLanguagePython,
+			tool: "python3",
+			source: `import os
+os.makedirs("out", exist_ok=True)
+with open("data/in.txt") as f, open("out/result.txt", "w") as out:
+    out.write(f.read().upper())
+print("done")
+`,
+		},
+		{
+			lang: CodeLanguagePython,
+			tool: "python3",
+			source: `#!/usr/bin/env python3
+# -*- coding: utf-8 -*-
+"""Uppercases the input.
+
+The __future__ import has to stay first.
+"""
+from __future__ import (
+    annotations,
+)
+import os
+os.makedirs("out", exist_ok=True)
+with open("data/in.txt") as f, open("out/result.txt", "w") as out:
+    out.write(f.read().upper())
+print("done")
+`,
+		},
+		{
+			lang: CodeLanguageNode,
+			tool: "node",
+			source: `// Uppercases the input, in strict mode.
+"use strict";
+if ((function () { return this; })() !== undefined) {
+  throw new Error("not in strict mode");
+}
+const fs = require("fs");
+fs.mkdirSync("out", { recursive: true });
+fs.writeFileSync("out/result.txt", fs.readFileSync("data/in.txt", "utf8").toUpperCase());
+console.log("done");
+`,
+		},
+		{
+			lang: CodeLanguageNode,
+			tool: "node",
+			source: `#!/usr/bin/env node
+const fs = require("fs");
+fs.mkdirSync("out", { recursive: true });
+fs.writeFileSync("out/result.txt", fs.readFileSync("data/in.txt", "utf8").toUpperCase());
+console.log("done");
+`,
+		},
+		{
+			lang: CodeLanguageGo,
+			tool: "go",
+			source: `package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	in, _ := os.ReadFile("data/in.txt")
+	os.MkdirAll("out", 0o755)
+	os.WriteFile("out/result.txt", []byte(strings.ToUpper(string(in))), 0o644)
+	fmt.Println("done")
+}
+`,
+		},
+		{
+			lang: CodeLanguageGo,
+			tool: "go",
+			source: `package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// The input is read before main, by the initializers.
+var in, err = os.ReadFile("data/in.txt")
+
+func init() {
+	if err != nil {
+		panic(err)
+	}
+	os.MkdirAll("out", 0o755)
+}
+
+func main() {
+	os.WriteFile("out/result.txt", []byte(strings.ToUpper(string(in))), 0o644)
+	fmt.Println("done")
+}
+`,
+		},
+	} {
+		t.Run(fmt.Sprintf("%s/%d", test.lang, i), func(t *testing.T) {
+			if _, err := exec.LookPath(test.tool); err != nil {
+				t.Skipf("%s is not installed", test.tool)
+			}
+			client := newExecutorStandIn(t)
+			dir := t.TempDir()
+
+			result, err := client.Executor.ExecuteToDir(Execution{
+				Language:   test.lang,
+				Source:     []byte(test.source),
+				InputFiles: map[string][]byte{"data/in.txt": []byte("gear")},
+				Outputs:    []string{"out/result.txt"},
+			}, dir)
+			if err != nil {
+				t.Fatalf("executing failed: %v", err)
+			}
+			if result.Stdout != "done\n" {
+				t.Fatalf("unexpected stdout %q", result.Stdout)
+			}
+			if contents, err := os.ReadFile(filepath.Join(dir, "out", "result.txt")); err != nil || string(contents) != "GEAR" {
+				t.Fatalf("unexpected output %q (%v)", contents, err)
+			}
+		})
+	}
+}
+
+func TestStageInputFilesKeepsHeaders(t *testing.T) {
+	for _, test := range []struct {
+		lang   CodeLanguage
+		header string
+		body   string
+	}{
+		{CodeLanguagePython, "", "import os\n"},
+		{CodeLanguagePython, "#!/usr/bin/env python3\n# coding: latin-1\n", "print('done')\n"},
+		{CodeLanguagePython, "'''Docstring\nspanning lines.'''\nfrom __future__ import annotations\n", "import os\n"},
+		{CodeLanguagePython, `r"""Docstring."""` + "\nfrom __future__ import \\\n    annotations\n", "\"not a docstring\"\n"},
+		{CodeLanguageNode, "#!/usr/bin/env node\n/* A\n   comment. */\n'use strict'\n", "console.log('done');\n"},
+		{CodeLanguageNode, "\"use strict\";\n\"use client\";\n", "const fs = require(\"fs\");\n"},
+		{CodeLanguageGo, "package main\n\nimport \"os\"", "\n\nvar in, _ = os.ReadFile(\"in.txt\")\n"},
+	} {
+		staged, err := stageInputFiles(test.lang, []byte(test.header+test.body), map[string][]byte{"in.txt": []byte("gear")})
+		if err != nil {
+			t.Fatalf("staging failed: %v", err)
+		}
+		if !strings.HasPrefix(string(staged), test.header) || !strings.HasSuffix(string(staged), test.body) {
+			t.Errorf("expected the staging code between %q and %q, got %q", test.header, test.body, staged)
+		}
+		if len(staged) == len(test.header)+len(test.body) {
+			t.Errorf("expected the staging code to be added to %q", test.header+test.body)
+		}
+	}
+}
+
+func TestExecutorExecuteError(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+	client := newExecutorStandIn(t)
+
+	_, err := client.Executor.Execute(Execution{Language: CodeLanguagePython, Source: []byte("raise ValueError('bad gear')")})
+	var execErr *ExecutionError
+	if !errors.As(err, &execErr) || !strings.Contains(execErr.Result.Stderr, "bad gear") {
+		t.Fatalf("expected an *ExecutionError, got %v", err)
+	}
+}
+
+func TestExecutorExecuteRefusesTraversal(t *testing.T) {
+	client := newExecutorStandIn(t)
+
+	if _, err := client.Executor.Execute(Execution{Language: CodeLanguagePython, InputFiles: map[string][]byte{"../in.txt": nil}}); err == nil {
+		t.Fatalf("expected the input file to be refused")
+	}
+	if _, err := client.Executor.Execute(Execution{Language: CodeLanguagePython, Outputs: []string{"/etc/passwd"}}); err == nil {
+		t.Fatalf("expected the output to be refused")
+	}
+}
@@ -0,0 +1,189 @@
+package kittycad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/term"
+)
+
+// terminalResize is the control message resizing the remote terminal.
+type terminalResize struct {
+	Type string `json:"type"`
+	Cols int    `json:"cols"`
+	Rows int    `json:"rows"`
+}
+
+// Terminal is an interactive terminal in an executor container, opened with
+// ExecutorService.CreateTerm, as an io.ReadWriteCloser. Read returns io.EOF
+// once the container closed the terminal.
+//
+// The API spec does not describe the messages of the terminal websocket, so
+// the framing is an assumption, following the usual convention of websocket
+// terminals: the input is sent as binary messages, and the output is the
+// payload of the binary and text messages received. Resize assumes the
+// container accepts text messages of the form
+// {"type":"resize","cols":80,"rows":24}; a container which does not keeps
+// its size.
+type Terminal struct {
+	conn *websocket.Conn
+
+	readMu sync.Mutex
+	// reader is the message being read.
+	reader io.Reader
+
+	writeMu   sync.Mutex
+	closeOnce sync.Once
+}
+
+// OpenTerminal opens an interactive terminal in an executor container.
+func (s *ExecutorService) OpenTerminal(ctx context.Context) (*Terminal, error) {
+	conn, err := s.CreateTerm(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewTerminal(conn), nil
+}
+
+// NewTerminal wraps a websocket opened with ExecutorService.CreateTerm.
+func NewTerminal(conn *websocket.Conn) *Terminal {
+	return &Terminal{conn: conn}
+}
+
+// Read reads the output of the terminal.
+func (t *Terminal) Read(p []byte) (int, error) {
+	t.readMu.Lock()
+	defer t.readMu.Unlock()
+
+	for {
+		if t.reader == nil {
+			_, reader, err := t.conn.NextReader()
+			if err != nil {
+				return 0, terminalError(err)
+			}
+			t.reader = reader
+		}
+
+		n, err := t.reader.Read(p)
+		if err == io.EOF {
+			t.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// terminalError converts the end of the websocket to io.EOF.
+func terminalError(err error) error {
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) || errors.Is(err, net.ErrClosed) {
+		return io.EOF
+	}
+	return fmt.Errorf("reading from the terminal websocket failed: %w", err)
+}
+
+// Write sends the input to the terminal.
+func (t *Terminal) Write(p []byte) (int, error) {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	if err := t.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, fmt.Errorf("writing to the terminal websocket failed: %w", err)
+	}
+	return len(p), nil
+}
+
+// Resize resizes the terminal to the number of columns and rows, with the
+// assumed resize message described on Terminal.
+func (t *Terminal) Resize(cols, rows int) error {
+	message, err := json.Marshal(terminalResize{Type: "resize", Cols: cols, Rows: rows})
+	if err != nil {
+		return err
+	}
+
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	if err := t.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+		return fmt.Errorf("resizing the terminal failed: %w", err)
+	}
+	return nil
+}
+
+// Close tells the container the terminal is closed, and closes the
+// websocket.
+func (t *Terminal) Close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		t.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+		if e := t.conn.Close(); e != nil && !errors.Is(e, net.ErrClosed) {
+			err = e
+		}
+	})
+	return err
+}
+
+// Attach copies in to the terminal and the output of the terminal to out,
+// until the container closes the terminal or the context is done. The
+// terminal is closed when it returns. Reading in is not interrupted, so the
+// goroutine reading it lingers until in returns.
+func (t *Terminal) Attach(ctx context.Context, in io.Reader, out io.Writer) error {
+	defer t.Close()
+	stop := context.AfterFunc(ctx, func() { t.Close() })
+	defer stop()
+
+	go io.Copy(t, in)
+
+	_, err := io.Copy(out, t)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// AttachTTY attaches the terminal to the local terminal, like Attach. The
+// local terminal is put in raw mode until it returns, and its size is sent
+// to the remote terminal whenever it changes.
+func (t *Terminal) AttachTTY(ctx context.Context, in, out *os.File) error {
+	if !term.IsTerminal(int(in.Fd())) {
+		return fmt.Errorf("%s is not a terminal", in.Name())
+	}
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return fmt.Errorf("setting the terminal in raw mode failed: %v", err)
+	}
+	defer term.Restore(int(in.Fd()), state)
+
+	// Only send the size when it changed, since it may be polled.
+	var mu sync.Mutex
+	lastCols, lastRows := 0, 0
+	resize := func() {
+		cols, rows, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if cols != lastCols || rows != lastRows {
+			lastCols, lastRows = cols, rows
+			t.Resize(cols, rows)
+		}
+	}
+	resize()
+	stop := notifyResize(resize)
+	defer stop()
+
+	return t.Attach(ctx, in, out)
+}
@@ -0,0 +1,108 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// startTerminalStandIn starts a websocket server echoing the input of a
+// terminal, which closes the terminal on "exit". It sends the resizes it
+// receives to resizes.
+func startTerminalStandIn(t *testing.T, resizes chan<- terminalResize) *Terminal {
+	t.Helper()
+
+	client := newStandInClient(t, websocketStandIn(func(conn *websocket.Conn) {
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType == websocket.TextMessage {
+				var resize terminalResize
+				json.Unmarshal(message, &resize)
+				resizes <- resize
+				continue
+			}
+
+			conn.WriteMessage(websocket.BinaryMessage, message)
+			if bytes.Contains(message, []byte("exit")) {
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+		}
+	}))
+	terminal, err := client.Executor.OpenTerminal(context.Background())
+	if err != nil {
+		t.Fatalf("opening the terminal failed: %v", err)
+	}
+	t.Cleanup(func() { terminal.Close() })
+	return terminal
+}
+
+func TestTerminalAttach(t *testing.T) {
+	terminal := startTerminalStandIn(t, nil)
+
+	var out bytes.Buffer
+	if err := terminal.Attach(context.Background(), strings.NewReader("ls\nexit\n"), &out); err != nil {
+		t.Fatalf("attaching failed: %v", err)
+	}
+	if out.String() != "ls\nexit\n" {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+}
+
+func TestTerminalReadWrite(t *testing.T) {
+	resizes := make(chan terminalResize, 1)
+	terminal := startTerminalStandIn(t, resizes)
+
+	if err := terminal.Resize(120, 40); err != nil {
+		t.Fatalf("resizing failed: %v", err)
+	}
+	select {
+	case resize := <-resizes:
+		if resize != (terminalResize{Type: "resize", Cols: 120, Rows: 40}) {
+			t.Fatalf("unexpected resize %+v", resize)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the resize was not received")
+	}
+
+	if _, err := io.WriteString(terminal, "echo hello"); err != nil {
+		t.Fatalf("writing failed: %v", err)
+	}
+	// Read in small chunks, across the message.
+	got := make([]byte, 10)
+	for n := 0; n < len(got); {
+		m, err := terminal.Read(got[n:min(n+3, len(got))])
+		if err != nil {
+			t.Fatalf("reading failed: %v", err)
+		}
+		n += m
+	}
+	if string(got) != "echo hello" {
+		t.Fatalf("unexpected output %q", got)
+	}
+
+	io.WriteString(terminal, "exit")
+	if rest, err := io.ReadAll(terminal); err != nil || string(rest) != "exit" {
+		t.Fatalf("expected the output up to the end of the terminal, got %q (%v)", rest, err)
+	}
+}
+
+func TestTerminalAttachCancel(t *testing.T) {
+	terminal := startTerminalStandIn(t, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	in, _ := io.Pipe()
+	if err := terminal.Attach(ctx, in, io.Discard); err != context.DeadlineExceeded {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !windows
+
+package kittycad
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyResize calls resize whenever the local terminal is resized, until
+// stop is called.
+func notifyResize(resize func()) (stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				resize()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
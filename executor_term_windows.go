@@ -0,0 +1,29 @@
+//go:build windows
+
+package kittycad
+
+import "time"
+
+// notifyResize calls resize whenever the local terminal may have been
+// resized, until stop is called. Windows has no SIGWINCH, so the size is
+// polled.
+func notifyResize(resize func()) (stop func()) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				resize()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
@@ -0,0 +1,451 @@
+package kittycad
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The statuses of a BulkResult.
+const (
+	BulkSucceeded = "succeeded"
+	BulkFailed    = "failed"
+)
+
+// BulkConvertOptions configures BulkConvert.
+type BulkConvertOptions struct {
+	// OutputFormat is the format to convert the files to.
+	OutputFormat FileExportFormat
+	// SrcFormats restricts the conversion to the files of these formats.
+	// By default every file of a known format is converted.
+	SrcFormats []FileImportFormat
+	// OutputDir is the directory the converted files are written into,
+	// mirroring the tree of the sources. BulkConvertDir defaults it to the
+	// source directory, writing the outputs next to their sources.
+	OutputDir string
+	// Concurrency is the number of conversions run at once. It defaults
+	// to 4.
+	Concurrency int
+	// Retries is the number of times a conversion failing with a network
+	// error, a rate limit or a server error is retried. Conversions the API
+	// reports as failed are not retried. Polling the status of a conversion
+	// is retried the same number of times, without converting it again.
+	Retries int
+	// RetryBackoff is the wait before the first retry, doubled after every
+	// retry. It defaults to a second.
+	RetryBackoff time.Duration
+	// PollInterval is how often the status of conversions performed
+	// asynchronously, which the API does for large files, is polled. It
+	// defaults to two seconds.
+	PollInterval time.Duration
+	// Resume is the report of a previous run. The files it reports as
+	// succeeded are not converted again, and their results are carried over.
+	Resume *BulkReport
+	// OnResult is called with the result of every file as it completes,
+	// for example to show progress. It is not called concurrently.
+	OnResult func(BulkResult)
+}
+
+// BulkReport is the report of BulkConvert, which can be saved with
+// WriteJSON and passed back as BulkConvertOptions.Resume.
+type BulkReport struct {
+	// OutputFormat is the format the files were converted to.
+	OutputFormat FileExportFormat `json:"output_format"`
+	// Results are the results of the files, sorted by source.
+	Results []BulkResult `json:"results"`
+}
+
+// BulkResult is the result of converting one file.
+type BulkResult struct {
+	// Source is the slash-separated path of the file, relative to the
+	// source directory.
+	Source string `json:"source"`
+	// SrcFormat is the format of the file, inferred from its extension.
+	SrcFormat FileImportFormat `json:"src_format"`
+	// Status is BulkSucceeded or BulkFailed.
+	Status string `json:"status"`
+	// APICallID is the ID of the API call of the last attempt, if it was
+	// made.
+	APICallID string `json:"api_call_id,omitempty"`
+	// RequestID is the ID of the request the API reported with the error
+	// of the last attempt, if it failed with one, to look it up with
+	// support.
+	RequestID string `json:"request_id,omitempty"`
+	// Outputs are the paths of the files written.
+	Outputs []string `json:"outputs,omitempty"`
+	// Attempts is the number of conversions attempted.
+	Attempts int `json:"attempts"`
+	// Duration is the time the file took, including retries, in
+	// nanoseconds in JSON.
+	Duration time.Duration `json:"duration"`
+	// Error is the error of the last attempt of a failed file.
+	Error string `json:"error,omitempty"`
+}
+
+// Failed returns the results of the files which failed.
+func (r *BulkReport) Failed() []BulkResult {
+	failed := []BulkResult{}
+	for _, result := range r.Results {
+		if result.Status == BulkFailed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns an error summarizing the failed files, or nil if every file
+// succeeded.
+func (r *BulkReport) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("converting %d of %d files failed, first %q: %s", len(failed), len(r.Results), failed[0].Source, failed[0].Error)
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *BulkReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("writing the report failed: %v", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the results as CSV, with a header row. The outputs are
+// separated by semicolons and the duration is in nanoseconds.
+func (r *BulkReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"source", "src_format", "output_format", "status", "api_call_id", "request_id", "outputs", "attempts", "duration", "error"})
+	for _, result := range r.Results {
+		writer.Write([]string{
+			result.Source,
+			string(result.SrcFormat),
+			string(r.OutputFormat),
+			result.Status,
+			result.APICallID,
+			result.RequestID,
+			strings.Join(result.Outputs, ";"),
+			strconv.Itoa(result.Attempts),
+			strconv.FormatInt(int64(result.Duration), 10),
+			result.Error,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("writing the report failed: %v", err)
+	}
+	return nil
+}
+
+// ReadBulkReport reads a report written with BulkReport.WriteJSON.
+func ReadBulkReport(r io.Reader) (*BulkReport, error) {
+	var report BulkReport
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("reading the report failed: %v", err)
+	}
+	return &report, nil
+}
+
+// BulkConvertDir converts the files in the directory tree like BulkConvert.
+// The outputs are written next to their sources unless
+// BulkConvertOptions.OutputDir is set.
+func BulkConvertDir(ctx context.Context, client ClientAPI, dir string, opts BulkConvertOptions) (*BulkReport, error) {
+	if opts.OutputDir == "" {
+		opts.OutputDir = dir
+	}
+	return BulkConvert(ctx, client, os.DirFS(dir), opts)
+}
+
+// BulkConvert converts every file of src whose format is known from its
+// extension to the output format, and writes the outputs into
+// BulkConvertOptions.OutputDir, mirroring the tree of src.
+//
+// A conversion with one output is written next to where its source would
+// be, named after the source, like part.stl for part.step. The outputs of a
+// conversion with several, like a glTF file with its buffers, are written
+// in a directory named after the source and format, like part-gltf, keeping
+// their names so they still reference each other. An output named like its
+// source is refused rather than overwriting it.
+//
+// Files failing to convert are reported in the report, which is returned
+// even if the context was canceled. The error is only about walking src
+// and the context.
+func BulkConvert(ctx context.Context, client ClientAPI, src fs.FS, opts BulkConvertOptions) (*BulkReport, error) {
+	if !opts.OutputFormat.IsValid() {
+		return nil, fmt.Errorf("invalid output format %q", opts.OutputFormat)
+	}
+	if opts.OutputDir == "" {
+		return nil, errors.New("the output directory is required")
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = time.Second
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 2 * time.Second
+	}
+
+	// Carry over the files which succeeded, and skip their outputs, which
+	// are found when writing next to the sources.
+	previous := map[string]BulkResult{}
+	written := map[string]bool{}
+	if opts.Resume != nil && opts.Resume.OutputFormat == opts.OutputFormat {
+		for _, result := range opts.Resume.Results {
+			if result.Status != BulkSucceeded {
+				continue
+			}
+			previous[result.Source] = result
+			for _, output := range result.Outputs {
+				written[filepath.Clean(output)] = true
+			}
+		}
+	}
+
+	report := &BulkReport{OutputFormat: opts.OutputFormat}
+	pending := []BulkResult{}
+	err := fs.WalkDir(src, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		format, ok := importFormatFromName(name)
+		if !ok || (len(opts.SrcFormats) > 0 && !slices.Contains(opts.SrcFormats, format)) {
+			return nil
+		}
+		if result, ok := previous[name]; ok {
+			report.Results = append(report.Results, result)
+			return nil
+		}
+		if written[filepath.Join(opts.OutputDir, filepath.FromSlash(name))] {
+			return nil
+		}
+		pending = append(pending, BulkResult{Source: name, SrcFormat: format})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking the source files failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(opts.Concurrency, len(pending)) {
+		wg.Go(func() {
+			for i := range jobs {
+				result := bulkConvertFile(ctx, client, src, pending[i], opts)
+
+				mu.Lock()
+				pending[i] = result
+				if opts.OnResult != nil {
+					opts.OnResult(result)
+				}
+				mu.Unlock()
+			}
+		})
+	}
+dispatch:
+	for i := range pending {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, result := range pending {
+		// Files never started because the context was canceled are left out,
+		// so resuming converts them.
+		if result.Status != "" {
+			report.Results = append(report.Results, result)
+		}
+	}
+	slices.SortFunc(report.Results, func(a, b BulkResult) int { return strings.Compare(a.Source, b.Source) })
+	return report, ctx.Err()
+}
+
+// bulkConvertFile converts the file, retrying temporary errors, and writes
+// its outputs.
+func bulkConvertFile(ctx context.Context, client ClientAPI, src fs.FS, result BulkResult, opts BulkConvertOptions) (converted BulkResult) {
+	start := time.Now()
+	defer func() { converted.Duration = time.Since(start) }()
+
+	fail := func(err error) BulkResult {
+		result.Status = BulkFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	body, err := fs.ReadFile(src, result.Source)
+	if err != nil {
+		return fail(fmt.Errorf("reading the source failed: %v", err))
+	}
+
+	backoff := opts.RetryBackoff
+	var conversion *FileConversion
+	for {
+		result.Attempts++
+		conversion, err = client.FileAPI().CreateConversion(result.SrcFormat, opts.OutputFormat, body)
+		if err == nil || result.Attempts > opts.Retries || !retryableError(err) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fail(ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	if err == nil {
+		// The conversion was created, so failing to poll it only retries the
+		// polling, in waitFileConversion.
+		result.APICallID = conversion.ID.String()
+		conversion, err = waitFileConversion(ctx, client, conversion, opts)
+	}
+	if err != nil {
+		result.RequestID = errorRequestID(err)
+		return fail(err)
+	}
+
+	outputs, err := bulkWriteOutputs(conversion, result.Source, opts.OutputDir)
+	if err != nil {
+		return fail(err)
+	}
+	result.Status = BulkSucceeded
+	result.Outputs = outputs
+	return result
+}
+
+// errorRequestID returns the ID of the request the API reported with the
+// HTTP error, or "" if it did not report one.
+func errorRequestID(err error) string {
+	httpErr, ok := errors.AsType[*HTTPError](err)
+	if !ok {
+		return ""
+	}
+	var apiErr Error
+	if json.Unmarshal([]byte(httpErr.Body), &apiErr) == nil && apiErr.RequestID != "" {
+		return apiErr.RequestID
+	}
+	if httpErr.Header != nil {
+		return httpErr.Header.Get("X-Request-Id")
+	}
+	return ""
+}
+
+// waitFileConversion polls the conversion until it completed, if it is
+// performed asynchronously. Failed polls are retried like conversions.
+func waitFileConversion(ctx context.Context, client ClientAPI, conversion *FileConversion, opts BulkConvertOptions) (*FileConversion, error) {
+	failures := 0
+	wait := opts.PollInterval
+	for {
+		switch conversion.Status {
+		case APICallStatusCompleted:
+			return conversion, nil
+		case APICallStatusFailed:
+			message := "no error was reported"
+			if conversion.Error != nil {
+				message = *conversion.Error
+			}
+			return nil, &conversionError{message: message}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		operation, err := client.APICallAPI().GetAsyncOperation(conversion.ID)
+		if err != nil {
+			failures++
+			if failures > opts.Retries || !retryableError(err) {
+				return nil, fmt.Errorf("polling the conversion failed: %w", err)
+			}
+			wait = opts.RetryBackoff << (failures - 1)
+			continue
+		}
+		failures = 0
+		wait = opts.PollInterval
+		b, err := json.Marshal(operation)
+		if err != nil {
+			return nil, err
+		}
+		conversion = &FileConversion{}
+		if err := json.Unmarshal(b, conversion); err != nil {
+			return nil, fmt.Errorf("decoding the async operation failed: %v", err)
+		}
+	}
+}
+
+// conversionError is a conversion the API reported as failed.
+type conversionError struct {
+	message string
+}
+
+func (err *conversionError) Error() string {
+	return "the conversion failed: " + err.message
+}
+
+// retryableError reports whether the conversion may succeed if retried.
+func retryableError(err error) bool {
+	var httpErr *HTTPError
+	var convErr *conversionError
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded), errors.As(err, &convErr):
+		return false
+	case errors.As(err, &httpErr):
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+	// Network errors.
+	return true
+}
+
+// bulkWriteOutputs writes the outputs of the conversion of the source, as
+// described by BulkConvert.
+func bulkWriteOutputs(conversion *FileConversion, source, outputDir string) ([]string, error) {
+	files, err := ConversionOutputs(conversion)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("the conversion has no outputs")
+	}
+
+	dir := filepath.Join(outputDir, filepath.FromSlash(path.Dir(source)))
+	stem := strings.TrimSuffix(path.Base(source), path.Ext(source))
+
+	if len(files) == 1 {
+		ext := path.Ext(files[0].Name)
+		if ext == "" {
+			ext = "." + string(conversion.OutputFormat)
+		}
+		files[0].Name = stem + ext
+		if files[0].Name == path.Base(source) {
+			return nil, fmt.Errorf("the output %q would overwrite its source", files[0].Name)
+		}
+	} else {
+		dir = filepath.Join(dir, stem+"-"+string(conversion.OutputFormat))
+	}
+	return WriteExportFiles(dir, files)
+}
@@ -0,0 +1,225 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newConversionStandIn starts a server converting files like the API, by
+// prefixing their contents with the output format. Sources containing
+// "async" are converted asynchronously, with the first poll failing with a
+// server error, "flaky" fail once with a server error, "down" always fail
+// with one, "bad" fail to convert, and "multi" have two outputs.
+func newConversionStandIn(t *testing.T) (*Client, *[]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := []string{}
+	failed := map[string]bool{}
+	pollFailed := false
+	client := newStandInClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.URL.Path)
+
+		conversion := map[string]any{
+			"id":            "33333333-3333-3333-3333-333333333333",
+			"src_format":    "step",
+			"output_format": "stl",
+			"status":        "completed",
+			"created_at":    "2024-01-01T00:00:00Z",
+			"updated_at":    "2024-01-01T00:00:00Z",
+			"user_id":       "44444444-4444-4444-4444-444444444444",
+		}
+		if strings.HasPrefix(r.URL.Path, "/async/operations/") {
+			if !pollFailed {
+				pollFailed = true
+				http.Error(w, `{"message":"try again","request_id":"poll"}`, http.StatusBadGateway)
+				return
+			}
+			conversion["type"] = "file_conversion"
+			conversion["outputs"] = map[string]string{"output.stl": "c3RsOmFzeW5j"}
+			json.NewEncoder(w).Encode(conversion)
+			return
+		}
+
+		var body bytes.Buffer
+		body.ReadFrom(r.Body)
+		source := body.String()
+		switch {
+		case strings.Contains(source, "flaky") && !failed[source]:
+			failed[source] = true
+			http.Error(w, `{"message":"try again"}`, http.StatusServiceUnavailable)
+			return
+		case strings.Contains(source, "down"):
+			http.Error(w, `{"message":"unavailable","request_id":"down"}`, http.StatusServiceUnavailable)
+			return
+		case strings.Contains(source, "bad"):
+			conversion["status"] = "failed"
+			conversion["error"] = "invalid geometry"
+		case strings.Contains(source, "async"):
+			conversion["status"] = "queued"
+		case strings.Contains(source, "multi"):
+			conversion["output_format"] = "gltf"
+			conversion["outputs"] = map[string]string{"output.gltf": "Z2x0Zg", "output.bin": "AAEC"}
+		default:
+			conversion["outputs"] = map[string]string{"output.stl": Base64{Inner: []byte("stl:" + source)}.String()}
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(conversion)
+	})
+	return client, &requests
+}
+
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0o755)
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatalf("writing %s failed: %v", name, err)
+		}
+	}
+}
+
+func TestBulkConvertDir(t *testing.T) {
+	client, requests := newConversionStandIn(t)
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{
+		"bracket.step":      "bracket",
+		"parts/gear.SLDPRT": "flaky gear",
+		"parts/large.stp":   "async large",
+		"parts/broken.step": "bad",
+		"notes.txt":         "not a model",
+		"mesh.obj":          "not selected",
+	})
+
+	opts := BulkConvertOptions{
+		OutputFormat: FileExportFormatStl,
+		SrcFormats:   []FileImportFormat{FileImportFormatStep, FileImportFormatSldprt},
+		Retries:      1,
+		RetryBackoff: time.Millisecond,
+		PollInterval: time.Millisecond,
+	}
+	report, err := BulkConvertDir(context.Background(), client, dir, opts)
+	if err != nil {
+		t.Fatalf("converting failed: %v", err)
+	}
+
+	want := []struct {
+		source   string
+		status   string
+		attempts int
+		output   string
+		contents string
+	}{
+		{source: "bracket.step", status: BulkSucceeded, attempts: 1, output: "bracket.stl", contents: "stl:bracket"},
+		{source: "parts/broken.step", status: BulkFailed, attempts: 1},
+		{source: "parts/gear.SLDPRT", status: BulkSucceeded, attempts: 2, output: "parts/gear.stl", contents: "stl:flaky gear"},
+		{source: "parts/large.stp", status: BulkSucceeded, attempts: 1, output: "parts/large.stl", contents: "stl:async"},
+	}
+	if len(report.Results) != len(want) {
+		t.Fatalf("expected %d results, got %+v", len(want), report.Results)
+	}
+	for i, w := range want {
+		result := report.Results[i]
+		if result.Source != w.source || result.Status != w.status || result.Attempts != w.attempts {
+			t.Fatalf("unexpected result %+v, expected %+v", result, w)
+		}
+		if w.output == "" {
+			if !strings.Contains(result.Error, "invalid geometry") {
+				t.Fatalf("unexpected error %q", result.Error)
+			}
+			continue
+		}
+		if result.APICallID != "33333333-3333-3333-3333-333333333333" {
+			t.Fatalf("unexpected API call ID %q", result.APICallID)
+		}
+		contents, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(w.output)))
+		if err != nil || string(contents) != w.contents {
+			t.Fatalf("unexpected output %s: %q, %v", w.output, contents, err)
+		}
+	}
+	if err := report.Err(); err == nil || !strings.Contains(err.Error(), "1 of 4") {
+		t.Fatalf("unexpected report error %v", err)
+	}
+
+	// Resuming only converts the file which failed, and not the outputs.
+	var saved bytes.Buffer
+	if err := report.WriteJSON(&saved); err != nil {
+		t.Fatalf("writing the report failed: %v", err)
+	}
+	opts.Resume, err = ReadBulkReport(&saved)
+	if err != nil {
+		t.Fatalf("reading the report failed: %v", err)
+	}
+	opts.SrcFormats = nil
+	*requests = nil
+	resumed, err := BulkConvertDir(context.Background(), client, dir, opts)
+	if err != nil {
+		t.Fatalf("resuming failed: %v", err)
+	}
+	if len(*requests) != 2 || len(resumed.Results) != 5 {
+		t.Fatalf("unexpected requests %v and results %+v", *requests, resumed.Results)
+	}
+	if resumed.Results[0].Source != "bracket.step" || resumed.Results[0].Duration == 0 || resumed.Results[0].Duration != report.Results[0].Duration {
+		t.Fatalf("expected the result to be carried over, got %+v", resumed.Results[0])
+	}
+
+	var csv bytes.Buffer
+	if err := resumed.WriteCSV(&csv); err != nil {
+		t.Fatalf("writing the csv failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(csv.String()), "\n")
+	if len(lines) != 6 || !strings.HasPrefix(lines[0], "source,src_format,output_format,status,api_call_id,request_id") || !strings.HasPrefix(lines[1], "bracket.step,step,stl,succeeded,") {
+		t.Fatalf("unexpected csv %q", csv.String())
+	}
+}
+
+func TestBulkConvertReportsRequestID(t *testing.T) {
+	client, requests := newConversionStandIn(t)
+	dir := t.TempDir()
+	writeTestFiles(t, dir, map[string]string{"down.step": "down"})
+
+	report, err := BulkConvertDir(context.Background(), client, dir, BulkConvertOptions{
+		OutputFormat: FileExportFormatStl,
+		Retries:      2,
+		RetryBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("converting failed: %v", err)
+	}
+	result := report.Results[0]
+	if result.Status != BulkFailed || result.Attempts != 3 || result.RequestID != "down" || len(*requests) != 3 {
+		t.Fatalf("unexpected result %+v after requests %v", result, *requests)
+	}
+	// The duration includes the backoffs of 1ms and 2ms.
+	if result.Duration < 3*time.Millisecond {
+		t.Fatalf("expected the duration to include the retries, got %v", result.Duration)
+	}
+}
+
+func TestBulkConvertMirroredTree(t *testing.T) {
+	client, _ := newConversionStandIn(t)
+	src := t.TempDir()
+	out := t.TempDir()
+	writeTestFiles(t, src, map[string]string{"models/multi.fbx": "multi"})
+
+	report, err := BulkConvertDir(context.Background(), client, src, BulkConvertOptions{OutputFormat: FileExportFormatGltf, OutputDir: out})
+	if err != nil || report.Err() != nil {
+		t.Fatalf("converting failed: %v, %v", err, report.Err())
+	}
+	for _, name := range []string{"output.gltf", "output.bin"} {
+		if _, err := os.Stat(filepath.Join(out, "models", "multi-gltf", name)); err != nil {
+			t.Fatalf("expected %s to be written: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,394 @@
+package kittycad
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// SystemZUp is the KittyCAD co-ordinate system, with Z up and the front
+	// of models looking along -Y. It is the default of most formats.
+	SystemZUp = System{
+		Forward: AxisDirectionPair{Axis: AxiY, Direction: DirectionNegative},
+		Up:      AxisDirectionPair{Axis: AxiZ, Direction: DirectionPositive},
+	}
+	// SystemYUp is the co-ordinate system with Y up and the front of models
+	// looking along +Z, used by SolidWorks and Creo.
+	SystemYUp = System{
+		Forward: AxisDirectionPair{Axis: AxiZ, Direction: DirectionPositive},
+		Up:      AxisDirectionPair{Axis: AxiY, Direction: DirectionPositive},
+	}
+)
+
+// validateSystem checks the axes of the co-ordinate system are valid and
+// orthogonal.
+func validateSystem(s System) error {
+	for _, pair := range []AxisDirectionPair{s.Forward, s.Up} {
+		if !pair.Axis.IsValid() || !pair.Direction.IsValid() {
+			return fmt.Errorf("invalid co-ordinate axis %q %q", pair.Direction, pair.Axis)
+		}
+	}
+	if s.Forward.Axis == s.Up.Axis {
+		return fmt.Errorf("the up axis %q is not orthogonal to the forward axis", s.Up.Axis)
+	}
+	return nil
+}
+
+// InputFormat describes the source files of a conversion. Create one with
+// the constructor of the format, like InputSTEP.
+type InputFormat struct {
+	Type                 FileImportFormat `json:"type"`
+	Coords               *System          `json:"coords,omitempty"`
+	Units                UnitLength       `json:"units,omitempty"`
+	SplitClosedFaces     *bool            `json:"split_closed_faces,omitempty"`
+	TargetRepresentation string           `json:"target_representation,omitempty"`
+}
+
+// BrepInputOptions are the options of the B-rep source formats.
+type BrepInputOptions struct {
+	// Coords is the co-ordinate system of the source, if not the default of
+	// the format.
+	Coords *System
+	// SplitClosedFaces splits all closed faces into two open faces.
+	SplitClosedFaces bool
+}
+
+func brepInput(format FileImportFormat, opts BrepInputOptions) InputFormat {
+	return InputFormat{Type: format, Coords: opts.Coords, SplitClosedFaces: new(opts.SplitClosedFaces)}
+}
+
+// InputACIS describes ACIS source files.
+func InputACIS(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatAcis, opts)
+}
+
+// InputCATIA describes CATIA source files.
+func InputCATIA(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatCatia, opts)
+}
+
+// InputCreo describes Creo source files.
+func InputCreo(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatCreo, opts)
+}
+
+// InputInventor describes Inventor source files.
+func InputInventor(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatInventor, opts)
+}
+
+// InputNX describes NX source files.
+func InputNX(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatNx, opts)
+}
+
+// InputParasolid describes Parasolid source files.
+func InputParasolid(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatParasolid, opts)
+}
+
+// InputSLDPRT describes SolidWorks part source files.
+func InputSLDPRT(opts BrepInputOptions) InputFormat {
+	return brepInput(FileImportFormatSldprt, opts)
+}
+
+// InputSTEP describes STEP source files. If mesh is true, the files are
+// imported as meshes instead of B-reps.
+func InputSTEP(opts BrepInputOptions, mesh bool) InputFormat {
+	f := brepInput(FileImportFormatStep, opts)
+	f.TargetRepresentation = "brep"
+	if mesh {
+		f.TargetRepresentation = "mesh"
+	}
+	return f
+}
+
+// InputFBX describes FBX source files.
+func InputFBX() InputFormat {
+	return InputFormat{Type: FileImportFormatFbx}
+}
+
+// InputGLTF describes glTF source files.
+func InputGLTF() InputFormat {
+	return InputFormat{Type: FileImportFormatGltf}
+}
+
+// InputOBJ describes OBJ source files, whose units have to be given since
+// the format does not store them.
+func InputOBJ(coords System, units UnitLength) InputFormat {
+	return InputFormat{Type: FileImportFormatObj, Coords: &coords, Units: units}
+}
+
+// InputPLY describes PLY source files, whose units have to be given since
+// the format does not store them.
+func InputPLY(coords System, units UnitLength) InputFormat {
+	return InputFormat{Type: FileImportFormatPly, Coords: &coords, Units: units}
+}
+
+// InputSTL describes STL source files, whose units have to be given since
+// the format does not store them.
+func InputSTL(coords System, units UnitLength) InputFormat {
+	return InputFormat{Type: FileImportFormatStl, Coords: &coords, Units: units}
+}
+
+// Validate checks the options are valid for the format.
+func (f InputFormat) Validate() error {
+	if !f.Type.IsValid() {
+		return fmt.Errorf("invalid source format %q", f.Type)
+	}
+	if f.Coords != nil {
+		if err := validateSystem(*f.Coords); err != nil {
+			return fmt.Errorf("%s source: %v", f.Type, err)
+		}
+	}
+
+	switch f.Type {
+	case FileImportFormatObj, FileImportFormatPly, FileImportFormatStl:
+		if f.Coords == nil {
+			return fmt.Errorf("%s source: the co-ordinate system is required", f.Type)
+		}
+		if !f.Units.IsValid() {
+			return fmt.Errorf("%s source: invalid units %q", f.Type, f.Units)
+		}
+	case FileImportFormatFbx, FileImportFormatGltf:
+		if f.Coords != nil || f.Units != "" || f.SplitClosedFaces != nil {
+			return fmt.Errorf("%s source: the format takes no options", f.Type)
+		}
+	default:
+		if f.Units != "" {
+			return fmt.Errorf("%s source: the format stores its units", f.Type)
+		}
+	}
+	if f.TargetRepresentation != "" && f.Type != FileImportFormatStep {
+		return fmt.Errorf("%s source: only STEP files have a target representation", f.Type)
+	}
+	return nil
+}
+
+// OutputFormat describes the output files of a conversion. Create one with
+// the constructor of the format, like OutputSTL.
+type OutputFormat struct {
+	Type         FileExportFormat `json:"type"`
+	Coords       *System          `json:"coords,omitempty"`
+	Units        UnitLength       `json:"units,omitempty"`
+	Storage      string           `json:"storage,omitempty"`
+	Presentation string           `json:"presentation,omitempty"`
+	Selection    Selection        `json:"selection,omitempty"`
+	Created      *Time            `json:"created,omitempty"`
+}
+
+// OutputFBX describes FBX output files.
+func OutputFBX(storage FbxStorage) OutputFormat {
+	return OutputFormat{Type: FileExportFormatFbx, Storage: string(storage)}
+}
+
+// OutputGLTF describes glTF output files. Binary storage outputs a single
+// .glb file.
+func OutputGLTF(storage GltfStorage, presentation GltfPresentation) OutputFormat {
+	return OutputFormat{Type: FileExportFormatGltf, Storage: string(storage), Presentation: string(presentation)}
+}
+
+// OutputOBJ describes OBJ output files.
+func OutputOBJ(coords System, units UnitLength) OutputFormat {
+	return OutputFormat{Type: FileExportFormatObj, Coords: &coords, Units: units}
+}
+
+// OutputPLY describes PLY output files of the selection.
+func OutputPLY(coords System, units UnitLength, storage PlyStorage, selection Selection) OutputFormat {
+	return OutputFormat{Type: FileExportFormatPly, Coords: &coords, Units: units, Storage: string(storage), Selection: selection}
+}
+
+// StepOutputOptions are the options of STEP output files.
+type StepOutputOptions struct {
+	// Coords is the co-ordinate system of the output, if not SystemZUp.
+	Coords *System
+	// Units are the units of the output, if not meters.
+	Units UnitLength
+	// Presentation is the presentation of the output, if not pretty.
+	Presentation StepPresentation
+}
+
+// OutputSTEP describes STEP output files.
+func OutputSTEP(opts StepOutputOptions) OutputFormat {
+	return OutputFormat{Type: FileExportFormatStep, Coords: opts.Coords, Units: opts.Units, Presentation: string(opts.Presentation)}
+}
+
+// OutputSTL describes STL output files of the selection.
+func OutputSTL(coords System, units UnitLength, storage StlStorage, selection Selection) OutputFormat {
+	return OutputFormat{Type: FileExportFormatStl, Coords: &coords, Units: units, Storage: string(storage), Selection: selection}
+}
+
+// Validate checks the options are valid for the format.
+func (f OutputFormat) Validate() error {
+	if !f.Type.IsValid() {
+		return fmt.Errorf("invalid output format %q", f.Type)
+	}
+	if f.Coords != nil {
+		if err := validateSystem(*f.Coords); err != nil {
+			return fmt.Errorf("%s output: %v", f.Type, err)
+		}
+	}
+	if f.Units != "" && !f.Units.IsValid() {
+		return fmt.Errorf("%s output: invalid units %q", f.Type, f.Units)
+	}
+
+	var storageValid, presentationValid bool
+	switch f.Type {
+	case FileExportFormatFbx:
+		storageValid = FbxStorage(f.Storage).IsValid()
+		presentationValid = f.Presentation == ""
+	case FileExportFormatGltf:
+		storageValid = GltfStorage(f.Storage).IsValid()
+		presentationValid = GltfPresentation(f.Presentation).IsValid()
+	case FileExportFormatObj:
+		storageValid, presentationValid = f.Storage == "", f.Presentation == ""
+	case FileExportFormatPly:
+		storageValid = PlyStorage(f.Storage).IsValid()
+		presentationValid = f.Presentation == ""
+	case FileExportFormatStep:
+		storageValid = f.Storage == ""
+		presentationValid = f.Presentation == "" || StepPresentation(f.Presentation).IsValid()
+	case FileExportFormatStl:
+		storageValid = StlStorage(f.Storage).IsValid()
+		presentationValid = f.Presentation == ""
+	default:
+		return fmt.Errorf("%s output is not supported, use glTF with binary storage", f.Type)
+	}
+	if !storageValid {
+		return fmt.Errorf("%s output: invalid storage %q", f.Type, f.Storage)
+	}
+	if !presentationValid {
+		return fmt.Errorf("%s output: invalid presentation %q", f.Type, f.Presentation)
+	}
+
+	switch f.Type {
+	case FileExportFormatObj, FileExportFormatPly, FileExportFormatStl:
+		if f.Coords == nil || f.Units == "" {
+			return fmt.Errorf("%s output: the co-ordinate system and units are required", f.Type)
+		}
+	}
+	switch f.Type {
+	case FileExportFormatPly, FileExportFormatStl:
+		if f.Selection == nil {
+			return fmt.Errorf("%s output: the selection is required", f.Type)
+		}
+	}
+	return nil
+}
+
+// selection is the wire format of a Selection.
+type selection struct {
+	Type  string `json:"type"`
+	Index *int   `json:"index,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// SelectDefaultScene selects the default scene of the model.
+func SelectDefaultScene() Selection {
+	return selection{Type: "default_scene"}
+}
+
+// SelectSceneByIndex selects the scene with the index.
+func SelectSceneByIndex(index int) Selection {
+	return selection{Type: "scene_by_index", Index: &index}
+}
+
+// SelectSceneByName selects the first scene with the name.
+func SelectSceneByName(name string) Selection {
+	return selection{Type: "scene_by_name", Name: name}
+}
+
+// SelectMeshByIndex selects the mesh with the index.
+func SelectMeshByIndex(index int) Selection {
+	return selection{Type: "mesh_by_index", Index: &index}
+}
+
+// SelectMeshByName selects the first mesh with the name.
+func SelectMeshByName(name string) Selection {
+	return selection{Type: "mesh_by_name", Name: name}
+}
+
+// ConversionRequest is a conversion of source files with options, to send
+// with FileService.Convert.
+type ConversionRequest struct {
+	// Src describes the source files.
+	Src InputFormat
+	// Output describes the output files.
+	Output OutputFormat
+	// Files are the source files, in order. Formats referencing other files,
+	// like glTF with its buffers or OBJ with its materials, need all of them.
+	Files []ConversionFile
+}
+
+// ConversionFile is a source file of a ConversionRequest.
+type ConversionFile struct {
+	// Name is the name of the file, which files referencing it use.
+	Name string
+	// Contents are the contents of the file.
+	Contents []byte
+}
+
+// NewConversionRequest creates a conversion request from the source format
+// to the output format.
+func NewConversionRequest(src InputFormat, output OutputFormat) *ConversionRequest {
+	return &ConversionRequest{Src: src, Output: output}
+}
+
+// AddFile adds a source file to the request.
+func (r *ConversionRequest) AddFile(name string, contents []byte) *ConversionRequest {
+	r.Files = append(r.Files, ConversionFile{Name: name, Contents: contents})
+	return r
+}
+
+// Validate checks the formats and the files of the request.
+func (r *ConversionRequest) Validate() error {
+	if err := r.Src.Validate(); err != nil {
+		return err
+	}
+	if err := r.Output.Validate(); err != nil {
+		return err
+	}
+	if len(r.Files) == 0 {
+		return errors.New("the conversion has no source files")
+	}
+
+	names := map[string]bool{}
+	for _, file := range r.Files {
+		if file.Name == "" || file.Name == "body" {
+			return fmt.Errorf("invalid source file name %q", file.Name)
+		}
+		if names[file.Name] {
+			return fmt.Errorf("source file %q is given twice", file.Name)
+		}
+		names[file.Name] = true
+	}
+	return nil
+}
+
+// MultipartForm validates the request and assembles its multipart body,
+// for FileService.CreateConversionOptions.
+func (r *ConversionRequest) MultipartForm() (*MultipartForm, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	form := NewMultipartForm()
+	if err := form.WriteJSONField("body", ConversionParams{SrcFormat: r.Src, OutputFormat: r.Output}); err != nil {
+		return nil, err
+	}
+	for _, file := range r.Files {
+		if err := form.WriteFile(file.Name, file.Name, file.Contents); err != nil {
+			return nil, err
+		}
+	}
+	return form, nil
+}
+
+// Convert validates the request, and starts the conversion.
+func (s *FileService) Convert(r *ConversionRequest) (*FileConversion, error) {
+	form, err := r.MultipartForm()
+	if err != nil {
+		return nil, err
+	}
+	return s.CreateConversionOptions(form)
+}
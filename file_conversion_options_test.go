@@ -0,0 +1,110 @@
+package kittycad
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConversionRequestValidate(t *testing.T) {
+	sideways := System{
+		Forward: AxisDirectionPair{Axis: AxiZ, Direction: DirectionPositive},
+		Up:      AxisDirectionPair{Axis: AxiZ, Direction: DirectionNegative},
+	}
+
+	for _, test := range []struct {
+		name    string
+		src     InputFormat
+		output  OutputFormat
+		wantErr string
+	}{
+		{name: "stl to gltf", src: InputSTL(SystemZUp, UnitLengthMm), output: OutputGLTF(GltfStorageBinary, GltfPresentationCompact)},
+		{name: "step to stl", src: InputSTEP(BrepInputOptions{Coords: new(SystemYUp)}, false), output: OutputSTL(SystemZUp, UnitLengthIn, StlStorageAscii, SelectDefaultScene())},
+		{name: "fbx to step", src: InputFBX(), output: OutputSTEP(StepOutputOptions{})},
+		{name: "units required", src: InputOBJ(SystemZUp, ""), output: OutputFBX(FbxStorageBinary), wantErr: "invalid units"},
+		{name: "parallel axes", src: InputPLY(sideways, UnitLengthM), output: OutputFBX(FbxStorageBinary), wantErr: "not orthogonal"},
+		{name: "gltf takes no options", src: InputFormat{Type: FileImportFormatGltf, Units: UnitLengthM}, output: OutputFBX(FbxStorageBinary), wantErr: "takes no options"},
+		{name: "storage required", src: InputGLTF(), output: OutputGLTF("", GltfPresentationPretty), wantErr: "invalid storage"},
+		{name: "selection required", src: InputGLTF(), output: OutputSTL(SystemZUp, UnitLengthM, StlStorageBinary, nil), wantErr: "selection is required"},
+		{name: "glb output", src: InputGLTF(), output: OutputFormat{Type: FileExportFormatGlb}, wantErr: "not supported"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewConversionRequest(test.src, test.output).AddFile("model", []byte("solid")).Validate()
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validating the request failed: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFileConvert(t *testing.T) {
+	var params map[string]any
+	files := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if part.FormName() == "body" {
+				json.NewDecoder(part).Decode(&params)
+				continue
+			}
+			contents, _ := io.ReadAll(part)
+			files[part.FileName()] = string(contents)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"id": "33333333-3333-3333-3333-333333333333", "output_format": "stl", "src_format": "obj", "status": "queued", "created_at": "2024-01-01T00:00:00Z"})
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+
+	req := NewConversionRequest(InputOBJ(SystemYUp, UnitLengthCm), OutputSTL(SystemZUp, UnitLengthMm, StlStorageBinary, SelectMeshByName("gear"))).
+		AddFile("gear.obj", []byte("mtllib gear.mtl")).
+		AddFile("gear.mtl", []byte("newmtl steel"))
+	conversion, err := client.File.Convert(req)
+	if err != nil {
+		t.Fatalf("converting failed: %v", err)
+	}
+	if conversion.OutputFormat != FileExportFormatStl {
+		t.Fatalf("unexpected conversion %+v", conversion)
+	}
+
+	if files["gear.obj"] != "mtllib gear.mtl" || files["gear.mtl"] != "newmtl steel" {
+		t.Fatalf("unexpected files %v", files)
+	}
+	src, _ := json.Marshal(params["src_format"])
+	if want := `{"coords":{"forward":{"axis":"z","direction":"positive"},"up":{"axis":"y","direction":"positive"}},"type":"obj","units":"cm"}`; string(src) != want {
+		t.Fatalf("unexpected source format %s", src)
+	}
+	output, _ := json.Marshal(params["output_format"])
+	if want := `{"coords":{"forward":{"axis":"y","direction":"negative"},"up":{"axis":"z","direction":"positive"}},"selection":{"name":"gear","type":"mesh_by_name"},"storage":"binary","type":"stl","units":"mm"}`; string(output) != want {
+		t.Fatalf("unexpected output format %s", output)
+	}
+}
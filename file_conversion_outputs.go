@@ -0,0 +1,131 @@
+package kittycad
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// ArchiveFormat is the format of an archive written with
+// WriteConversionOutputsArchive.
+type ArchiveFormat string
+
+const (
+	// ArchiveZip is a zip archive.
+	ArchiveZip ArchiveFormat = "zip"
+	// ArchiveTar is an uncompressed tar archive.
+	ArchiveTar ArchiveFormat = "tar"
+)
+
+// ConversionOutputs returns the outputs of a completed file conversion,
+// sorted by path. The conversion can be a FileConversion, the file
+// conversion variant of AsyncAPICallOutput, pointers to them, or an async
+// operation as returned by APICallService.GetAsyncOperation.
+func ConversionOutputs(resp any) ([]ExportFile, error) {
+	var c FileConversion
+	switch r := resp.(type) {
+	case FileConversion:
+		c = r
+	case *FileConversion:
+		c = *r
+	case AsyncAPICallOutputCompletedAt:
+		c = FileConversion{ID: r.ID, Status: r.Status, Error: r.Error, OutputFormat: r.OutputFormat, Outputs: r.Outputs}
+	case *AsyncAPICallOutputCompletedAt:
+		c = FileConversion{ID: r.ID, Status: r.Status, Error: r.Error, OutputFormat: r.OutputFormat, Outputs: r.Outputs}
+	case *any:
+		return ConversionOutputs(*r)
+	default:
+		var op struct {
+			Type string `json:"type"`
+			FileConversion
+		}
+		b, err := json.Marshal(r)
+		if err != nil {
+			return nil, fmt.Errorf("encoding the conversion failed: %v", err)
+		}
+		if err := json.Unmarshal(b, &op); err != nil {
+			return nil, fmt.Errorf("decoding the conversion failed: %v", err)
+		}
+		if op.Type != "file_conversion" {
+			return nil, fmt.Errorf("%T of type %q is not a file conversion", resp, op.Type)
+		}
+		c = op.FileConversion
+	}
+
+	switch c.Status {
+	case APICallStatusCompleted:
+	case APICallStatusFailed:
+		message := "no error was reported"
+		if c.Error != nil {
+			message = *c.Error
+		}
+		return nil, fmt.Errorf("the conversion failed: %s", message)
+	default:
+		return nil, fmt.Errorf("the conversion is %s, not completed", c.Status)
+	}
+
+	files := []ExportFile{}
+	for _, name := range slices.Sorted(maps.Keys(c.Outputs)) {
+		files = append(files, ExportFile{Name: name, Contents: c.Outputs[name]})
+	}
+	return files, nil
+}
+
+// WriteConversionOutputs writes the outputs of the completed conversion
+// into dir, keeping their relative paths, like WriteExportFiles. The
+// conversion can be anything ConversionOutputs accepts. It returns the paths
+// of the files written.
+func WriteConversionOutputs(dir string, resp any) ([]string, error) {
+	files, err := ConversionOutputs(resp)
+	if err != nil {
+		return nil, err
+	}
+	return WriteExportFiles(dir, files)
+}
+
+// WriteConversionOutputsArchive writes the outputs of the completed
+// conversion as an archive to w, keeping their relative paths. The
+// conversion can be anything ConversionOutputs accepts. It returns the
+// paths of the files in the archive.
+func WriteConversionOutputsArchive(w io.Writer, format ArchiveFormat, resp any) ([]string, error) {
+	files, err := ConversionOutputs(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, file := range files {
+		name, err := localFileName(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	switch format {
+	case ArchiveZip:
+		err = WriteExportZip(w, files)
+	case ArchiveTar:
+		err = WriteExportTar(w, files)
+	default:
+		return nil, fmt.Errorf("invalid archive format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// WriteOutputs writes the outputs of the completed conversion into dir,
+// like WriteConversionOutputs.
+func (c *FileConversion) WriteOutputs(dir string) ([]string, error) {
+	return WriteConversionOutputs(dir, c)
+}
+
+// WriteOutputsArchive writes the outputs of the completed conversion as an
+// archive to w, like WriteConversionOutputsArchive.
+func (c *FileConversion) WriteOutputsArchive(w io.Writer, format ArchiveFormat) ([]string, error) {
+	return WriteConversionOutputsArchive(w, format, c)
+}
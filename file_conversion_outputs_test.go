@@ -0,0 +1,111 @@
+package kittycad
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFileConversionWriteOutputs(t *testing.T) {
+	conversion := &FileConversion{
+		Status: APICallStatusCompleted,
+		Outputs: map[string]Base64{
+			"model/output.obj": {Inner: []byte("mtllib output.mtl")},
+			"model/output.mtl": {Inner: []byte("newmtl steel")},
+		},
+	}
+
+	dir := t.TempDir()
+	paths, err := conversion.WriteOutputs(dir)
+	if err != nil {
+		t.Fatalf("writing the outputs failed: %v", err)
+	}
+	want := []string{filepath.Join(dir, "model", "output.mtl"), filepath.Join(dir, "model", "output.obj")}
+	if !slices.Equal(paths, want) {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+	if contents, _ := os.ReadFile(want[1]); string(contents) != "mtllib output.mtl" {
+		t.Fatalf("unexpected contents %q", contents)
+	}
+
+	var archive bytes.Buffer
+	names, err := conversion.WriteOutputsArchive(&archive, ArchiveZip)
+	if err != nil {
+		t.Fatalf("writing the zip archive failed: %v", err)
+	}
+	if !slices.Equal(names, []string{"model/output.mtl", "model/output.obj"}) {
+		t.Fatalf("unexpected names %v", names)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil || len(reader.File) != 2 || reader.File[1].Name != "model/output.obj" {
+		t.Fatalf("unexpected zip archive: %v", err)
+	}
+
+	archive.Reset()
+	if _, err := conversion.WriteOutputsArchive(&archive, ArchiveTar); err != nil {
+		t.Fatalf("writing the tar archive failed: %v", err)
+	}
+	tr := tar.NewReader(&archive)
+	header, err := tr.Next()
+	if err != nil || header.Name != "model/output.mtl" {
+		t.Fatalf("unexpected tar archive: %v", err)
+	}
+	if contents, _ := io.ReadAll(tr); string(contents) != "newmtl steel" {
+		t.Fatalf("unexpected contents %q", contents)
+	}
+}
+
+func TestConversionOutputsRefused(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		conversion *FileConversion
+		wantErr    string
+	}{
+		{name: "absolute", conversion: &FileConversion{Status: APICallStatusCompleted, Outputs: map[string]Base64{"/etc/passwd": {}}}, wantErr: "not a local path"},
+		{name: "parent", conversion: &FileConversion{Status: APICallStatusCompleted, Outputs: map[string]Base64{"../output.stl": {}}}, wantErr: "not a local path"},
+		{name: "failed", conversion: &FileConversion{Status: APICallStatusFailed, Error: new("invalid geometry")}, wantErr: "invalid geometry"},
+		{name: "in progress", conversion: &FileConversion{Status: APICallStatusInProgress}, wantErr: "not completed"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if _, err := test.conversion.WriteOutputs(dir); err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", test.wantErr, err)
+			}
+			if _, err := test.conversion.WriteOutputsArchive(io.Discard, ArchiveTar); err == nil {
+				t.Fatalf("expected the archive to be refused")
+			}
+			if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+				t.Fatalf("expected nothing to be written, got %v", entries)
+			}
+		})
+	}
+}
+
+func TestConversionOutputsAsyncOperation(t *testing.T) {
+	var operation any
+	json.Unmarshal([]byte(`{"type":"file_conversion","status":"completed","output_format":"stl","outputs":{"output.stl":"c29saWQ"}}`), &operation)
+
+	for _, resp := range []any{&operation, operation, AsyncAPICallOutputCompletedAt{Status: APICallStatusCompleted, Outputs: map[string]Base64{"output.stl": {Inner: []byte("solid")}}}} {
+		dir := t.TempDir()
+		paths, err := WriteConversionOutputs(dir, resp)
+		if err != nil || len(paths) != 1 {
+			t.Fatalf("writing the outputs of %T failed: %v", resp, err)
+		}
+		if contents, _ := os.ReadFile(paths[0]); string(contents) != "solid" {
+			t.Fatalf("unexpected contents %q", contents)
+		}
+	}
+
+	var textToCad any
+	json.Unmarshal([]byte(`{"type":"text_to_cad","status":"completed","outputs":{"output.stl":"c29saWQ"}}`), &textToCad)
+	if _, err := ConversionOutputs(textToCad); err == nil || !strings.Contains(err.Error(), "not a file conversion") {
+		t.Fatalf("expected the text-to-CAD output to be refused, got %v", err)
+	}
+}
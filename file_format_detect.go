@@ -0,0 +1,253 @@
+package kittycad
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"unicode/utf8"
+)
+
+// DetectHeaderSize is the number of bytes at the start of a file
+// DetectImportFormat needs to recognize every format by its contents.
+const DetectHeaderSize = 1024
+
+// ErrUnknownImportFormat is returned by DetectImportFormat when neither the
+// name nor the contents of a file tell its format.
+var ErrUnknownImportFormat = errors.New("unknown import format")
+
+// importFormatExtensions are the file extensions of the import formats.
+// The .prt extension is used by both Creo and NX, so it is told apart by
+// the contents of the file.
+var importFormatExtensions = map[string]FileImportFormat{
+	".sat":     FileImportFormatAcis,
+	".sab":     FileImportFormatAcis,
+	".catpart": FileImportFormatCatia,
+	".fbx":     FileImportFormatFbx,
+	".gltf":    FileImportFormatGltf,
+	".glb":     FileImportFormatGltf,
+	".ipt":     FileImportFormatInventor,
+	".obj":     FileImportFormatObj,
+	".x_t":     FileImportFormatParasolid,
+	".x_b":     FileImportFormatParasolid,
+	".xmt_txt": FileImportFormatParasolid,
+	".xmt_bin": FileImportFormatParasolid,
+	".ply":     FileImportFormatPly,
+	".sldprt":  FileImportFormatSldprt,
+	".step":    FileImportFormatStep,
+	".stp":     FileImportFormatStep,
+	".stl":     FileImportFormatStl,
+}
+
+// importFormatFromName returns the format of the file from its extension.
+func importFormatFromName(name string) (FileImportFormat, bool) {
+	format, ok := importFormatExtensions[fileExtension(name)]
+	return format, ok
+}
+
+// fileExtension returns the lower case extension of the file name,
+// ignoring the version number Creo appends, like part.prt.3.
+func fileExtension(name string) string {
+	name = strings.ToLower(path.Base(strings.ReplaceAll(name, `\`, "/")))
+	ext := path.Ext(name)
+	if len(ext) > 1 && strings.Trim(ext[1:], "0123456789") == "" {
+		ext = path.Ext(strings.TrimSuffix(name, ext))
+	}
+	return ext
+}
+
+// oleMagic starts the OLE compound files of SolidWorks, Inventor and NX.
+var oleMagic = []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}
+
+// sniffImportFormat returns the format of the file from the magic numbers
+// and headers of its contents, or "" if they are not distinctive.
+func sniffImportFormat(header []byte) FileImportFormat {
+	text := bytes.TrimLeft(bytes.TrimPrefix(header, []byte("\xef\xbb\xbf")), " \t\r\n")
+	switch {
+	case bytes.HasPrefix(header, []byte("glTF")):
+		return FileImportFormatGltf
+	case bytes.HasPrefix(text, []byte("{")) && bytes.Contains(text, []byte(`"asset"`)):
+		return FileImportFormatGltf
+	case bytes.HasPrefix(text, []byte("ISO-10303-21;")):
+		return FileImportFormatStep
+	case bytes.HasPrefix(header, []byte("Kaydara FBX Binary")), bytes.HasPrefix(text, []byte("; FBX ")):
+		return FileImportFormatFbx
+	case bytes.HasPrefix(header, []byte("ply\n")), bytes.HasPrefix(header, []byte("ply\r\n")):
+		return FileImportFormatPly
+	case bytes.HasPrefix(text, []byte("**ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz**")), bytes.HasPrefix(header, []byte("PS")) && bytes.Contains(header, []byte(": TRANSMIT FILE")):
+		return FileImportFormatParasolid
+	case bytes.HasPrefix(header, []byte("ACIS BinaryFile")):
+		return FileImportFormatAcis
+	case bytes.HasPrefix(header, []byte("V5_CFV2")):
+		return FileImportFormatCatia
+	case bytes.HasPrefix(header, []byte("#UGC:")):
+		return FileImportFormatCreo
+	case isASCIISTL(text):
+		return FileImportFormatStl
+	case isOBJ(text):
+		return FileImportFormatObj
+	}
+	return ""
+}
+
+// isASCIISTL reports whether the header is an ASCII STL file. Binary STL
+// headers may start with "solid" too, so the first facet is looked for.
+func isASCIISTL(text []byte) bool {
+	if !bytes.HasPrefix(text, []byte("solid")) || !isText(text) {
+		return false
+	}
+	_, rest, ok := bytes.Cut(text, []byte("\n"))
+	rest = bytes.TrimLeft(rest, " \t\r\n")
+	return ok && (bytes.HasPrefix(rest, []byte("facet")) || bytes.HasPrefix(rest, []byte("endsolid")))
+}
+
+// isOBJ reports whether the header is the text of an OBJ file, which has
+// no magic number, by its first statement.
+func isOBJ(text []byte) bool {
+	if !isText(text) {
+		return false
+	}
+	for line := range bytes.Lines(text) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		keyword, _, _ := bytes.Cut(line, []byte(" "))
+		switch string(keyword) {
+		case "v", "vt", "vn", "f", "o", "g", "s", "mtllib", "usemtl":
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// isText reports whether the header is UTF-8 text, allowing its end to cut
+// a character.
+func isText(header []byte) bool {
+	if bytes.IndexByte(header, 0) >= 0 {
+		return false
+	}
+	for i := 0; i < utf8.UTFMax && len(header) > 0 && !utf8.Valid(header); i++ {
+		header = header[:len(header)-1]
+	}
+	return utf8.Valid(header)
+}
+
+// DetectImportFormat returns the format of the file from its name and the
+// start of its contents, which should be at least DetectHeaderSize bytes
+// long unless the file is shorter, and the suggested options to import it.
+//
+// The contents are trusted over the name when they are distinctive, so a
+// STEP file named part.stl is detected as STEP. Binary STL files and some
+// proprietary formats have no magic number, so they are detected from
+// their name, with the contents used to tell Creo and NX .prt files apart.
+//
+// The options are the defaults of the API, made explicit: the KittyCAD
+// co-ordinate system and millimeters for the mesh formats, which do not
+// store their units, and B-rep import for STEP. They can be changed before
+// the conversion. STEP files are imported the same way whatever their
+// application protocol, which DetectStepSchema tells.
+func DetectImportFormat(name string, header []byte) (FileImportFormat, InputFormat, error) {
+	format := sniffImportFormat(header)
+	if format == "" {
+		format, _ = importFormatFromName(name)
+	}
+	if format == "" && fileExtension(name) == ".prt" && bytes.HasPrefix(header, oleMagic) {
+		format = FileImportFormatNx
+	}
+	if format == "" {
+		return "", InputFormat{}, fmt.Errorf("%w: %q", ErrUnknownImportFormat, name)
+	}
+
+	var opts InputFormat
+	switch format {
+	case FileImportFormatFbx:
+		opts = InputFBX()
+	case FileImportFormatGltf:
+		opts = InputGLTF()
+	case FileImportFormatObj:
+		opts = InputOBJ(SystemZUp, UnitLengthMm)
+	case FileImportFormatPly:
+		opts = InputPLY(SystemZUp, UnitLengthMm)
+	case FileImportFormatStl:
+		opts = InputSTL(SystemZUp, UnitLengthMm)
+	case FileImportFormatStep:
+		opts = InputSTEP(BrepInputOptions{}, false)
+	default:
+		opts = brepInput(format, BrepInputOptions{})
+	}
+	return format, opts, nil
+}
+
+// StepSchema is the application protocol of a STEP file, as named in the
+// FILE_SCHEMA of its header.
+type StepSchema string
+
+// The application protocols told apart by DetectStepSchema.
+const (
+	StepSchemaAP203 StepSchema = "AP203"
+	StepSchemaAP214 StepSchema = "AP214"
+	StepSchemaAP242 StepSchema = "AP242"
+)
+
+// stepSchemas maps the prefixes of the schema names in FILE_SCHEMA to their
+// application protocol.
+var stepSchemas = []struct {
+	prefix string
+	schema StepSchema
+}{
+	{"CONFIG_CONTROL_DESIGN", StepSchemaAP203},
+	{"AP203_", StepSchemaAP203},
+	{"AUTOMOTIVE_DESIGN", StepSchemaAP214},
+	{"AP214_", StepSchemaAP214},
+	{"AP242_", StepSchemaAP242},
+}
+
+// DetectStepSchema returns the application protocol of a STEP file from
+// the FILE_SCHEMA of its header, or "" if the header does not hold it or
+// names another schema. The header usually fits in DetectHeaderSize bytes,
+// but long FILE_NAME entries can push FILE_SCHEMA further.
+//
+// The API imports every application protocol with the same options, so
+// DetectImportFormat does not report it. It is useful to check that a file
+// carries what the protocol can hold, like the colors and layers of AP214,
+// which AP203 files lack.
+func DetectStepSchema(header []byte) StepSchema {
+	_, rest, ok := bytes.Cut(header, []byte("FILE_SCHEMA"))
+	if !ok {
+		return ""
+	}
+	_, rest, ok = bytes.Cut(rest, []byte("'"))
+	if !ok {
+		return ""
+	}
+	name, _, _ := bytes.Cut(rest, []byte("'"))
+	name = bytes.ToUpper(bytes.TrimSpace(name))
+	for _, s := range stepSchemas {
+		if bytes.HasPrefix(name, []byte(s.prefix)) {
+			return s.schema
+		}
+	}
+	return ""
+}
+
+// DetectImportFormatFile detects the format of the file at the path, like
+// DetectImportFormat.
+func DetectImportFormatFile(name string) (FileImportFormat, InputFormat, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", InputFormat{}, err
+	}
+	defer f.Close()
+
+	header := make([]byte, DetectHeaderSize)
+	n, err := io.ReadFull(f, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", InputFormat{}, fmt.Errorf("reading %s failed: %v", name, err)
+	}
+	return DetectImportFormat(name, header[:n])
+}
@@ -0,0 +1,110 @@
+package kittycad
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectImportFormat(t *testing.T) {
+	// A binary STL whose header starts with "solid", as many exporters write.
+	binarySTL := make([]byte, 84+50)
+	copy(binarySTL, "solid exported by a CAD tool")
+	binary.LittleEndian.PutUint32(binarySTL[80:], 1)
+
+	for _, test := range []struct {
+		name   string
+		header string
+		want   FileImportFormat
+	}{
+		{name: "bracket.step", header: "ISO-10303-21;\nHEADER;\nFILE_SCHEMA(('AUTOMOTIVE_DESIGN { 1 0 10303 214 1 1 1 1 }'));", want: FileImportFormatStep},
+		{name: "bracket.STP", header: "ISO-10303-21;\nHEADER;\nFILE_SCHEMA(('CONFIG_CONTROL_DESIGN'));", want: FileImportFormatStep},
+		{name: "misnamed.stl", header: "ISO-10303-21;\nHEADER;", want: FileImportFormatStep},
+		{name: "cube.stl", header: "solid cube\n  facet normal 0 0 1\n    outer loop\n", want: FileImportFormatStl},
+		{name: "cube.stl", header: string(binarySTL), want: FileImportFormatStl},
+		{name: "mesh", header: "solid cube\nendsolid cube\n", want: FileImportFormatStl},
+		{name: "gear.obj", header: "# exported\nmtllib gear.mtl\nv 0 0 0\n", want: FileImportFormatObj},
+		{name: "mesh", header: "v 1.0 2.0 3.0\nv 1.0 2.0 3.5\nf 1 2 3\n", want: FileImportFormatObj},
+		{name: "scan.ply", header: "ply\nformat binary_little_endian 1.0\n", want: FileImportFormatPly},
+		{name: "scene.glb", header: "glTF\x02\x00\x00\x00", want: FileImportFormatGltf},
+		{name: "scene.gltf", header: "{\n  \"asset\": {\"version\": \"2.0\"},", want: FileImportFormatGltf},
+		{name: "rig.fbx", header: "Kaydara FBX Binary  \x00\x1a\x00", want: FileImportFormatFbx},
+		{name: "rig.fbx", header: "; FBX 7.4.0 project file\n", want: FileImportFormatFbx},
+		{name: "block.x_t", header: "**ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz**************************\n", want: FileImportFormatParasolid},
+		{name: "block.x_b", header: "PS\x00\x00\x00\x33: TRANSMIT FILE (partition) created by modeller version 3300", want: FileImportFormatParasolid},
+		{name: "block.sab", header: "ACIS BinaryFile(c) 2003 Spatial", want: FileImportFormatAcis},
+		{name: "block.sat", header: "700 0 1 0\n", want: FileImportFormatAcis},
+		{name: "body.CATPart", header: "V5_CFV2\x00\x00", want: FileImportFormatCatia},
+		{name: "housing.SLDPRT", header: "\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1", want: FileImportFormatSldprt},
+		{name: "housing.ipt", header: "\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1", want: FileImportFormatInventor},
+		{name: "housing.prt.3", header: "#UGC:2 PART 1229 1140 600 1 not_compressed", want: FileImportFormatCreo},
+		{name: `C:\models\housing.prt`, header: "\xd0\xcf\x11\xe0\xa1\xb1\x1a\xe1", want: FileImportFormatNx},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			format, opts, err := DetectImportFormat(test.name, []byte(test.header))
+			if err != nil {
+				t.Fatalf("detecting the format failed: %v", err)
+			}
+			if format != test.want || opts.Type != test.want {
+				t.Fatalf("expected %s, got %s with options %+v", test.want, format, opts)
+			}
+			if err := opts.Validate(); err != nil {
+				t.Fatalf("the suggested options are invalid: %v", err)
+			}
+		})
+	}
+}
+
+func TestDetectImportFormatOptions(t *testing.T) {
+	_, opts, _ := DetectImportFormat("cube.stl", []byte("solid cube\nendsolid cube\n"))
+	if opts.Units != UnitLengthMm || opts.Coords == nil || *opts.Coords != SystemZUp {
+		t.Fatalf("unexpected STL options %+v", opts)
+	}
+	_, opts, _ = DetectImportFormat("bracket.step", []byte("ISO-10303-21;"))
+	if opts.TargetRepresentation != "brep" || opts.Units != "" {
+		t.Fatalf("unexpected STEP options %+v", opts)
+	}
+}
+
+func TestDetectStepSchema(t *testing.T) {
+	header := func(schema string) []byte {
+		return []byte("ISO-10303-21;\nHEADER;\nFILE_DESCRIPTION(('bracket'),'2;1');\nFILE_NAME('bracket.step','2024-01-01T00:00:00',(''),(''),'','','');\nFILE_SCHEMA((" + schema + "));\nENDSEC;\n")
+	}
+	for schema, want := range map[string]StepSchema{
+		"'CONFIG_CONTROL_DESIGN'": StepSchemaAP203,
+		"'AP203_CONFIGURATION_CONTROLLED_3D_DESIGN_OF_MECHANICAL_PARTS_AND_ASSEMBLIES_MIM_LF { 1 0 10303 403 3 1 4 }'": StepSchemaAP203,
+		"'AUTOMOTIVE_DESIGN { 1 0 10303 214 1 1 1 1 }'":                                                                StepSchemaAP214,
+		"'automotive_design_cc2'": StepSchemaAP214,
+		"'AP242_MANAGED_MODEL_BASED_3D_ENGINEERING_MIM_LF { 1 0 10303 442 1 1 4 }'": StepSchemaAP242,
+		"'IFC4'": "",
+	} {
+		if got := DetectStepSchema(header(schema)); got != want {
+			t.Errorf("expected %q for %s, got %q", want, schema, got)
+		}
+	}
+	if got := DetectStepSchema([]byte("ISO-10303-21;\nHEADER;\n")); got != "" {
+		t.Errorf("expected no schema for a truncated header, got %q", got)
+	}
+}
+
+func TestDetectImportFormatUnknown(t *testing.T) {
+	for _, name := range []string{"notes.txt", "housing.prt"} {
+		if _, _, err := DetectImportFormat(name, []byte("just some notes")); !errors.Is(err, ErrUnknownImportFormat) {
+			t.Fatalf("expected ErrUnknownImportFormat for %s, got %v", name, err)
+		}
+	}
+}
+
+func TestDetectImportFormatFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "model.bin")
+	header := "solid cube\n" + strings.Repeat("facet normal 0 0 1\nendfacet\n", 100)
+	if err := os.WriteFile(name, []byte(header), 0o644); err != nil {
+		t.Fatalf("writing the file failed: %v", err)
+	}
+	if format, _, err := DetectImportFormatFile(name); err != nil || format != FileImportFormatStl {
+		t.Fatalf("expected an STL file, got %s, %v", format, err)
+	}
+}
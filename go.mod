@@ -10,6 +10,7 @@ require (
 	github.com/iancoleman/strcase v0.3.0
 	github.com/sirupsen/logrus v1.9.4
 	github.com/wI2L/jsondiff v0.7.1
+	golang.org/x/term v0.34.0
 )
 
 require (
@@ -0,0 +1,517 @@
+// Code generated by `generate`. DO NOT EDIT.
+
+package kittycad
+
+import (
+	"context"
+
+	"github.com/gorilla/websocket"
+)
+
+// ClientAPI is the interface implemented by Client. It gives access to each
+// service as an interface, so code depending on the client can be tested
+// with a fake implementation.
+type ClientAPI interface {
+	// APICallAPI returns the APICall service.
+	APICallAPI() APICallServiceAPI
+	// APITokenAPI returns the APIToken service.
+	APITokenAPI() APITokenServiceAPI
+	// AppAPI returns the App service.
+	AppAPI() AppServiceAPI
+	// BetaAPI returns the Beta service.
+	BetaAPI() BetaServiceAPI
+	// ConstantAPI returns the Constant service.
+	ConstantAPI() ConstantServiceAPI
+	// ExecutorAPI returns the Executor service.
+	ExecutorAPI() ExecutorServiceAPI
+	// FactoryAPI returns the Factory service.
+	FactoryAPI() FactoryServiceAPI
+	// FileAPI returns the File service.
+	FileAPI() FileServiceAPI
+	// HiddenAPI returns the Hidden service.
+	HiddenAPI() HiddenServiceAPI
+	// MetaAPI returns the Meta service.
+	MetaAPI() MetaServiceAPI
+	// MlAPI returns the Ml service.
+	MlAPI() MlServiceAPI
+	// ModelingAPI returns the Modeling service.
+	ModelingAPI() ModelingServiceAPI
+	// Oauth2API returns the Oauth2 service.
+	Oauth2API() Oauth2ServiceAPI
+	// OrgAPI returns the Org service.
+	OrgAPI() OrgServiceAPI
+	// PaymentAPI returns the Payment service.
+	PaymentAPI() PaymentServiceAPI
+	// ProjectAPI returns the Project service.
+	ProjectAPI() ProjectServiceAPI
+	// ServiceAccountAPI returns the ServiceAccount service.
+	ServiceAccountAPI() ServiceAccountServiceAPI
+	// ShortlinkAPI returns the Shortlink service.
+	ShortlinkAPI() ShortlinkServiceAPI
+	// StoreAPI returns the Store service.
+	StoreAPI() StoreServiceAPI
+	// UnitAPI returns the Unit service.
+	UnitAPI() UnitServiceAPI
+	// UserAPI returns the User service.
+	UserAPI() UserServiceAPI
+}
+
+var _ ClientAPI = (*Client)(nil)
+
+// APICallAPI returns the APICall service.
+func (c *Client) APICallAPI() APICallServiceAPI {
+	return c.APICall
+}
+
+// APITokenAPI returns the APIToken service.
+func (c *Client) APITokenAPI() APITokenServiceAPI {
+	return c.APIToken
+}
+
+// AppAPI returns the App service.
+func (c *Client) AppAPI() AppServiceAPI {
+	return c.App
+}
+
+// BetaAPI returns the Beta service.
+func (c *Client) BetaAPI() BetaServiceAPI {
+	return c.Beta
+}
+
+// ConstantAPI returns the Constant service.
+func (c *Client) ConstantAPI() ConstantServiceAPI {
+	return c.Constant
+}
+
+// ExecutorAPI returns the Executor service.
+func (c *Client) ExecutorAPI() ExecutorServiceAPI {
+	return c.Executor
+}
+
+// FactoryAPI returns the Factory service.
+func (c *Client) FactoryAPI() FactoryServiceAPI {
+	return c.Factory
+}
+
+// FileAPI returns the File service.
+func (c *Client) FileAPI() FileServiceAPI {
+	return c.File
+}
+
+// HiddenAPI returns the Hidden service.
+func (c *Client) HiddenAPI() HiddenServiceAPI {
+	return c.Hidden
+}
+
+// MetaAPI returns the Meta service.
+func (c *Client) MetaAPI() MetaServiceAPI {
+	return c.Meta
+}
+
+// MlAPI returns the Ml service.
+func (c *Client) MlAPI() MlServiceAPI {
+	return c.Ml
+}
+
+// ModelingAPI returns the Modeling service.
+func (c *Client) ModelingAPI() ModelingServiceAPI {
+	return c.Modeling
+}
+
+// Oauth2API returns the Oauth2 service.
+func (c *Client) Oauth2API() Oauth2ServiceAPI {
+	return c.Oauth2
+}
+
+// OrgAPI returns the Org service.
+func (c *Client) OrgAPI() OrgServiceAPI {
+	return c.Org
+}
+
+// PaymentAPI returns the Payment service.
+func (c *Client) PaymentAPI() PaymentServiceAPI {
+	return c.Payment
+}
+
+// ProjectAPI returns the Project service.
+func (c *Client) ProjectAPI() ProjectServiceAPI {
+	return c.Project
+}
+
+// ServiceAccountAPI returns the ServiceAccount service.
+func (c *Client) ServiceAccountAPI() ServiceAccountServiceAPI {
+	return c.ServiceAccount
+}
+
+// ShortlinkAPI returns the Shortlink service.
+func (c *Client) ShortlinkAPI() ShortlinkServiceAPI {
+	return c.Shortlink
+}
+
+// StoreAPI returns the Store service.
+func (c *Client) StoreAPI() StoreServiceAPI {
+	return c.Store
+}
+
+// UnitAPI returns the Unit service.
+func (c *Client) UnitAPI() UnitServiceAPI {
+	return c.Unit
+}
+
+// UserAPI returns the User service.
+func (c *Client) UserAPI() UserServiceAPI {
+	return c.User
+}
+
+// APICallServiceAPI is the interface implemented by APICallService.
+type APICallServiceAPI interface {
+	Get(id UUID) (*APICallWithPrice, error)
+	GetAsyncOperation(id UUID) (*any, error)
+	GetForOrg(id UUID) (*APICallWithPrice, error)
+	GetForUser(id UUID) (*APICallWithPrice, error)
+	ListForUser(id string, params APICallListForUserParams) (*APICallWithPriceResultsPage, error)
+	OrgList(params APICallOrgListParams) (*APICallWithPriceResultsPage, error)
+	UserList(params APICallUserListParams) (*APICallWithPriceResultsPage, error)
+}
+
+var _ APICallServiceAPI = (*APICallService)(nil)
+
+// APITokenServiceAPI is the interface implemented by APITokenService.
+type APITokenServiceAPI interface {
+	CreateForUser(params APITokenCreateForUserParams) (*APITokenWithFullToken, error)
+	DeleteForUser(token string) error
+	GetForUser(token string) (*APIToken, error)
+	ListForUser(params APITokenListForUserParams) (*APITokenResultsPage, error)
+}
+
+var _ APITokenServiceAPI = (*APITokenService)(nil)
+
+// AppServiceAPI is the interface implemented by AppService.
+type AppServiceAPI interface {
+	GithubCallback(body any) error
+	GithubConsent() (*AppClientInfo, error)
+	GithubWebhook(body []byte) error
+}
+
+var _ AppServiceAPI = (*AppService)(nil)
+
+// BetaServiceAPI is the interface implemented by BetaService.
+type BetaServiceAPI interface {
+}
+
+var _ BetaServiceAPI = (*BetaService)(nil)
+
+// ConstantServiceAPI is the interface implemented by ConstantService.
+type ConstantServiceAPI interface {
+}
+
+var _ ConstantServiceAPI = (*ConstantService)(nil)
+
+// ExecutorServiceAPI is the interface implemented by ExecutorService.
+type ExecutorServiceAPI interface {
+	CreateFileExecution(lang CodeLanguage, params ExecutorCreateFileExecutionParams, body []byte) (*CodeOutput, error)
+	CreateTerm(ctx context.Context) (*websocket.Conn, error)
+}
+
+var _ ExecutorServiceAPI = (*ExecutorService)(nil)
+
+// FactoryServiceAPI is the interface implemented by FactoryService.
+type FactoryServiceAPI interface {
+	CreateUserJob(body *MultipartForm) (*FactoryJobResponse, error)
+	GetUserFinishes() (*[]FactoryCustomerCatalogOption, error)
+	GetUserMaterials() (*[]FactoryCustomerCatalogOption, error)
+}
+
+var _ FactoryServiceAPI = (*FactoryService)(nil)
+
+// FileServiceAPI is the interface implemented by FileService.
+type FileServiceAPI interface {
+	CreateCenterOfMass(params FileCreateCenterOfMassParams, body []byte) (*FileCenterOfMass, error)
+	CreateConversion(srcFormat FileImportFormat, outputFormat FileExportFormat, body []byte) (*FileConversion, error)
+	CreateConversionOptions(body *MultipartForm) (*FileConversion, error)
+	CreateDensity(params FileCreateDensityParams, body []byte) (*FileDensity, error)
+	CreateMass(params FileCreateMassParams, body []byte) (*FileMass, error)
+	CreateSurfaceArea(params FileCreateSurfaceAreaParams, body []byte) (*FileSurfaceArea, error)
+	CreateVolume(params FileCreateVolumeParams, body []byte) (*FileVolume, error)
+}
+
+var _ FileServiceAPI = (*FileService)(nil)
+
+// HiddenServiceAPI is the interface implemented by HiddenService.
+type HiddenServiceAPI interface {
+	AuthAPIKey() (*AuthAPIKeyResponse, error)
+	AuthEmail(body EmailAuthenticationForm) (*VerificationTokenResponse, error)
+	AuthEmailCallback(params HiddenAuthEmailCallbackParams) error
+	AuthEmailMarketingConfirmCreate(body EmailMarketingConfirmTokenBody) error
+	DownloadSharedProject(key string, params HiddenDownloadSharedProjectParams) error
+	GetAuthSaml(providerId UUID, params HiddenGetAuthSamlParams) error
+	GetAuthSamlByOrg(orgId UUID, params HiddenGetAuthSamlByOrgParams) error
+	Logout() error
+	PostAuthSaml(providerId UUID, body []byte) error
+	RedirectUserShortlink(key string) error
+}
+
+var _ HiddenServiceAPI = (*HiddenService)(nil)
+
+// MetaServiceAPI is the interface implemented by MetaService.
+type MetaServiceAPI interface {
+	CommunitySso(sso string, sig string) error
+	GetAnnouncements() (*AnnouncementList, error)
+	GetIpinfo() (*IpAddrInfo, error)
+	GetPricingSubscriptions() (*map[string][]ZooProductSubscription, error)
+	GetSchema() error
+	InternalGetAPITokenForDiscordUser(discordId string) (*APIToken, error)
+	Ping() (*Pong, error)
+}
+
+var _ MetaServiceAPI = (*MetaService)(nil)
+
+// MlServiceAPI is the interface implemented by MlService.
+type MlServiceAPI interface {
+	CopilotWs(ctx context.Context, params MlCopilotWsParams, body any) (*websocket.Conn, error)
+	CreateCustomModel(body CreateCustomModel) (*CustomModel, error)
+	CreateKclCodeCompletions(body KclCodeCompletionRequest) (*KclCodeCompletionResponse, error)
+	CreateProprietaryToKcl(params MlCreateProprietaryToKclParams, body *MultipartForm) (*KclModel, error)
+	CreateTextToCad(outputFormat FileExportFormat, params MlCreateTextToCadParams, body TextToCadCreateBody) (*TextToCad, error)
+	CreateTextToCadIteration(body TextToCadIterationBody) (*TextToCadIteration, error)
+	CreateTextToCadMultiFileIteration(body *MultipartForm) (*TextToCadMultiFileIteration, error)
+	CreateTextToCadPartFeedback(id UUID, feedback MlFeedback) error
+	GetCustomModel(id UUID) (*CustomModel, error)
+	GetTextToCadPartForUser(id UUID) (*any, error)
+	ListConversationsForUser(params MlListConversationsForUserParams) (*ConversationResultsPage, error)
+	ListOrgDatasetsForModel(id UUID) (*[]OrgDataset, error)
+	ListTextToCadPartsForUser(params MlListTextToCadPartsForUserParams) (*TextToCadResponseResultsPage, error)
+	ReasoningWs(ctx context.Context, id UUID, body any) (*websocket.Conn, error)
+	UpdateCustomModel(id UUID, body UpdateCustomModel) (*CustomModel, error)
+}
+
+var _ MlServiceAPI = (*MlService)(nil)
+
+// ModelingServiceAPI is the interface implemented by ModelingService.
+type ModelingServiceAPI interface {
+	CommandsWs(ctx context.Context, params ModelingCommandsWsParams, body any) (*websocket.Conn, error)
+}
+
+var _ ModelingServiceAPI = (*ModelingService)(nil)
+
+// Oauth2ServiceAPI is the interface implemented by Oauth2Service.
+type Oauth2ServiceAPI interface {
+	ApproveAuthorizationRequest(requestId UUID) (*Oauth2AuthorizationDecisionResponse, error)
+	Authorize(params Oauth2AuthorizeParams) error
+	CreateOrgApp(body CreateOauth2AppRequest) (*Oauth2AppResponse, error)
+	CreateUserApp(body CreateOauth2AppRequest) (*Oauth2AppResponse, error)
+	DeleteOrgApp(clientId UUID) error
+	DeleteUserApp(clientId UUID) error
+	DenyAuthorizationRequest(requestId UUID) (*Oauth2AuthorizationDecisionResponse, error)
+	DeviceAccessToken(body DeviceAccessTokenRequestForm) error
+	DeviceAuthConfirm(body DeviceAuthConfirmParams) error
+	DeviceAuthRequest(body DeviceAuthRequestForm) error
+	DeviceAuthVerify(params Oauth2DeviceAuthVerifyParams) error
+	GetAuthorizationRequest(requestId UUID) (*Oauth2AuthorizationRequestResponse, error)
+	GetOrgApp(clientId UUID) (*Oauth2AppResponse, error)
+	GetUserApp(clientId UUID) (*Oauth2AppResponse, error)
+	ListAppsForAnyOrg(id UUID, params Oauth2ListAppsForAnyOrgParams) (*Oauth2AppResponseResultsPage, error)
+	ListAppsForAnyUser(id string, params Oauth2ListAppsForAnyUserParams) (*Oauth2AppResponseResultsPage, error)
+	ListOrgApps(params Oauth2ListOrgAppsParams) (*Oauth2AppResponseResultsPage, error)
+	ListUserApps(params Oauth2ListUserAppsParams) (*Oauth2AppResponseResultsPage, error)
+	ProviderCallback(provider AccountProvider, params Oauth2ProviderCallbackParams) error
+	ProviderCallbackCreate(provider AccountProvider, body AuthCallback) error
+	ProviderConsent(provider AccountProvider, params Oauth2ProviderConsentParams) (*Oauth2ClientInfo, error)
+	Token(body Oauth2TokenRequestForm) error
+	TokenRevoke(body TokenRevokeRequestForm) error
+	UpdateOrgApp(clientId UUID, body UpdateOauth2AppRequest) (*Oauth2AppResponse, error)
+	UpdateUserApp(clientId UUID, body UpdateOauth2AppRequest) (*Oauth2AppResponse, error)
+	VerifyOauthAccountLinking(params Oauth2VerifyOauthAccountLinkingParams) error
+}
+
+var _ Oauth2ServiceAPI = (*Oauth2Service)(nil)
+
+// OrgServiceAPI is the interface implemented by OrgService.
+type OrgServiceAPI interface {
+	Create(body OrgDetails) (*Org, error)
+	CreateDataset(body CreateOrgDataset) (*OrgDataset, error)
+	CreateMember(body AddOrgMember) (*OrgMember, error)
+	CreateSamlIdp(body SamlIdentityProviderCreate) (*SamlIdentityProvider, error)
+	DatasetS3Policies(uri string, roleArn string) (*DatasetS3Policies, error)
+	Delete() error
+	DeleteDataset(id UUID) error
+	DeleteMember(userId UUID) error
+	DeleteSamlIdp() error
+	DownloadDatasetConversionOriginal(id UUID, conversionId UUID) error
+	DownloadDatasetSuccessfulKclBulk(id UUID) error
+	Get() (*Org, error)
+	GetBillingContractForAny(id UUID) (*BillingContractView, error)
+	GetDataset(id UUID) (*OrgDataset, error)
+	GetDatasetConversion(id UUID, conversionId UUID) (*OrgDatasetFileConversionDetails, error)
+	GetDatasetConversionStats(id UUID) (*OrgDatasetConversionStatsResponse, error)
+	GetMember(userId UUID) (*OrgMember, error)
+	GetPrivacySettings() (*PrivacySettings, error)
+	GetSamlIdp() (*SamlIdentityProvider, error)
+	GetShortlinks(params OrgGetShortlinksParams) (*ShortlinkResultsPage, error)
+	GetUser() (*UserOrgInfo, error)
+	ListDatasetConversions(id UUID, params OrgListDatasetConversionsParams) (*OrgDatasetFileConversionSummaryResultsPage, error)
+	ListDatasets(params OrgListDatasetsParams) (*OrgDatasetResultsPage, error)
+	ListMembers(params OrgListMembersParams) (*OrgMemberResultsPage, error)
+	ListSkills() (*[]OrgSkillResponse, error)
+	RetriggerDataset(id UUID, params OrgRetriggerDatasetParams) error
+	RetriggerDatasetConversion(id UUID, conversionId UUID) error
+	SearchDatasetConversions(id UUID, params OrgSearchDatasetConversionsParams) (*OrgDatasetFileConversionSummaryResultsPage, error)
+	SearchDatasetSemantic(id UUID, params OrgSearchDatasetSemanticParams) (*[]OrgDatasetSemanticSearchMatch, error)
+	Update(body OrgDetails) (*Org, error)
+	UpdateDataset(id UUID, body UpdateOrgDataset) (*OrgDataset, error)
+	UpdateMember(userId UUID, body UpdateMemberToOrgBody) (*OrgMember, error)
+	UpdatePrivacySettings(body PrivacySettings) (*PrivacySettings, error)
+	UpdateSamlIdp(body SamlIdentityProviderCreate) (*SamlIdentityProvider, error)
+	UploadDatasetFiles(id UUID, body *MultipartForm) (*UploadOrgDatasetFilesResponse, error)
+	UpsertBillingContractForAny(id UUID, body BillingContractUpsert) (*BillingContractView, error)
+}
+
+var _ OrgServiceAPI = (*OrgService)(nil)
+
+// PaymentServiceAPI is the interface implemented by PaymentService.
+type PaymentServiceAPI interface {
+	CreateInformationForOrg(body BillingInfo) (*Customer, error)
+	CreateInformationForUser(body BillingInfo) (*Customer, error)
+	CreateIntentForOrg() (*PaymentIntent, error)
+	CreateIntentForUser() (*PaymentIntent, error)
+	CreateOrgSubscription(body ZooProductSubscriptionsOrgRequest) (*ZooProductSubscriptions, error)
+	CreateUserSubscription(body ZooProductSubscriptionsUserRequest) (*ZooProductSubscriptions, error)
+	DeleteInformationForOrg() error
+	DeleteInformationForUser() error
+	DeleteMethodForOrg(id string) error
+	DeleteMethodForUser(id string) error
+	GetBalanceForAnyOrg(id UUID, params PaymentGetBalanceForAnyOrgParams) (*CustomerBalance, error)
+	GetBalanceForAnyUser(id string, params PaymentGetBalanceForAnyUserParams) (*CustomerBalance, error)
+	GetBalanceForOrg(params PaymentGetBalanceForOrgParams) (*CustomerBalance, error)
+	GetBalanceForUser(params PaymentGetBalanceForUserParams) (*CustomerBalance, error)
+	GetInformationForOrg() (*Customer, error)
+	GetInformationForUser() (*Customer, error)
+	GetOrgSubscription() (*ZooProductSubscriptions, error)
+	GetOrgUsageCollectionThreshold() (*AggregateUsageCollectionThresholdView, error)
+	GetUserSubscription() (*ZooProductSubscriptions, error)
+	GetUserUsageCollectionThreshold() (*AggregateUsageCollectionThresholdView, error)
+	ListInvoicesForOrg(params PaymentListInvoicesForOrgParams) (*InvoiceResultsPage, error)
+	ListInvoicesForUser(params PaymentListInvoicesForUserParams) (*InvoiceResultsPage, error)
+	ListMethodsForOrg() (*[]PaymentMethod, error)
+	ListMethodsForUser() (*[]PaymentMethod, error)
+	RedirectMethodPortalLinkForOrg(params PaymentRedirectMethodPortalLinkForOrgParams) error
+	RedirectMethodPortalLinkForUser(params PaymentRedirectMethodPortalLinkForUserParams) error
+	ResetOrgUsageCollectionThreshold(expectedVersion int) (*AggregateUsageCollectionThresholdView, error)
+	ResetUserUsageCollectionThreshold(expectedVersion int) (*AggregateUsageCollectionThresholdView, error)
+	SetDefaultMethodForUser(id string) error
+	SetOrgUsageCollectionThreshold(body AggregateUsageCollectionThresholdSet) (*AggregateUsageCollectionThresholdView, error)
+	SetUserUsageCollectionThreshold(body AggregateUsageCollectionThresholdSet) (*AggregateUsageCollectionThresholdView, error)
+	UpdateBalanceForAnyOrg(id UUID, params PaymentUpdateBalanceForAnyOrgParams, body UpdatePaymentBalance) (*CustomerBalance, error)
+	UpdateBalanceForAnyUser(id string, params PaymentUpdateBalanceForAnyUserParams, body UpdatePaymentBalance) (*CustomerBalance, error)
+	UpdateInformationForOrg(body BillingInfo) (*Customer, error)
+	UpdateInformationForUser(body BillingInfo) (*Customer, error)
+	UpdateOrgSubscription(body ZooProductSubscriptionsOrgRequest) (*ZooProductSubscriptions, error)
+	UpdateOrgSubscriptionForAnyOrg(id UUID, body ZooProductSubscriptionsOrgRequest) (*ZooProductSubscriptions, error)
+	UpdateUserSubscription(body ZooProductSubscriptionsUserRequest) (*ZooProductSubscriptions, error)
+	UpsertSubscriptionPlanPrice(slug string, body PriceUpsertRequest) (*SubscriptionPlanPriceRecord, error)
+	ValidateCustomerTaxInformationForOrg() error
+	ValidateCustomerTaxInformationForUser() error
+}
+
+var _ PaymentServiceAPI = (*PaymentService)(nil)
+
+// ProjectServiceAPI is the interface implemented by ProjectService.
+type ProjectServiceAPI interface {
+	Create(body *MultipartForm) (*ProjectResponse, error)
+	CreatePublicVote(id UUID) (*PublicProjectVoteResponse, error)
+	CreateShareLink(id UUID, body CreateProjectShareLinkRequest) (*ProjectShareLinkResponse, error)
+	Delete(id UUID) error
+	DeletePublicVote(id UUID) (*PublicProjectVoteResponse, error)
+	DeleteShareLink(id UUID, key string) error
+	Download(id UUID, params ProjectDownloadParams) error
+	DownloadPublic(id UUID, params ProjectDownloadPublicParams) error
+	Get(id UUID) (*ProjectResponse, error)
+	GetPublic(id UUID) (*PublicProjectResponse, error)
+	GetPublicThumbnail(id UUID) error
+	GetThumbnail(id UUID) error
+	List() (*[]ProjectSummaryResponse, error)
+	ListCategories() (*[]ProjectCategoryResponse, error)
+	ListPublic() (*[]PublicProjectResponse, error)
+	ListShareLinks(id UUID) (*[]ProjectShareLinkResponse, error)
+	Publish(id UUID) (*ProjectResponse, error)
+	Update(id UUID, body *MultipartForm) (*ProjectResponse, error)
+}
+
+var _ ProjectServiceAPI = (*ProjectService)(nil)
+
+// ServiceAccountServiceAPI is the interface implemented by ServiceAccountService.
+type ServiceAccountServiceAPI interface {
+	CreateForOrg(params ServiceAccountCreateForOrgParams) (*ServiceAccount, error)
+	DeleteForOrg(token string) error
+	GetForOrg(token string) (*ServiceAccount, error)
+	ListForOrg(params ServiceAccountListForOrgParams) (*ServiceAccountResultsPage, error)
+}
+
+var _ ServiceAccountServiceAPI = (*ServiceAccountService)(nil)
+
+// ShortlinkServiceAPI is the interface implemented by ShortlinkService.
+type ShortlinkServiceAPI interface {
+}
+
+var _ ShortlinkServiceAPI = (*ShortlinkService)(nil)
+
+// StoreServiceAPI is the interface implemented by StoreService.
+type StoreServiceAPI interface {
+	CreateCoupon(body StoreCouponParams) (*DiscountCode, error)
+}
+
+var _ StoreServiceAPI = (*StoreService)(nil)
+
+// UnitServiceAPI is the interface implemented by UnitService.
+type UnitServiceAPI interface {
+	GetAngleConversion(inputUnit UnitAngle, outputUnit UnitAngle, value float64) (*UnitAngleConversion, error)
+	GetAreaConversion(inputUnit UnitArea, outputUnit UnitArea, value float64) (*UnitAreaConversion, error)
+	GetCurrentConversion(inputUnit UnitCurrent, outputUnit UnitCurrent, value float64) (*UnitCurrentConversion, error)
+	GetEnergyConversion(inputUnit UnitEnergy, outputUnit UnitEnergy, value float64) (*UnitEnergyConversion, error)
+	GetForceConversion(inputUnit UnitForce, outputUnit UnitForce, value float64) (*UnitForceConversion, error)
+	GetFrequencyConversion(inputUnit UnitFrequency, outputUnit UnitFrequency, value float64) (*UnitFrequencyConversion, error)
+	GetLengthConversion(inputUnit UnitLength, outputUnit UnitLength, value float64) (*UnitLengthConversion, error)
+	GetMassConversion(inputUnit UnitMas, outputUnit UnitMas, value float64) (*UnitMassConversion, error)
+	GetPowerConversion(inputUnit UnitPower, outputUnit UnitPower, value float64) (*UnitPowerConversion, error)
+	GetPressureConversion(inputUnit UnitPressure, outputUnit UnitPressure, value float64) (*UnitPressureConversion, error)
+	GetTemperatureConversion(inputUnit UnitTemperature, outputUnit UnitTemperature, value float64) (*UnitTemperatureConversion, error)
+	GetTorqueConversion(inputUnit UnitTorque, outputUnit UnitTorque, value float64) (*UnitTorqueConversion, error)
+	GetVolumeConversion(inputUnit UnitVolume, outputUnit UnitVolume, value float64) (*UnitVolumeConversion, error)
+}
+
+var _ UnitServiceAPI = (*UnitService)(nil)
+
+// UserServiceAPI is the interface implemented by UserService.
+type UserServiceAPI interface {
+	AdminDetailsList(id string) (*UserAdminDetails, error)
+	CreateShortlink(body CreateShortlinkRequest) (*CreateShortlinkResponse, error)
+	DeleteSelf() error
+	DeleteShortlink(key string) error
+	EmailMarketingConsentDeclineCreate() error
+	EmailMarketingConsentList() (*EmailMarketingConsentState, error)
+	EmailMarketingConsentRequestCreate() error
+	EmailMarketingConsentSeenCreate() error
+	FeaturesList() (*UserFeatureList, error)
+	Get(id string) (*UserResponse, error)
+	GetCadInfoForm() (*WebsiteCadUserInfoForm, error)
+	GetExtended(id string) (*ExtendedUser, error)
+	GetOauth2ProvidersFor() (*[]AccountProvider, error)
+	GetPrivacySettings() (*PrivacySettings, error)
+	GetSelf() (*UserResponse, error)
+	GetSelfExtended() (*ExtendedUser, error)
+	GetSessionFor(token string) (*Session, error)
+	GetShortlinks(params UserGetShortlinksParams) (*ShortlinkResultsPage, error)
+	PutCadInfoForm(body WebsiteCadUserInfoForm) error
+	PutPublicEmailMarketingConsentRequest(body PublicEmailMarketingConsentRequest) error
+	PutPublicMailingListSubscribe(slug string, body PublicMailingListMembershipRequest) error
+	PutPublicMailingListUnsubscribe(slug string, body PublicMailingListMembershipRequest) error
+	PutPublicSalesForm(body WebsiteSalesForm) error
+	PutPublicSupportForm(body WebsiteSupportForm) error
+	ReportClientError(body ClientErrorReport) (*ClientErrorReportAccepted, error)
+	UpdatePrivacySettings(body PrivacySettings) (*PrivacySettings, error)
+	UpdateSelf(body UpdateUser) (*UserResponse, error)
+	UpdateShortlink(key string, body UpdateShortlinkRequest) error
+	UpdateSubscriptionFor(id string, body ZooProductSubscriptionsUserRequest) (*ZooProductSubscriptions, error)
+}
+
+var _ UserServiceAPI = (*UserService)(nil)
@@ -0,0 +1,2869 @@
+// Code generated by `generate`. DO NOT EDIT.
+
+// Package kittycadmock provides in-memory implementations of the
+// kittycad service interfaces, for testing code that depends on them.
+//
+// Each mock records the calls made to it, and returns the response of the
+// matching function field, for example FileService.CreateConversionFunc.
+// Calling a method whose function field is not set returns ErrNotProgrammed.
+package kittycadmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/kittycad/kittycad.go"
+)
+
+// ErrNotProgrammed is returned when calling a method without a response.
+var ErrNotProgrammed = errors.New("no response programmed for the mock method")
+
+// Call is a recorded call to a mock method.
+type Call struct {
+	// Method is the name of the method, for example "CreateConversion".
+	Method string
+	// Args are the arguments the method was called with.
+	Args []any
+}
+
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made to the mock so far.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// Client is a mock implementation of kittycad.ClientAPI.
+type Client struct {
+	APICall        *APICallService
+	APIToken       *APITokenService
+	App            *AppService
+	Beta           *BetaService
+	Constant       *ConstantService
+	Executor       *ExecutorService
+	Factory        *FactoryService
+	File           *FileService
+	Hidden         *HiddenService
+	Meta           *MetaService
+	Ml             *MlService
+	Modeling       *ModelingService
+	Oauth2         *Oauth2Service
+	Org            *OrgService
+	Payment        *PaymentService
+	Project        *ProjectService
+	ServiceAccount *ServiceAccountService
+	Shortlink      *ShortlinkService
+	Store          *StoreService
+	Unit           *UnitService
+	User           *UserService
+}
+
+// NewClient creates a new mock client with a mock for every service.
+func NewClient() *Client {
+	return &Client{
+		APICall:        &APICallService{},
+		APIToken:       &APITokenService{},
+		App:            &AppService{},
+		Beta:           &BetaService{},
+		Constant:       &ConstantService{},
+		Executor:       &ExecutorService{},
+		Factory:        &FactoryService{},
+		File:           &FileService{},
+		Hidden:         &HiddenService{},
+		Meta:           &MetaService{},
+		Ml:             &MlService{},
+		Modeling:       &ModelingService{},
+		Oauth2:         &Oauth2Service{},
+		Org:            &OrgService{},
+		Payment:        &PaymentService{},
+		Project:        &ProjectService{},
+		ServiceAccount: &ServiceAccountService{},
+		Shortlink:      &ShortlinkService{},
+		Store:          &StoreService{},
+		Unit:           &UnitService{},
+		User:           &UserService{},
+	}
+}
+
+var _ kittycad.ClientAPI = (*Client)(nil)
+
+// APICallAPI returns the APICall service mock.
+func (c *Client) APICallAPI() kittycad.APICallServiceAPI {
+	return c.APICall
+}
+
+// APITokenAPI returns the APIToken service mock.
+func (c *Client) APITokenAPI() kittycad.APITokenServiceAPI {
+	return c.APIToken
+}
+
+// AppAPI returns the App service mock.
+func (c *Client) AppAPI() kittycad.AppServiceAPI {
+	return c.App
+}
+
+// BetaAPI returns the Beta service mock.
+func (c *Client) BetaAPI() kittycad.BetaServiceAPI {
+	return c.Beta
+}
+
+// ConstantAPI returns the Constant service mock.
+func (c *Client) ConstantAPI() kittycad.ConstantServiceAPI {
+	return c.Constant
+}
+
+// ExecutorAPI returns the Executor service mock.
+func (c *Client) ExecutorAPI() kittycad.ExecutorServiceAPI {
+	return c.Executor
+}
+
+// FactoryAPI returns the Factory service mock.
+func (c *Client) FactoryAPI() kittycad.FactoryServiceAPI {
+	return c.Factory
+}
+
+// FileAPI returns the File service mock.
+func (c *Client) FileAPI() kittycad.FileServiceAPI {
+	return c.File
+}
+
+// HiddenAPI returns the Hidden service mock.
+func (c *Client) HiddenAPI() kittycad.HiddenServiceAPI {
+	return c.Hidden
+}
+
+// MetaAPI returns the Meta service mock.
+func (c *Client) MetaAPI() kittycad.MetaServiceAPI {
+	return c.Meta
+}
+
+// MlAPI returns the Ml service mock.
+func (c *Client) MlAPI() kittycad.MlServiceAPI {
+	return c.Ml
+}
+
+// ModelingAPI returns the Modeling service mock.
+func (c *Client) ModelingAPI() kittycad.ModelingServiceAPI {
+	return c.Modeling
+}
+
+// Oauth2API returns the Oauth2 service mock.
+func (c *Client) Oauth2API() kittycad.Oauth2ServiceAPI {
+	return c.Oauth2
+}
+
+// OrgAPI returns the Org service mock.
+func (c *Client) OrgAPI() kittycad.OrgServiceAPI {
+	return c.Org
+}
+
+// PaymentAPI returns the Payment service mock.
+func (c *Client) PaymentAPI() kittycad.PaymentServiceAPI {
+	return c.Payment
+}
+
+// ProjectAPI returns the Project service mock.
+func (c *Client) ProjectAPI() kittycad.ProjectServiceAPI {
+	return c.Project
+}
+
+// ServiceAccountAPI returns the ServiceAccount service mock.
+func (c *Client) ServiceAccountAPI() kittycad.ServiceAccountServiceAPI {
+	return c.ServiceAccount
+}
+
+// ShortlinkAPI returns the Shortlink service mock.
+func (c *Client) ShortlinkAPI() kittycad.ShortlinkServiceAPI {
+	return c.Shortlink
+}
+
+// StoreAPI returns the Store service mock.
+func (c *Client) StoreAPI() kittycad.StoreServiceAPI {
+	return c.Store
+}
+
+// UnitAPI returns the Unit service mock.
+func (c *Client) UnitAPI() kittycad.UnitServiceAPI {
+	return c.Unit
+}
+
+// UserAPI returns the User service mock.
+func (c *Client) UserAPI() kittycad.UserServiceAPI {
+	return c.User
+}
+
+// APICallService is a mock implementation of kittycad.APICallServiceAPI.
+type APICallService struct {
+	recorder
+
+	// GetFunc is called by Get.
+	GetFunc func(id kittycad.UUID) (*kittycad.APICallWithPrice, error)
+	// GetAsyncOperationFunc is called by GetAsyncOperation.
+	GetAsyncOperationFunc func(id kittycad.UUID) (*any, error)
+	// GetForOrgFunc is called by GetForOrg.
+	GetForOrgFunc func(id kittycad.UUID) (*kittycad.APICallWithPrice, error)
+	// GetForUserFunc is called by GetForUser.
+	GetForUserFunc func(id kittycad.UUID) (*kittycad.APICallWithPrice, error)
+	// ListForUserFunc is called by ListForUser.
+	ListForUserFunc func(id string, params kittycad.APICallListForUserParams) (*kittycad.APICallWithPriceResultsPage, error)
+	// OrgListFunc is called by OrgList.
+	OrgListFunc func(params kittycad.APICallOrgListParams) (*kittycad.APICallWithPriceResultsPage, error)
+	// UserListFunc is called by UserList.
+	UserListFunc func(params kittycad.APICallUserListParams) (*kittycad.APICallWithPriceResultsPage, error)
+}
+
+var _ kittycad.APICallServiceAPI = (*APICallService)(nil)
+
+// Get records the call and returns the response of GetFunc.
+func (m *APICallService) Get(id kittycad.UUID) (*kittycad.APICallWithPrice, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.Get", ErrNotProgrammed)
+	}
+	return m.GetFunc(id)
+}
+
+// GetAsyncOperation records the call and returns the response of GetAsyncOperationFunc.
+func (m *APICallService) GetAsyncOperation(id kittycad.UUID) (*any, error) {
+	m.record("GetAsyncOperation", id)
+	if m.GetAsyncOperationFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.GetAsyncOperation", ErrNotProgrammed)
+	}
+	return m.GetAsyncOperationFunc(id)
+}
+
+// GetForOrg records the call and returns the response of GetForOrgFunc.
+func (m *APICallService) GetForOrg(id kittycad.UUID) (*kittycad.APICallWithPrice, error) {
+	m.record("GetForOrg", id)
+	if m.GetForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.GetForOrg", ErrNotProgrammed)
+	}
+	return m.GetForOrgFunc(id)
+}
+
+// GetForUser records the call and returns the response of GetForUserFunc.
+func (m *APICallService) GetForUser(id kittycad.UUID) (*kittycad.APICallWithPrice, error) {
+	m.record("GetForUser", id)
+	if m.GetForUserFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.GetForUser", ErrNotProgrammed)
+	}
+	return m.GetForUserFunc(id)
+}
+
+// ListForUser records the call and returns the response of ListForUserFunc.
+func (m *APICallService) ListForUser(id string, params kittycad.APICallListForUserParams) (*kittycad.APICallWithPriceResultsPage, error) {
+	m.record("ListForUser", id, params)
+	if m.ListForUserFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.ListForUser", ErrNotProgrammed)
+	}
+	return m.ListForUserFunc(id, params)
+}
+
+// OrgList records the call and returns the response of OrgListFunc.
+func (m *APICallService) OrgList(params kittycad.APICallOrgListParams) (*kittycad.APICallWithPriceResultsPage, error) {
+	m.record("OrgList", params)
+	if m.OrgListFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.OrgList", ErrNotProgrammed)
+	}
+	return m.OrgListFunc(params)
+}
+
+// UserList records the call and returns the response of UserListFunc.
+func (m *APICallService) UserList(params kittycad.APICallUserListParams) (*kittycad.APICallWithPriceResultsPage, error) {
+	m.record("UserList", params)
+	if m.UserListFunc == nil {
+		return nil, fmt.Errorf("%w: APICallService.UserList", ErrNotProgrammed)
+	}
+	return m.UserListFunc(params)
+}
+
+// APITokenService is a mock implementation of kittycad.APITokenServiceAPI.
+type APITokenService struct {
+	recorder
+
+	// CreateForUserFunc is called by CreateForUser.
+	CreateForUserFunc func(params kittycad.APITokenCreateForUserParams) (*kittycad.APITokenWithFullToken, error)
+	// DeleteForUserFunc is called by DeleteForUser.
+	DeleteForUserFunc func(token string) error
+	// GetForUserFunc is called by GetForUser.
+	GetForUserFunc func(token string) (*kittycad.APIToken, error)
+	// ListForUserFunc is called by ListForUser.
+	ListForUserFunc func(params kittycad.APITokenListForUserParams) (*kittycad.APITokenResultsPage, error)
+}
+
+var _ kittycad.APITokenServiceAPI = (*APITokenService)(nil)
+
+// CreateForUser records the call and returns the response of CreateForUserFunc.
+func (m *APITokenService) CreateForUser(params kittycad.APITokenCreateForUserParams) (*kittycad.APITokenWithFullToken, error) {
+	m.record("CreateForUser", params)
+	if m.CreateForUserFunc == nil {
+		return nil, fmt.Errorf("%w: APITokenService.CreateForUser", ErrNotProgrammed)
+	}
+	return m.CreateForUserFunc(params)
+}
+
+// DeleteForUser records the call and returns the response of DeleteForUserFunc.
+func (m *APITokenService) DeleteForUser(token string) error {
+	m.record("DeleteForUser", token)
+	if m.DeleteForUserFunc == nil {
+		return fmt.Errorf("%w: APITokenService.DeleteForUser", ErrNotProgrammed)
+	}
+	return m.DeleteForUserFunc(token)
+}
+
+// GetForUser records the call and returns the response of GetForUserFunc.
+func (m *APITokenService) GetForUser(token string) (*kittycad.APIToken, error) {
+	m.record("GetForUser", token)
+	if m.GetForUserFunc == nil {
+		return nil, fmt.Errorf("%w: APITokenService.GetForUser", ErrNotProgrammed)
+	}
+	return m.GetForUserFunc(token)
+}
+
+// ListForUser records the call and returns the response of ListForUserFunc.
+func (m *APITokenService) ListForUser(params kittycad.APITokenListForUserParams) (*kittycad.APITokenResultsPage, error) {
+	m.record("ListForUser", params)
+	if m.ListForUserFunc == nil {
+		return nil, fmt.Errorf("%w: APITokenService.ListForUser", ErrNotProgrammed)
+	}
+	return m.ListForUserFunc(params)
+}
+
+// AppService is a mock implementation of kittycad.AppServiceAPI.
+type AppService struct {
+	recorder
+
+	// GithubCallbackFunc is called by GithubCallback.
+	GithubCallbackFunc func(body any) error
+	// GithubConsentFunc is called by GithubConsent.
+	GithubConsentFunc func() (*kittycad.AppClientInfo, error)
+	// GithubWebhookFunc is called by GithubWebhook.
+	GithubWebhookFunc func(body []byte) error
+}
+
+var _ kittycad.AppServiceAPI = (*AppService)(nil)
+
+// GithubCallback records the call and returns the response of GithubCallbackFunc.
+func (m *AppService) GithubCallback(body any) error {
+	m.record("GithubCallback", body)
+	if m.GithubCallbackFunc == nil {
+		return fmt.Errorf("%w: AppService.GithubCallback", ErrNotProgrammed)
+	}
+	return m.GithubCallbackFunc(body)
+}
+
+// GithubConsent records the call and returns the response of GithubConsentFunc.
+func (m *AppService) GithubConsent() (*kittycad.AppClientInfo, error) {
+	m.record("GithubConsent")
+	if m.GithubConsentFunc == nil {
+		return nil, fmt.Errorf("%w: AppService.GithubConsent", ErrNotProgrammed)
+	}
+	return m.GithubConsentFunc()
+}
+
+// GithubWebhook records the call and returns the response of GithubWebhookFunc.
+func (m *AppService) GithubWebhook(body []byte) error {
+	m.record("GithubWebhook", body)
+	if m.GithubWebhookFunc == nil {
+		return fmt.Errorf("%w: AppService.GithubWebhook", ErrNotProgrammed)
+	}
+	return m.GithubWebhookFunc(body)
+}
+
+// BetaService is a mock implementation of kittycad.BetaServiceAPI.
+type BetaService struct {
+	recorder
+}
+
+var _ kittycad.BetaServiceAPI = (*BetaService)(nil)
+
+// ConstantService is a mock implementation of kittycad.ConstantServiceAPI.
+type ConstantService struct {
+	recorder
+}
+
+var _ kittycad.ConstantServiceAPI = (*ConstantService)(nil)
+
+// ExecutorService is a mock implementation of kittycad.ExecutorServiceAPI.
+type ExecutorService struct {
+	recorder
+
+	// CreateFileExecutionFunc is called by CreateFileExecution.
+	CreateFileExecutionFunc func(lang kittycad.CodeLanguage, params kittycad.ExecutorCreateFileExecutionParams, body []byte) (*kittycad.CodeOutput, error)
+	// CreateTermFunc is called by CreateTerm.
+	CreateTermFunc func(ctx context.Context) (*websocket.Conn, error)
+}
+
+var _ kittycad.ExecutorServiceAPI = (*ExecutorService)(nil)
+
+// CreateFileExecution records the call and returns the response of CreateFileExecutionFunc.
+func (m *ExecutorService) CreateFileExecution(lang kittycad.CodeLanguage, params kittycad.ExecutorCreateFileExecutionParams, body []byte) (*kittycad.CodeOutput, error) {
+	m.record("CreateFileExecution", lang, params, body)
+	if m.CreateFileExecutionFunc == nil {
+		return nil, fmt.Errorf("%w: ExecutorService.CreateFileExecution", ErrNotProgrammed)
+	}
+	return m.CreateFileExecutionFunc(lang, params, body)
+}
+
+// CreateTerm records the call and returns the response of CreateTermFunc.
+func (m *ExecutorService) CreateTerm(ctx context.Context) (*websocket.Conn, error) {
+	m.record("CreateTerm", ctx)
+	if m.CreateTermFunc == nil {
+		return nil, fmt.Errorf("%w: ExecutorService.CreateTerm", ErrNotProgrammed)
+	}
+	return m.CreateTermFunc(ctx)
+}
+
+// FactoryService is a mock implementation of kittycad.FactoryServiceAPI.
+type FactoryService struct {
+	recorder
+
+	// CreateUserJobFunc is called by CreateUserJob.
+	CreateUserJobFunc func(body *kittycad.MultipartForm) (*kittycad.FactoryJobResponse, error)
+	// GetUserFinishesFunc is called by GetUserFinishes.
+	GetUserFinishesFunc func() (*[]kittycad.FactoryCustomerCatalogOption, error)
+	// GetUserMaterialsFunc is called by GetUserMaterials.
+	GetUserMaterialsFunc func() (*[]kittycad.FactoryCustomerCatalogOption, error)
+}
+
+var _ kittycad.FactoryServiceAPI = (*FactoryService)(nil)
+
+// CreateUserJob records the call and returns the response of CreateUserJobFunc.
+func (m *FactoryService) CreateUserJob(body *kittycad.MultipartForm) (*kittycad.FactoryJobResponse, error) {
+	m.record("CreateUserJob", body)
+	if m.CreateUserJobFunc == nil {
+		return nil, fmt.Errorf("%w: FactoryService.CreateUserJob", ErrNotProgrammed)
+	}
+	return m.CreateUserJobFunc(body)
+}
+
+// GetUserFinishes records the call and returns the response of GetUserFinishesFunc.
+func (m *FactoryService) GetUserFinishes() (*[]kittycad.FactoryCustomerCatalogOption, error) {
+	m.record("GetUserFinishes")
+	if m.GetUserFinishesFunc == nil {
+		return nil, fmt.Errorf("%w: FactoryService.GetUserFinishes", ErrNotProgrammed)
+	}
+	return m.GetUserFinishesFunc()
+}
+
+// GetUserMaterials records the call and returns the response of GetUserMaterialsFunc.
+func (m *FactoryService) GetUserMaterials() (*[]kittycad.FactoryCustomerCatalogOption, error) {
+	m.record("GetUserMaterials")
+	if m.GetUserMaterialsFunc == nil {
+		return nil, fmt.Errorf("%w: FactoryService.GetUserMaterials", ErrNotProgrammed)
+	}
+	return m.GetUserMaterialsFunc()
+}
+
+// FileService is a mock implementation of kittycad.FileServiceAPI.
+type FileService struct {
+	recorder
+
+	// CreateCenterOfMassFunc is called by CreateCenterOfMass.
+	CreateCenterOfMassFunc func(params kittycad.FileCreateCenterOfMassParams, body []byte) (*kittycad.FileCenterOfMass, error)
+	// CreateConversionFunc is called by CreateConversion.
+	CreateConversionFunc func(srcFormat kittycad.FileImportFormat, outputFormat kittycad.FileExportFormat, body []byte) (*kittycad.FileConversion, error)
+	// CreateConversionOptionsFunc is called by CreateConversionOptions.
+	CreateConversionOptionsFunc func(body *kittycad.MultipartForm) (*kittycad.FileConversion, error)
+	// CreateDensityFunc is called by CreateDensity.
+	CreateDensityFunc func(params kittycad.FileCreateDensityParams, body []byte) (*kittycad.FileDensity, error)
+	// CreateMassFunc is called by CreateMass.
+	CreateMassFunc func(params kittycad.FileCreateMassParams, body []byte) (*kittycad.FileMass, error)
+	// CreateSurfaceAreaFunc is called by CreateSurfaceArea.
+	CreateSurfaceAreaFunc func(params kittycad.FileCreateSurfaceAreaParams, body []byte) (*kittycad.FileSurfaceArea, error)
+	// CreateVolumeFunc is called by CreateVolume.
+	CreateVolumeFunc func(params kittycad.FileCreateVolumeParams, body []byte) (*kittycad.FileVolume, error)
+}
+
+var _ kittycad.FileServiceAPI = (*FileService)(nil)
+
+// CreateCenterOfMass records the call and returns the response of CreateCenterOfMassFunc.
+func (m *FileService) CreateCenterOfMass(params kittycad.FileCreateCenterOfMassParams, body []byte) (*kittycad.FileCenterOfMass, error) {
+	m.record("CreateCenterOfMass", params, body)
+	if m.CreateCenterOfMassFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateCenterOfMass", ErrNotProgrammed)
+	}
+	return m.CreateCenterOfMassFunc(params, body)
+}
+
+// CreateConversion records the call and returns the response of CreateConversionFunc.
+func (m *FileService) CreateConversion(srcFormat kittycad.FileImportFormat, outputFormat kittycad.FileExportFormat, body []byte) (*kittycad.FileConversion, error) {
+	m.record("CreateConversion", srcFormat, outputFormat, body)
+	if m.CreateConversionFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateConversion", ErrNotProgrammed)
+	}
+	return m.CreateConversionFunc(srcFormat, outputFormat, body)
+}
+
+// CreateConversionOptions records the call and returns the response of CreateConversionOptionsFunc.
+func (m *FileService) CreateConversionOptions(body *kittycad.MultipartForm) (*kittycad.FileConversion, error) {
+	m.record("CreateConversionOptions", body)
+	if m.CreateConversionOptionsFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateConversionOptions", ErrNotProgrammed)
+	}
+	return m.CreateConversionOptionsFunc(body)
+}
+
+// CreateDensity records the call and returns the response of CreateDensityFunc.
+func (m *FileService) CreateDensity(params kittycad.FileCreateDensityParams, body []byte) (*kittycad.FileDensity, error) {
+	m.record("CreateDensity", params, body)
+	if m.CreateDensityFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateDensity", ErrNotProgrammed)
+	}
+	return m.CreateDensityFunc(params, body)
+}
+
+// CreateMass records the call and returns the response of CreateMassFunc.
+func (m *FileService) CreateMass(params kittycad.FileCreateMassParams, body []byte) (*kittycad.FileMass, error) {
+	m.record("CreateMass", params, body)
+	if m.CreateMassFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateMass", ErrNotProgrammed)
+	}
+	return m.CreateMassFunc(params, body)
+}
+
+// CreateSurfaceArea records the call and returns the response of CreateSurfaceAreaFunc.
+func (m *FileService) CreateSurfaceArea(params kittycad.FileCreateSurfaceAreaParams, body []byte) (*kittycad.FileSurfaceArea, error) {
+	m.record("CreateSurfaceArea", params, body)
+	if m.CreateSurfaceAreaFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateSurfaceArea", ErrNotProgrammed)
+	}
+	return m.CreateSurfaceAreaFunc(params, body)
+}
+
+// CreateVolume records the call and returns the response of CreateVolumeFunc.
+func (m *FileService) CreateVolume(params kittycad.FileCreateVolumeParams, body []byte) (*kittycad.FileVolume, error) {
+	m.record("CreateVolume", params, body)
+	if m.CreateVolumeFunc == nil {
+		return nil, fmt.Errorf("%w: FileService.CreateVolume", ErrNotProgrammed)
+	}
+	return m.CreateVolumeFunc(params, body)
+}
+
+// HiddenService is a mock implementation of kittycad.HiddenServiceAPI.
+type HiddenService struct {
+	recorder
+
+	// AuthAPIKeyFunc is called by AuthAPIKey.
+	AuthAPIKeyFunc func() (*kittycad.AuthAPIKeyResponse, error)
+	// AuthEmailFunc is called by AuthEmail.
+	AuthEmailFunc func(body kittycad.EmailAuthenticationForm) (*kittycad.VerificationTokenResponse, error)
+	// AuthEmailCallbackFunc is called by AuthEmailCallback.
+	AuthEmailCallbackFunc func(params kittycad.HiddenAuthEmailCallbackParams) error
+	// AuthEmailMarketingConfirmCreateFunc is called by AuthEmailMarketingConfirmCreate.
+	AuthEmailMarketingConfirmCreateFunc func(body kittycad.EmailMarketingConfirmTokenBody) error
+	// DownloadSharedProjectFunc is called by DownloadSharedProject.
+	DownloadSharedProjectFunc func(key string, params kittycad.HiddenDownloadSharedProjectParams) error
+	// GetAuthSamlFunc is called by GetAuthSaml.
+	GetAuthSamlFunc func(providerId kittycad.UUID, params kittycad.HiddenGetAuthSamlParams) error
+	// GetAuthSamlByOrgFunc is called by GetAuthSamlByOrg.
+	GetAuthSamlByOrgFunc func(orgId kittycad.UUID, params kittycad.HiddenGetAuthSamlByOrgParams) error
+	// LogoutFunc is called by Logout.
+	LogoutFunc func() error
+	// PostAuthSamlFunc is called by PostAuthSaml.
+	PostAuthSamlFunc func(providerId kittycad.UUID, body []byte) error
+	// RedirectUserShortlinkFunc is called by RedirectUserShortlink.
+	RedirectUserShortlinkFunc func(key string) error
+}
+
+var _ kittycad.HiddenServiceAPI = (*HiddenService)(nil)
+
+// AuthAPIKey records the call and returns the response of AuthAPIKeyFunc.
+func (m *HiddenService) AuthAPIKey() (*kittycad.AuthAPIKeyResponse, error) {
+	m.record("AuthAPIKey")
+	if m.AuthAPIKeyFunc == nil {
+		return nil, fmt.Errorf("%w: HiddenService.AuthAPIKey", ErrNotProgrammed)
+	}
+	return m.AuthAPIKeyFunc()
+}
+
+// AuthEmail records the call and returns the response of AuthEmailFunc.
+func (m *HiddenService) AuthEmail(body kittycad.EmailAuthenticationForm) (*kittycad.VerificationTokenResponse, error) {
+	m.record("AuthEmail", body)
+	if m.AuthEmailFunc == nil {
+		return nil, fmt.Errorf("%w: HiddenService.AuthEmail", ErrNotProgrammed)
+	}
+	return m.AuthEmailFunc(body)
+}
+
+// AuthEmailCallback records the call and returns the response of AuthEmailCallbackFunc.
+func (m *HiddenService) AuthEmailCallback(params kittycad.HiddenAuthEmailCallbackParams) error {
+	m.record("AuthEmailCallback", params)
+	if m.AuthEmailCallbackFunc == nil {
+		return fmt.Errorf("%w: HiddenService.AuthEmailCallback", ErrNotProgrammed)
+	}
+	return m.AuthEmailCallbackFunc(params)
+}
+
+// AuthEmailMarketingConfirmCreate records the call and returns the response of AuthEmailMarketingConfirmCreateFunc.
+func (m *HiddenService) AuthEmailMarketingConfirmCreate(body kittycad.EmailMarketingConfirmTokenBody) error {
+	m.record("AuthEmailMarketingConfirmCreate", body)
+	if m.AuthEmailMarketingConfirmCreateFunc == nil {
+		return fmt.Errorf("%w: HiddenService.AuthEmailMarketingConfirmCreate", ErrNotProgrammed)
+	}
+	return m.AuthEmailMarketingConfirmCreateFunc(body)
+}
+
+// DownloadSharedProject records the call and returns the response of DownloadSharedProjectFunc.
+func (m *HiddenService) DownloadSharedProject(key string, params kittycad.HiddenDownloadSharedProjectParams) error {
+	m.record("DownloadSharedProject", key, params)
+	if m.DownloadSharedProjectFunc == nil {
+		return fmt.Errorf("%w: HiddenService.DownloadSharedProject", ErrNotProgrammed)
+	}
+	return m.DownloadSharedProjectFunc(key, params)
+}
+
+// GetAuthSaml records the call and returns the response of GetAuthSamlFunc.
+func (m *HiddenService) GetAuthSaml(providerId kittycad.UUID, params kittycad.HiddenGetAuthSamlParams) error {
+	m.record("GetAuthSaml", providerId, params)
+	if m.GetAuthSamlFunc == nil {
+		return fmt.Errorf("%w: HiddenService.GetAuthSaml", ErrNotProgrammed)
+	}
+	return m.GetAuthSamlFunc(providerId, params)
+}
+
+// GetAuthSamlByOrg records the call and returns the response of GetAuthSamlByOrgFunc.
+func (m *HiddenService) GetAuthSamlByOrg(orgId kittycad.UUID, params kittycad.HiddenGetAuthSamlByOrgParams) error {
+	m.record("GetAuthSamlByOrg", orgId, params)
+	if m.GetAuthSamlByOrgFunc == nil {
+		return fmt.Errorf("%w: HiddenService.GetAuthSamlByOrg", ErrNotProgrammed)
+	}
+	return m.GetAuthSamlByOrgFunc(orgId, params)
+}
+
+// Logout records the call and returns the response of LogoutFunc.
+func (m *HiddenService) Logout() error {
+	m.record("Logout")
+	if m.LogoutFunc == nil {
+		return fmt.Errorf("%w: HiddenService.Logout", ErrNotProgrammed)
+	}
+	return m.LogoutFunc()
+}
+
+// PostAuthSaml records the call and returns the response of PostAuthSamlFunc.
+func (m *HiddenService) PostAuthSaml(providerId kittycad.UUID, body []byte) error {
+	m.record("PostAuthSaml", providerId, body)
+	if m.PostAuthSamlFunc == nil {
+		return fmt.Errorf("%w: HiddenService.PostAuthSaml", ErrNotProgrammed)
+	}
+	return m.PostAuthSamlFunc(providerId, body)
+}
+
+// RedirectUserShortlink records the call and returns the response of RedirectUserShortlinkFunc.
+func (m *HiddenService) RedirectUserShortlink(key string) error {
+	m.record("RedirectUserShortlink", key)
+	if m.RedirectUserShortlinkFunc == nil {
+		return fmt.Errorf("%w: HiddenService.RedirectUserShortlink", ErrNotProgrammed)
+	}
+	return m.RedirectUserShortlinkFunc(key)
+}
+
+// MetaService is a mock implementation of kittycad.MetaServiceAPI.
+type MetaService struct {
+	recorder
+
+	// CommunitySsoFunc is called by CommunitySso.
+	CommunitySsoFunc func(sso string, sig string) error
+	// GetAnnouncementsFunc is called by GetAnnouncements.
+	GetAnnouncementsFunc func() (*kittycad.AnnouncementList, error)
+	// GetIpinfoFunc is called by GetIpinfo.
+	GetIpinfoFunc func() (*kittycad.IpAddrInfo, error)
+	// GetPricingSubscriptionsFunc is called by GetPricingSubscriptions.
+	GetPricingSubscriptionsFunc func() (*map[string][]kittycad.ZooProductSubscription, error)
+	// GetSchemaFunc is called by GetSchema.
+	GetSchemaFunc func() error
+	// InternalGetAPITokenForDiscordUserFunc is called by InternalGetAPITokenForDiscordUser.
+	InternalGetAPITokenForDiscordUserFunc func(discordId string) (*kittycad.APIToken, error)
+	// PingFunc is called by Ping.
+	PingFunc func() (*kittycad.Pong, error)
+}
+
+var _ kittycad.MetaServiceAPI = (*MetaService)(nil)
+
+// CommunitySso records the call and returns the response of CommunitySsoFunc.
+func (m *MetaService) CommunitySso(sso string, sig string) error {
+	m.record("CommunitySso", sso, sig)
+	if m.CommunitySsoFunc == nil {
+		return fmt.Errorf("%w: MetaService.CommunitySso", ErrNotProgrammed)
+	}
+	return m.CommunitySsoFunc(sso, sig)
+}
+
+// GetAnnouncements records the call and returns the response of GetAnnouncementsFunc.
+func (m *MetaService) GetAnnouncements() (*kittycad.AnnouncementList, error) {
+	m.record("GetAnnouncements")
+	if m.GetAnnouncementsFunc == nil {
+		return nil, fmt.Errorf("%w: MetaService.GetAnnouncements", ErrNotProgrammed)
+	}
+	return m.GetAnnouncementsFunc()
+}
+
+// GetIpinfo records the call and returns the response of GetIpinfoFunc.
+func (m *MetaService) GetIpinfo() (*kittycad.IpAddrInfo, error) {
+	m.record("GetIpinfo")
+	if m.GetIpinfoFunc == nil {
+		return nil, fmt.Errorf("%w: MetaService.GetIpinfo", ErrNotProgrammed)
+	}
+	return m.GetIpinfoFunc()
+}
+
+// GetPricingSubscriptions records the call and returns the response of GetPricingSubscriptionsFunc.
+func (m *MetaService) GetPricingSubscriptions() (*map[string][]kittycad.ZooProductSubscription, error) {
+	m.record("GetPricingSubscriptions")
+	if m.GetPricingSubscriptionsFunc == nil {
+		return nil, fmt.Errorf("%w: MetaService.GetPricingSubscriptions", ErrNotProgrammed)
+	}
+	return m.GetPricingSubscriptionsFunc()
+}
+
+// GetSchema records the call and returns the response of GetSchemaFunc.
+func (m *MetaService) GetSchema() error {
+	m.record("GetSchema")
+	if m.GetSchemaFunc == nil {
+		return fmt.Errorf("%w: MetaService.GetSchema", ErrNotProgrammed)
+	}
+	return m.GetSchemaFunc()
+}
+
+// InternalGetAPITokenForDiscordUser records the call and returns the response of InternalGetAPITokenForDiscordUserFunc.
+func (m *MetaService) InternalGetAPITokenForDiscordUser(discordId string) (*kittycad.APIToken, error) {
+	m.record("InternalGetAPITokenForDiscordUser", discordId)
+	if m.InternalGetAPITokenForDiscordUserFunc == nil {
+		return nil, fmt.Errorf("%w: MetaService.InternalGetAPITokenForDiscordUser", ErrNotProgrammed)
+	}
+	return m.InternalGetAPITokenForDiscordUserFunc(discordId)
+}
+
+// Ping records the call and returns the response of PingFunc.
+func (m *MetaService) Ping() (*kittycad.Pong, error) {
+	m.record("Ping")
+	if m.PingFunc == nil {
+		return nil, fmt.Errorf("%w: MetaService.Ping", ErrNotProgrammed)
+	}
+	return m.PingFunc()
+}
+
+// MlService is a mock implementation of kittycad.MlServiceAPI.
+type MlService struct {
+	recorder
+
+	// CopilotWsFunc is called by CopilotWs.
+	CopilotWsFunc func(ctx context.Context, params kittycad.MlCopilotWsParams, body any) (*websocket.Conn, error)
+	// CreateCustomModelFunc is called by CreateCustomModel.
+	CreateCustomModelFunc func(body kittycad.CreateCustomModel) (*kittycad.CustomModel, error)
+	// CreateKclCodeCompletionsFunc is called by CreateKclCodeCompletions.
+	CreateKclCodeCompletionsFunc func(body kittycad.KclCodeCompletionRequest) (*kittycad.KclCodeCompletionResponse, error)
+	// CreateProprietaryToKclFunc is called by CreateProprietaryToKcl.
+	CreateProprietaryToKclFunc func(params kittycad.MlCreateProprietaryToKclParams, body *kittycad.MultipartForm) (*kittycad.KclModel, error)
+	// CreateTextToCadFunc is called by CreateTextToCad.
+	CreateTextToCadFunc func(outputFormat kittycad.FileExportFormat, params kittycad.MlCreateTextToCadParams, body kittycad.TextToCadCreateBody) (*kittycad.TextToCad, error)
+	// CreateTextToCadIterationFunc is called by CreateTextToCadIteration.
+	CreateTextToCadIterationFunc func(body kittycad.TextToCadIterationBody) (*kittycad.TextToCadIteration, error)
+	// CreateTextToCadMultiFileIterationFunc is called by CreateTextToCadMultiFileIteration.
+	CreateTextToCadMultiFileIterationFunc func(body *kittycad.MultipartForm) (*kittycad.TextToCadMultiFileIteration, error)
+	// CreateTextToCadPartFeedbackFunc is called by CreateTextToCadPartFeedback.
+	CreateTextToCadPartFeedbackFunc func(id kittycad.UUID, feedback kittycad.MlFeedback) error
+	// GetCustomModelFunc is called by GetCustomModel.
+	GetCustomModelFunc func(id kittycad.UUID) (*kittycad.CustomModel, error)
+	// GetTextToCadPartForUserFunc is called by GetTextToCadPartForUser.
+	GetTextToCadPartForUserFunc func(id kittycad.UUID) (*any, error)
+	// ListConversationsForUserFunc is called by ListConversationsForUser.
+	ListConversationsForUserFunc func(params kittycad.MlListConversationsForUserParams) (*kittycad.ConversationResultsPage, error)
+	// ListOrgDatasetsForModelFunc is called by ListOrgDatasetsForModel.
+	ListOrgDatasetsForModelFunc func(id kittycad.UUID) (*[]kittycad.OrgDataset, error)
+	// ListTextToCadPartsForUserFunc is called by ListTextToCadPartsForUser.
+	ListTextToCadPartsForUserFunc func(params kittycad.MlListTextToCadPartsForUserParams) (*kittycad.TextToCadResponseResultsPage, error)
+	// ReasoningWsFunc is called by ReasoningWs.
+	ReasoningWsFunc func(ctx context.Context, id kittycad.UUID, body any) (*websocket.Conn, error)
+	// UpdateCustomModelFunc is called by UpdateCustomModel.
+	UpdateCustomModelFunc func(id kittycad.UUID, body kittycad.UpdateCustomModel) (*kittycad.CustomModel, error)
+}
+
+var _ kittycad.MlServiceAPI = (*MlService)(nil)
+
+// CopilotWs records the call and returns the response of CopilotWsFunc.
+func (m *MlService) CopilotWs(ctx context.Context, params kittycad.MlCopilotWsParams, body any) (*websocket.Conn, error) {
+	m.record("CopilotWs", ctx, params, body)
+	if m.CopilotWsFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CopilotWs", ErrNotProgrammed)
+	}
+	return m.CopilotWsFunc(ctx, params, body)
+}
+
+// CreateCustomModel records the call and returns the response of CreateCustomModelFunc.
+func (m *MlService) CreateCustomModel(body kittycad.CreateCustomModel) (*kittycad.CustomModel, error) {
+	m.record("CreateCustomModel", body)
+	if m.CreateCustomModelFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateCustomModel", ErrNotProgrammed)
+	}
+	return m.CreateCustomModelFunc(body)
+}
+
+// CreateKclCodeCompletions records the call and returns the response of CreateKclCodeCompletionsFunc.
+func (m *MlService) CreateKclCodeCompletions(body kittycad.KclCodeCompletionRequest) (*kittycad.KclCodeCompletionResponse, error) {
+	m.record("CreateKclCodeCompletions", body)
+	if m.CreateKclCodeCompletionsFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateKclCodeCompletions", ErrNotProgrammed)
+	}
+	return m.CreateKclCodeCompletionsFunc(body)
+}
+
+// CreateProprietaryToKcl records the call and returns the response of CreateProprietaryToKclFunc.
+func (m *MlService) CreateProprietaryToKcl(params kittycad.MlCreateProprietaryToKclParams, body *kittycad.MultipartForm) (*kittycad.KclModel, error) {
+	m.record("CreateProprietaryToKcl", params, body)
+	if m.CreateProprietaryToKclFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateProprietaryToKcl", ErrNotProgrammed)
+	}
+	return m.CreateProprietaryToKclFunc(params, body)
+}
+
+// CreateTextToCad records the call and returns the response of CreateTextToCadFunc.
+func (m *MlService) CreateTextToCad(outputFormat kittycad.FileExportFormat, params kittycad.MlCreateTextToCadParams, body kittycad.TextToCadCreateBody) (*kittycad.TextToCad, error) {
+	m.record("CreateTextToCad", outputFormat, params, body)
+	if m.CreateTextToCadFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateTextToCad", ErrNotProgrammed)
+	}
+	return m.CreateTextToCadFunc(outputFormat, params, body)
+}
+
+// CreateTextToCadIteration records the call and returns the response of CreateTextToCadIterationFunc.
+func (m *MlService) CreateTextToCadIteration(body kittycad.TextToCadIterationBody) (*kittycad.TextToCadIteration, error) {
+	m.record("CreateTextToCadIteration", body)
+	if m.CreateTextToCadIterationFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateTextToCadIteration", ErrNotProgrammed)
+	}
+	return m.CreateTextToCadIterationFunc(body)
+}
+
+// CreateTextToCadMultiFileIteration records the call and returns the response of CreateTextToCadMultiFileIterationFunc.
+func (m *MlService) CreateTextToCadMultiFileIteration(body *kittycad.MultipartForm) (*kittycad.TextToCadMultiFileIteration, error) {
+	m.record("CreateTextToCadMultiFileIteration", body)
+	if m.CreateTextToCadMultiFileIterationFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.CreateTextToCadMultiFileIteration", ErrNotProgrammed)
+	}
+	return m.CreateTextToCadMultiFileIterationFunc(body)
+}
+
+// CreateTextToCadPartFeedback records the call and returns the response of CreateTextToCadPartFeedbackFunc.
+func (m *MlService) CreateTextToCadPartFeedback(id kittycad.UUID, feedback kittycad.MlFeedback) error {
+	m.record("CreateTextToCadPartFeedback", id, feedback)
+	if m.CreateTextToCadPartFeedbackFunc == nil {
+		return fmt.Errorf("%w: MlService.CreateTextToCadPartFeedback", ErrNotProgrammed)
+	}
+	return m.CreateTextToCadPartFeedbackFunc(id, feedback)
+}
+
+// GetCustomModel records the call and returns the response of GetCustomModelFunc.
+func (m *MlService) GetCustomModel(id kittycad.UUID) (*kittycad.CustomModel, error) {
+	m.record("GetCustomModel", id)
+	if m.GetCustomModelFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.GetCustomModel", ErrNotProgrammed)
+	}
+	return m.GetCustomModelFunc(id)
+}
+
+// GetTextToCadPartForUser records the call and returns the response of GetTextToCadPartForUserFunc.
+func (m *MlService) GetTextToCadPartForUser(id kittycad.UUID) (*any, error) {
+	m.record("GetTextToCadPartForUser", id)
+	if m.GetTextToCadPartForUserFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.GetTextToCadPartForUser", ErrNotProgrammed)
+	}
+	return m.GetTextToCadPartForUserFunc(id)
+}
+
+// ListConversationsForUser records the call and returns the response of ListConversationsForUserFunc.
+func (m *MlService) ListConversationsForUser(params kittycad.MlListConversationsForUserParams) (*kittycad.ConversationResultsPage, error) {
+	m.record("ListConversationsForUser", params)
+	if m.ListConversationsForUserFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.ListConversationsForUser", ErrNotProgrammed)
+	}
+	return m.ListConversationsForUserFunc(params)
+}
+
+// ListOrgDatasetsForModel records the call and returns the response of ListOrgDatasetsForModelFunc.
+func (m *MlService) ListOrgDatasetsForModel(id kittycad.UUID) (*[]kittycad.OrgDataset, error) {
+	m.record("ListOrgDatasetsForModel", id)
+	if m.ListOrgDatasetsForModelFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.ListOrgDatasetsForModel", ErrNotProgrammed)
+	}
+	return m.ListOrgDatasetsForModelFunc(id)
+}
+
+// ListTextToCadPartsForUser records the call and returns the response of ListTextToCadPartsForUserFunc.
+func (m *MlService) ListTextToCadPartsForUser(params kittycad.MlListTextToCadPartsForUserParams) (*kittycad.TextToCadResponseResultsPage, error) {
+	m.record("ListTextToCadPartsForUser", params)
+	if m.ListTextToCadPartsForUserFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.ListTextToCadPartsForUser", ErrNotProgrammed)
+	}
+	return m.ListTextToCadPartsForUserFunc(params)
+}
+
+// ReasoningWs records the call and returns the response of ReasoningWsFunc.
+func (m *MlService) ReasoningWs(ctx context.Context, id kittycad.UUID, body any) (*websocket.Conn, error) {
+	m.record("ReasoningWs", ctx, id, body)
+	if m.ReasoningWsFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.ReasoningWs", ErrNotProgrammed)
+	}
+	return m.ReasoningWsFunc(ctx, id, body)
+}
+
+// UpdateCustomModel records the call and returns the response of UpdateCustomModelFunc.
+func (m *MlService) UpdateCustomModel(id kittycad.UUID, body kittycad.UpdateCustomModel) (*kittycad.CustomModel, error) {
+	m.record("UpdateCustomModel", id, body)
+	if m.UpdateCustomModelFunc == nil {
+		return nil, fmt.Errorf("%w: MlService.UpdateCustomModel", ErrNotProgrammed)
+	}
+	return m.UpdateCustomModelFunc(id, body)
+}
+
+// ModelingService is a mock implementation of kittycad.ModelingServiceAPI.
+type ModelingService struct {
+	recorder
+
+	// CommandsWsFunc is called by CommandsWs.
+	CommandsWsFunc func(ctx context.Context, params kittycad.ModelingCommandsWsParams, body any) (*websocket.Conn, error)
+}
+
+var _ kittycad.ModelingServiceAPI = (*ModelingService)(nil)
+
+// CommandsWs records the call and returns the response of CommandsWsFunc.
+func (m *ModelingService) CommandsWs(ctx context.Context, params kittycad.ModelingCommandsWsParams, body any) (*websocket.Conn, error) {
+	m.record("CommandsWs", ctx, params, body)
+	if m.CommandsWsFunc == nil {
+		return nil, fmt.Errorf("%w: ModelingService.CommandsWs", ErrNotProgrammed)
+	}
+	return m.CommandsWsFunc(ctx, params, body)
+}
+
+// Oauth2Service is a mock implementation of kittycad.Oauth2ServiceAPI.
+type Oauth2Service struct {
+	recorder
+
+	// ApproveAuthorizationRequestFunc is called by ApproveAuthorizationRequest.
+	ApproveAuthorizationRequestFunc func(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationDecisionResponse, error)
+	// AuthorizeFunc is called by Authorize.
+	AuthorizeFunc func(params kittycad.Oauth2AuthorizeParams) error
+	// CreateOrgAppFunc is called by CreateOrgApp.
+	CreateOrgAppFunc func(body kittycad.CreateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error)
+	// CreateUserAppFunc is called by CreateUserApp.
+	CreateUserAppFunc func(body kittycad.CreateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error)
+	// DeleteOrgAppFunc is called by DeleteOrgApp.
+	DeleteOrgAppFunc func(clientId kittycad.UUID) error
+	// DeleteUserAppFunc is called by DeleteUserApp.
+	DeleteUserAppFunc func(clientId kittycad.UUID) error
+	// DenyAuthorizationRequestFunc is called by DenyAuthorizationRequest.
+	DenyAuthorizationRequestFunc func(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationDecisionResponse, error)
+	// DeviceAccessTokenFunc is called by DeviceAccessToken.
+	DeviceAccessTokenFunc func(body kittycad.DeviceAccessTokenRequestForm) error
+	// DeviceAuthConfirmFunc is called by DeviceAuthConfirm.
+	DeviceAuthConfirmFunc func(body kittycad.DeviceAuthConfirmParams) error
+	// DeviceAuthRequestFunc is called by DeviceAuthRequest.
+	DeviceAuthRequestFunc func(body kittycad.DeviceAuthRequestForm) error
+	// DeviceAuthVerifyFunc is called by DeviceAuthVerify.
+	DeviceAuthVerifyFunc func(params kittycad.Oauth2DeviceAuthVerifyParams) error
+	// GetAuthorizationRequestFunc is called by GetAuthorizationRequest.
+	GetAuthorizationRequestFunc func(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationRequestResponse, error)
+	// GetOrgAppFunc is called by GetOrgApp.
+	GetOrgAppFunc func(clientId kittycad.UUID) (*kittycad.Oauth2AppResponse, error)
+	// GetUserAppFunc is called by GetUserApp.
+	GetUserAppFunc func(clientId kittycad.UUID) (*kittycad.Oauth2AppResponse, error)
+	// ListAppsForAnyOrgFunc is called by ListAppsForAnyOrg.
+	ListAppsForAnyOrgFunc func(id kittycad.UUID, params kittycad.Oauth2ListAppsForAnyOrgParams) (*kittycad.Oauth2AppResponseResultsPage, error)
+	// ListAppsForAnyUserFunc is called by ListAppsForAnyUser.
+	ListAppsForAnyUserFunc func(id string, params kittycad.Oauth2ListAppsForAnyUserParams) (*kittycad.Oauth2AppResponseResultsPage, error)
+	// ListOrgAppsFunc is called by ListOrgApps.
+	ListOrgAppsFunc func(params kittycad.Oauth2ListOrgAppsParams) (*kittycad.Oauth2AppResponseResultsPage, error)
+	// ListUserAppsFunc is called by ListUserApps.
+	ListUserAppsFunc func(params kittycad.Oauth2ListUserAppsParams) (*kittycad.Oauth2AppResponseResultsPage, error)
+	// ProviderCallbackFunc is called by ProviderCallback.
+	ProviderCallbackFunc func(provider kittycad.AccountProvider, params kittycad.Oauth2ProviderCallbackParams) error
+	// ProviderCallbackCreateFunc is called by ProviderCallbackCreate.
+	ProviderCallbackCreateFunc func(provider kittycad.AccountProvider, body kittycad.AuthCallback) error
+	// ProviderConsentFunc is called by ProviderConsent.
+	ProviderConsentFunc func(provider kittycad.AccountProvider, params kittycad.Oauth2ProviderConsentParams) (*kittycad.Oauth2ClientInfo, error)
+	// TokenFunc is called by Token.
+	TokenFunc func(body kittycad.Oauth2TokenRequestForm) error
+	// TokenRevokeFunc is called by TokenRevoke.
+	TokenRevokeFunc func(body kittycad.TokenRevokeRequestForm) error
+	// UpdateOrgAppFunc is called by UpdateOrgApp.
+	UpdateOrgAppFunc func(clientId kittycad.UUID, body kittycad.UpdateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error)
+	// UpdateUserAppFunc is called by UpdateUserApp.
+	UpdateUserAppFunc func(clientId kittycad.UUID, body kittycad.UpdateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error)
+	// VerifyOauthAccountLinkingFunc is called by VerifyOauthAccountLinking.
+	VerifyOauthAccountLinkingFunc func(params kittycad.Oauth2VerifyOauthAccountLinkingParams) error
+}
+
+var _ kittycad.Oauth2ServiceAPI = (*Oauth2Service)(nil)
+
+// ApproveAuthorizationRequest records the call and returns the response of ApproveAuthorizationRequestFunc.
+func (m *Oauth2Service) ApproveAuthorizationRequest(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationDecisionResponse, error) {
+	m.record("ApproveAuthorizationRequest", requestId)
+	if m.ApproveAuthorizationRequestFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ApproveAuthorizationRequest", ErrNotProgrammed)
+	}
+	return m.ApproveAuthorizationRequestFunc(requestId)
+}
+
+// Authorize records the call and returns the response of AuthorizeFunc.
+func (m *Oauth2Service) Authorize(params kittycad.Oauth2AuthorizeParams) error {
+	m.record("Authorize", params)
+	if m.AuthorizeFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.Authorize", ErrNotProgrammed)
+	}
+	return m.AuthorizeFunc(params)
+}
+
+// CreateOrgApp records the call and returns the response of CreateOrgAppFunc.
+func (m *Oauth2Service) CreateOrgApp(body kittycad.CreateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error) {
+	m.record("CreateOrgApp", body)
+	if m.CreateOrgAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.CreateOrgApp", ErrNotProgrammed)
+	}
+	return m.CreateOrgAppFunc(body)
+}
+
+// CreateUserApp records the call and returns the response of CreateUserAppFunc.
+func (m *Oauth2Service) CreateUserApp(body kittycad.CreateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error) {
+	m.record("CreateUserApp", body)
+	if m.CreateUserAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.CreateUserApp", ErrNotProgrammed)
+	}
+	return m.CreateUserAppFunc(body)
+}
+
+// DeleteOrgApp records the call and returns the response of DeleteOrgAppFunc.
+func (m *Oauth2Service) DeleteOrgApp(clientId kittycad.UUID) error {
+	m.record("DeleteOrgApp", clientId)
+	if m.DeleteOrgAppFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeleteOrgApp", ErrNotProgrammed)
+	}
+	return m.DeleteOrgAppFunc(clientId)
+}
+
+// DeleteUserApp records the call and returns the response of DeleteUserAppFunc.
+func (m *Oauth2Service) DeleteUserApp(clientId kittycad.UUID) error {
+	m.record("DeleteUserApp", clientId)
+	if m.DeleteUserAppFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeleteUserApp", ErrNotProgrammed)
+	}
+	return m.DeleteUserAppFunc(clientId)
+}
+
+// DenyAuthorizationRequest records the call and returns the response of DenyAuthorizationRequestFunc.
+func (m *Oauth2Service) DenyAuthorizationRequest(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationDecisionResponse, error) {
+	m.record("DenyAuthorizationRequest", requestId)
+	if m.DenyAuthorizationRequestFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.DenyAuthorizationRequest", ErrNotProgrammed)
+	}
+	return m.DenyAuthorizationRequestFunc(requestId)
+}
+
+// DeviceAccessToken records the call and returns the response of DeviceAccessTokenFunc.
+func (m *Oauth2Service) DeviceAccessToken(body kittycad.DeviceAccessTokenRequestForm) error {
+	m.record("DeviceAccessToken", body)
+	if m.DeviceAccessTokenFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeviceAccessToken", ErrNotProgrammed)
+	}
+	return m.DeviceAccessTokenFunc(body)
+}
+
+// DeviceAuthConfirm records the call and returns the response of DeviceAuthConfirmFunc.
+func (m *Oauth2Service) DeviceAuthConfirm(body kittycad.DeviceAuthConfirmParams) error {
+	m.record("DeviceAuthConfirm", body)
+	if m.DeviceAuthConfirmFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeviceAuthConfirm", ErrNotProgrammed)
+	}
+	return m.DeviceAuthConfirmFunc(body)
+}
+
+// DeviceAuthRequest records the call and returns the response of DeviceAuthRequestFunc.
+func (m *Oauth2Service) DeviceAuthRequest(body kittycad.DeviceAuthRequestForm) error {
+	m.record("DeviceAuthRequest", body)
+	if m.DeviceAuthRequestFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeviceAuthRequest", ErrNotProgrammed)
+	}
+	return m.DeviceAuthRequestFunc(body)
+}
+
+// DeviceAuthVerify records the call and returns the response of DeviceAuthVerifyFunc.
+func (m *Oauth2Service) DeviceAuthVerify(params kittycad.Oauth2DeviceAuthVerifyParams) error {
+	m.record("DeviceAuthVerify", params)
+	if m.DeviceAuthVerifyFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.DeviceAuthVerify", ErrNotProgrammed)
+	}
+	return m.DeviceAuthVerifyFunc(params)
+}
+
+// GetAuthorizationRequest records the call and returns the response of GetAuthorizationRequestFunc.
+func (m *Oauth2Service) GetAuthorizationRequest(requestId kittycad.UUID) (*kittycad.Oauth2AuthorizationRequestResponse, error) {
+	m.record("GetAuthorizationRequest", requestId)
+	if m.GetAuthorizationRequestFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.GetAuthorizationRequest", ErrNotProgrammed)
+	}
+	return m.GetAuthorizationRequestFunc(requestId)
+}
+
+// GetOrgApp records the call and returns the response of GetOrgAppFunc.
+func (m *Oauth2Service) GetOrgApp(clientId kittycad.UUID) (*kittycad.Oauth2AppResponse, error) {
+	m.record("GetOrgApp", clientId)
+	if m.GetOrgAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.GetOrgApp", ErrNotProgrammed)
+	}
+	return m.GetOrgAppFunc(clientId)
+}
+
+// GetUserApp records the call and returns the response of GetUserAppFunc.
+func (m *Oauth2Service) GetUserApp(clientId kittycad.UUID) (*kittycad.Oauth2AppResponse, error) {
+	m.record("GetUserApp", clientId)
+	if m.GetUserAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.GetUserApp", ErrNotProgrammed)
+	}
+	return m.GetUserAppFunc(clientId)
+}
+
+// ListAppsForAnyOrg records the call and returns the response of ListAppsForAnyOrgFunc.
+func (m *Oauth2Service) ListAppsForAnyOrg(id kittycad.UUID, params kittycad.Oauth2ListAppsForAnyOrgParams) (*kittycad.Oauth2AppResponseResultsPage, error) {
+	m.record("ListAppsForAnyOrg", id, params)
+	if m.ListAppsForAnyOrgFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ListAppsForAnyOrg", ErrNotProgrammed)
+	}
+	return m.ListAppsForAnyOrgFunc(id, params)
+}
+
+// ListAppsForAnyUser records the call and returns the response of ListAppsForAnyUserFunc.
+func (m *Oauth2Service) ListAppsForAnyUser(id string, params kittycad.Oauth2ListAppsForAnyUserParams) (*kittycad.Oauth2AppResponseResultsPage, error) {
+	m.record("ListAppsForAnyUser", id, params)
+	if m.ListAppsForAnyUserFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ListAppsForAnyUser", ErrNotProgrammed)
+	}
+	return m.ListAppsForAnyUserFunc(id, params)
+}
+
+// ListOrgApps records the call and returns the response of ListOrgAppsFunc.
+func (m *Oauth2Service) ListOrgApps(params kittycad.Oauth2ListOrgAppsParams) (*kittycad.Oauth2AppResponseResultsPage, error) {
+	m.record("ListOrgApps", params)
+	if m.ListOrgAppsFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ListOrgApps", ErrNotProgrammed)
+	}
+	return m.ListOrgAppsFunc(params)
+}
+
+// ListUserApps records the call and returns the response of ListUserAppsFunc.
+func (m *Oauth2Service) ListUserApps(params kittycad.Oauth2ListUserAppsParams) (*kittycad.Oauth2AppResponseResultsPage, error) {
+	m.record("ListUserApps", params)
+	if m.ListUserAppsFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ListUserApps", ErrNotProgrammed)
+	}
+	return m.ListUserAppsFunc(params)
+}
+
+// ProviderCallback records the call and returns the response of ProviderCallbackFunc.
+func (m *Oauth2Service) ProviderCallback(provider kittycad.AccountProvider, params kittycad.Oauth2ProviderCallbackParams) error {
+	m.record("ProviderCallback", provider, params)
+	if m.ProviderCallbackFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.ProviderCallback", ErrNotProgrammed)
+	}
+	return m.ProviderCallbackFunc(provider, params)
+}
+
+// ProviderCallbackCreate records the call and returns the response of ProviderCallbackCreateFunc.
+func (m *Oauth2Service) ProviderCallbackCreate(provider kittycad.AccountProvider, body kittycad.AuthCallback) error {
+	m.record("ProviderCallbackCreate", provider, body)
+	if m.ProviderCallbackCreateFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.ProviderCallbackCreate", ErrNotProgrammed)
+	}
+	return m.ProviderCallbackCreateFunc(provider, body)
+}
+
+// ProviderConsent records the call and returns the response of ProviderConsentFunc.
+func (m *Oauth2Service) ProviderConsent(provider kittycad.AccountProvider, params kittycad.Oauth2ProviderConsentParams) (*kittycad.Oauth2ClientInfo, error) {
+	m.record("ProviderConsent", provider, params)
+	if m.ProviderConsentFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.ProviderConsent", ErrNotProgrammed)
+	}
+	return m.ProviderConsentFunc(provider, params)
+}
+
+// Token records the call and returns the response of TokenFunc.
+func (m *Oauth2Service) Token(body kittycad.Oauth2TokenRequestForm) error {
+	m.record("Token", body)
+	if m.TokenFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.Token", ErrNotProgrammed)
+	}
+	return m.TokenFunc(body)
+}
+
+// TokenRevoke records the call and returns the response of TokenRevokeFunc.
+func (m *Oauth2Service) TokenRevoke(body kittycad.TokenRevokeRequestForm) error {
+	m.record("TokenRevoke", body)
+	if m.TokenRevokeFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.TokenRevoke", ErrNotProgrammed)
+	}
+	return m.TokenRevokeFunc(body)
+}
+
+// UpdateOrgApp records the call and returns the response of UpdateOrgAppFunc.
+func (m *Oauth2Service) UpdateOrgApp(clientId kittycad.UUID, body kittycad.UpdateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error) {
+	m.record("UpdateOrgApp", clientId, body)
+	if m.UpdateOrgAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.UpdateOrgApp", ErrNotProgrammed)
+	}
+	return m.UpdateOrgAppFunc(clientId, body)
+}
+
+// UpdateUserApp records the call and returns the response of UpdateUserAppFunc.
+func (m *Oauth2Service) UpdateUserApp(clientId kittycad.UUID, body kittycad.UpdateOauth2AppRequest) (*kittycad.Oauth2AppResponse, error) {
+	m.record("UpdateUserApp", clientId, body)
+	if m.UpdateUserAppFunc == nil {
+		return nil, fmt.Errorf("%w: Oauth2Service.UpdateUserApp", ErrNotProgrammed)
+	}
+	return m.UpdateUserAppFunc(clientId, body)
+}
+
+// VerifyOauthAccountLinking records the call and returns the response of VerifyOauthAccountLinkingFunc.
+func (m *Oauth2Service) VerifyOauthAccountLinking(params kittycad.Oauth2VerifyOauthAccountLinkingParams) error {
+	m.record("VerifyOauthAccountLinking", params)
+	if m.VerifyOauthAccountLinkingFunc == nil {
+		return fmt.Errorf("%w: Oauth2Service.VerifyOauthAccountLinking", ErrNotProgrammed)
+	}
+	return m.VerifyOauthAccountLinkingFunc(params)
+}
+
+// OrgService is a mock implementation of kittycad.OrgServiceAPI.
+type OrgService struct {
+	recorder
+
+	// CreateFunc is called by Create.
+	CreateFunc func(body kittycad.OrgDetails) (*kittycad.Org, error)
+	// CreateDatasetFunc is called by CreateDataset.
+	CreateDatasetFunc func(body kittycad.CreateOrgDataset) (*kittycad.OrgDataset, error)
+	// CreateMemberFunc is called by CreateMember.
+	CreateMemberFunc func(body kittycad.AddOrgMember) (*kittycad.OrgMember, error)
+	// CreateSamlIdpFunc is called by CreateSamlIdp.
+	CreateSamlIdpFunc func(body kittycad.SamlIdentityProviderCreate) (*kittycad.SamlIdentityProvider, error)
+	// DatasetS3PoliciesFunc is called by DatasetS3Policies.
+	DatasetS3PoliciesFunc func(uri string, roleArn string) (*kittycad.DatasetS3Policies, error)
+	// DeleteFunc is called by Delete.
+	DeleteFunc func() error
+	// DeleteDatasetFunc is called by DeleteDataset.
+	DeleteDatasetFunc func(id kittycad.UUID) error
+	// DeleteMemberFunc is called by DeleteMember.
+	DeleteMemberFunc func(userId kittycad.UUID) error
+	// DeleteSamlIdpFunc is called by DeleteSamlIdp.
+	DeleteSamlIdpFunc func() error
+	// DownloadDatasetConversionOriginalFunc is called by DownloadDatasetConversionOriginal.
+	DownloadDatasetConversionOriginalFunc func(id kittycad.UUID, conversionId kittycad.UUID) error
+	// DownloadDatasetSuccessfulKclBulkFunc is called by DownloadDatasetSuccessfulKclBulk.
+	DownloadDatasetSuccessfulKclBulkFunc func(id kittycad.UUID) error
+	// GetFunc is called by Get.
+	GetFunc func() (*kittycad.Org, error)
+	// GetBillingContractForAnyFunc is called by GetBillingContractForAny.
+	GetBillingContractForAnyFunc func(id kittycad.UUID) (*kittycad.BillingContractView, error)
+	// GetDatasetFunc is called by GetDataset.
+	GetDatasetFunc func(id kittycad.UUID) (*kittycad.OrgDataset, error)
+	// GetDatasetConversionFunc is called by GetDatasetConversion.
+	GetDatasetConversionFunc func(id kittycad.UUID, conversionId kittycad.UUID) (*kittycad.OrgDatasetFileConversionDetails, error)
+	// GetDatasetConversionStatsFunc is called by GetDatasetConversionStats.
+	GetDatasetConversionStatsFunc func(id kittycad.UUID) (*kittycad.OrgDatasetConversionStatsResponse, error)
+	// GetMemberFunc is called by GetMember.
+	GetMemberFunc func(userId kittycad.UUID) (*kittycad.OrgMember, error)
+	// GetPrivacySettingsFunc is called by GetPrivacySettings.
+	GetPrivacySettingsFunc func() (*kittycad.PrivacySettings, error)
+	// GetSamlIdpFunc is called by GetSamlIdp.
+	GetSamlIdpFunc func() (*kittycad.SamlIdentityProvider, error)
+	// GetShortlinksFunc is called by GetShortlinks.
+	GetShortlinksFunc func(params kittycad.OrgGetShortlinksParams) (*kittycad.ShortlinkResultsPage, error)
+	// GetUserFunc is called by GetUser.
+	GetUserFunc func() (*kittycad.UserOrgInfo, error)
+	// ListDatasetConversionsFunc is called by ListDatasetConversions.
+	ListDatasetConversionsFunc func(id kittycad.UUID, params kittycad.OrgListDatasetConversionsParams) (*kittycad.OrgDatasetFileConversionSummaryResultsPage, error)
+	// ListDatasetsFunc is called by ListDatasets.
+	ListDatasetsFunc func(params kittycad.OrgListDatasetsParams) (*kittycad.OrgDatasetResultsPage, error)
+	// ListMembersFunc is called by ListMembers.
+	ListMembersFunc func(params kittycad.OrgListMembersParams) (*kittycad.OrgMemberResultsPage, error)
+	// ListSkillsFunc is called by ListSkills.
+	ListSkillsFunc func() (*[]kittycad.OrgSkillResponse, error)
+	// RetriggerDatasetFunc is called by RetriggerDataset.
+	RetriggerDatasetFunc func(id kittycad.UUID, params kittycad.OrgRetriggerDatasetParams) error
+	// RetriggerDatasetConversionFunc is called by RetriggerDatasetConversion.
+	RetriggerDatasetConversionFunc func(id kittycad.UUID, conversionId kittycad.UUID) error
+	// SearchDatasetConversionsFunc is called by SearchDatasetConversions.
+	SearchDatasetConversionsFunc func(id kittycad.UUID, params kittycad.OrgSearchDatasetConversionsParams) (*kittycad.OrgDatasetFileConversionSummaryResultsPage, error)
+	// SearchDatasetSemanticFunc is called by SearchDatasetSemantic.
+	SearchDatasetSemanticFunc func(id kittycad.UUID, params kittycad.OrgSearchDatasetSemanticParams) (*[]kittycad.OrgDatasetSemanticSearchMatch, error)
+	// UpdateFunc is called by Update.
+	UpdateFunc func(body kittycad.OrgDetails) (*kittycad.Org, error)
+	// UpdateDatasetFunc is called by UpdateDataset.
+	UpdateDatasetFunc func(id kittycad.UUID, body kittycad.UpdateOrgDataset) (*kittycad.OrgDataset, error)
+	// UpdateMemberFunc is called by UpdateMember.
+	UpdateMemberFunc func(userId kittycad.UUID, body kittycad.UpdateMemberToOrgBody) (*kittycad.OrgMember, error)
+	// UpdatePrivacySettingsFunc is called by UpdatePrivacySettings.
+	UpdatePrivacySettingsFunc func(body kittycad.PrivacySettings) (*kittycad.PrivacySettings, error)
+	// UpdateSamlIdpFunc is called by UpdateSamlIdp.
+	UpdateSamlIdpFunc func(body kittycad.SamlIdentityProviderCreate) (*kittycad.SamlIdentityProvider, error)
+	// UploadDatasetFilesFunc is called by UploadDatasetFiles.
+	UploadDatasetFilesFunc func(id kittycad.UUID, body *kittycad.MultipartForm) (*kittycad.UploadOrgDatasetFilesResponse, error)
+	// UpsertBillingContractForAnyFunc is called by UpsertBillingContractForAny.
+	UpsertBillingContractForAnyFunc func(id kittycad.UUID, body kittycad.BillingContractUpsert) (*kittycad.BillingContractView, error)
+}
+
+var _ kittycad.OrgServiceAPI = (*OrgService)(nil)
+
+// Create records the call and returns the response of CreateFunc.
+func (m *OrgService) Create(body kittycad.OrgDetails) (*kittycad.Org, error) {
+	m.record("Create", body)
+	if m.CreateFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.Create", ErrNotProgrammed)
+	}
+	return m.CreateFunc(body)
+}
+
+// CreateDataset records the call and returns the response of CreateDatasetFunc.
+func (m *OrgService) CreateDataset(body kittycad.CreateOrgDataset) (*kittycad.OrgDataset, error) {
+	m.record("CreateDataset", body)
+	if m.CreateDatasetFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.CreateDataset", ErrNotProgrammed)
+	}
+	return m.CreateDatasetFunc(body)
+}
+
+// CreateMember records the call and returns the response of CreateMemberFunc.
+func (m *OrgService) CreateMember(body kittycad.AddOrgMember) (*kittycad.OrgMember, error) {
+	m.record("CreateMember", body)
+	if m.CreateMemberFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.CreateMember", ErrNotProgrammed)
+	}
+	return m.CreateMemberFunc(body)
+}
+
+// CreateSamlIdp records the call and returns the response of CreateSamlIdpFunc.
+func (m *OrgService) CreateSamlIdp(body kittycad.SamlIdentityProviderCreate) (*kittycad.SamlIdentityProvider, error) {
+	m.record("CreateSamlIdp", body)
+	if m.CreateSamlIdpFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.CreateSamlIdp", ErrNotProgrammed)
+	}
+	return m.CreateSamlIdpFunc(body)
+}
+
+// DatasetS3Policies records the call and returns the response of DatasetS3PoliciesFunc.
+func (m *OrgService) DatasetS3Policies(uri string, roleArn string) (*kittycad.DatasetS3Policies, error) {
+	m.record("DatasetS3Policies", uri, roleArn)
+	if m.DatasetS3PoliciesFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.DatasetS3Policies", ErrNotProgrammed)
+	}
+	return m.DatasetS3PoliciesFunc(uri, roleArn)
+}
+
+// Delete records the call and returns the response of DeleteFunc.
+func (m *OrgService) Delete() error {
+	m.record("Delete")
+	if m.DeleteFunc == nil {
+		return fmt.Errorf("%w: OrgService.Delete", ErrNotProgrammed)
+	}
+	return m.DeleteFunc()
+}
+
+// DeleteDataset records the call and returns the response of DeleteDatasetFunc.
+func (m *OrgService) DeleteDataset(id kittycad.UUID) error {
+	m.record("DeleteDataset", id)
+	if m.DeleteDatasetFunc == nil {
+		return fmt.Errorf("%w: OrgService.DeleteDataset", ErrNotProgrammed)
+	}
+	return m.DeleteDatasetFunc(id)
+}
+
+// DeleteMember records the call and returns the response of DeleteMemberFunc.
+func (m *OrgService) DeleteMember(userId kittycad.UUID) error {
+	m.record("DeleteMember", userId)
+	if m.DeleteMemberFunc == nil {
+		return fmt.Errorf("%w: OrgService.DeleteMember", ErrNotProgrammed)
+	}
+	return m.DeleteMemberFunc(userId)
+}
+
+// DeleteSamlIdp records the call and returns the response of DeleteSamlIdpFunc.
+func (m *OrgService) DeleteSamlIdp() error {
+	m.record("DeleteSamlIdp")
+	if m.DeleteSamlIdpFunc == nil {
+		return fmt.Errorf("%w: OrgService.DeleteSamlIdp", ErrNotProgrammed)
+	}
+	return m.DeleteSamlIdpFunc()
+}
+
+// DownloadDatasetConversionOriginal records the call and returns the response of DownloadDatasetConversionOriginalFunc.
+func (m *OrgService) DownloadDatasetConversionOriginal(id kittycad.UUID, conversionId kittycad.UUID) error {
+	m.record("DownloadDatasetConversionOriginal", id, conversionId)
+	if m.DownloadDatasetConversionOriginalFunc == nil {
+		return fmt.Errorf("%w: OrgService.DownloadDatasetConversionOriginal", ErrNotProgrammed)
+	}
+	return m.DownloadDatasetConversionOriginalFunc(id, conversionId)
+}
+
+// DownloadDatasetSuccessfulKclBulk records the call and returns the response of DownloadDatasetSuccessfulKclBulkFunc.
+func (m *OrgService) DownloadDatasetSuccessfulKclBulk(id kittycad.UUID) error {
+	m.record("DownloadDatasetSuccessfulKclBulk", id)
+	if m.DownloadDatasetSuccessfulKclBulkFunc == nil {
+		return fmt.Errorf("%w: OrgService.DownloadDatasetSuccessfulKclBulk", ErrNotProgrammed)
+	}
+	return m.DownloadDatasetSuccessfulKclBulkFunc(id)
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *OrgService) Get() (*kittycad.Org, error) {
+	m.record("Get")
+	if m.GetFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.Get", ErrNotProgrammed)
+	}
+	return m.GetFunc()
+}
+
+// GetBillingContractForAny records the call and returns the response of GetBillingContractForAnyFunc.
+func (m *OrgService) GetBillingContractForAny(id kittycad.UUID) (*kittycad.BillingContractView, error) {
+	m.record("GetBillingContractForAny", id)
+	if m.GetBillingContractForAnyFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetBillingContractForAny", ErrNotProgrammed)
+	}
+	return m.GetBillingContractForAnyFunc(id)
+}
+
+// GetDataset records the call and returns the response of GetDatasetFunc.
+func (m *OrgService) GetDataset(id kittycad.UUID) (*kittycad.OrgDataset, error) {
+	m.record("GetDataset", id)
+	if m.GetDatasetFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetDataset", ErrNotProgrammed)
+	}
+	return m.GetDatasetFunc(id)
+}
+
+// GetDatasetConversion records the call and returns the response of GetDatasetConversionFunc.
+func (m *OrgService) GetDatasetConversion(id kittycad.UUID, conversionId kittycad.UUID) (*kittycad.OrgDatasetFileConversionDetails, error) {
+	m.record("GetDatasetConversion", id, conversionId)
+	if m.GetDatasetConversionFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetDatasetConversion", ErrNotProgrammed)
+	}
+	return m.GetDatasetConversionFunc(id, conversionId)
+}
+
+// GetDatasetConversionStats records the call and returns the response of GetDatasetConversionStatsFunc.
+func (m *OrgService) GetDatasetConversionStats(id kittycad.UUID) (*kittycad.OrgDatasetConversionStatsResponse, error) {
+	m.record("GetDatasetConversionStats", id)
+	if m.GetDatasetConversionStatsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetDatasetConversionStats", ErrNotProgrammed)
+	}
+	return m.GetDatasetConversionStatsFunc(id)
+}
+
+// GetMember records the call and returns the response of GetMemberFunc.
+func (m *OrgService) GetMember(userId kittycad.UUID) (*kittycad.OrgMember, error) {
+	m.record("GetMember", userId)
+	if m.GetMemberFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetMember", ErrNotProgrammed)
+	}
+	return m.GetMemberFunc(userId)
+}
+
+// GetPrivacySettings records the call and returns the response of GetPrivacySettingsFunc.
+func (m *OrgService) GetPrivacySettings() (*kittycad.PrivacySettings, error) {
+	m.record("GetPrivacySettings")
+	if m.GetPrivacySettingsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetPrivacySettings", ErrNotProgrammed)
+	}
+	return m.GetPrivacySettingsFunc()
+}
+
+// GetSamlIdp records the call and returns the response of GetSamlIdpFunc.
+func (m *OrgService) GetSamlIdp() (*kittycad.SamlIdentityProvider, error) {
+	m.record("GetSamlIdp")
+	if m.GetSamlIdpFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetSamlIdp", ErrNotProgrammed)
+	}
+	return m.GetSamlIdpFunc()
+}
+
+// GetShortlinks records the call and returns the response of GetShortlinksFunc.
+func (m *OrgService) GetShortlinks(params kittycad.OrgGetShortlinksParams) (*kittycad.ShortlinkResultsPage, error) {
+	m.record("GetShortlinks", params)
+	if m.GetShortlinksFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetShortlinks", ErrNotProgrammed)
+	}
+	return m.GetShortlinksFunc(params)
+}
+
+// GetUser records the call and returns the response of GetUserFunc.
+func (m *OrgService) GetUser() (*kittycad.UserOrgInfo, error) {
+	m.record("GetUser")
+	if m.GetUserFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.GetUser", ErrNotProgrammed)
+	}
+	return m.GetUserFunc()
+}
+
+// ListDatasetConversions records the call and returns the response of ListDatasetConversionsFunc.
+func (m *OrgService) ListDatasetConversions(id kittycad.UUID, params kittycad.OrgListDatasetConversionsParams) (*kittycad.OrgDatasetFileConversionSummaryResultsPage, error) {
+	m.record("ListDatasetConversions", id, params)
+	if m.ListDatasetConversionsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.ListDatasetConversions", ErrNotProgrammed)
+	}
+	return m.ListDatasetConversionsFunc(id, params)
+}
+
+// ListDatasets records the call and returns the response of ListDatasetsFunc.
+func (m *OrgService) ListDatasets(params kittycad.OrgListDatasetsParams) (*kittycad.OrgDatasetResultsPage, error) {
+	m.record("ListDatasets", params)
+	if m.ListDatasetsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.ListDatasets", ErrNotProgrammed)
+	}
+	return m.ListDatasetsFunc(params)
+}
+
+// ListMembers records the call and returns the response of ListMembersFunc.
+func (m *OrgService) ListMembers(params kittycad.OrgListMembersParams) (*kittycad.OrgMemberResultsPage, error) {
+	m.record("ListMembers", params)
+	if m.ListMembersFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.ListMembers", ErrNotProgrammed)
+	}
+	return m.ListMembersFunc(params)
+}
+
+// ListSkills records the call and returns the response of ListSkillsFunc.
+func (m *OrgService) ListSkills() (*[]kittycad.OrgSkillResponse, error) {
+	m.record("ListSkills")
+	if m.ListSkillsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.ListSkills", ErrNotProgrammed)
+	}
+	return m.ListSkillsFunc()
+}
+
+// RetriggerDataset records the call and returns the response of RetriggerDatasetFunc.
+func (m *OrgService) RetriggerDataset(id kittycad.UUID, params kittycad.OrgRetriggerDatasetParams) error {
+	m.record("RetriggerDataset", id, params)
+	if m.RetriggerDatasetFunc == nil {
+		return fmt.Errorf("%w: OrgService.RetriggerDataset", ErrNotProgrammed)
+	}
+	return m.RetriggerDatasetFunc(id, params)
+}
+
+// RetriggerDatasetConversion records the call and returns the response of RetriggerDatasetConversionFunc.
+func (m *OrgService) RetriggerDatasetConversion(id kittycad.UUID, conversionId kittycad.UUID) error {
+	m.record("RetriggerDatasetConversion", id, conversionId)
+	if m.RetriggerDatasetConversionFunc == nil {
+		return fmt.Errorf("%w: OrgService.RetriggerDatasetConversion", ErrNotProgrammed)
+	}
+	return m.RetriggerDatasetConversionFunc(id, conversionId)
+}
+
+// SearchDatasetConversions records the call and returns the response of SearchDatasetConversionsFunc.
+func (m *OrgService) SearchDatasetConversions(id kittycad.UUID, params kittycad.OrgSearchDatasetConversionsParams) (*kittycad.OrgDatasetFileConversionSummaryResultsPage, error) {
+	m.record("SearchDatasetConversions", id, params)
+	if m.SearchDatasetConversionsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.SearchDatasetConversions", ErrNotProgrammed)
+	}
+	return m.SearchDatasetConversionsFunc(id, params)
+}
+
+// SearchDatasetSemantic records the call and returns the response of SearchDatasetSemanticFunc.
+func (m *OrgService) SearchDatasetSemantic(id kittycad.UUID, params kittycad.OrgSearchDatasetSemanticParams) (*[]kittycad.OrgDatasetSemanticSearchMatch, error) {
+	m.record("SearchDatasetSemantic", id, params)
+	if m.SearchDatasetSemanticFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.SearchDatasetSemantic", ErrNotProgrammed)
+	}
+	return m.SearchDatasetSemanticFunc(id, params)
+}
+
+// Update records the call and returns the response of UpdateFunc.
+func (m *OrgService) Update(body kittycad.OrgDetails) (*kittycad.Org, error) {
+	m.record("Update", body)
+	if m.UpdateFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.Update", ErrNotProgrammed)
+	}
+	return m.UpdateFunc(body)
+}
+
+// UpdateDataset records the call and returns the response of UpdateDatasetFunc.
+func (m *OrgService) UpdateDataset(id kittycad.UUID, body kittycad.UpdateOrgDataset) (*kittycad.OrgDataset, error) {
+	m.record("UpdateDataset", id, body)
+	if m.UpdateDatasetFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UpdateDataset", ErrNotProgrammed)
+	}
+	return m.UpdateDatasetFunc(id, body)
+}
+
+// UpdateMember records the call and returns the response of UpdateMemberFunc.
+func (m *OrgService) UpdateMember(userId kittycad.UUID, body kittycad.UpdateMemberToOrgBody) (*kittycad.OrgMember, error) {
+	m.record("UpdateMember", userId, body)
+	if m.UpdateMemberFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UpdateMember", ErrNotProgrammed)
+	}
+	return m.UpdateMemberFunc(userId, body)
+}
+
+// UpdatePrivacySettings records the call and returns the response of UpdatePrivacySettingsFunc.
+func (m *OrgService) UpdatePrivacySettings(body kittycad.PrivacySettings) (*kittycad.PrivacySettings, error) {
+	m.record("UpdatePrivacySettings", body)
+	if m.UpdatePrivacySettingsFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UpdatePrivacySettings", ErrNotProgrammed)
+	}
+	return m.UpdatePrivacySettingsFunc(body)
+}
+
+// UpdateSamlIdp records the call and returns the response of UpdateSamlIdpFunc.
+func (m *OrgService) UpdateSamlIdp(body kittycad.SamlIdentityProviderCreate) (*kittycad.SamlIdentityProvider, error) {
+	m.record("UpdateSamlIdp", body)
+	if m.UpdateSamlIdpFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UpdateSamlIdp", ErrNotProgrammed)
+	}
+	return m.UpdateSamlIdpFunc(body)
+}
+
+// UploadDatasetFiles records the call and returns the response of UploadDatasetFilesFunc.
+func (m *OrgService) UploadDatasetFiles(id kittycad.UUID, body *kittycad.MultipartForm) (*kittycad.UploadOrgDatasetFilesResponse, error) {
+	m.record("UploadDatasetFiles", id, body)
+	if m.UploadDatasetFilesFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UploadDatasetFiles", ErrNotProgrammed)
+	}
+	return m.UploadDatasetFilesFunc(id, body)
+}
+
+// UpsertBillingContractForAny records the call and returns the response of UpsertBillingContractForAnyFunc.
+func (m *OrgService) UpsertBillingContractForAny(id kittycad.UUID, body kittycad.BillingContractUpsert) (*kittycad.BillingContractView, error) {
+	m.record("UpsertBillingContractForAny", id, body)
+	if m.UpsertBillingContractForAnyFunc == nil {
+		return nil, fmt.Errorf("%w: OrgService.UpsertBillingContractForAny", ErrNotProgrammed)
+	}
+	return m.UpsertBillingContractForAnyFunc(id, body)
+}
+
+// PaymentService is a mock implementation of kittycad.PaymentServiceAPI.
+type PaymentService struct {
+	recorder
+
+	// CreateInformationForOrgFunc is called by CreateInformationForOrg.
+	CreateInformationForOrgFunc func(body kittycad.BillingInfo) (*kittycad.Customer, error)
+	// CreateInformationForUserFunc is called by CreateInformationForUser.
+	CreateInformationForUserFunc func(body kittycad.BillingInfo) (*kittycad.Customer, error)
+	// CreateIntentForOrgFunc is called by CreateIntentForOrg.
+	CreateIntentForOrgFunc func() (*kittycad.PaymentIntent, error)
+	// CreateIntentForUserFunc is called by CreateIntentForUser.
+	CreateIntentForUserFunc func() (*kittycad.PaymentIntent, error)
+	// CreateOrgSubscriptionFunc is called by CreateOrgSubscription.
+	CreateOrgSubscriptionFunc func(body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error)
+	// CreateUserSubscriptionFunc is called by CreateUserSubscription.
+	CreateUserSubscriptionFunc func(body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error)
+	// DeleteInformationForOrgFunc is called by DeleteInformationForOrg.
+	DeleteInformationForOrgFunc func() error
+	// DeleteInformationForUserFunc is called by DeleteInformationForUser.
+	DeleteInformationForUserFunc func() error
+	// DeleteMethodForOrgFunc is called by DeleteMethodForOrg.
+	DeleteMethodForOrgFunc func(id string) error
+	// DeleteMethodForUserFunc is called by DeleteMethodForUser.
+	DeleteMethodForUserFunc func(id string) error
+	// GetBalanceForAnyOrgFunc is called by GetBalanceForAnyOrg.
+	GetBalanceForAnyOrgFunc func(id kittycad.UUID, params kittycad.PaymentGetBalanceForAnyOrgParams) (*kittycad.CustomerBalance, error)
+	// GetBalanceForAnyUserFunc is called by GetBalanceForAnyUser.
+	GetBalanceForAnyUserFunc func(id string, params kittycad.PaymentGetBalanceForAnyUserParams) (*kittycad.CustomerBalance, error)
+	// GetBalanceForOrgFunc is called by GetBalanceForOrg.
+	GetBalanceForOrgFunc func(params kittycad.PaymentGetBalanceForOrgParams) (*kittycad.CustomerBalance, error)
+	// GetBalanceForUserFunc is called by GetBalanceForUser.
+	GetBalanceForUserFunc func(params kittycad.PaymentGetBalanceForUserParams) (*kittycad.CustomerBalance, error)
+	// GetInformationForOrgFunc is called by GetInformationForOrg.
+	GetInformationForOrgFunc func() (*kittycad.Customer, error)
+	// GetInformationForUserFunc is called by GetInformationForUser.
+	GetInformationForUserFunc func() (*kittycad.Customer, error)
+	// GetOrgSubscriptionFunc is called by GetOrgSubscription.
+	GetOrgSubscriptionFunc func() (*kittycad.ZooProductSubscriptions, error)
+	// GetOrgUsageCollectionThresholdFunc is called by GetOrgUsageCollectionThreshold.
+	GetOrgUsageCollectionThresholdFunc func() (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// GetUserSubscriptionFunc is called by GetUserSubscription.
+	GetUserSubscriptionFunc func() (*kittycad.ZooProductSubscriptions, error)
+	// GetUserUsageCollectionThresholdFunc is called by GetUserUsageCollectionThreshold.
+	GetUserUsageCollectionThresholdFunc func() (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// ListInvoicesForOrgFunc is called by ListInvoicesForOrg.
+	ListInvoicesForOrgFunc func(params kittycad.PaymentListInvoicesForOrgParams) (*kittycad.InvoiceResultsPage, error)
+	// ListInvoicesForUserFunc is called by ListInvoicesForUser.
+	ListInvoicesForUserFunc func(params kittycad.PaymentListInvoicesForUserParams) (*kittycad.InvoiceResultsPage, error)
+	// ListMethodsForOrgFunc is called by ListMethodsForOrg.
+	ListMethodsForOrgFunc func() (*[]kittycad.PaymentMethod, error)
+	// ListMethodsForUserFunc is called by ListMethodsForUser.
+	ListMethodsForUserFunc func() (*[]kittycad.PaymentMethod, error)
+	// RedirectMethodPortalLinkForOrgFunc is called by RedirectMethodPortalLinkForOrg.
+	RedirectMethodPortalLinkForOrgFunc func(params kittycad.PaymentRedirectMethodPortalLinkForOrgParams) error
+	// RedirectMethodPortalLinkForUserFunc is called by RedirectMethodPortalLinkForUser.
+	RedirectMethodPortalLinkForUserFunc func(params kittycad.PaymentRedirectMethodPortalLinkForUserParams) error
+	// ResetOrgUsageCollectionThresholdFunc is called by ResetOrgUsageCollectionThreshold.
+	ResetOrgUsageCollectionThresholdFunc func(expectedVersion int) (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// ResetUserUsageCollectionThresholdFunc is called by ResetUserUsageCollectionThreshold.
+	ResetUserUsageCollectionThresholdFunc func(expectedVersion int) (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// SetDefaultMethodForUserFunc is called by SetDefaultMethodForUser.
+	SetDefaultMethodForUserFunc func(id string) error
+	// SetOrgUsageCollectionThresholdFunc is called by SetOrgUsageCollectionThreshold.
+	SetOrgUsageCollectionThresholdFunc func(body kittycad.AggregateUsageCollectionThresholdSet) (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// SetUserUsageCollectionThresholdFunc is called by SetUserUsageCollectionThreshold.
+	SetUserUsageCollectionThresholdFunc func(body kittycad.AggregateUsageCollectionThresholdSet) (*kittycad.AggregateUsageCollectionThresholdView, error)
+	// UpdateBalanceForAnyOrgFunc is called by UpdateBalanceForAnyOrg.
+	UpdateBalanceForAnyOrgFunc func(id kittycad.UUID, params kittycad.PaymentUpdateBalanceForAnyOrgParams, body kittycad.UpdatePaymentBalance) (*kittycad.CustomerBalance, error)
+	// UpdateBalanceForAnyUserFunc is called by UpdateBalanceForAnyUser.
+	UpdateBalanceForAnyUserFunc func(id string, params kittycad.PaymentUpdateBalanceForAnyUserParams, body kittycad.UpdatePaymentBalance) (*kittycad.CustomerBalance, error)
+	// UpdateInformationForOrgFunc is called by UpdateInformationForOrg.
+	UpdateInformationForOrgFunc func(body kittycad.BillingInfo) (*kittycad.Customer, error)
+	// UpdateInformationForUserFunc is called by UpdateInformationForUser.
+	UpdateInformationForUserFunc func(body kittycad.BillingInfo) (*kittycad.Customer, error)
+	// UpdateOrgSubscriptionFunc is called by UpdateOrgSubscription.
+	UpdateOrgSubscriptionFunc func(body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error)
+	// UpdateOrgSubscriptionForAnyOrgFunc is called by UpdateOrgSubscriptionForAnyOrg.
+	UpdateOrgSubscriptionForAnyOrgFunc func(id kittycad.UUID, body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error)
+	// UpdateUserSubscriptionFunc is called by UpdateUserSubscription.
+	UpdateUserSubscriptionFunc func(body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error)
+	// UpsertSubscriptionPlanPriceFunc is called by UpsertSubscriptionPlanPrice.
+	UpsertSubscriptionPlanPriceFunc func(slug string, body kittycad.PriceUpsertRequest) (*kittycad.SubscriptionPlanPriceRecord, error)
+	// ValidateCustomerTaxInformationForOrgFunc is called by ValidateCustomerTaxInformationForOrg.
+	ValidateCustomerTaxInformationForOrgFunc func() error
+	// ValidateCustomerTaxInformationForUserFunc is called by ValidateCustomerTaxInformationForUser.
+	ValidateCustomerTaxInformationForUserFunc func() error
+}
+
+var _ kittycad.PaymentServiceAPI = (*PaymentService)(nil)
+
+// CreateInformationForOrg records the call and returns the response of CreateInformationForOrgFunc.
+func (m *PaymentService) CreateInformationForOrg(body kittycad.BillingInfo) (*kittycad.Customer, error) {
+	m.record("CreateInformationForOrg", body)
+	if m.CreateInformationForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateInformationForOrg", ErrNotProgrammed)
+	}
+	return m.CreateInformationForOrgFunc(body)
+}
+
+// CreateInformationForUser records the call and returns the response of CreateInformationForUserFunc.
+func (m *PaymentService) CreateInformationForUser(body kittycad.BillingInfo) (*kittycad.Customer, error) {
+	m.record("CreateInformationForUser", body)
+	if m.CreateInformationForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateInformationForUser", ErrNotProgrammed)
+	}
+	return m.CreateInformationForUserFunc(body)
+}
+
+// CreateIntentForOrg records the call and returns the response of CreateIntentForOrgFunc.
+func (m *PaymentService) CreateIntentForOrg() (*kittycad.PaymentIntent, error) {
+	m.record("CreateIntentForOrg")
+	if m.CreateIntentForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateIntentForOrg", ErrNotProgrammed)
+	}
+	return m.CreateIntentForOrgFunc()
+}
+
+// CreateIntentForUser records the call and returns the response of CreateIntentForUserFunc.
+func (m *PaymentService) CreateIntentForUser() (*kittycad.PaymentIntent, error) {
+	m.record("CreateIntentForUser")
+	if m.CreateIntentForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateIntentForUser", ErrNotProgrammed)
+	}
+	return m.CreateIntentForUserFunc()
+}
+
+// CreateOrgSubscription records the call and returns the response of CreateOrgSubscriptionFunc.
+func (m *PaymentService) CreateOrgSubscription(body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("CreateOrgSubscription", body)
+	if m.CreateOrgSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateOrgSubscription", ErrNotProgrammed)
+	}
+	return m.CreateOrgSubscriptionFunc(body)
+}
+
+// CreateUserSubscription records the call and returns the response of CreateUserSubscriptionFunc.
+func (m *PaymentService) CreateUserSubscription(body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("CreateUserSubscription", body)
+	if m.CreateUserSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.CreateUserSubscription", ErrNotProgrammed)
+	}
+	return m.CreateUserSubscriptionFunc(body)
+}
+
+// DeleteInformationForOrg records the call and returns the response of DeleteInformationForOrgFunc.
+func (m *PaymentService) DeleteInformationForOrg() error {
+	m.record("DeleteInformationForOrg")
+	if m.DeleteInformationForOrgFunc == nil {
+		return fmt.Errorf("%w: PaymentService.DeleteInformationForOrg", ErrNotProgrammed)
+	}
+	return m.DeleteInformationForOrgFunc()
+}
+
+// DeleteInformationForUser records the call and returns the response of DeleteInformationForUserFunc.
+func (m *PaymentService) DeleteInformationForUser() error {
+	m.record("DeleteInformationForUser")
+	if m.DeleteInformationForUserFunc == nil {
+		return fmt.Errorf("%w: PaymentService.DeleteInformationForUser", ErrNotProgrammed)
+	}
+	return m.DeleteInformationForUserFunc()
+}
+
+// DeleteMethodForOrg records the call and returns the response of DeleteMethodForOrgFunc.
+func (m *PaymentService) DeleteMethodForOrg(id string) error {
+	m.record("DeleteMethodForOrg", id)
+	if m.DeleteMethodForOrgFunc == nil {
+		return fmt.Errorf("%w: PaymentService.DeleteMethodForOrg", ErrNotProgrammed)
+	}
+	return m.DeleteMethodForOrgFunc(id)
+}
+
+// DeleteMethodForUser records the call and returns the response of DeleteMethodForUserFunc.
+func (m *PaymentService) DeleteMethodForUser(id string) error {
+	m.record("DeleteMethodForUser", id)
+	if m.DeleteMethodForUserFunc == nil {
+		return fmt.Errorf("%w: PaymentService.DeleteMethodForUser", ErrNotProgrammed)
+	}
+	return m.DeleteMethodForUserFunc(id)
+}
+
+// GetBalanceForAnyOrg records the call and returns the response of GetBalanceForAnyOrgFunc.
+func (m *PaymentService) GetBalanceForAnyOrg(id kittycad.UUID, params kittycad.PaymentGetBalanceForAnyOrgParams) (*kittycad.CustomerBalance, error) {
+	m.record("GetBalanceForAnyOrg", id, params)
+	if m.GetBalanceForAnyOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetBalanceForAnyOrg", ErrNotProgrammed)
+	}
+	return m.GetBalanceForAnyOrgFunc(id, params)
+}
+
+// GetBalanceForAnyUser records the call and returns the response of GetBalanceForAnyUserFunc.
+func (m *PaymentService) GetBalanceForAnyUser(id string, params kittycad.PaymentGetBalanceForAnyUserParams) (*kittycad.CustomerBalance, error) {
+	m.record("GetBalanceForAnyUser", id, params)
+	if m.GetBalanceForAnyUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetBalanceForAnyUser", ErrNotProgrammed)
+	}
+	return m.GetBalanceForAnyUserFunc(id, params)
+}
+
+// GetBalanceForOrg records the call and returns the response of GetBalanceForOrgFunc.
+func (m *PaymentService) GetBalanceForOrg(params kittycad.PaymentGetBalanceForOrgParams) (*kittycad.CustomerBalance, error) {
+	m.record("GetBalanceForOrg", params)
+	if m.GetBalanceForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetBalanceForOrg", ErrNotProgrammed)
+	}
+	return m.GetBalanceForOrgFunc(params)
+}
+
+// GetBalanceForUser records the call and returns the response of GetBalanceForUserFunc.
+func (m *PaymentService) GetBalanceForUser(params kittycad.PaymentGetBalanceForUserParams) (*kittycad.CustomerBalance, error) {
+	m.record("GetBalanceForUser", params)
+	if m.GetBalanceForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetBalanceForUser", ErrNotProgrammed)
+	}
+	return m.GetBalanceForUserFunc(params)
+}
+
+// GetInformationForOrg records the call and returns the response of GetInformationForOrgFunc.
+func (m *PaymentService) GetInformationForOrg() (*kittycad.Customer, error) {
+	m.record("GetInformationForOrg")
+	if m.GetInformationForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetInformationForOrg", ErrNotProgrammed)
+	}
+	return m.GetInformationForOrgFunc()
+}
+
+// GetInformationForUser records the call and returns the response of GetInformationForUserFunc.
+func (m *PaymentService) GetInformationForUser() (*kittycad.Customer, error) {
+	m.record("GetInformationForUser")
+	if m.GetInformationForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetInformationForUser", ErrNotProgrammed)
+	}
+	return m.GetInformationForUserFunc()
+}
+
+// GetOrgSubscription records the call and returns the response of GetOrgSubscriptionFunc.
+func (m *PaymentService) GetOrgSubscription() (*kittycad.ZooProductSubscriptions, error) {
+	m.record("GetOrgSubscription")
+	if m.GetOrgSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetOrgSubscription", ErrNotProgrammed)
+	}
+	return m.GetOrgSubscriptionFunc()
+}
+
+// GetOrgUsageCollectionThreshold records the call and returns the response of GetOrgUsageCollectionThresholdFunc.
+func (m *PaymentService) GetOrgUsageCollectionThreshold() (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("GetOrgUsageCollectionThreshold")
+	if m.GetOrgUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetOrgUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.GetOrgUsageCollectionThresholdFunc()
+}
+
+// GetUserSubscription records the call and returns the response of GetUserSubscriptionFunc.
+func (m *PaymentService) GetUserSubscription() (*kittycad.ZooProductSubscriptions, error) {
+	m.record("GetUserSubscription")
+	if m.GetUserSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetUserSubscription", ErrNotProgrammed)
+	}
+	return m.GetUserSubscriptionFunc()
+}
+
+// GetUserUsageCollectionThreshold records the call and returns the response of GetUserUsageCollectionThresholdFunc.
+func (m *PaymentService) GetUserUsageCollectionThreshold() (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("GetUserUsageCollectionThreshold")
+	if m.GetUserUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.GetUserUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.GetUserUsageCollectionThresholdFunc()
+}
+
+// ListInvoicesForOrg records the call and returns the response of ListInvoicesForOrgFunc.
+func (m *PaymentService) ListInvoicesForOrg(params kittycad.PaymentListInvoicesForOrgParams) (*kittycad.InvoiceResultsPage, error) {
+	m.record("ListInvoicesForOrg", params)
+	if m.ListInvoicesForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ListInvoicesForOrg", ErrNotProgrammed)
+	}
+	return m.ListInvoicesForOrgFunc(params)
+}
+
+// ListInvoicesForUser records the call and returns the response of ListInvoicesForUserFunc.
+func (m *PaymentService) ListInvoicesForUser(params kittycad.PaymentListInvoicesForUserParams) (*kittycad.InvoiceResultsPage, error) {
+	m.record("ListInvoicesForUser", params)
+	if m.ListInvoicesForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ListInvoicesForUser", ErrNotProgrammed)
+	}
+	return m.ListInvoicesForUserFunc(params)
+}
+
+// ListMethodsForOrg records the call and returns the response of ListMethodsForOrgFunc.
+func (m *PaymentService) ListMethodsForOrg() (*[]kittycad.PaymentMethod, error) {
+	m.record("ListMethodsForOrg")
+	if m.ListMethodsForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ListMethodsForOrg", ErrNotProgrammed)
+	}
+	return m.ListMethodsForOrgFunc()
+}
+
+// ListMethodsForUser records the call and returns the response of ListMethodsForUserFunc.
+func (m *PaymentService) ListMethodsForUser() (*[]kittycad.PaymentMethod, error) {
+	m.record("ListMethodsForUser")
+	if m.ListMethodsForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ListMethodsForUser", ErrNotProgrammed)
+	}
+	return m.ListMethodsForUserFunc()
+}
+
+// RedirectMethodPortalLinkForOrg records the call and returns the response of RedirectMethodPortalLinkForOrgFunc.
+func (m *PaymentService) RedirectMethodPortalLinkForOrg(params kittycad.PaymentRedirectMethodPortalLinkForOrgParams) error {
+	m.record("RedirectMethodPortalLinkForOrg", params)
+	if m.RedirectMethodPortalLinkForOrgFunc == nil {
+		return fmt.Errorf("%w: PaymentService.RedirectMethodPortalLinkForOrg", ErrNotProgrammed)
+	}
+	return m.RedirectMethodPortalLinkForOrgFunc(params)
+}
+
+// RedirectMethodPortalLinkForUser records the call and returns the response of RedirectMethodPortalLinkForUserFunc.
+func (m *PaymentService) RedirectMethodPortalLinkForUser(params kittycad.PaymentRedirectMethodPortalLinkForUserParams) error {
+	m.record("RedirectMethodPortalLinkForUser", params)
+	if m.RedirectMethodPortalLinkForUserFunc == nil {
+		return fmt.Errorf("%w: PaymentService.RedirectMethodPortalLinkForUser", ErrNotProgrammed)
+	}
+	return m.RedirectMethodPortalLinkForUserFunc(params)
+}
+
+// ResetOrgUsageCollectionThreshold records the call and returns the response of ResetOrgUsageCollectionThresholdFunc.
+func (m *PaymentService) ResetOrgUsageCollectionThreshold(expectedVersion int) (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("ResetOrgUsageCollectionThreshold", expectedVersion)
+	if m.ResetOrgUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ResetOrgUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.ResetOrgUsageCollectionThresholdFunc(expectedVersion)
+}
+
+// ResetUserUsageCollectionThreshold records the call and returns the response of ResetUserUsageCollectionThresholdFunc.
+func (m *PaymentService) ResetUserUsageCollectionThreshold(expectedVersion int) (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("ResetUserUsageCollectionThreshold", expectedVersion)
+	if m.ResetUserUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.ResetUserUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.ResetUserUsageCollectionThresholdFunc(expectedVersion)
+}
+
+// SetDefaultMethodForUser records the call and returns the response of SetDefaultMethodForUserFunc.
+func (m *PaymentService) SetDefaultMethodForUser(id string) error {
+	m.record("SetDefaultMethodForUser", id)
+	if m.SetDefaultMethodForUserFunc == nil {
+		return fmt.Errorf("%w: PaymentService.SetDefaultMethodForUser", ErrNotProgrammed)
+	}
+	return m.SetDefaultMethodForUserFunc(id)
+}
+
+// SetOrgUsageCollectionThreshold records the call and returns the response of SetOrgUsageCollectionThresholdFunc.
+func (m *PaymentService) SetOrgUsageCollectionThreshold(body kittycad.AggregateUsageCollectionThresholdSet) (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("SetOrgUsageCollectionThreshold", body)
+	if m.SetOrgUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.SetOrgUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.SetOrgUsageCollectionThresholdFunc(body)
+}
+
+// SetUserUsageCollectionThreshold records the call and returns the response of SetUserUsageCollectionThresholdFunc.
+func (m *PaymentService) SetUserUsageCollectionThreshold(body kittycad.AggregateUsageCollectionThresholdSet) (*kittycad.AggregateUsageCollectionThresholdView, error) {
+	m.record("SetUserUsageCollectionThreshold", body)
+	if m.SetUserUsageCollectionThresholdFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.SetUserUsageCollectionThreshold", ErrNotProgrammed)
+	}
+	return m.SetUserUsageCollectionThresholdFunc(body)
+}
+
+// UpdateBalanceForAnyOrg records the call and returns the response of UpdateBalanceForAnyOrgFunc.
+func (m *PaymentService) UpdateBalanceForAnyOrg(id kittycad.UUID, params kittycad.PaymentUpdateBalanceForAnyOrgParams, body kittycad.UpdatePaymentBalance) (*kittycad.CustomerBalance, error) {
+	m.record("UpdateBalanceForAnyOrg", id, params, body)
+	if m.UpdateBalanceForAnyOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateBalanceForAnyOrg", ErrNotProgrammed)
+	}
+	return m.UpdateBalanceForAnyOrgFunc(id, params, body)
+}
+
+// UpdateBalanceForAnyUser records the call and returns the response of UpdateBalanceForAnyUserFunc.
+func (m *PaymentService) UpdateBalanceForAnyUser(id string, params kittycad.PaymentUpdateBalanceForAnyUserParams, body kittycad.UpdatePaymentBalance) (*kittycad.CustomerBalance, error) {
+	m.record("UpdateBalanceForAnyUser", id, params, body)
+	if m.UpdateBalanceForAnyUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateBalanceForAnyUser", ErrNotProgrammed)
+	}
+	return m.UpdateBalanceForAnyUserFunc(id, params, body)
+}
+
+// UpdateInformationForOrg records the call and returns the response of UpdateInformationForOrgFunc.
+func (m *PaymentService) UpdateInformationForOrg(body kittycad.BillingInfo) (*kittycad.Customer, error) {
+	m.record("UpdateInformationForOrg", body)
+	if m.UpdateInformationForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateInformationForOrg", ErrNotProgrammed)
+	}
+	return m.UpdateInformationForOrgFunc(body)
+}
+
+// UpdateInformationForUser records the call and returns the response of UpdateInformationForUserFunc.
+func (m *PaymentService) UpdateInformationForUser(body kittycad.BillingInfo) (*kittycad.Customer, error) {
+	m.record("UpdateInformationForUser", body)
+	if m.UpdateInformationForUserFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateInformationForUser", ErrNotProgrammed)
+	}
+	return m.UpdateInformationForUserFunc(body)
+}
+
+// UpdateOrgSubscription records the call and returns the response of UpdateOrgSubscriptionFunc.
+func (m *PaymentService) UpdateOrgSubscription(body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("UpdateOrgSubscription", body)
+	if m.UpdateOrgSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateOrgSubscription", ErrNotProgrammed)
+	}
+	return m.UpdateOrgSubscriptionFunc(body)
+}
+
+// UpdateOrgSubscriptionForAnyOrg records the call and returns the response of UpdateOrgSubscriptionForAnyOrgFunc.
+func (m *PaymentService) UpdateOrgSubscriptionForAnyOrg(id kittycad.UUID, body kittycad.ZooProductSubscriptionsOrgRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("UpdateOrgSubscriptionForAnyOrg", id, body)
+	if m.UpdateOrgSubscriptionForAnyOrgFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateOrgSubscriptionForAnyOrg", ErrNotProgrammed)
+	}
+	return m.UpdateOrgSubscriptionForAnyOrgFunc(id, body)
+}
+
+// UpdateUserSubscription records the call and returns the response of UpdateUserSubscriptionFunc.
+func (m *PaymentService) UpdateUserSubscription(body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("UpdateUserSubscription", body)
+	if m.UpdateUserSubscriptionFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpdateUserSubscription", ErrNotProgrammed)
+	}
+	return m.UpdateUserSubscriptionFunc(body)
+}
+
+// UpsertSubscriptionPlanPrice records the call and returns the response of UpsertSubscriptionPlanPriceFunc.
+func (m *PaymentService) UpsertSubscriptionPlanPrice(slug string, body kittycad.PriceUpsertRequest) (*kittycad.SubscriptionPlanPriceRecord, error) {
+	m.record("UpsertSubscriptionPlanPrice", slug, body)
+	if m.UpsertSubscriptionPlanPriceFunc == nil {
+		return nil, fmt.Errorf("%w: PaymentService.UpsertSubscriptionPlanPrice", ErrNotProgrammed)
+	}
+	return m.UpsertSubscriptionPlanPriceFunc(slug, body)
+}
+
+// ValidateCustomerTaxInformationForOrg records the call and returns the response of ValidateCustomerTaxInformationForOrgFunc.
+func (m *PaymentService) ValidateCustomerTaxInformationForOrg() error {
+	m.record("ValidateCustomerTaxInformationForOrg")
+	if m.ValidateCustomerTaxInformationForOrgFunc == nil {
+		return fmt.Errorf("%w: PaymentService.ValidateCustomerTaxInformationForOrg", ErrNotProgrammed)
+	}
+	return m.ValidateCustomerTaxInformationForOrgFunc()
+}
+
+// ValidateCustomerTaxInformationForUser records the call and returns the response of ValidateCustomerTaxInformationForUserFunc.
+func (m *PaymentService) ValidateCustomerTaxInformationForUser() error {
+	m.record("ValidateCustomerTaxInformationForUser")
+	if m.ValidateCustomerTaxInformationForUserFunc == nil {
+		return fmt.Errorf("%w: PaymentService.ValidateCustomerTaxInformationForUser", ErrNotProgrammed)
+	}
+	return m.ValidateCustomerTaxInformationForUserFunc()
+}
+
+// ProjectService is a mock implementation of kittycad.ProjectServiceAPI.
+type ProjectService struct {
+	recorder
+
+	// CreateFunc is called by Create.
+	CreateFunc func(body *kittycad.MultipartForm) (*kittycad.ProjectResponse, error)
+	// CreatePublicVoteFunc is called by CreatePublicVote.
+	CreatePublicVoteFunc func(id kittycad.UUID) (*kittycad.PublicProjectVoteResponse, error)
+	// CreateShareLinkFunc is called by CreateShareLink.
+	CreateShareLinkFunc func(id kittycad.UUID, body kittycad.CreateProjectShareLinkRequest) (*kittycad.ProjectShareLinkResponse, error)
+	// DeleteFunc is called by Delete.
+	DeleteFunc func(id kittycad.UUID) error
+	// DeletePublicVoteFunc is called by DeletePublicVote.
+	DeletePublicVoteFunc func(id kittycad.UUID) (*kittycad.PublicProjectVoteResponse, error)
+	// DeleteShareLinkFunc is called by DeleteShareLink.
+	DeleteShareLinkFunc func(id kittycad.UUID, key string) error
+	// DownloadFunc is called by Download.
+	DownloadFunc func(id kittycad.UUID, params kittycad.ProjectDownloadParams) error
+	// DownloadPublicFunc is called by DownloadPublic.
+	DownloadPublicFunc func(id kittycad.UUID, params kittycad.ProjectDownloadPublicParams) error
+	// GetFunc is called by Get.
+	GetFunc func(id kittycad.UUID) (*kittycad.ProjectResponse, error)
+	// GetPublicFunc is called by GetPublic.
+	GetPublicFunc func(id kittycad.UUID) (*kittycad.PublicProjectResponse, error)
+	// GetPublicThumbnailFunc is called by GetPublicThumbnail.
+	GetPublicThumbnailFunc func(id kittycad.UUID) error
+	// GetThumbnailFunc is called by GetThumbnail.
+	GetThumbnailFunc func(id kittycad.UUID) error
+	// ListFunc is called by List.
+	ListFunc func() (*[]kittycad.ProjectSummaryResponse, error)
+	// ListCategoriesFunc is called by ListCategories.
+	ListCategoriesFunc func() (*[]kittycad.ProjectCategoryResponse, error)
+	// ListPublicFunc is called by ListPublic.
+	ListPublicFunc func() (*[]kittycad.PublicProjectResponse, error)
+	// ListShareLinksFunc is called by ListShareLinks.
+	ListShareLinksFunc func(id kittycad.UUID) (*[]kittycad.ProjectShareLinkResponse, error)
+	// PublishFunc is called by Publish.
+	PublishFunc func(id kittycad.UUID) (*kittycad.ProjectResponse, error)
+	// UpdateFunc is called by Update.
+	UpdateFunc func(id kittycad.UUID, body *kittycad.MultipartForm) (*kittycad.ProjectResponse, error)
+}
+
+var _ kittycad.ProjectServiceAPI = (*ProjectService)(nil)
+
+// Create records the call and returns the response of CreateFunc.
+func (m *ProjectService) Create(body *kittycad.MultipartForm) (*kittycad.ProjectResponse, error) {
+	m.record("Create", body)
+	if m.CreateFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.Create", ErrNotProgrammed)
+	}
+	return m.CreateFunc(body)
+}
+
+// CreatePublicVote records the call and returns the response of CreatePublicVoteFunc.
+func (m *ProjectService) CreatePublicVote(id kittycad.UUID) (*kittycad.PublicProjectVoteResponse, error) {
+	m.record("CreatePublicVote", id)
+	if m.CreatePublicVoteFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.CreatePublicVote", ErrNotProgrammed)
+	}
+	return m.CreatePublicVoteFunc(id)
+}
+
+// CreateShareLink records the call and returns the response of CreateShareLinkFunc.
+func (m *ProjectService) CreateShareLink(id kittycad.UUID, body kittycad.CreateProjectShareLinkRequest) (*kittycad.ProjectShareLinkResponse, error) {
+	m.record("CreateShareLink", id, body)
+	if m.CreateShareLinkFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.CreateShareLink", ErrNotProgrammed)
+	}
+	return m.CreateShareLinkFunc(id, body)
+}
+
+// Delete records the call and returns the response of DeleteFunc.
+func (m *ProjectService) Delete(id kittycad.UUID) error {
+	m.record("Delete", id)
+	if m.DeleteFunc == nil {
+		return fmt.Errorf("%w: ProjectService.Delete", ErrNotProgrammed)
+	}
+	return m.DeleteFunc(id)
+}
+
+// DeletePublicVote records the call and returns the response of DeletePublicVoteFunc.
+func (m *ProjectService) DeletePublicVote(id kittycad.UUID) (*kittycad.PublicProjectVoteResponse, error) {
+	m.record("DeletePublicVote", id)
+	if m.DeletePublicVoteFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.DeletePublicVote", ErrNotProgrammed)
+	}
+	return m.DeletePublicVoteFunc(id)
+}
+
+// DeleteShareLink records the call and returns the response of DeleteShareLinkFunc.
+func (m *ProjectService) DeleteShareLink(id kittycad.UUID, key string) error {
+	m.record("DeleteShareLink", id, key)
+	if m.DeleteShareLinkFunc == nil {
+		return fmt.Errorf("%w: ProjectService.DeleteShareLink", ErrNotProgrammed)
+	}
+	return m.DeleteShareLinkFunc(id, key)
+}
+
+// Download records the call and returns the response of DownloadFunc.
+func (m *ProjectService) Download(id kittycad.UUID, params kittycad.ProjectDownloadParams) error {
+	m.record("Download", id, params)
+	if m.DownloadFunc == nil {
+		return fmt.Errorf("%w: ProjectService.Download", ErrNotProgrammed)
+	}
+	return m.DownloadFunc(id, params)
+}
+
+// DownloadPublic records the call and returns the response of DownloadPublicFunc.
+func (m *ProjectService) DownloadPublic(id kittycad.UUID, params kittycad.ProjectDownloadPublicParams) error {
+	m.record("DownloadPublic", id, params)
+	if m.DownloadPublicFunc == nil {
+		return fmt.Errorf("%w: ProjectService.DownloadPublic", ErrNotProgrammed)
+	}
+	return m.DownloadPublicFunc(id, params)
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *ProjectService) Get(id kittycad.UUID) (*kittycad.ProjectResponse, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.Get", ErrNotProgrammed)
+	}
+	return m.GetFunc(id)
+}
+
+// GetPublic records the call and returns the response of GetPublicFunc.
+func (m *ProjectService) GetPublic(id kittycad.UUID) (*kittycad.PublicProjectResponse, error) {
+	m.record("GetPublic", id)
+	if m.GetPublicFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.GetPublic", ErrNotProgrammed)
+	}
+	return m.GetPublicFunc(id)
+}
+
+// GetPublicThumbnail records the call and returns the response of GetPublicThumbnailFunc.
+func (m *ProjectService) GetPublicThumbnail(id kittycad.UUID) error {
+	m.record("GetPublicThumbnail", id)
+	if m.GetPublicThumbnailFunc == nil {
+		return fmt.Errorf("%w: ProjectService.GetPublicThumbnail", ErrNotProgrammed)
+	}
+	return m.GetPublicThumbnailFunc(id)
+}
+
+// GetThumbnail records the call and returns the response of GetThumbnailFunc.
+func (m *ProjectService) GetThumbnail(id kittycad.UUID) error {
+	m.record("GetThumbnail", id)
+	if m.GetThumbnailFunc == nil {
+		return fmt.Errorf("%w: ProjectService.GetThumbnail", ErrNotProgrammed)
+	}
+	return m.GetThumbnailFunc(id)
+}
+
+// List records the call and returns the response of ListFunc.
+func (m *ProjectService) List() (*[]kittycad.ProjectSummaryResponse, error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.List", ErrNotProgrammed)
+	}
+	return m.ListFunc()
+}
+
+// ListCategories records the call and returns the response of ListCategoriesFunc.
+func (m *ProjectService) ListCategories() (*[]kittycad.ProjectCategoryResponse, error) {
+	m.record("ListCategories")
+	if m.ListCategoriesFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.ListCategories", ErrNotProgrammed)
+	}
+	return m.ListCategoriesFunc()
+}
+
+// ListPublic records the call and returns the response of ListPublicFunc.
+func (m *ProjectService) ListPublic() (*[]kittycad.PublicProjectResponse, error) {
+	m.record("ListPublic")
+	if m.ListPublicFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.ListPublic", ErrNotProgrammed)
+	}
+	return m.ListPublicFunc()
+}
+
+// ListShareLinks records the call and returns the response of ListShareLinksFunc.
+func (m *ProjectService) ListShareLinks(id kittycad.UUID) (*[]kittycad.ProjectShareLinkResponse, error) {
+	m.record("ListShareLinks", id)
+	if m.ListShareLinksFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.ListShareLinks", ErrNotProgrammed)
+	}
+	return m.ListShareLinksFunc(id)
+}
+
+// Publish records the call and returns the response of PublishFunc.
+func (m *ProjectService) Publish(id kittycad.UUID) (*kittycad.ProjectResponse, error) {
+	m.record("Publish", id)
+	if m.PublishFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.Publish", ErrNotProgrammed)
+	}
+	return m.PublishFunc(id)
+}
+
+// Update records the call and returns the response of UpdateFunc.
+func (m *ProjectService) Update(id kittycad.UUID, body *kittycad.MultipartForm) (*kittycad.ProjectResponse, error) {
+	m.record("Update", id, body)
+	if m.UpdateFunc == nil {
+		return nil, fmt.Errorf("%w: ProjectService.Update", ErrNotProgrammed)
+	}
+	return m.UpdateFunc(id, body)
+}
+
+// ServiceAccountService is a mock implementation of kittycad.ServiceAccountServiceAPI.
+type ServiceAccountService struct {
+	recorder
+
+	// CreateForOrgFunc is called by CreateForOrg.
+	CreateForOrgFunc func(params kittycad.ServiceAccountCreateForOrgParams) (*kittycad.ServiceAccount, error)
+	// DeleteForOrgFunc is called by DeleteForOrg.
+	DeleteForOrgFunc func(token string) error
+	// GetForOrgFunc is called by GetForOrg.
+	GetForOrgFunc func(token string) (*kittycad.ServiceAccount, error)
+	// ListForOrgFunc is called by ListForOrg.
+	ListForOrgFunc func(params kittycad.ServiceAccountListForOrgParams) (*kittycad.ServiceAccountResultsPage, error)
+}
+
+var _ kittycad.ServiceAccountServiceAPI = (*ServiceAccountService)(nil)
+
+// CreateForOrg records the call and returns the response of CreateForOrgFunc.
+func (m *ServiceAccountService) CreateForOrg(params kittycad.ServiceAccountCreateForOrgParams) (*kittycad.ServiceAccount, error) {
+	m.record("CreateForOrg", params)
+	if m.CreateForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: ServiceAccountService.CreateForOrg", ErrNotProgrammed)
+	}
+	return m.CreateForOrgFunc(params)
+}
+
+// DeleteForOrg records the call and returns the response of DeleteForOrgFunc.
+func (m *ServiceAccountService) DeleteForOrg(token string) error {
+	m.record("DeleteForOrg", token)
+	if m.DeleteForOrgFunc == nil {
+		return fmt.Errorf("%w: ServiceAccountService.DeleteForOrg", ErrNotProgrammed)
+	}
+	return m.DeleteForOrgFunc(token)
+}
+
+// GetForOrg records the call and returns the response of GetForOrgFunc.
+func (m *ServiceAccountService) GetForOrg(token string) (*kittycad.ServiceAccount, error) {
+	m.record("GetForOrg", token)
+	if m.GetForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: ServiceAccountService.GetForOrg", ErrNotProgrammed)
+	}
+	return m.GetForOrgFunc(token)
+}
+
+// ListForOrg records the call and returns the response of ListForOrgFunc.
+func (m *ServiceAccountService) ListForOrg(params kittycad.ServiceAccountListForOrgParams) (*kittycad.ServiceAccountResultsPage, error) {
+	m.record("ListForOrg", params)
+	if m.ListForOrgFunc == nil {
+		return nil, fmt.Errorf("%w: ServiceAccountService.ListForOrg", ErrNotProgrammed)
+	}
+	return m.ListForOrgFunc(params)
+}
+
+// ShortlinkService is a mock implementation of kittycad.ShortlinkServiceAPI.
+type ShortlinkService struct {
+	recorder
+}
+
+var _ kittycad.ShortlinkServiceAPI = (*ShortlinkService)(nil)
+
+// StoreService is a mock implementation of kittycad.StoreServiceAPI.
+type StoreService struct {
+	recorder
+
+	// CreateCouponFunc is called by CreateCoupon.
+	CreateCouponFunc func(body kittycad.StoreCouponParams) (*kittycad.DiscountCode, error)
+}
+
+var _ kittycad.StoreServiceAPI = (*StoreService)(nil)
+
+// CreateCoupon records the call and returns the response of CreateCouponFunc.
+func (m *StoreService) CreateCoupon(body kittycad.StoreCouponParams) (*kittycad.DiscountCode, error) {
+	m.record("CreateCoupon", body)
+	if m.CreateCouponFunc == nil {
+		return nil, fmt.Errorf("%w: StoreService.CreateCoupon", ErrNotProgrammed)
+	}
+	return m.CreateCouponFunc(body)
+}
+
+// UnitService is a mock implementation of kittycad.UnitServiceAPI.
+type UnitService struct {
+	recorder
+
+	// GetAngleConversionFunc is called by GetAngleConversion.
+	GetAngleConversionFunc func(inputUnit kittycad.UnitAngle, outputUnit kittycad.UnitAngle, value float64) (*kittycad.UnitAngleConversion, error)
+	// GetAreaConversionFunc is called by GetAreaConversion.
+	GetAreaConversionFunc func(inputUnit kittycad.UnitArea, outputUnit kittycad.UnitArea, value float64) (*kittycad.UnitAreaConversion, error)
+	// GetCurrentConversionFunc is called by GetCurrentConversion.
+	GetCurrentConversionFunc func(inputUnit kittycad.UnitCurrent, outputUnit kittycad.UnitCurrent, value float64) (*kittycad.UnitCurrentConversion, error)
+	// GetEnergyConversionFunc is called by GetEnergyConversion.
+	GetEnergyConversionFunc func(inputUnit kittycad.UnitEnergy, outputUnit kittycad.UnitEnergy, value float64) (*kittycad.UnitEnergyConversion, error)
+	// GetForceConversionFunc is called by GetForceConversion.
+	GetForceConversionFunc func(inputUnit kittycad.UnitForce, outputUnit kittycad.UnitForce, value float64) (*kittycad.UnitForceConversion, error)
+	// GetFrequencyConversionFunc is called by GetFrequencyConversion.
+	GetFrequencyConversionFunc func(inputUnit kittycad.UnitFrequency, outputUnit kittycad.UnitFrequency, value float64) (*kittycad.UnitFrequencyConversion, error)
+	// GetLengthConversionFunc is called by GetLengthConversion.
+	GetLengthConversionFunc func(inputUnit kittycad.UnitLength, outputUnit kittycad.UnitLength, value float64) (*kittycad.UnitLengthConversion, error)
+	// GetMassConversionFunc is called by GetMassConversion.
+	GetMassConversionFunc func(inputUnit kittycad.UnitMas, outputUnit kittycad.UnitMas, value float64) (*kittycad.UnitMassConversion, error)
+	// GetPowerConversionFunc is called by GetPowerConversion.
+	GetPowerConversionFunc func(inputUnit kittycad.UnitPower, outputUnit kittycad.UnitPower, value float64) (*kittycad.UnitPowerConversion, error)
+	// GetPressureConversionFunc is called by GetPressureConversion.
+	GetPressureConversionFunc func(inputUnit kittycad.UnitPressure, outputUnit kittycad.UnitPressure, value float64) (*kittycad.UnitPressureConversion, error)
+	// GetTemperatureConversionFunc is called by GetTemperatureConversion.
+	GetTemperatureConversionFunc func(inputUnit kittycad.UnitTemperature, outputUnit kittycad.UnitTemperature, value float64) (*kittycad.UnitTemperatureConversion, error)
+	// GetTorqueConversionFunc is called by GetTorqueConversion.
+	GetTorqueConversionFunc func(inputUnit kittycad.UnitTorque, outputUnit kittycad.UnitTorque, value float64) (*kittycad.UnitTorqueConversion, error)
+	// GetVolumeConversionFunc is called by GetVolumeConversion.
+	GetVolumeConversionFunc func(inputUnit kittycad.UnitVolume, outputUnit kittycad.UnitVolume, value float64) (*kittycad.UnitVolumeConversion, error)
+}
+
+var _ kittycad.UnitServiceAPI = (*UnitService)(nil)
+
+// GetAngleConversion records the call and returns the response of GetAngleConversionFunc.
+func (m *UnitService) GetAngleConversion(inputUnit kittycad.UnitAngle, outputUnit kittycad.UnitAngle, value float64) (*kittycad.UnitAngleConversion, error) {
+	m.record("GetAngleConversion", inputUnit, outputUnit, value)
+	if m.GetAngleConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetAngleConversion", ErrNotProgrammed)
+	}
+	return m.GetAngleConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetAreaConversion records the call and returns the response of GetAreaConversionFunc.
+func (m *UnitService) GetAreaConversion(inputUnit kittycad.UnitArea, outputUnit kittycad.UnitArea, value float64) (*kittycad.UnitAreaConversion, error) {
+	m.record("GetAreaConversion", inputUnit, outputUnit, value)
+	if m.GetAreaConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetAreaConversion", ErrNotProgrammed)
+	}
+	return m.GetAreaConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetCurrentConversion records the call and returns the response of GetCurrentConversionFunc.
+func (m *UnitService) GetCurrentConversion(inputUnit kittycad.UnitCurrent, outputUnit kittycad.UnitCurrent, value float64) (*kittycad.UnitCurrentConversion, error) {
+	m.record("GetCurrentConversion", inputUnit, outputUnit, value)
+	if m.GetCurrentConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetCurrentConversion", ErrNotProgrammed)
+	}
+	return m.GetCurrentConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetEnergyConversion records the call and returns the response of GetEnergyConversionFunc.
+func (m *UnitService) GetEnergyConversion(inputUnit kittycad.UnitEnergy, outputUnit kittycad.UnitEnergy, value float64) (*kittycad.UnitEnergyConversion, error) {
+	m.record("GetEnergyConversion", inputUnit, outputUnit, value)
+	if m.GetEnergyConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetEnergyConversion", ErrNotProgrammed)
+	}
+	return m.GetEnergyConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetForceConversion records the call and returns the response of GetForceConversionFunc.
+func (m *UnitService) GetForceConversion(inputUnit kittycad.UnitForce, outputUnit kittycad.UnitForce, value float64) (*kittycad.UnitForceConversion, error) {
+	m.record("GetForceConversion", inputUnit, outputUnit, value)
+	if m.GetForceConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetForceConversion", ErrNotProgrammed)
+	}
+	return m.GetForceConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetFrequencyConversion records the call and returns the response of GetFrequencyConversionFunc.
+func (m *UnitService) GetFrequencyConversion(inputUnit kittycad.UnitFrequency, outputUnit kittycad.UnitFrequency, value float64) (*kittycad.UnitFrequencyConversion, error) {
+	m.record("GetFrequencyConversion", inputUnit, outputUnit, value)
+	if m.GetFrequencyConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetFrequencyConversion", ErrNotProgrammed)
+	}
+	return m.GetFrequencyConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetLengthConversion records the call and returns the response of GetLengthConversionFunc.
+func (m *UnitService) GetLengthConversion(inputUnit kittycad.UnitLength, outputUnit kittycad.UnitLength, value float64) (*kittycad.UnitLengthConversion, error) {
+	m.record("GetLengthConversion", inputUnit, outputUnit, value)
+	if m.GetLengthConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetLengthConversion", ErrNotProgrammed)
+	}
+	return m.GetLengthConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetMassConversion records the call and returns the response of GetMassConversionFunc.
+func (m *UnitService) GetMassConversion(inputUnit kittycad.UnitMas, outputUnit kittycad.UnitMas, value float64) (*kittycad.UnitMassConversion, error) {
+	m.record("GetMassConversion", inputUnit, outputUnit, value)
+	if m.GetMassConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetMassConversion", ErrNotProgrammed)
+	}
+	return m.GetMassConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetPowerConversion records the call and returns the response of GetPowerConversionFunc.
+func (m *UnitService) GetPowerConversion(inputUnit kittycad.UnitPower, outputUnit kittycad.UnitPower, value float64) (*kittycad.UnitPowerConversion, error) {
+	m.record("GetPowerConversion", inputUnit, outputUnit, value)
+	if m.GetPowerConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetPowerConversion", ErrNotProgrammed)
+	}
+	return m.GetPowerConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetPressureConversion records the call and returns the response of GetPressureConversionFunc.
+func (m *UnitService) GetPressureConversion(inputUnit kittycad.UnitPressure, outputUnit kittycad.UnitPressure, value float64) (*kittycad.UnitPressureConversion, error) {
+	m.record("GetPressureConversion", inputUnit, outputUnit, value)
+	if m.GetPressureConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetPressureConversion", ErrNotProgrammed)
+	}
+	return m.GetPressureConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetTemperatureConversion records the call and returns the response of GetTemperatureConversionFunc.
+func (m *UnitService) GetTemperatureConversion(inputUnit kittycad.UnitTemperature, outputUnit kittycad.UnitTemperature, value float64) (*kittycad.UnitTemperatureConversion, error) {
+	m.record("GetTemperatureConversion", inputUnit, outputUnit, value)
+	if m.GetTemperatureConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetTemperatureConversion", ErrNotProgrammed)
+	}
+	return m.GetTemperatureConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetTorqueConversion records the call and returns the response of GetTorqueConversionFunc.
+func (m *UnitService) GetTorqueConversion(inputUnit kittycad.UnitTorque, outputUnit kittycad.UnitTorque, value float64) (*kittycad.UnitTorqueConversion, error) {
+	m.record("GetTorqueConversion", inputUnit, outputUnit, value)
+	if m.GetTorqueConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetTorqueConversion", ErrNotProgrammed)
+	}
+	return m.GetTorqueConversionFunc(inputUnit, outputUnit, value)
+}
+
+// GetVolumeConversion records the call and returns the response of GetVolumeConversionFunc.
+func (m *UnitService) GetVolumeConversion(inputUnit kittycad.UnitVolume, outputUnit kittycad.UnitVolume, value float64) (*kittycad.UnitVolumeConversion, error) {
+	m.record("GetVolumeConversion", inputUnit, outputUnit, value)
+	if m.GetVolumeConversionFunc == nil {
+		return nil, fmt.Errorf("%w: UnitService.GetVolumeConversion", ErrNotProgrammed)
+	}
+	return m.GetVolumeConversionFunc(inputUnit, outputUnit, value)
+}
+
+// UserService is a mock implementation of kittycad.UserServiceAPI.
+type UserService struct {
+	recorder
+
+	// AdminDetailsListFunc is called by AdminDetailsList.
+	AdminDetailsListFunc func(id string) (*kittycad.UserAdminDetails, error)
+	// CreateShortlinkFunc is called by CreateShortlink.
+	CreateShortlinkFunc func(body kittycad.CreateShortlinkRequest) (*kittycad.CreateShortlinkResponse, error)
+	// DeleteSelfFunc is called by DeleteSelf.
+	DeleteSelfFunc func() error
+	// DeleteShortlinkFunc is called by DeleteShortlink.
+	DeleteShortlinkFunc func(key string) error
+	// EmailMarketingConsentDeclineCreateFunc is called by EmailMarketingConsentDeclineCreate.
+	EmailMarketingConsentDeclineCreateFunc func() error
+	// EmailMarketingConsentListFunc is called by EmailMarketingConsentList.
+	EmailMarketingConsentListFunc func() (*kittycad.EmailMarketingConsentState, error)
+	// EmailMarketingConsentRequestCreateFunc is called by EmailMarketingConsentRequestCreate.
+	EmailMarketingConsentRequestCreateFunc func() error
+	// EmailMarketingConsentSeenCreateFunc is called by EmailMarketingConsentSeenCreate.
+	EmailMarketingConsentSeenCreateFunc func() error
+	// FeaturesListFunc is called by FeaturesList.
+	FeaturesListFunc func() (*kittycad.UserFeatureList, error)
+	// GetFunc is called by Get.
+	GetFunc func(id string) (*kittycad.UserResponse, error)
+	// GetCadInfoFormFunc is called by GetCadInfoForm.
+	GetCadInfoFormFunc func() (*kittycad.WebsiteCadUserInfoForm, error)
+	// GetExtendedFunc is called by GetExtended.
+	GetExtendedFunc func(id string) (*kittycad.ExtendedUser, error)
+	// GetOauth2ProvidersForFunc is called by GetOauth2ProvidersFor.
+	GetOauth2ProvidersForFunc func() (*[]kittycad.AccountProvider, error)
+	// GetPrivacySettingsFunc is called by GetPrivacySettings.
+	GetPrivacySettingsFunc func() (*kittycad.PrivacySettings, error)
+	// GetSelfFunc is called by GetSelf.
+	GetSelfFunc func() (*kittycad.UserResponse, error)
+	// GetSelfExtendedFunc is called by GetSelfExtended.
+	GetSelfExtendedFunc func() (*kittycad.ExtendedUser, error)
+	// GetSessionForFunc is called by GetSessionFor.
+	GetSessionForFunc func(token string) (*kittycad.Session, error)
+	// GetShortlinksFunc is called by GetShortlinks.
+	GetShortlinksFunc func(params kittycad.UserGetShortlinksParams) (*kittycad.ShortlinkResultsPage, error)
+	// PutCadInfoFormFunc is called by PutCadInfoForm.
+	PutCadInfoFormFunc func(body kittycad.WebsiteCadUserInfoForm) error
+	// PutPublicEmailMarketingConsentRequestFunc is called by PutPublicEmailMarketingConsentRequest.
+	PutPublicEmailMarketingConsentRequestFunc func(body kittycad.PublicEmailMarketingConsentRequest) error
+	// PutPublicMailingListSubscribeFunc is called by PutPublicMailingListSubscribe.
+	PutPublicMailingListSubscribeFunc func(slug string, body kittycad.PublicMailingListMembershipRequest) error
+	// PutPublicMailingListUnsubscribeFunc is called by PutPublicMailingListUnsubscribe.
+	PutPublicMailingListUnsubscribeFunc func(slug string, body kittycad.PublicMailingListMembershipRequest) error
+	// PutPublicSalesFormFunc is called by PutPublicSalesForm.
+	PutPublicSalesFormFunc func(body kittycad.WebsiteSalesForm) error
+	// PutPublicSupportFormFunc is called by PutPublicSupportForm.
+	PutPublicSupportFormFunc func(body kittycad.WebsiteSupportForm) error
+	// ReportClientErrorFunc is called by ReportClientError.
+	ReportClientErrorFunc func(body kittycad.ClientErrorReport) (*kittycad.ClientErrorReportAccepted, error)
+	// UpdatePrivacySettingsFunc is called by UpdatePrivacySettings.
+	UpdatePrivacySettingsFunc func(body kittycad.PrivacySettings) (*kittycad.PrivacySettings, error)
+	// UpdateSelfFunc is called by UpdateSelf.
+	UpdateSelfFunc func(body kittycad.UpdateUser) (*kittycad.UserResponse, error)
+	// UpdateShortlinkFunc is called by UpdateShortlink.
+	UpdateShortlinkFunc func(key string, body kittycad.UpdateShortlinkRequest) error
+	// UpdateSubscriptionForFunc is called by UpdateSubscriptionFor.
+	UpdateSubscriptionForFunc func(id string, body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error)
+}
+
+var _ kittycad.UserServiceAPI = (*UserService)(nil)
+
+// AdminDetailsList records the call and returns the response of AdminDetailsListFunc.
+func (m *UserService) AdminDetailsList(id string) (*kittycad.UserAdminDetails, error) {
+	m.record("AdminDetailsList", id)
+	if m.AdminDetailsListFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.AdminDetailsList", ErrNotProgrammed)
+	}
+	return m.AdminDetailsListFunc(id)
+}
+
+// CreateShortlink records the call and returns the response of CreateShortlinkFunc.
+func (m *UserService) CreateShortlink(body kittycad.CreateShortlinkRequest) (*kittycad.CreateShortlinkResponse, error) {
+	m.record("CreateShortlink", body)
+	if m.CreateShortlinkFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.CreateShortlink", ErrNotProgrammed)
+	}
+	return m.CreateShortlinkFunc(body)
+}
+
+// DeleteSelf records the call and returns the response of DeleteSelfFunc.
+func (m *UserService) DeleteSelf() error {
+	m.record("DeleteSelf")
+	if m.DeleteSelfFunc == nil {
+		return fmt.Errorf("%w: UserService.DeleteSelf", ErrNotProgrammed)
+	}
+	return m.DeleteSelfFunc()
+}
+
+// DeleteShortlink records the call and returns the response of DeleteShortlinkFunc.
+func (m *UserService) DeleteShortlink(key string) error {
+	m.record("DeleteShortlink", key)
+	if m.DeleteShortlinkFunc == nil {
+		return fmt.Errorf("%w: UserService.DeleteShortlink", ErrNotProgrammed)
+	}
+	return m.DeleteShortlinkFunc(key)
+}
+
+// EmailMarketingConsentDeclineCreate records the call and returns the response of EmailMarketingConsentDeclineCreateFunc.
+func (m *UserService) EmailMarketingConsentDeclineCreate() error {
+	m.record("EmailMarketingConsentDeclineCreate")
+	if m.EmailMarketingConsentDeclineCreateFunc == nil {
+		return fmt.Errorf("%w: UserService.EmailMarketingConsentDeclineCreate", ErrNotProgrammed)
+	}
+	return m.EmailMarketingConsentDeclineCreateFunc()
+}
+
+// EmailMarketingConsentList records the call and returns the response of EmailMarketingConsentListFunc.
+func (m *UserService) EmailMarketingConsentList() (*kittycad.EmailMarketingConsentState, error) {
+	m.record("EmailMarketingConsentList")
+	if m.EmailMarketingConsentListFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.EmailMarketingConsentList", ErrNotProgrammed)
+	}
+	return m.EmailMarketingConsentListFunc()
+}
+
+// EmailMarketingConsentRequestCreate records the call and returns the response of EmailMarketingConsentRequestCreateFunc.
+func (m *UserService) EmailMarketingConsentRequestCreate() error {
+	m.record("EmailMarketingConsentRequestCreate")
+	if m.EmailMarketingConsentRequestCreateFunc == nil {
+		return fmt.Errorf("%w: UserService.EmailMarketingConsentRequestCreate", ErrNotProgrammed)
+	}
+	return m.EmailMarketingConsentRequestCreateFunc()
+}
+
+// EmailMarketingConsentSeenCreate records the call and returns the response of EmailMarketingConsentSeenCreateFunc.
+func (m *UserService) EmailMarketingConsentSeenCreate() error {
+	m.record("EmailMarketingConsentSeenCreate")
+	if m.EmailMarketingConsentSeenCreateFunc == nil {
+		return fmt.Errorf("%w: UserService.EmailMarketingConsentSeenCreate", ErrNotProgrammed)
+	}
+	return m.EmailMarketingConsentSeenCreateFunc()
+}
+
+// FeaturesList records the call and returns the response of FeaturesListFunc.
+func (m *UserService) FeaturesList() (*kittycad.UserFeatureList, error) {
+	m.record("FeaturesList")
+	if m.FeaturesListFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.FeaturesList", ErrNotProgrammed)
+	}
+	return m.FeaturesListFunc()
+}
+
+// Get records the call and returns the response of GetFunc.
+func (m *UserService) Get(id string) (*kittycad.UserResponse, error) {
+	m.record("Get", id)
+	if m.GetFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.Get", ErrNotProgrammed)
+	}
+	return m.GetFunc(id)
+}
+
+// GetCadInfoForm records the call and returns the response of GetCadInfoFormFunc.
+func (m *UserService) GetCadInfoForm() (*kittycad.WebsiteCadUserInfoForm, error) {
+	m.record("GetCadInfoForm")
+	if m.GetCadInfoFormFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetCadInfoForm", ErrNotProgrammed)
+	}
+	return m.GetCadInfoFormFunc()
+}
+
+// GetExtended records the call and returns the response of GetExtendedFunc.
+func (m *UserService) GetExtended(id string) (*kittycad.ExtendedUser, error) {
+	m.record("GetExtended", id)
+	if m.GetExtendedFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetExtended", ErrNotProgrammed)
+	}
+	return m.GetExtendedFunc(id)
+}
+
+// GetOauth2ProvidersFor records the call and returns the response of GetOauth2ProvidersForFunc.
+func (m *UserService) GetOauth2ProvidersFor() (*[]kittycad.AccountProvider, error) {
+	m.record("GetOauth2ProvidersFor")
+	if m.GetOauth2ProvidersForFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetOauth2ProvidersFor", ErrNotProgrammed)
+	}
+	return m.GetOauth2ProvidersForFunc()
+}
+
+// GetPrivacySettings records the call and returns the response of GetPrivacySettingsFunc.
+func (m *UserService) GetPrivacySettings() (*kittycad.PrivacySettings, error) {
+	m.record("GetPrivacySettings")
+	if m.GetPrivacySettingsFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetPrivacySettings", ErrNotProgrammed)
+	}
+	return m.GetPrivacySettingsFunc()
+}
+
+// GetSelf records the call and returns the response of GetSelfFunc.
+func (m *UserService) GetSelf() (*kittycad.UserResponse, error) {
+	m.record("GetSelf")
+	if m.GetSelfFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetSelf", ErrNotProgrammed)
+	}
+	return m.GetSelfFunc()
+}
+
+// GetSelfExtended records the call and returns the response of GetSelfExtendedFunc.
+func (m *UserService) GetSelfExtended() (*kittycad.ExtendedUser, error) {
+	m.record("GetSelfExtended")
+	if m.GetSelfExtendedFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetSelfExtended", ErrNotProgrammed)
+	}
+	return m.GetSelfExtendedFunc()
+}
+
+// GetSessionFor records the call and returns the response of GetSessionForFunc.
+func (m *UserService) GetSessionFor(token string) (*kittycad.Session, error) {
+	m.record("GetSessionFor", token)
+	if m.GetSessionForFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetSessionFor", ErrNotProgrammed)
+	}
+	return m.GetSessionForFunc(token)
+}
+
+// GetShortlinks records the call and returns the response of GetShortlinksFunc.
+func (m *UserService) GetShortlinks(params kittycad.UserGetShortlinksParams) (*kittycad.ShortlinkResultsPage, error) {
+	m.record("GetShortlinks", params)
+	if m.GetShortlinksFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.GetShortlinks", ErrNotProgrammed)
+	}
+	return m.GetShortlinksFunc(params)
+}
+
+// PutCadInfoForm records the call and returns the response of PutCadInfoFormFunc.
+func (m *UserService) PutCadInfoForm(body kittycad.WebsiteCadUserInfoForm) error {
+	m.record("PutCadInfoForm", body)
+	if m.PutCadInfoFormFunc == nil {
+		return fmt.Errorf("%w: UserService.PutCadInfoForm", ErrNotProgrammed)
+	}
+	return m.PutCadInfoFormFunc(body)
+}
+
+// PutPublicEmailMarketingConsentRequest records the call and returns the response of PutPublicEmailMarketingConsentRequestFunc.
+func (m *UserService) PutPublicEmailMarketingConsentRequest(body kittycad.PublicEmailMarketingConsentRequest) error {
+	m.record("PutPublicEmailMarketingConsentRequest", body)
+	if m.PutPublicEmailMarketingConsentRequestFunc == nil {
+		return fmt.Errorf("%w: UserService.PutPublicEmailMarketingConsentRequest", ErrNotProgrammed)
+	}
+	return m.PutPublicEmailMarketingConsentRequestFunc(body)
+}
+
+// PutPublicMailingListSubscribe records the call and returns the response of PutPublicMailingListSubscribeFunc.
+func (m *UserService) PutPublicMailingListSubscribe(slug string, body kittycad.PublicMailingListMembershipRequest) error {
+	m.record("PutPublicMailingListSubscribe", slug, body)
+	if m.PutPublicMailingListSubscribeFunc == nil {
+		return fmt.Errorf("%w: UserService.PutPublicMailingListSubscribe", ErrNotProgrammed)
+	}
+	return m.PutPublicMailingListSubscribeFunc(slug, body)
+}
+
+// PutPublicMailingListUnsubscribe records the call and returns the response of PutPublicMailingListUnsubscribeFunc.
+func (m *UserService) PutPublicMailingListUnsubscribe(slug string, body kittycad.PublicMailingListMembershipRequest) error {
+	m.record("PutPublicMailingListUnsubscribe", slug, body)
+	if m.PutPublicMailingListUnsubscribeFunc == nil {
+		return fmt.Errorf("%w: UserService.PutPublicMailingListUnsubscribe", ErrNotProgrammed)
+	}
+	return m.PutPublicMailingListUnsubscribeFunc(slug, body)
+}
+
+// PutPublicSalesForm records the call and returns the response of PutPublicSalesFormFunc.
+func (m *UserService) PutPublicSalesForm(body kittycad.WebsiteSalesForm) error {
+	m.record("PutPublicSalesForm", body)
+	if m.PutPublicSalesFormFunc == nil {
+		return fmt.Errorf("%w: UserService.PutPublicSalesForm", ErrNotProgrammed)
+	}
+	return m.PutPublicSalesFormFunc(body)
+}
+
+// PutPublicSupportForm records the call and returns the response of PutPublicSupportFormFunc.
+func (m *UserService) PutPublicSupportForm(body kittycad.WebsiteSupportForm) error {
+	m.record("PutPublicSupportForm", body)
+	if m.PutPublicSupportFormFunc == nil {
+		return fmt.Errorf("%w: UserService.PutPublicSupportForm", ErrNotProgrammed)
+	}
+	return m.PutPublicSupportFormFunc(body)
+}
+
+// ReportClientError records the call and returns the response of ReportClientErrorFunc.
+func (m *UserService) ReportClientError(body kittycad.ClientErrorReport) (*kittycad.ClientErrorReportAccepted, error) {
+	m.record("ReportClientError", body)
+	if m.ReportClientErrorFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.ReportClientError", ErrNotProgrammed)
+	}
+	return m.ReportClientErrorFunc(body)
+}
+
+// UpdatePrivacySettings records the call and returns the response of UpdatePrivacySettingsFunc.
+func (m *UserService) UpdatePrivacySettings(body kittycad.PrivacySettings) (*kittycad.PrivacySettings, error) {
+	m.record("UpdatePrivacySettings", body)
+	if m.UpdatePrivacySettingsFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.UpdatePrivacySettings", ErrNotProgrammed)
+	}
+	return m.UpdatePrivacySettingsFunc(body)
+}
+
+// UpdateSelf records the call and returns the response of UpdateSelfFunc.
+func (m *UserService) UpdateSelf(body kittycad.UpdateUser) (*kittycad.UserResponse, error) {
+	m.record("UpdateSelf", body)
+	if m.UpdateSelfFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.UpdateSelf", ErrNotProgrammed)
+	}
+	return m.UpdateSelfFunc(body)
+}
+
+// UpdateShortlink records the call and returns the response of UpdateShortlinkFunc.
+func (m *UserService) UpdateShortlink(key string, body kittycad.UpdateShortlinkRequest) error {
+	m.record("UpdateShortlink", key, body)
+	if m.UpdateShortlinkFunc == nil {
+		return fmt.Errorf("%w: UserService.UpdateShortlink", ErrNotProgrammed)
+	}
+	return m.UpdateShortlinkFunc(key, body)
+}
+
+// UpdateSubscriptionFor records the call and returns the response of UpdateSubscriptionForFunc.
+func (m *UserService) UpdateSubscriptionFor(id string, body kittycad.ZooProductSubscriptionsUserRequest) (*kittycad.ZooProductSubscriptions, error) {
+	m.record("UpdateSubscriptionFor", id, body)
+	if m.UpdateSubscriptionForFunc == nil {
+		return nil, fmt.Errorf("%w: UserService.UpdateSubscriptionFor", ErrNotProgrammed)
+	}
+	return m.UpdateSubscriptionForFunc(id, body)
+}
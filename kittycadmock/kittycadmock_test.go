@@ -0,0 +1,57 @@
+package kittycadmock
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+func ping(client kittycad.ClientAPI) (string, error) {
+	pong, err := client.MetaAPI().Ping()
+	if err != nil {
+		return "", err
+	}
+	return pong.Message, nil
+}
+
+func TestMockRecordsCallsAndReturnsResponses(t *testing.T) {
+	client := NewClient()
+
+	if _, err := ping(client); !errors.Is(err, ErrNotProgrammed) {
+		t.Fatalf("expected ErrNotProgrammed, got %v", err)
+	}
+
+	client.Meta.PingFunc = func() (*kittycad.Pong, error) {
+		return &kittycad.Pong{Message: "pong"}, nil
+	}
+	message, err := ping(client)
+	if err != nil {
+		t.Fatalf("pinging the mock failed: %v", err)
+	}
+	if message != "pong" {
+		t.Errorf("expected pong, got %q", message)
+	}
+
+	calls := client.Meta.Calls()
+	if len(calls) != 2 || calls[0].Method != "Ping" {
+		t.Errorf("expected two recorded Ping calls, got %#v", calls)
+	}
+}
+
+func TestMockRecordsArguments(t *testing.T) {
+	client := NewClient()
+	client.File.CreateConversionFunc = func(srcFormat kittycad.FileImportFormat, outputFormat kittycad.FileExportFormat, body []byte) (*kittycad.FileConversion, error) {
+		return &kittycad.FileConversion{SrcFormat: srcFormat, OutputFormat: outputFormat}, nil
+	}
+
+	var file kittycad.FileServiceAPI = client.File
+	if _, err := file.CreateConversion(kittycad.FileImportFormatStl, kittycad.FileExportFormatObj, []byte("solid")); err != nil {
+		t.Fatalf("converting with the mock failed: %v", err)
+	}
+
+	calls := client.File.Calls()
+	if len(calls) != 1 || calls[0].Args[0] != kittycad.FileImportFormatStl || calls[0].Args[1] != kittycad.FileExportFormatObj {
+		t.Errorf("unexpected recorded calls: %#v", calls)
+	}
+}
@@ -0,0 +1,115 @@
+package kittycadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+// nextStatus is the status an async operation advances to from each status.
+var nextStatus = map[kittycad.APICallStatus]kittycad.APICallStatus{
+	kittycad.APICallStatusQueued:     kittycad.APICallStatusInProgress,
+	kittycad.APICallStatusUploaded:   kittycad.APICallStatusInProgress,
+	kittycad.APICallStatusInProgress: kittycad.APICallStatusCompleted,
+}
+
+// SetAutoAdvance sets whether async operations advance a status every time
+// they are fetched, going from queued to in_progress to completed. By default
+// they stay queued until their status is set with SetAsyncOperationStatus.
+func (s *Server) SetAutoAdvance(autoAdvance bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.autoAdvance = autoAdvance
+}
+
+// SetAsyncOperationStatus sets the status of the async operation with the ID.
+func (s *Server) SetAsyncOperationStatus(id kittycad.UUID, status kittycad.APICallStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.operations[id.String()]
+	if !ok {
+		return fmt.Errorf("async operation %s not found", id)
+	}
+	setStatus(op, status)
+	return nil
+}
+
+// SetAsyncOperationFields sets fields of the async operation with the ID, for
+// example the outputs of a completed file conversion.
+func (s *Server) SetAsyncOperationFields(id kittycad.UUID, fields map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.operations[id.String()]
+	if !ok {
+		return fmt.Errorf("async operation %s not found", id)
+	}
+	for k, v := range fields {
+		op[k] = v
+	}
+	op["updated_at"] = time.Now().Format(time.RFC3339)
+	return nil
+}
+
+// setStatus sets the status of the operation, along with its timestamps.
+func setStatus(op map[string]any, status kittycad.APICallStatus) {
+	now := time.Now().Format(time.RFC3339)
+	op["status"] = status
+	op["updated_at"] = now
+	switch status {
+	case kittycad.APICallStatusInProgress:
+		op["started_at"] = now
+	case kittycad.APICallStatusCompleted, kittycad.APICallStatusFailed:
+		if _, ok := op["started_at"]; !ok {
+			op["started_at"] = now
+		}
+		op["completed_at"] = now
+	}
+}
+
+// createAsyncOperation creates a queued async operation from the example of
+// the route, filling in the path parameters it has fields for.
+func (s *Server) createAsyncOperation(w http.ResponseWriter, rt route, params map[string]string) {
+	op := map[string]any{}
+	if err := json.Unmarshal([]byte(rt.example), &op); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("decoding the example of %s failed: %v", rt.operationID, err))
+		return
+	}
+	for k, v := range params {
+		if _, ok := op[k]; ok {
+			op[k] = v
+		}
+	}
+	now := time.Now().Format(time.RFC3339)
+	op["id"] = newID().String()
+	op["created_at"] = now
+	setStatus(op, kittycad.APICallStatusQueued)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op["user_id"] = s.user.ID.String()
+	stored := map[string]any{"type": rt.asyncType}
+	for k, v := range op {
+		stored[k] = v
+	}
+	s.operations[op["id"].(string)] = stored
+	writeJSON(w, rt.status, op)
+}
+
+func (s *Server) getAsyncOperation(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.operations[params["id"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "async operation not found")
+		return
+	}
+	if s.autoAdvance {
+		if next, ok := nextStatus[kittycad.APICallStatus(fmt.Sprint(op["status"]))]; ok {
+			setStatus(op, next)
+		}
+	}
+	writeJSON(w, http.StatusOK, op)
+}
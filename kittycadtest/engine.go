@@ -0,0 +1,289 @@
+package kittycadtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/kittycad/kittycad.go"
+)
+
+// EngineReplay is a fake modeling engine, answering CommandsWs websockets
+// with the frames of a recording written by kittycad.ModelingRecorder.
+//
+// Every connection replays the recording from the start, sending every frame
+// with its recorded type. The messages sent by the client have to match the
+// recorded ones, with the same type, apart from the IDs of commands and
+// batches, which are mapped to the IDs the client sends. Recorded responses,
+// binary ones included, are sent back with the IDs mapped the same way. Pings
+// are answered with pongs, and metrics responses are ignored, so a session
+// does not need the same keepalive as the recorded one.
+type EngineReplay struct {
+	server *httptest.Server
+	frames []kittycad.WebsocketFrame
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// NewEngineReplay starts a fake engine replaying the frames. The caller
+// should call Close when finished, to shut it down.
+func NewEngineReplay(frames []kittycad.WebsocketFrame) *EngineReplay {
+	e := &EngineReplay{frames: frames}
+	e.server = httptest.NewServer(http.HandlerFunc(e.serveHTTP))
+	return e
+}
+
+// LoadEngineReplay starts a fake engine replaying the recording at path.
+func LoadEngineReplay(path string) (*EngineReplay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening the modeling recording failed: %v", err)
+	}
+	defer f.Close()
+
+	frames, err := kittycad.ReadModelingRecording(f)
+	if err != nil {
+		return nil, err
+	}
+	return NewEngineReplay(frames), nil
+}
+
+// URL returns the base URL of the fake engine, for kittycad.Client.WithBaseURL.
+func (e *EngineReplay) URL() string {
+	return e.server.URL
+}
+
+// Client returns a client whose modeling websockets connect to the fake
+// engine.
+func (e *EngineReplay) Client() (*kittycad.Client, error) {
+	client, err := kittycad.NewClient("replay", "kittycadtest")
+	if err != nil {
+		return nil, err
+	}
+	if err := client.WithBaseURL(e.server.URL); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// Err returns the mismatches between the messages sent by clients and the
+// recording, or nil if everything matched.
+func (e *EngineReplay) Err() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return errors.Join(e.errs...)
+}
+
+// Close shuts down the fake engine.
+func (e *EngineReplay) Close() {
+	e.server.Close()
+}
+
+func (e *EngineReplay) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/ws/modeling/commands" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s is not a modeling websocket", r.URL.Path))
+		return
+	}
+
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	e.replay(conn)
+}
+
+// replay answers the messages of the client with the recorded frames, until
+// the client disconnects or does not match the recording.
+func (e *EngineReplay) replay(conn *websocket.Conn) {
+	// ids maps the recorded IDs to the IDs sent by the client.
+	ids := map[string]string{}
+	pos := 0
+
+	for {
+		// Send the recorded frames up to the next message of the client.
+		for ; pos < len(e.frames) && !isReplayedRequest(e.frames[pos]); pos++ {
+			frame := e.frames[pos]
+			if frame.Direction != kittycad.WebsocketReceived || replayedType(frame) == "pong" {
+				continue
+			}
+			var data []byte
+			if frame.Type == websocket.TextMessage {
+				data = mapIDs(frame.Data, ids)
+			} else {
+				data = mapBinaryIDs(frame.Data, ids)
+			}
+			if err := conn.WriteMessage(frame.Type, data); err != nil {
+				return
+			}
+		}
+
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			// The client went away.
+			return
+		}
+		sent := kittycad.WebsocketFrame{Direction: kittycad.WebsocketSent, Type: messageType, Data: message}
+
+		switch replayedType(sent) {
+		case "ping":
+			if err := conn.WriteJSON(map[string]any{"success": true, "resp": map[string]any{"type": "pong", "data": map[string]any{}}}); err != nil {
+				return
+			}
+			continue
+		case "metrics_response":
+			continue
+		}
+
+		if pos == len(e.frames) {
+			e.fail(conn, message, fmt.Errorf("message %s was not recorded", message))
+			return
+		}
+		recorded := e.frames[pos]
+		pos++
+
+		if recorded.Type != messageType {
+			e.fail(conn, message, fmt.Errorf("message %q of type %d does not match the recorded message %q of type %d", message, messageType, recorded.Data, recorded.Type))
+			return
+		}
+		if messageType != websocket.TextMessage {
+			if !bytes.Equal(recorded.Data, message) {
+				e.fail(conn, message, fmt.Errorf("message %q does not match the recorded message %q", message, recorded.Data))
+				return
+			}
+			continue
+		}
+
+		recordedIDs, sentIDs := requestIDs(recorded.Data), requestIDs(message)
+		for i := range min(len(recordedIDs), len(sentIDs)) {
+			ids[recordedIDs[i]] = sentIDs[i]
+		}
+		if !jsonEqual(mapIDs(recorded.Data, ids), message) {
+			e.fail(conn, message, fmt.Errorf("message %s does not match the recorded message %s", message, recorded.Data))
+			return
+		}
+	}
+}
+
+// isReplayedRequest returns whether the frame is a message of the client
+// which is matched when replaying.
+func isReplayedRequest(frame kittycad.WebsocketFrame) bool {
+	if frame.Direction != kittycad.WebsocketSent {
+		return false
+	}
+	t := replayedType(frame)
+	return t != "ping" && t != "metrics_response"
+}
+
+// replayedType returns the type of a request, or of the data of a response.
+// Binary messages have no type.
+func replayedType(frame kittycad.WebsocketFrame) string {
+	if frame.Type != websocket.TextMessage {
+		return ""
+	}
+
+	var message struct {
+		Type string `json:"type"`
+		Resp struct {
+			Type string `json:"type"`
+		} `json:"resp"`
+	}
+	json.Unmarshal(frame.Data, &message)
+	if message.Type != "" {
+		return message.Type
+	}
+	return message.Resp.Type
+}
+
+// requestIDs returns the IDs of the commands and the batch of a request, in
+// the order they appear.
+func requestIDs(data []byte) []string {
+	var request struct {
+		CmdID    string `json:"cmd_id"`
+		BatchID  string `json:"batch_id"`
+		Requests []struct {
+			CmdID string `json:"cmd_id"`
+		} `json:"requests"`
+	}
+	json.Unmarshal(data, &request)
+
+	ids := []string{}
+	for _, id := range []string{request.CmdID, request.BatchID} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	for _, r := range request.Requests {
+		ids = append(ids, r.CmdID)
+	}
+	return ids
+}
+
+// mapIDs replaces the recorded IDs in the message with the IDs sent by the
+// client.
+func mapIDs(data []byte, ids map[string]string) []byte {
+	s := string(data)
+	for recorded, sent := range ids {
+		s = strings.ReplaceAll(s, `"`+recorded+`"`, `"`+sent+`"`)
+	}
+	return []byte(s)
+}
+
+// mapBinaryIDs replaces the recorded IDs in a binary BSON message with the
+// IDs sent by the client, whether they are encoded as strings or as their 16
+// bytes. Both keep the length of the message.
+func mapBinaryIDs(data []byte, ids map[string]string) []byte {
+	data = bytes.Clone(data)
+	for recorded, sent := range ids {
+		data = bytes.ReplaceAll(data, []byte(recorded), []byte(sent))
+
+		recordedID, err := uuid.Parse(recorded)
+		if err != nil {
+			continue
+		}
+		sentID, err := uuid.Parse(sent)
+		if err != nil {
+			continue
+		}
+		data = bytes.ReplaceAll(data, recordedID[:], sentID[:])
+	}
+	return data
+}
+
+func jsonEqual(a, b []byte) bool {
+	var av, bv any
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// fail records the mismatch, and fails the request of the client with it.
+func (e *EngineReplay) fail(conn *websocket.Conn, message []byte, mismatch error) {
+	// Record it first, so it is reported once the client sees the failure.
+	e.mu.Lock()
+	e.errs = append(e.errs, mismatch)
+	e.mu.Unlock()
+
+	var requestID *string
+	if ids := requestIDs(message); len(ids) > 0 {
+		requestID = &ids[0]
+	}
+
+	conn.WriteJSON(map[string]any{
+		"success":    false,
+		"request_id": requestID,
+		"errors":     []map[string]any{{"error_code": "bad_request", "message": mismatch.Error()}},
+	})
+}
@@ -0,0 +1,136 @@
+package kittycadtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/kittycad/kittycad.go"
+)
+
+// recordedSession is a recording of a session starting a path and extending
+// it, with a metrics request and a ping in between.
+var recordedSession = []kittycad.WebsocketFrame{
+	{Direction: kittycad.WebsocketReceived, Type: websocket.TextMessage, Data: []byte(`{"success":true,"resp":{"type":"metrics_request","data":{}}}`)},
+	{Direction: kittycad.WebsocketSent, Type: websocket.TextMessage, Data: []byte(`{"type":"metrics_response","metrics":{}}`)},
+	{Direction: kittycad.WebsocketSent, Type: websocket.TextMessage, Data: []byte(`{"type":"modeling_cmd_req","cmd":{"type":"start_path"},"cmd_id":"11111111-1111-4111-8111-111111111111"}`)},
+	{Direction: kittycad.WebsocketReceived, Type: websocket.TextMessage, Data: []byte(`{"success":true,"request_id":"11111111-1111-4111-8111-111111111111","resp":{"type":"modeling","data":{"modeling_response":{"type":"start_path","data":{"path_id":"33333333-3333-4333-8333-333333333333"}}}}}`)},
+	{Direction: kittycad.WebsocketSent, Type: websocket.TextMessage, Data: []byte(`{"type":"ping"}`)},
+	{Direction: kittycad.WebsocketReceived, Type: websocket.TextMessage, Data: []byte(`{"success":true,"resp":{"type":"pong","data":{}}}`)},
+	{Direction: kittycad.WebsocketSent, Type: websocket.TextMessage, Data: []byte(`{"type":"modeling_cmd_req","cmd":{"type":"extend_path","path":"33333333-3333-4333-8333-333333333333"},"cmd_id":"22222222-2222-4222-8222-222222222222"}`)},
+	{Direction: kittycad.WebsocketReceived, Type: websocket.TextMessage, Data: []byte(`{"success":true,"request_id":"22222222-2222-4222-8222-222222222222","resp":{"type":"modeling","data":{"modeling_response":{"type":"extend_path"}}}}`)},
+}
+
+func newReplaySession(t *testing.T, engine *EngineReplay, opts kittycad.ModelingSessionOptions) *kittycad.ModelingSession {
+	t.Helper()
+
+	client, err := engine.Client()
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	session, err := client.Modeling.NewSession(context.Background(), kittycad.ModelingCommandsWsParams{}, opts)
+	if err != nil {
+		t.Fatalf("opening the session failed: %v", err)
+	}
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func TestEngineReplay(t *testing.T) {
+	engine := NewEngineReplay(recordedSession)
+	defer engine.Close()
+	session := newReplaySession(t, engine, kittycad.ModelingSessionOptions{})
+
+	// The session picks new command IDs, which the responses are mapped to.
+	resp, err := session.Send(context.Background(), map[string]any{"type": "start_path"})
+	if err != nil {
+		t.Fatalf("sending the first command failed: %v", err)
+	}
+	pathID := resp.(map[string]any)["data"].(map[string]any)["path_id"]
+
+	resp, err = session.Send(context.Background(), map[string]any{"type": "extend_path", "path": pathID})
+	if err != nil {
+		t.Fatalf("sending the second command failed: %v", err)
+	}
+	if resp.(map[string]any)["type"] != "extend_path" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+
+	if err := engine.Err(); err != nil {
+		t.Fatalf("unexpected mismatch: %v", err)
+	}
+}
+
+func TestEngineReplayMismatch(t *testing.T) {
+	engine := NewEngineReplay(recordedSession)
+	defer engine.Close()
+	session := newReplaySession(t, engine, kittycad.ModelingSessionOptions{})
+
+	_, err := session.Send(context.Background(), map[string]any{"type": "close_path"})
+	var cmdErr *kittycad.ModelingCmdError
+	if !errors.As(err, &cmdErr) {
+		t.Fatalf("expected the command to fail, got %v", err)
+	}
+	session.Close()
+
+	if engine.Err() == nil {
+		t.Fatalf("expected the mismatch to be reported")
+	}
+}
+
+func TestEngineReplayBinaryFrames(t *testing.T) {
+	binary := []byte{0xff, 0x00, 0xfe}
+	engine := NewEngineReplay([]kittycad.WebsocketFrame{
+		recordedSession[2],
+		{Direction: kittycad.WebsocketReceived, Type: websocket.BinaryMessage, Data: binary},
+		recordedSession[3],
+	})
+	defer engine.Close()
+
+	unhandled := make(chan int, 1)
+	session := newReplaySession(t, engine, kittycad.ModelingSessionOptions{
+		OnUnhandledMessage: func(messageType int, data []byte) {
+			if bytes.Equal(data, binary) {
+				unhandled <- messageType
+			}
+		},
+	})
+
+	if _, err := session.Send(context.Background(), map[string]any{"type": "start_path"}); err != nil {
+		t.Fatalf("sending the command failed: %v", err)
+	}
+	select {
+	case messageType := <-unhandled:
+		if messageType != websocket.BinaryMessage {
+			t.Fatalf("expected the binary message to be replayed as binary, got type %d", messageType)
+		}
+	default:
+		t.Fatalf("the binary message was not replayed")
+	}
+}
+
+func TestEngineReplayExport(t *testing.T) {
+	// The engine sends exported files in a BSON message, here exporting
+	// output.stl for the command 44444444-4444-4444-8444-444444444444.
+	export, err := hex.DecodeString("9400000005726571756573745f6964001000000004444444444444444484444444444444440372657370005e000000036461746100420000000466696c657300360000000330002e00000005636f6e74656e7473000500000000736f6c6964026e616d65000b0000006f75747075742e73746c00000000027479706500070000006578706f727400000873756363657373000100")
+	if err != nil {
+		t.Fatalf("decoding the export message failed: %v", err)
+	}
+	engine := NewEngineReplay([]kittycad.WebsocketFrame{
+		{Direction: kittycad.WebsocketSent, Type: websocket.TextMessage, Data: []byte(`{"type":"modeling_cmd_req","cmd":{"type":"export3d","entity_ids":[],"format":{"type":"stl"}},"cmd_id":"44444444-4444-4444-8444-444444444444"}`)},
+		{Direction: kittycad.WebsocketReceived, Type: websocket.BinaryMessage, Data: export},
+	})
+	defer engine.Close()
+	session := newReplaySession(t, engine, kittycad.ModelingSessionOptions{})
+
+	files, err := session.Export3D(context.Background(), map[string]any{"type": "stl"})
+	if err != nil {
+		t.Fatalf("exporting failed: %v", err)
+	}
+	if len(files) != 1 || files[0].Name != "output.stl" || string(files[0].Contents.Inner) != "solid" {
+		t.Fatalf("unexpected files %+v", files)
+	}
+}
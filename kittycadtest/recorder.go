@@ -0,0 +1,502 @@
+package kittycadtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+	"github.com/kittycad/kittycad.go"
+)
+
+// RecorderMode is whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay answers the requests from the fixture, without sending them.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends the requests and records the interactions to the fixture.
+	ModeRecord
+)
+
+// cassette is the contents of a fixture file.
+type cassette struct {
+	Interactions []interaction     `json:"interactions"`
+	Websockets   []websocketStream `json:"websockets,omitempty"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	// Operation is the ID of the operation in the API spec, or the method and
+	// path if the request does not match an operation.
+	Operation string         `json:"operation"`
+	Method    string         `json:"method"`
+	Path      string         `json:"path"`
+	Query     string         `json:"query,omitempty"`
+	Header    http.Header    `json:"header,omitempty"`
+	Body      recordedBody   `json:"body,omitzero"`
+	Parts     []recordedPart `json:"parts,omitempty"`
+}
+
+// key returns what requests are matched on when replaying.
+func (r recordedRequest) key() string {
+	parts, _ := json.Marshal(r.Parts)
+	return fmt.Sprintf("%s?%s\n%s\n%s", r.Operation, r.Query, r.Body.Data, parts)
+}
+
+type recordedPart struct {
+	Name        string       `json:"name"`
+	Filename    string       `json:"filename,omitempty"`
+	ContentType string       `json:"content_type,omitempty"`
+	Body        recordedBody `json:"body"`
+}
+
+type recordedResponse struct {
+	Status int          `json:"status"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   recordedBody `json:"body,omitzero"`
+}
+
+// recordedBody is a body, stored as a string if it is valid UTF-8 and
+// base64 encoded otherwise.
+type recordedBody struct {
+	Data     string `json:"data"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+func newRecordedBody(b []byte) recordedBody {
+	if utf8.Valid(b) {
+		return recordedBody{Data: string(b)}
+	}
+	return recordedBody{Data: base64.StdEncoding.EncodeToString(b), Encoding: "base64"}
+}
+
+func (b recordedBody) bytes() ([]byte, error) {
+	if b.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(b.Data)
+	}
+	return []byte(b.Data), nil
+}
+
+// Recorder is an http.RoundTripper which records interactions with the API
+// to a fixture file, and replays them in later runs. Tokens, emails and IDs
+// are redacted from the recordings.
+//
+// When replaying, requests are matched by their operation, query and body,
+// and each recorded interaction is used once. Values the client made up
+// itself, like the IDs of modeling commands, match the placeholders they
+// were recorded as, and the responses hold them in place of the
+// placeholders. A request without a matching interaction fails. Set the
+// recorder as the transport of the client with kittycad.Client.WithTransport
+// and its Dialer with kittycad.Client.WithDialer, and call Close at the end
+// of the test to write the fixture.
+type Recorder struct {
+	// Transport sends the requests when recording. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+	// WebsocketDialer opens the websockets when recording. If nil,
+	// websocket.DefaultDialer is used.
+	WebsocketDialer *websocket.Dialer
+
+	path string
+	mode RecorderMode
+
+	mu       sync.Mutex
+	cassette cassette
+	used     []bool
+	redactor *redactor
+
+	// Websockets replayed, and the mismatches of the messages sent on them.
+	server      *httptest.Server
+	usedStreams []bool
+	errs        []error
+}
+
+// NewRecorder creates a recorder for the fixture file at path. In replay
+// mode the file has to exist.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		mode:     mode,
+		redactor: newRedactor(),
+	}
+
+	if mode == ModeReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the fixture failed: %v", err)
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("decoding the fixture %s failed: %v", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+		r.usedStreams = make([]bool, len(r.cassette.Websockets))
+		r.redactor.replaying = true
+	}
+
+	return r, nil
+}
+
+// Close writes the fixture when recording, and stops replaying websockets
+// when replaying.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode != ModeRecord {
+		if r.server != nil {
+			r.server.Close()
+			r.server = nil
+		}
+		return nil
+	}
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding the fixture failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating the fixture directory failed: %v", err)
+	}
+	if err := os.WriteFile(r.path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing the fixture failed: %v", err)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading the request body failed: %v", err)
+		}
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, body)
+	}
+	return r.record(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading the response body failed: %v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recorded, err := r.recordRequest(req, body)
+	if err != nil {
+		return nil, err
+	}
+	header := r.redactor.redactHeader(resp.Header)
+	header.Del("Content-Length")
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction{
+		Request: recorded,
+		Response: recordedResponse{
+			Status: resp.StatusCode,
+			Header: header,
+			Body:   r.recordBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recorded, err := r.recordRequest(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	key := recorded.key()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !r.redactor.match(in.Request.key(), key) {
+			continue
+		}
+		r.used[i] = true
+
+		respBody, err := in.Response.Body.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("decoding the recorded response body failed: %v", err)
+		}
+		respBody = r.redactor.unredact(respBody)
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        r.redactor.unredactHeader(in.Response.Header),
+			Body:          io.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction in %s matches %s %s (operation %s, query %q)", r.path, req.Method, recorded.Path, recorded.Operation, recorded.Query)
+}
+
+// recordRequest returns the redacted request. The caller must hold the lock.
+func (r *Recorder) recordRequest(req *http.Request, body []byte) (recordedRequest, error) {
+	r.redactor.begin()
+	operation := fmt.Sprintf("%s %s", req.Method, r.redactor.redactPath(req.URL.Path))
+	if rt, _, err := matchRoute(req.Method, req.URL.Path); err == nil {
+		operation = rt.operationID
+	}
+
+	recorded := recordedRequest{
+		Operation: operation,
+		Method:    req.Method,
+		Path:      r.redactor.redactPath(req.URL.Path),
+		Query:     r.redactor.redactQuery(req.URL.Query()),
+		Header:    r.redactor.redactHeader(req.Header),
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		recorded.Body = r.recordBody(contentType, body)
+		return recorded, nil
+	}
+
+	// The boundary is random, so record the parts instead of the body.
+	recorded.Header.Del("Content-Type")
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return recorded, fmt.Errorf("reading the multipart request body failed: %v", err)
+		}
+		contents, err := io.ReadAll(part)
+		if err != nil {
+			return recorded, fmt.Errorf("reading the multipart part %q failed: %v", part.FormName(), err)
+		}
+		recorded.Parts = append(recorded.Parts, recordedPart{
+			Name:        part.FormName(),
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Body:        r.recordBody(part.Header.Get("Content-Type"), contents),
+		})
+	}
+	return recorded, nil
+}
+
+// recordBody returns the redacted body. Only JSON and text bodies are
+// redacted. The caller must hold the lock.
+func (r *Recorder) recordBody(contentType string, body []byte) recordedBody {
+	if len(body) == 0 {
+		return recordedBody{}
+	}
+	if strings.Contains(contentType, "json") || json.Valid(body) {
+		return recordedBody{Data: string(r.redactor.redactBody(body))}
+	}
+	if strings.HasPrefix(contentType, "text/") {
+		return recordedBody{Data: r.redactor.redactString(string(body))}
+	}
+	return newRecordedBody(body)
+}
+
+// websocketStream is the messages of a recorded websocket.
+type websocketStream struct {
+	// Request is the request opening the websocket.
+	Request recordedRequest  `json:"request"`
+	Frames  []websocketFrame `json:"frames"`
+}
+
+type websocketFrame struct {
+	// Direction is kittycad.WebsocketSent or kittycad.WebsocketReceived.
+	Direction string `json:"direction"`
+	// Type is websocket.TextMessage or websocket.BinaryMessage.
+	Type int          `json:"type"`
+	Data recordedBody `json:"data"`
+}
+
+// Dialer returns the dialer for the websockets of the client, to set with
+// kittycad.Client.WithDialer. When recording, it records the messages of the
+// websockets opened with WebsocketDialer. When replaying, it connects them
+// to the recorded websockets, matched like requests, which send the
+// recorded messages once the client sent the messages preceding them. The
+// messages sent by the client have to match the recorded ones, or the
+// websocket is closed, and the mismatch returned by Err.
+func (r *Recorder) Dialer() *websocket.Dialer {
+	if r.mode == ModeRecord {
+		return kittycad.RecordingDialer(r.WebsocketDialer, r.openStream)
+	}
+
+	r.mu.Lock()
+	if r.server == nil {
+		r.server = httptest.NewServer(http.HandlerFunc(r.serveStream))
+	}
+	addr := r.server.Listener.Addr().String()
+	r.mu.Unlock()
+
+	// Every websocket is served by the replaying server, whatever its host.
+	dial := func(ctx context.Context, network, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, addr)
+	}
+	return &websocket.Dialer{NetDialContext: dial, NetDialTLSContext: dial}
+}
+
+// Err returns the mismatches between the messages sent on replayed
+// websockets and the recorded ones, or nil if everything matched.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return errors.Join(r.errs...)
+}
+
+// openStream records a new websocket.
+func (r *Recorder) openStream(u *url.URL) func(kittycad.WebsocketFrame) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recorded, _ := r.recordRequest(&http.Request{Method: http.MethodGet, URL: u, Header: http.Header{}}, nil)
+	recorded.Header = nil
+	r.cassette.Websockets = append(r.cassette.Websockets, websocketStream{Request: recorded})
+	stream := len(r.cassette.Websockets) - 1
+
+	return func(frame kittycad.WebsocketFrame) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.redactor.begin()
+		r.cassette.Websockets[stream].Frames = append(r.cassette.Websockets[stream].Frames, websocketFrame{
+			Direction: frame.Direction,
+			Type:      frame.Type,
+			Data:      r.recordFrame(frame.Type, frame.Data),
+		})
+	}
+}
+
+// recordFrame returns the redacted message. Only text messages are
+// redacted. The caller must hold the lock.
+func (r *Recorder) recordFrame(messageType int, data []byte) recordedBody {
+	if messageType != websocket.TextMessage {
+		return newRecordedBody(data)
+	}
+	return r.recordBody("", data)
+}
+
+// serveStream replays the first unused recorded websocket matching the
+// request.
+func (r *Recorder) serveStream(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	stream := -1
+	recorded, _ := r.recordRequest(req, nil)
+	for i, s := range r.cassette.Websockets {
+		if !r.usedStreams[i] && r.redactor.match(s.Request.key(), recorded.key()) {
+			r.usedStreams[i] = true
+			stream = i
+			break
+		}
+	}
+	r.mu.Unlock()
+
+	if stream < 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no recorded websocket in %s matches %s (operation %s, query %q)", r.path, recorded.Path, recorded.Operation, recorded.Query))
+		return
+	}
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for _, frame := range r.cassette.Websockets[stream].Frames {
+		if frame.Direction == kittycad.WebsocketReceived {
+			data, err := frame.Data.bytes()
+			if err != nil {
+				r.mismatch(conn, fmt.Errorf("decoding the recorded websocket message failed: %v", err))
+				return
+			}
+			r.mu.Lock()
+			if frame.Type == websocket.TextMessage {
+				data = r.redactor.unredact(data)
+			}
+			r.mu.Unlock()
+			if err := conn.WriteMessage(frame.Type, data); err != nil {
+				return
+			}
+			continue
+		}
+
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			r.mismatch(conn, fmt.Errorf("the websocket was closed before sending the recorded message %s", frame.Data.Data))
+			return
+		}
+		r.mu.Lock()
+		r.redactor.begin()
+		sent := r.recordFrame(messageType, data)
+		matched := messageType == frame.Type && sent.Encoding == frame.Data.Encoding && r.redactor.match(frame.Data.Data, sent.Data)
+		r.mu.Unlock()
+		if !matched {
+			r.mismatch(conn, fmt.Errorf("websocket message %s does not match the recorded message %s", data, frame.Data.Data))
+			return
+		}
+	}
+
+	// The recording is over, the client can only close the websocket.
+	if _, data, err := conn.ReadMessage(); err == nil {
+		r.mismatch(conn, fmt.Errorf("websocket message %s was not recorded", data))
+	}
+}
+
+// mismatch records the mismatch, and closes the websocket with it.
+func (r *Recorder) mismatch(conn *websocket.Conn, err error) {
+	r.mu.Lock()
+	r.errs = append(r.errs, err)
+	r.mu.Unlock()
+
+	// Close messages hold at most 125 bytes.
+	text := err.Error()
+	if len(text) > 120 {
+		text = text[:120]
+	}
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, text), time.Now().Add(time.Second))
+}
@@ -0,0 +1,245 @@
+package kittycadtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/kittycad/kittycad.go"
+)
+
+// runRecordedCalls makes the calls recorded and replayed by TestRecorder.
+func runRecordedCalls(t *testing.T, client *kittycad.Client) (*kittycad.UserResponse, string) {
+	t.Helper()
+
+	user, err := client.User.GetSelf()
+	if err != nil {
+		t.Fatalf("getting the user failed: %v", err)
+	}
+
+	created, err := client.APIToken.CreateForUser(kittycad.APITokenCreateForUserParams{Label: new("ci")})
+	if err != nil {
+		t.Fatalf("creating the token failed: %v", err)
+	}
+
+	token, err := client.APIToken.GetForUser(created.Token)
+	if err != nil {
+		t.Fatalf("getting the token failed: %v", err)
+	}
+
+	form := kittycad.NewMultipartForm()
+	if err := form.WriteJSONField("body", map[string]string{"title": "Cube"}); err != nil {
+		t.Fatalf("writing the body failed: %v", err)
+	}
+	if err := form.WriteFile("main.kcl", "main.kcl", []byte{0xff, 0x00}); err != nil {
+		t.Fatalf("writing the file failed: %v", err)
+	}
+	project, err := client.Project.Create(form)
+	if err != nil {
+		t.Fatalf("creating the project failed: %v", err)
+	}
+
+	if err := client.APIToken.DeleteForUser(created.Token); err != nil {
+		t.Fatalf("deleting the token failed: %v", err)
+	}
+
+	return user, *token.Label + " " + project.Title
+}
+
+func TestRecorder(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "fixtures", "recorder.json")
+
+	server := NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+
+	recorder, err := NewRecorder(fixture, ModeRecord)
+	if err != nil {
+		t.Fatalf("creating the recorder failed: %v", err)
+	}
+	client.WithTransport(recorder)
+	user, recorded := runRecordedCalls(t, client)
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("closing the recorder failed: %v", err)
+	}
+
+	b, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("reading the fixture failed: %v", err)
+	}
+	for _, secret := range []string{server.Token(), user.ID.String(), *user.Email} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("the fixture contains %q", secret)
+		}
+	}
+	// The IDs of the headers, like X-Api-Call-Id, are redacted too.
+	for _, id := range uuidPattern.FindAllString(string(b), -1) {
+		if !redactedPattern.MatchString(id) {
+			t.Errorf("the fixture contains the ID %q", id)
+		}
+	}
+
+	// Replay without the server.
+	server.Close()
+	replayer, err := NewRecorder(fixture, ModeReplay)
+	if err != nil {
+		t.Fatalf("creating the replayer failed: %v", err)
+	}
+	client.WithTransport(replayer)
+	user, replayed := runRecordedCalls(t, client)
+	if replayed != recorded {
+		t.Errorf("replayed %q, recorded %q", replayed, recorded)
+	}
+	if !strings.HasSuffix(*user.Email, "@example.com") || !redactedPattern.MatchString(*user.Email) {
+		t.Errorf("expected the replayed email to be redacted, got %q", *user.Email)
+	}
+
+	if _, err := client.User.GetSelf(); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Errorf("expected an error for a request which was not recorded, got %v", err)
+	}
+}
+
+func TestRecorderWebsocket(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(messageType, data)
+		}
+	}))
+	defer server.Close()
+
+	client, err := kittycad.NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+
+	// exchange sends a message with a new ID and a binary message on a
+	// CommandsWs websocket, and returns the ID and what was echoed.
+	binary := []byte{0xff, 0x00, 0x01}
+	exchange := func() (string, map[string]string, error) {
+		conn, err := client.Modeling.CommandsWs(context.Background(), kittycad.ModelingCommandsWsParams{Fps: new(30)}, nil)
+		if err != nil {
+			return "", nil, err
+		}
+		defer conn.Close()
+
+		id := uuid.NewString()
+		if err := conn.WriteJSON(map[string]string{"cmd_id": id, "type": "ping"}); err != nil {
+			return "", nil, err
+		}
+		var echoed map[string]string
+		if err := conn.ReadJSON(&echoed); err != nil {
+			return "", nil, err
+		}
+		if err := conn.WriteMessage(websocket.BinaryMessage, binary); err != nil {
+			return "", nil, err
+		}
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return "", nil, err
+		}
+		if messageType != websocket.BinaryMessage || !bytes.Equal(data, binary) {
+			t.Errorf("expected the binary message to be echoed, got %d %x", messageType, data)
+		}
+		return id, echoed, nil
+	}
+
+	fixture := filepath.Join(t.TempDir(), "websocket.json")
+	recorder, err := NewRecorder(fixture, ModeRecord)
+	if err != nil {
+		t.Fatalf("creating the recorder failed: %v", err)
+	}
+	client.WithDialer(recorder.Dialer())
+	if _, _, err := exchange(); err != nil {
+		t.Fatalf("recording the exchange failed: %v", err)
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("closing the recorder failed: %v", err)
+	}
+
+	server.Close()
+	replayer, err := NewRecorder(fixture, ModeReplay)
+	if err != nil {
+		t.Fatalf("creating the replayer failed: %v", err)
+	}
+	defer replayer.Close()
+	client.WithDialer(replayer.Dialer())
+
+	// The ID made up by the client is echoed back, not its placeholder.
+	id, echoed, err := exchange()
+	if err != nil {
+		t.Fatalf("replaying the exchange failed: %v", err)
+	}
+	if echoed["type"] != "ping" || echoed["cmd_id"] != id {
+		t.Errorf("expected the ping with ID %s, got %v", id, echoed)
+	}
+	if err := replayer.Err(); err != nil {
+		t.Errorf("unexpected mismatch: %v", err)
+	}
+
+	// Only one websocket was recorded.
+	_, err = client.Modeling.CommandsWs(context.Background(), kittycad.ModelingCommandsWsParams{Fps: new(30)}, nil)
+	if httpErr, ok := errors.AsType[*kittycad.HTTPError](err); !ok || httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an error dialing a websocket which was not recorded, got %v", err)
+	}
+}
+
+func TestRecorderWebsocketMismatch(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "websocket.json")
+	recording := `{"interactions":[],"websockets":[{"request":{"operation":"GET /ws/modeling/commands","method":"GET","path":"/ws/modeling/commands"},"frames":[
+		{"direction":"sent","type":1,"data":{"data":"{\"type\":\"ping\"}"}},
+		{"direction":"received","type":1,"data":{"data":"{\"type\":\"pong\"}"}}
+	]}]}`
+	if err := os.WriteFile(fixture, []byte(recording), 0644); err != nil {
+		t.Fatalf("writing the fixture failed: %v", err)
+	}
+	replayer, err := NewRecorder(fixture, ModeReplay)
+	if err != nil {
+		t.Fatalf("creating the replayer failed: %v", err)
+	}
+	defer replayer.Close()
+
+	client, err := kittycad.NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	client.WithDialer(replayer.Dialer())
+	conn, err := client.Modeling.CommandsWs(context.Background(), kittycad.ModelingCommandsWsParams{}, nil)
+	if err != nil {
+		t.Fatalf("opening the websocket failed: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(map[string]string{"type": "close_path"}); err != nil {
+		t.Fatalf("writing failed: %v", err)
+	}
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+		t.Fatalf("expected the websocket to be closed, got %v", err)
+	}
+	if err := replayer.Err(); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected the mismatch to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,325 @@
+package kittycadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Placeholders replacing the redacted values. Each distinct value gets its
+// own number, so the same ID is always replaced by the same placeholder and
+// requests using IDs from earlier responses still match when replaying.
+const (
+	redactedIDFormat    = "00000000-0000-4000-8000-%012d"
+	redactedEmailFormat = "redacted-%d@example.com"
+	redactedTokenFormat = "redacted-token-%d"
+)
+
+// placeholderPattern matches the placeholders.
+const placeholderPattern = `00000000-0000-4000-8000-\d{12}|redacted-\d+@example\.com|redacted-token-\d+`
+
+var (
+	redactedPattern = regexp.MustCompile(`^(?:00000000-0000-4000-8000-(\d{12})|redacted-(\d+)@example\.com|redacted-token-(\d+))$`)
+	anyPlaceholder  = regexp.MustCompile(placeholderPattern)
+	// unboundPattern matches the markers of the values which were not seen
+	// before in a replayed request.
+	unboundPattern = regexp.MustCompile(`__unrecorded_(\d+)__`)
+	uuidPattern    = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+)
+
+// minReplacedLength is the length a redacted value needs to have to be
+// replaced inside other strings.
+const minReplacedLength = 8
+
+// redactedHeaders are the headers whose values are never recorded.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactor replaces tokens, emails and IDs with placeholders.
+//
+// When replaying, the values the client made up itself were recorded as
+// placeholders numbered in the order the recording met them, which
+// replaying cannot reproduce. So values which were not seen before are
+// replaced with markers instead, and match bound them to the placeholders
+// they were recorded as.
+type redactor struct {
+	values map[string]string
+	next   int
+
+	replaying bool
+	// unbound are the values replaced with markers since begin.
+	unbound []string
+}
+
+func newRedactor() *redactor {
+	return &redactor{values: map[string]string{}, next: 1}
+}
+
+// placeholder returns the placeholder for the value. Placeholders are left
+// as they are, so redacting a recording again does not change it.
+func (r *redactor) placeholder(format string, value string) string {
+	if p, ok := r.values[value]; ok {
+		return p
+	}
+	if m := redactedPattern.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1] + m[2] + m[3])
+		r.next = max(r.next, n+1)
+		return value
+	}
+	if r.replaying {
+		i := slices.Index(r.unbound, value)
+		if i < 0 {
+			i = len(r.unbound)
+			r.unbound = append(r.unbound, value)
+		}
+		return fmt.Sprintf("__unrecorded_%d__", i)
+	}
+
+	p := fmt.Sprintf(format, r.next)
+	r.next++
+	r.values[value] = p
+	return p
+}
+
+// begin starts redacting a new request or message, whose markers are
+// numbered from zero.
+func (r *redactor) begin() {
+	r.unbound = nil
+}
+
+// match returns whether the recorded request or message matches the one
+// redacted since begin, binding the values behind its markers to the
+// placeholders they match.
+func (r *redactor) match(recorded, redacted string) bool {
+	markers := unboundPattern.FindAllStringSubmatchIndex(redacted, -1)
+	if len(markers) == 0 {
+		return recorded == redacted
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	values := []string{}
+	prev := 0
+	for _, m := range markers {
+		i, _ := strconv.Atoi(redacted[m[2]:m[3]])
+		values = append(values, r.unbound[i])
+		pattern.WriteString(regexp.QuoteMeta(redacted[prev:m[0]]))
+		pattern.WriteString("(" + placeholderPattern + ")")
+		prev = m[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(redacted[prev:]) + "$")
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return false
+	}
+	m := re.FindStringSubmatch(recorded)
+	if m == nil {
+		return false
+	}
+
+	// Every value has to be bound to one placeholder, which no other value
+	// is bound to.
+	bound := map[string]string{}
+	for value, p := range r.values {
+		bound[p] = value
+	}
+	bindings := map[string]string{}
+	for i, value := range values {
+		p := m[i+1]
+		if b, ok := bindings[value]; ok && b != p {
+			return false
+		}
+		if v, ok := bound[p]; ok && v != value {
+			return false
+		}
+		bindings[value] = p
+		bound[p] = value
+	}
+
+	for value, p := range bindings {
+		r.values[value] = p
+	}
+	return true
+}
+
+// unredact replaces the placeholders bound to values by match with the
+// values, so the client gets back the values it made up.
+func (r *redactor) unredact(data []byte) []byte {
+	bound := map[string]string{}
+	for value, p := range r.values {
+		bound[p] = value
+	}
+	if len(bound) == 0 {
+		return data
+	}
+	return anyPlaceholder.ReplaceAllFunc(data, func(p []byte) []byte {
+		if value, ok := bound[string(p)]; ok {
+			return []byte(value)
+		}
+		return p
+	})
+}
+
+// redactedFormat returns the placeholder format for the values of the JSON
+// key, or "" if they are not redacted.
+func redactedFormat(key string) string {
+	key = strings.ToLower(key)
+	switch {
+	case key == "email" || strings.HasSuffix(key, "_email"):
+		return redactedEmailFormat
+	case key == "token" || strings.HasSuffix(key, "_token") || key == "password" || strings.HasSuffix(key, "_secret") || strings.HasSuffix(key, "_key"):
+		return redactedTokenFormat
+	case key == "id" || strings.HasSuffix(key, "_id"):
+		return redactedIDFormat
+	}
+	return ""
+}
+
+// redactJSON redacts the values of the JSON document in place.
+func (r *redactor) redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if s, ok := v[k].(string); ok && s != "" {
+				if format := redactedFormat(k); format != "" {
+					v[k] = r.placeholder(format, s)
+					continue
+				}
+			}
+			v[k] = r.redactJSON(v[k])
+		}
+	case []any:
+		for i := range v {
+			v[i] = r.redactJSON(v[i])
+		}
+	case string:
+		return r.redactString(v)
+	}
+	return v
+}
+
+// redactString replaces the values redacted so far, and anything which
+// looks like a UUID, in the string.
+func (r *redactor) redactString(s string) string {
+	if p, ok := r.values[s]; ok {
+		return p
+	}
+	s = uuidPattern.ReplaceAllStringFunc(s, func(id string) string {
+		return r.placeholder(redactedIDFormat, id)
+	})
+
+	// Replace the longest values first, in case one contains another.
+	values := make([]string, 0, len(r.values))
+	for v := range r.values {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	for _, v := range values {
+		if len(v) < minReplacedLength {
+			// Short values would replace unrelated parts of the string.
+			continue
+		}
+		s = strings.ReplaceAll(s, v, r.values[v])
+	}
+	return s
+}
+
+// redactBody redacts a JSON body, returning it in a canonical form with
+// sorted keys. Bodies which are not JSON are only redacted as strings.
+func (r *redactor) redactBody(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []byte(r.redactString(string(body)))
+	}
+
+	b, err := json.Marshal(r.redactJSON(v))
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// redactPath redacts the path segments of the URL.
+func (r *redactor) redactPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = r.redactString(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// redactQuery redacts the query and encodes it with sorted keys.
+func (r *redactor) redactQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	redacted := url.Values{}
+	for _, k := range keys {
+		for _, v := range query[k] {
+			if format := redactedFormat(k); format != "" && v != "" {
+				v = r.placeholder(format, v)
+			} else {
+				v = r.redactString(v)
+			}
+			redacted.Add(k, v)
+		}
+	}
+	return redacted.Encode()
+}
+
+// redactHeader returns a copy of the header without the redacted headers,
+// whose other values are redacted like query parameters.
+func (r *redactor) redactHeader(header http.Header) http.Header {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	redacted := http.Header{}
+	for _, k := range keys {
+		if slices.Contains(redactedHeaders, http.CanonicalHeaderKey(k)) {
+			continue
+		}
+		for _, v := range header[k] {
+			// Header names are dashed, like X-Request-Id.
+			if format := redactedFormat(strings.ReplaceAll(k, "-", "_")); format != "" && v != "" {
+				v = r.placeholder(format, v)
+			} else {
+				v = r.redactString(v)
+			}
+			redacted[k] = append(redacted[k], v)
+		}
+	}
+	return redacted
+}
+
+// unredactHeader returns a copy of the header whose placeholders bound by
+// match are replaced with the values, like unredact.
+func (r *redactor) unredactHeader(header http.Header) http.Header {
+	unredacted := header.Clone()
+	for _, values := range unredacted {
+		for i, v := range values {
+			values[i] = string(r.unredact([]byte(v)))
+		}
+	}
+	return unredacted
+}
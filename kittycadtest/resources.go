@@ -0,0 +1,591 @@
+package kittycadtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+func (s *Server) getUserSelf(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON(w, http.StatusOK, s.user)
+}
+
+func (s *Server) updateUserSelf(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.UpdateUser
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Only the fields sent are updated, like the API does.
+	setIfPresent(&s.user.AllowPayAsYouGo, body.AllowPayAsYouGo)
+	setIfPresent(&s.user.Company, body.Company)
+	setIfPresent(&s.user.Discord, body.Discord)
+	setIfPresent(&s.user.FirstName, body.FirstName)
+	setIfPresent(&s.user.Github, body.Github)
+	if body.Image.URL != nil {
+		s.user.Image = body.Image
+	}
+	setIfPresent(&s.user.IsOnboarded, body.IsOnboarded)
+	setIfPresent(&s.user.LastName, body.LastName)
+	setIfPresent(&s.user.Phone, body.Phone)
+	setIfPresent(&s.user.Username, body.Username)
+	s.user.UpdatedAt = kittycad.TimeNow()
+	writeJSON(w, http.StatusOK, s.user)
+}
+
+// deleteUserSelf deletes the user, invalidating all of its API tokens.
+func (s *Server) deleteUserSelf(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.tokens {
+		s.tokens[i].IsValid = false
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listAPITokens(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, next := paginate(s.tokens, r)
+	writeJSON(w, http.StatusOK, kittycad.APITokenResultsPage{Items: items, NextPage: next})
+}
+
+func (s *Server) createAPIToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	now := kittycad.TimeNow()
+	token := kittycad.APIToken{
+		CreatedAt: now,
+		ID:        newID(),
+		IsValid:   true,
+		Token:     newToken(),
+		UpdatedAt: now,
+	}
+	if r.URL.Query().Has("label") {
+		token.Label = new(r.URL.Query().Get("label"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token.UserID = s.user.ID
+	s.tokens = append(s.tokens, token)
+	writeJSON(w, http.StatusCreated, kittycad.APITokenWithFullToken(token))
+}
+
+func (s *Server) getAPIToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := slices.IndexFunc(s.tokens, func(t kittycad.APIToken) bool { return t.Token == params["token"] })
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "API token not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.tokens[i])
+}
+
+func (s *Server) deleteAPIToken(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := slices.IndexFunc(s.tokens, func(t kittycad.APIToken) bool { return t.Token == params["token"] })
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "API token not found")
+		return
+	}
+	s.tokens = slices.Delete(s.tokens, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getOrg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.org)
+}
+
+// createOrg creates the org of the user, and makes the user its admin.
+func (s *Server) createOrg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.OrgDetails
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org != nil {
+		writeError(w, http.StatusConflict, "the user is already a member of an org")
+		return
+	}
+
+	now := kittycad.TimeNow()
+	s.org = &kittycad.Org{
+		CreatedAt: now,
+		ID:        newID(),
+		UpdatedAt: now,
+	}
+	applyOrgDetails(s.org, body)
+	s.members = []kittycad.OrgMember{{
+		CreatedAt: now,
+		Email:     s.user.Email,
+		ID:        s.user.ID,
+		Image:     s.user.Image,
+		Name:      s.user.Name,
+		Role:      kittycad.OrgRoleAdmin,
+		UpdatedAt: now,
+	}}
+	writeJSON(w, http.StatusCreated, s.org)
+}
+
+func (s *Server) updateOrg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.OrgDetails
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+	applyOrgDetails(s.org, body)
+	s.org.UpdatedAt = kittycad.TimeNow()
+	writeJSON(w, http.StatusOK, s.org)
+}
+
+// applyOrgDetails updates the org with the details sent, leaving the
+// others as they are.
+func applyOrgDetails(org *kittycad.Org, details kittycad.OrgDetails) {
+	setIfPresent(&org.AllowUsersInDomainToAutoJoin, details.AllowUsersInDomainToAutoJoin)
+	if details.BillingEmail != nil {
+		org.BillingEmail = *details.BillingEmail
+	}
+	setIfPresent(&org.Domain, details.Domain)
+	setIfPresent(&org.Image, details.Image)
+	setIfPresent(&org.Name, details.Name)
+	setIfPresent(&org.Phone, details.Phone)
+}
+
+// setIfPresent sets the field to the value if it was sent.
+func setIfPresent[T any](field **T, value *T) {
+	if value != nil {
+		*field = value
+	}
+}
+
+func (s *Server) deleteOrg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+	s.org = nil
+	s.members = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getUserOrg(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+
+	role := kittycad.OrgRoleMember
+	if i := s.memberIndex(s.user.ID.String()); i >= 0 {
+		role = s.members[i].Role
+	}
+	writeJSON(w, http.StatusOK, kittycad.UserOrgInfo{
+		AllowUsersInDomainToAutoJoin: s.org.AllowUsersInDomainToAutoJoin,
+		BillingEmail:                 new(s.org.BillingEmail),
+		BillingEmailVerified:         s.org.BillingEmailVerified,
+		Block:                        s.org.Block,
+		CreatedAt:                    s.org.CreatedAt,
+		Domain:                       s.org.Domain,
+		ID:                           s.org.ID,
+		Image:                        s.org.Image,
+		Name:                         s.org.Name,
+		Phone:                        s.org.Phone,
+		Role:                         role,
+		StripeID:                     s.org.StripeID,
+		UpdatedAt:                    s.org.UpdatedAt,
+	})
+}
+
+// memberIndex returns the index of the org member with the ID, or -1. The
+// caller must hold the lock.
+func (s *Server) memberIndex(id string) int {
+	return slices.IndexFunc(s.members, func(m kittycad.OrgMember) bool { return m.ID.String() == id })
+}
+
+func (s *Server) listOrgMembers(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+
+	members := s.members
+	if role := r.URL.Query().Get("role"); role != "" {
+		members = slices.DeleteFunc(slices.Clone(members), func(m kittycad.OrgMember) bool { return string(m.Role) != role })
+	}
+	items, next := paginate(members, r)
+	writeJSON(w, http.StatusOK, kittycad.OrgMemberResultsPage{Items: items, NextPage: next})
+}
+
+func (s *Server) createOrgMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.AddOrgMember
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.org == nil {
+		writeError(w, http.StatusNotFound, "the user is not a member of an org")
+		return
+	}
+	if slices.ContainsFunc(s.members, func(m kittycad.OrgMember) bool { return m.Email != nil && *m.Email == body.Email }) {
+		writeError(w, http.StatusConflict, fmt.Sprintf("%s is already a member of the org", body.Email))
+		return
+	}
+
+	now := kittycad.TimeNow()
+	member := kittycad.OrgMember{
+		CreatedAt: now,
+		Email:     new(body.Email),
+		ID:        newID(),
+		Image:     parseURL("https://example.com/avatar.png"),
+		Role:      kittycad.OrgRole(body.Role),
+		UpdatedAt: now,
+	}
+	s.members = append(s.members, member)
+	writeJSON(w, http.StatusCreated, member)
+}
+
+func (s *Server) getOrgMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.memberIndex(params["user_id"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "org member not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.members[i])
+}
+
+func (s *Server) updateOrgMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.UpdateMemberToOrgBody
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.memberIndex(params["user_id"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "org member not found")
+		return
+	}
+	s.members[i].Role = kittycad.OrgRole(body.Role)
+	s.members[i].UpdatedAt = kittycad.TimeNow()
+	writeJSON(w, http.StatusOK, s.members[i])
+}
+
+func (s *Server) deleteOrgMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.memberIndex(params["user_id"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "org member not found")
+		return
+	}
+	s.members = slices.Delete(s.members, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// shortlinkIndex returns the index of the shortlink with the key, or -1. The
+// caller must hold the lock.
+func (s *Server) shortlinkIndex(key string) int {
+	return slices.IndexFunc(s.shortlinks, func(l kittycad.Shortlink) bool { return l.Key == key })
+}
+
+func (s *Server) listShortlinks(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, next := paginate(s.shortlinks, r)
+	writeJSON(w, http.StatusOK, kittycad.ShortlinkResultsPage{Items: items, NextPage: next})
+}
+
+func (s *Server) createShortlink(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.CreateShortlinkRequest
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	now := kittycad.TimeNow()
+	id := newID()
+	link := kittycad.Shortlink{
+		CreatedAt:     now,
+		ID:            id,
+		Key:           id.String()[:8],
+		PasswordHash:  hashPassword(body.Password),
+		RestrictToOrg: body.RestrictToOrg,
+		UpdatedAt:     now,
+		Value:         body.Url,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	link.UserID = s.user.ID
+	if s.org != nil {
+		link.OrgID = &s.org.ID
+	}
+	s.shortlinks = append(s.shortlinks, link)
+	writeJSON(w, http.StatusCreated, kittycad.CreateShortlinkResponse{
+		Key: link.Key,
+		Url: parseURL(s.URL + "/user/shortlinks/" + link.Key),
+	})
+}
+
+func (s *Server) redirectShortlink(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.shortlinkIndex(params["key"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "shortlink not found")
+		return
+	}
+	http.Redirect(w, r, s.shortlinks[i].Value.String(), http.StatusFound)
+}
+
+func (s *Server) updateShortlink(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var body kittycad.UpdateShortlinkRequest
+	if !decodeJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.shortlinkIndex(params["key"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "shortlink not found")
+		return
+	}
+	s.shortlinks[i].PasswordHash = hashPassword(body.Password)
+	s.shortlinks[i].RestrictToOrg = new(body.RestrictToOrg)
+	s.shortlinks[i].UpdatedAt = kittycad.TimeNow()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteShortlink(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.shortlinkIndex(params["key"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "shortlink not found")
+		return
+	}
+	s.shortlinks = slices.Delete(s.shortlinks, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func hashPassword(password *string) *string {
+	if password == nil || *password == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(*password))
+	return new(hex.EncodeToString(sum[:]))
+}
+
+// projectIndex returns the index of the project with the ID, or -1. The
+// caller must hold the lock.
+func (s *Server) projectIndex(id string) int {
+	return slices.IndexFunc(s.projects, func(p kittycad.ProjectResponse) bool { return p.ID.String() == id })
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	summaries := []kittycad.ProjectSummaryResponse{}
+	for _, p := range s.projects {
+		summaries = append(summaries, kittycad.ProjectSummaryResponse{
+			CategoryIds:       p.CategoryIds,
+			CreatedAt:         p.CreatedAt,
+			Description:       p.Description,
+			EntrypointPath:    p.EntrypointPath,
+			ID:                p.ID,
+			PreviewStatus:     p.PreviewStatus,
+			PreviewUrl:        p.PreviewUrl,
+			ProjectTomlPath:   p.ProjectTomlPath,
+			Publication:       p.Publication,
+			PublicationStatus: p.PublicationStatus,
+			Revision:          p.Revision,
+			Title:             p.Title,
+			UpdatedAt:         p.UpdatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, summaries)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	now := kittycad.TimeNow()
+	project := kittycad.ProjectResponse{
+		CategoryIds:       []kittycad.UUID{},
+		CreatedAt:         now,
+		EntrypointPath:    "main.kcl",
+		ID:                newID(),
+		PreviewStatus:     kittycad.KclProjectPreviewStatusReady,
+		ProjectTomlPath:   "project.toml",
+		PublicationStatus: kittycad.KclProjectPublicationStatusDraft,
+		Revision:          "1",
+		UpdatedAt:         now,
+	}
+	if !readProjectForm(w, r, &project) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects = append(s.projects, project)
+	writeJSON(w, http.StatusCreated, project)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.projectIndex(params["id"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.projects[i])
+}
+
+// updateProject replaces the project, bumping its revision.
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	i := s.projectIndex(params["id"])
+	if i < 0 {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	project := s.projects[i]
+	s.mu.Unlock()
+
+	if !readProjectForm(w, r, &project) {
+		return
+	}
+	revision, _ := strconv.Atoi(project.Revision)
+	project.Revision = strconv.Itoa(revision + 1)
+	project.UpdatedAt = kittycad.TimeNow()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.projectIndex(params["id"]); i >= 0 {
+		s.projects[i] = project
+	}
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.projectIndex(params["id"])
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	s.projects = slices.Delete(s.projects, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// projectFields are the fields of the JSON `body` part of a project form.
+type projectFields struct {
+	Title          *string `json:"title"`
+	Description    *string `json:"description"`
+	EntrypointPath *string `json:"entrypoint_path"`
+}
+
+// readProjectForm reads a multipart project form into the project. The
+// `body` part holds the JSON fields of the project, and every other part is
+// a file of the project. The files replace the existing ones.
+func readProjectForm(w http.ResponseWriter, r *http.Request, project *kittycad.ProjectResponse) bool {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("reading the multipart body failed: %v", err))
+		return false
+	}
+
+	now := kittycad.TimeNow()
+	files := []kittycad.ProjectFileResponse{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("reading the multipart body failed: %v", err))
+			return false
+		}
+
+		contents, err := io.ReadAll(part)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("reading the multipart part %q failed: %v", part.FormName(), err))
+			return false
+		}
+
+		if part.FormName() == "body" {
+			var fields projectFields
+			if err := json.Unmarshal(contents, &fields); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("decoding the project fields failed: %v", err))
+				return false
+			}
+			if fields.Title != nil {
+				project.Title = *fields.Title
+			}
+			if fields.Description != nil {
+				project.Description = *fields.Description
+			}
+			if fields.EntrypointPath != nil {
+				project.EntrypointPath = *fields.EntrypointPath
+			}
+			continue
+		}
+
+		role := kittycad.KclProjectFileRoleProjectFile
+		if part.FileName() == project.ProjectTomlPath {
+			role = kittycad.KclProjectFileRoleProjectToml
+		}
+		sum := sha256.Sum256(contents)
+		files = append(files, kittycad.ProjectFileResponse{
+			ByteSize:     len(contents),
+			ContentType:  part.Header.Get("Content-Type"),
+			CreatedAt:    now,
+			FileRole:     role,
+			ID:           newID(),
+			RelativePath: part.FileName(),
+			Sha256:       new(hex.EncodeToString(sum[:])),
+			SortOrder:    len(files),
+			UpdatedAt:    now,
+		})
+	}
+
+	project.Files = files
+	return true
+}
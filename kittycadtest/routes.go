@@ -0,0 +1,247 @@
+// Code generated by `generate`. DO NOT EDIT.
+
+package kittycadtest
+
+// route is an operation of the API, with the example response returned when
+// the server does not implement the operation itself.
+type route struct {
+	// method is the HTTP method of the operation.
+	method string
+	// pattern is the path of the operation, with its parameters in braces.
+	pattern string
+	// operationID is the ID of the operation in the spec.
+	operationID string
+	// status is the status code of a successful response.
+	status int
+	// asyncType is the type of the async operation the response describes, if any.
+	asyncType string
+	// example is the JSON body of a successful response, if it has one.
+	example string
+}
+
+// routes are all the operations of the API, apart from websockets.
+var routes = []route{
+	{method: "GET", pattern: "/", operationID: "get_schema", status: 200},
+	{method: "GET", pattern: "/_meta/ipinfo", operationID: "get_ipinfo", status: 200, example: "{}"},
+	{method: "POST", pattern: "/ai/text-to-cad/{output_format}", operationID: "create_text_to_cad", status: 201, asyncType: "text_to_cad", example: "{\"conversation_id\":\"00000000-0000-4000-8000-000000000000\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"model\":\"cad\",\"model_version\":\"string\",\"output_format\":\"fbx\",\"prompt\":\"string\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/announcements", operationID: "get_announcements", status: 200, example: "{\"announcements\":[{\"active\":false,\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/api-calls/{id}", operationID: "get_api_call", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/apps/github/callback", operationID: "apps_github_callback", status: 204},
+	{method: "GET", pattern: "/apps/github/consent", operationID: "apps_github_consent", status: 200, example: "{}"},
+	{method: "POST", pattern: "/apps/github/webhook", operationID: "apps_github_webhook", status: 204},
+	{method: "GET", pattern: "/async/operations/{id}", operationID: "get_async_operation", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_format\":\"fbx\",\"src_format\":\"acis\",\"status\":\"queued\",\"type\":\"file_conversion\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/auth/api-key", operationID: "auth_api_key", status: 200, example: "{\"session_token\":\"string\"}"},
+	{method: "POST", pattern: "/auth/email", operationID: "auth_email", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"expires\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/auth/email-marketing/confirm", operationID: "auth_email_marketing_confirm_post", status: 204},
+	{method: "GET", pattern: "/auth/email/callback", operationID: "auth_email_callback", status: 200},
+	{method: "GET", pattern: "/auth/saml/org/{org_id}/login", operationID: "get_auth_saml_by_org", status: 200},
+	{method: "GET", pattern: "/auth/saml/provider/{provider_id}/login", operationID: "get_auth_saml", status: 200},
+	{method: "POST", pattern: "/auth/saml/provider/{provider_id}/login", operationID: "post_auth_saml", status: 200},
+	{method: "GET", pattern: "/community/sso", operationID: "community_sso", status: 200},
+	{method: "POST", pattern: "/file/center-of-mass", operationID: "create_file_center_of_mass", status: 201, asyncType: "file_center_of_mass", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_unit\":\"cm\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/conversion", operationID: "create_file_conversion_options", status: 201, asyncType: "file_conversion", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_format\":\"fbx\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/conversion/{src_format}/{output_format}", operationID: "create_file_conversion", status: 201, asyncType: "file_conversion", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_format\":\"fbx\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/density", operationID: "create_file_density", status: 201, asyncType: "file_density", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"material_mass_unit\":\"g\",\"output_unit\":\"lb:ft3\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/execute/{lang}", operationID: "create_file_execution", status: 201, example: "{}"},
+	{method: "POST", pattern: "/file/mass", operationID: "create_file_mass", status: 201, asyncType: "file_mass", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"material_density_unit\":\"lb:ft3\",\"output_unit\":\"g\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/surface-area", operationID: "create_file_surface_area", status: 201, asyncType: "file_surface_area", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_unit\":\"cm2\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/file/volume", operationID: "create_file_volume", status: 201, asyncType: "file_volume", example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"output_unit\":\"mm3\",\"src_format\":\"acis\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/internal/discord/api-token/{discord_id}", operationID: "internal_get_api_token_for_discord_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/logout", operationID: "logout", status: 204},
+	{method: "GET", pattern: "/ml/conversations", operationID: "list_conversations_for_user", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"first_prompt\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "POST", pattern: "/ml/convert/proprietary-to-kcl", operationID: "create_proprietary_to_kcl", status: 201, example: "{\"code\":\"string\"}"},
+	{method: "POST", pattern: "/ml/custom/models", operationID: "create_custom_model", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"system_prompt\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/ml/custom/models/{id}", operationID: "get_custom_model", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"system_prompt\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/ml/custom/models/{id}", operationID: "update_custom_model", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"system_prompt\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/ml/custom/models/{id}/datasets", operationID: "list_org_datasets_for_model", status: 200, example: "[{\"access_role_arn\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"require_raw_kcl_similarity_score_for_success\":false,\"source_uri\":\"string\",\"status\":\"active\",\"storage_provider\":\"s3\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]"},
+	{method: "POST", pattern: "/ml/kcl/completions", operationID: "create_kcl_code_completions", status: 201, example: "{\"completions\":[\"string\"]}"},
+	{method: "POST", pattern: "/ml/text-to-cad/iteration", operationID: "create_text_to_cad_iteration", status: 201, asyncType: "text_to_cad_iteration", example: "{\"code\":\"string\",\"conversation_id\":\"00000000-0000-4000-8000-000000000000\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"model\":\"cad\",\"model_version\":\"string\",\"original_source_code\":\"string\",\"source_ranges\":[{\"prompt\":\"string\",\"range\":{\"end\":{\"column\":0,\"line\":0},\"start\":{\"column\":0,\"line\":0}}}],\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/ml/text-to-cad/multi-file/iteration", operationID: "create_text_to_cad_multi_file_iteration", status: 201, asyncType: "text_to_cad_multi_file_iteration", example: "{\"conversation_id\":\"00000000-0000-4000-8000-000000000000\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"model\":\"cad\",\"model_version\":\"string\",\"source_ranges\":[{\"prompt\":\"string\",\"range\":{\"end\":{\"column\":0,\"line\":0},\"start\":{\"column\":0,\"line\":0}}}],\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/oauth2/authorization-requests/{request_id}", operationID: "get_oauth2_authorization_request", status: 200, example: "{\"app_name\":\"string\",\"expires_at\":\"2025-01-01T00:00:00Z\",\"redirect_uri\":\"https://example.com\",\"request_id\":\"00000000-0000-4000-8000-000000000000\",\"scopes\":[\"user:read\"]}"},
+	{method: "POST", pattern: "/oauth2/authorization-requests/{request_id}/approve", operationID: "approve_oauth2_authorization_request", status: 200, example: "{\"redirect_url\":\"string\"}"},
+	{method: "POST", pattern: "/oauth2/authorization-requests/{request_id}/deny", operationID: "deny_oauth2_authorization_request", status: 200, example: "{\"redirect_url\":\"string\"}"},
+	{method: "GET", pattern: "/oauth2/authorize", operationID: "oauth2_authorize", status: 200},
+	{method: "POST", pattern: "/oauth2/device/auth", operationID: "device_auth_request", status: 200},
+	{method: "POST", pattern: "/oauth2/device/confirm", operationID: "device_auth_confirm", status: 204},
+	{method: "POST", pattern: "/oauth2/device/token", operationID: "device_access_token", status: 200},
+	{method: "GET", pattern: "/oauth2/device/verify", operationID: "device_auth_verify", status: 200},
+	{method: "GET", pattern: "/oauth2/provider/{provider}/callback", operationID: "oauth2_provider_callback", status: 200},
+	{method: "POST", pattern: "/oauth2/provider/{provider}/callback", operationID: "oauth2_provider_callback_post", status: 200},
+	{method: "GET", pattern: "/oauth2/provider/{provider}/consent", operationID: "oauth2_provider_consent", status: 200, example: "{}"},
+	{method: "POST", pattern: "/oauth2/token", operationID: "oauth2_token", status: 200},
+	{method: "POST", pattern: "/oauth2/token/revoke", operationID: "oauth2_token_revoke", status: 200},
+	{method: "GET", pattern: "/oauth2/verify-account-linking", operationID: "verify_oauth_account_linking", status: 200},
+	{method: "GET", pattern: "/org", operationID: "get_org", status: 200, example: "{\"billing_email\":\"user@example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/org", operationID: "create_org", status: 201, example: "{\"billing_email\":\"user@example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org", operationID: "update_org", status: 200, example: "{\"billing_email\":\"user@example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org", operationID: "delete_org", status: 204},
+	{method: "GET", pattern: "/org/api-calls", operationID: "org_list_api_calls", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "GET", pattern: "/org/api-calls/{id}", operationID: "get_api_call_for_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/org/billing/usage-collection-threshold", operationID: "get_org_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "PUT", pattern: "/org/billing/usage-collection-threshold", operationID: "set_org_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "DELETE", pattern: "/org/billing/usage-collection-threshold", operationID: "reset_org_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "GET", pattern: "/org/dataset/s3/policies", operationID: "org_dataset_s3_policies", status: 200, example: "{\"bucket_policy\":null,\"permission_policy\":null,\"trust_policy\":null}"},
+	{method: "GET", pattern: "/org/datasets", operationID: "list_org_datasets", status: 200, example: "{\"items\":[{\"access_role_arn\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"require_raw_kcl_similarity_score_for_success\":false,\"source_uri\":\"string\",\"status\":\"active\",\"storage_provider\":\"s3\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "POST", pattern: "/org/datasets", operationID: "create_org_dataset", status: 201, example: "{\"access_role_arn\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"require_raw_kcl_similarity_score_for_success\":false,\"source_uri\":\"string\",\"status\":\"active\",\"storage_provider\":\"s3\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/org/datasets/{id}", operationID: "get_org_dataset", status: 200, example: "{\"access_role_arn\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"require_raw_kcl_similarity_score_for_success\":false,\"source_uri\":\"string\",\"status\":\"active\",\"storage_provider\":\"s3\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org/datasets/{id}", operationID: "update_org_dataset", status: 200, example: "{\"access_role_arn\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"name\":\"string\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"require_raw_kcl_similarity_score_for_success\":false,\"source_uri\":\"string\",\"status\":\"active\",\"storage_provider\":\"s3\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/datasets/{id}", operationID: "delete_org_dataset", status: 204},
+	{method: "GET", pattern: "/org/datasets/{id}/bulk-download/kcl", operationID: "download_org_dataset_successful_kcl_bulk", status: 200},
+	{method: "GET", pattern: "/org/datasets/{id}/conversions", operationID: "list_org_dataset_conversions", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"dataset_id\":\"00000000-0000-4000-8000-000000000000\",\"file_etag\":\"string\",\"file_path\":\"string\",\"file_size\":0,\"id\":\"00000000-0000-4000-8000-000000000000\",\"manual_kcl_override_active\":false,\"phase\":\"queued\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/org/datasets/{id}/conversions/{conversion_id}", operationID: "get_org_dataset_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"dataset_id\":\"00000000-0000-4000-8000-000000000000\",\"file_etag\":\"string\",\"file_path\":\"string\",\"file_size\":0,\"id\":\"00000000-0000-4000-8000-000000000000\",\"manual_kcl_override_active\":false,\"original_snapshot_images\":[{\"data_base64\":\"\",\"mime_type\":\"string\"}],\"phase\":\"queued\",\"raw_kcl_snapshot_images\":[{\"data_base64\":\"\",\"mime_type\":\"string\"}],\"salon_kcl_snapshot_images\":[{\"data_base64\":\"\",\"mime_type\":\"string\"}],\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/org/datasets/{id}/conversions/{conversion_id}/original", operationID: "download_org_dataset_conversion_original", status: 200},
+	{method: "POST", pattern: "/org/datasets/{id}/conversions/{conversion_id}/retrigger", operationID: "retrigger_org_dataset_conversion", status: 204},
+	{method: "POST", pattern: "/org/datasets/{id}/retrigger", operationID: "retrigger_org_dataset", status: 204},
+	{method: "GET", pattern: "/org/datasets/{id}/search/conversions", operationID: "search_org_dataset_conversions", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"dataset_id\":\"00000000-0000-4000-8000-000000000000\",\"file_etag\":\"string\",\"file_path\":\"string\",\"file_size\":0,\"id\":\"00000000-0000-4000-8000-000000000000\",\"manual_kcl_override_active\":false,\"phase\":\"queued\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/org/datasets/{id}/search/semantic", operationID: "search_org_dataset_semantic", status: 200, example: "[{\"chunk_index\":0,\"content\":\"string\",\"conversion_id\":\"00000000-0000-4000-8000-000000000000\",\"similarity\":0,\"source_file_path\":\"string\"}]"},
+	{method: "GET", pattern: "/org/datasets/{id}/stats", operationID: "get_org_dataset_conversion_stats", status: 200, example: "{\"by_status\":{},\"dataset_id\":\"00000000-0000-4000-8000-000000000000\",\"failures\":0,\"successes\":0,\"total\":0}"},
+	{method: "POST", pattern: "/org/datasets/{id}/uploads", operationID: "upload_org_dataset_files", status: 202, example: "{\"queued_conversions\":0,\"uploaded_files\":0}"},
+	{method: "GET", pattern: "/org/members", operationID: "list_org_members", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"role\":\"admin\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "POST", pattern: "/org/members", operationID: "create_org_member", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"role\":\"admin\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/org/members/{user_id}", operationID: "get_org_member", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"role\":\"admin\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org/members/{user_id}", operationID: "update_org_member", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"role\":\"admin\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/members/{user_id}", operationID: "delete_org_member", status: 204},
+	{method: "GET", pattern: "/org/oauth2/apps", operationID: "list_org_oauth2_apps", status: 200, example: "{\"items\":[{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "POST", pattern: "/org/oauth2/apps", operationID: "create_org_oauth2_app", status: 201, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/org/oauth2/apps/{client_id}", operationID: "get_org_oauth2_app", status: 200, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org/oauth2/apps/{client_id}", operationID: "update_org_oauth2_app", status: 200, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/oauth2/apps/{client_id}", operationID: "delete_org_oauth2_app", status: 204},
+	{method: "GET", pattern: "/org/payment", operationID: "get_payment_information_for_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/org/payment", operationID: "create_payment_information_for_org", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org/payment", operationID: "update_payment_information_for_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/payment", operationID: "delete_payment_information_for_org", status: 204},
+	{method: "GET", pattern: "/org/payment/balance", operationID: "get_payment_balance_for_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/org/payment/intent", operationID: "create_payment_intent_for_org", status: 201, example: "{\"client_secret\":\"string\"}"},
+	{method: "GET", pattern: "/org/payment/invoices", operationID: "list_invoices_for_org", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/org/payment/method-portal-link", operationID: "redirect_payment_method_portal_link_for_org", status: 200},
+	{method: "GET", pattern: "/org/payment/methods", operationID: "list_payment_methods_for_org", status: 200, example: "[{\"billing_info\":{},\"created_at\":\"2025-01-01T00:00:00Z\",\"type\":\"card\"}]"},
+	{method: "DELETE", pattern: "/org/payment/methods/{id}", operationID: "delete_payment_method_for_org", status: 204},
+	{method: "GET", pattern: "/org/payment/subscriptions", operationID: "get_org_subscription", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "POST", pattern: "/org/payment/subscriptions", operationID: "create_org_subscription", status: 201, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "PUT", pattern: "/org/payment/subscriptions", operationID: "update_org_subscription", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "GET", pattern: "/org/payment/tax", operationID: "validate_customer_tax_information_for_org", status: 204},
+	{method: "GET", pattern: "/org/privacy", operationID: "get_org_privacy_settings", status: 200, example: "{\"can_train_on_data\":false}"},
+	{method: "PUT", pattern: "/org/privacy", operationID: "update_org_privacy_settings", status: 200, example: "{\"can_train_on_data\":false}"},
+	{method: "GET", pattern: "/org/saml/idp", operationID: "get_org_saml_idp", status: 200, example: "{\"acs_url\":\"https://example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"slo_url\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/org/saml/idp", operationID: "create_org_saml_idp", status: 201, example: "{\"acs_url\":\"https://example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"slo_url\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/org/saml/idp", operationID: "update_org_saml_idp", status: 200, example: "{\"acs_url\":\"https://example.com\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"slo_url\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/saml/idp", operationID: "delete_org_saml_idp", status: 204},
+	{method: "GET", pattern: "/org/service-accounts", operationID: "list_service_accounts_for_org", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "POST", pattern: "/org/service-accounts", operationID: "create_service_account_for_org", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/org/service-accounts/{token}", operationID: "get_service_account_for_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"org_id\":\"00000000-0000-4000-8000-000000000000\",\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/org/service-accounts/{token}", operationID: "delete_service_account_for_org", status: 204},
+	{method: "GET", pattern: "/org/shortlinks", operationID: "get_org_shortlinks", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"key\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\",\"value\":\"https://example.com\"}]}"},
+	{method: "GET", pattern: "/org/skills", operationID: "list_org_skills", status: 200, example: "[{\"description\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"markdown\":\"string\",\"name\":\"string\"}]"},
+	{method: "GET", pattern: "/orgs/{id}/billing/contract", operationID: "get_billing_contract_for_any_org", status: 200, example: "{\"account_id\":\"00000000-0000-4000-8000-000000000000\",\"billing_cadence\":\"annual\",\"commitment_scope\":\"pooled\",\"contract_id\":\"00000000-0000-4000-8000-000000000000\",\"currency\":\"string\",\"effective_at\":\"2025-01-01T00:00:00Z\",\"items\":[{\"active\":false,\"code\":\"enterprise_support\",\"display_name\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_commitment_eligible\":false,\"kind\":\"fixed_fee\",\"rate_tiers\":[{\"id\":\"00000000-0000-4000-8000-000000000000\",\"tier_start_inclusive\":0,\"unit_price\":0}],\"unit\":\"file\"}],\"name\":\"string\",\"periods\":[{\"commitment_amount\":0,\"id\":\"00000000-0000-4000-8000-000000000000\",\"period_end_at\":\"2025-01-01T00:00:00Z\",\"period_index\":0,\"period_start_at\":\"2025-01-01T00:00:00Z\",\"rollover_in_amount\":0,\"rollover_out_amount\":0,\"status\":\"open\"}],\"provider\":\"stripe\",\"rollover_policy\":\"none\",\"status\":\"draft\",\"term_end_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/orgs/{id}/billing/contract", operationID: "upsert_billing_contract_for_any_org", status: 200, example: "{\"account_id\":\"00000000-0000-4000-8000-000000000000\",\"billing_cadence\":\"annual\",\"commitment_scope\":\"pooled\",\"contract_id\":\"00000000-0000-4000-8000-000000000000\",\"currency\":\"string\",\"effective_at\":\"2025-01-01T00:00:00Z\",\"items\":[{\"active\":false,\"code\":\"enterprise_support\",\"display_name\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_commitment_eligible\":false,\"kind\":\"fixed_fee\",\"rate_tiers\":[{\"id\":\"00000000-0000-4000-8000-000000000000\",\"tier_start_inclusive\":0,\"unit_price\":0}],\"unit\":\"file\"}],\"name\":\"string\",\"periods\":[{\"commitment_amount\":0,\"id\":\"00000000-0000-4000-8000-000000000000\",\"period_end_at\":\"2025-01-01T00:00:00Z\",\"period_index\":0,\"period_start_at\":\"2025-01-01T00:00:00Z\",\"rollover_in_amount\":0,\"rollover_out_amount\":0,\"status\":\"open\"}],\"provider\":\"stripe\",\"rollover_policy\":\"none\",\"status\":\"draft\",\"term_end_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/orgs/{id}/oauth2/apps", operationID: "list_oauth2_apps_for_any_org", status: 200, example: "{\"items\":[{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/orgs/{id}/payment/balance", operationID: "get_payment_balance_for_any_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/orgs/{id}/payment/balance", operationID: "update_payment_balance_for_any_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/orgs/{id}/payment/subscriptions", operationID: "update_org_subscription_for_any_org", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "GET", pattern: "/ping", operationID: "ping", status: 200, example: "{\"message\":\"string\"}"},
+	{method: "GET", pattern: "/pricing/subscriptions", operationID: "get_pricing_subscriptions", status: 200, example: "{}"},
+	{method: "GET", pattern: "/projects/categories", operationID: "list_project_categories", status: 200, example: "[{\"description\":\"string\",\"display_name\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"slug\":\"string\",\"sort_order\":0}]"},
+	{method: "GET", pattern: "/projects/public", operationID: "list_public_projects", status: 200, example: "[{\"categories\":[{\"description\":\"string\",\"display_name\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"slug\":\"string\",\"sort_order\":0}],\"description\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"like_count\":0,\"owner\":{\"username\":\"string\"},\"published_at\":\"2025-01-01T00:00:00Z\",\"title\":\"string\"}]"},
+	{method: "GET", pattern: "/projects/public/{id}", operationID: "get_public_project", status: 200, example: "{\"categories\":[{\"description\":\"string\",\"display_name\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"slug\":\"string\",\"sort_order\":0}],\"description\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"like_count\":0,\"owner\":{\"username\":\"string\"},\"published_at\":\"2025-01-01T00:00:00Z\",\"title\":\"string\"}"},
+	{method: "GET", pattern: "/projects/public/{id}/download", operationID: "download_public_project", status: 200},
+	{method: "GET", pattern: "/projects/public/{id}/thumbnail", operationID: "get_public_project_thumbnail", status: 200},
+	{method: "POST", pattern: "/projects/public/{id}/vote", operationID: "create_public_project_vote", status: 200, example: "{\"like_count\":0,\"liked\":false}"},
+	{method: "DELETE", pattern: "/projects/public/{id}/vote", operationID: "delete_public_project_vote", status: 200, example: "{\"like_count\":0,\"liked\":false}"},
+	{method: "GET", pattern: "/projects/shared/{key}/download", operationID: "download_shared_project", status: 200},
+	{method: "POST", pattern: "/store/coupon", operationID: "create_store_coupon", status: 201, example: "{\"code\":\"string\",\"percent_off\":0}"},
+	{method: "POST", pattern: "/subscription-plans/{slug}/prices", operationID: "upsert_subscription_plan_price", status: 200, example: "{\"active\":false,\"billing_model\":\"flat\",\"cadence\":\"day\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"subscription_plan_id\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/unit/conversion/angle/{input_unit}/{output_unit}", operationID: "get_angle_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"degrees\",\"output_unit\":\"degrees\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/area/{input_unit}/{output_unit}", operationID: "get_area_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"cm2\",\"output_unit\":\"cm2\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/current/{input_unit}/{output_unit}", operationID: "get_current_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"amperes\",\"output_unit\":\"amperes\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/energy/{input_unit}/{output_unit}", operationID: "get_energy_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"btu\",\"output_unit\":\"btu\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/force/{input_unit}/{output_unit}", operationID: "get_force_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"dynes\",\"output_unit\":\"dynes\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/frequency/{input_unit}/{output_unit}", operationID: "get_frequency_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"gigahertz\",\"output_unit\":\"gigahertz\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/length/{input_unit}/{output_unit}", operationID: "get_length_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"cm\",\"output_unit\":\"cm\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/mass/{input_unit}/{output_unit}", operationID: "get_mass_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"g\",\"output_unit\":\"g\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/power/{input_unit}/{output_unit}", operationID: "get_power_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"btu_per_minute\",\"output_unit\":\"btu_per_minute\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/pressure/{input_unit}/{output_unit}", operationID: "get_pressure_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"atmospheres\",\"output_unit\":\"atmospheres\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/temperature/{input_unit}/{output_unit}", operationID: "get_temperature_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"celsius\",\"output_unit\":\"celsius\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/torque/{input_unit}/{output_unit}", operationID: "get_torque_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"newton_metres\",\"output_unit\":\"newton_metres\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/unit/conversion/volume/{input_unit}/{output_unit}", operationID: "get_volume_unit_conversion", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"input_unit\":\"mm3\",\"output_unit\":\"mm3\",\"status\":\"queued\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/user", operationID: "get_user_self", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/user", operationID: "update_user_self", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/user", operationID: "delete_user_self", status: 204},
+	{method: "GET", pattern: "/user/api-calls", operationID: "user_list_api_calls", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "GET", pattern: "/user/api-calls/{id}", operationID: "get_api_call_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/user/api-tokens", operationID: "list_api_tokens_for_user", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "POST", pattern: "/user/api-tokens", operationID: "create_api_token_for_user", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/user/api-tokens/{token}", operationID: "get_api_token_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"is_valid\":false,\"token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "DELETE", pattern: "/user/api-tokens/{token}", operationID: "delete_api_token_for_user", status: 204},
+	{method: "GET", pattern: "/user/billing/usage-collection-threshold", operationID: "get_user_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "PUT", pattern: "/user/billing/usage-collection-threshold", operationID: "set_user_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "DELETE", pattern: "/user/billing/usage-collection-threshold", operationID: "reset_user_usage_collection_threshold", status: 200, example: "{\"admin_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"customer_bounds\":{\"maximum_amount\":0,\"minimum_amount\":0},\"default_amount\":0,\"effective_amount\":0,\"source\":\"admin\",\"version\":0}"},
+	{method: "GET", pattern: "/user/cad-user-info", operationID: "get_user_cad_user_info_form", status: 200, example: "{}"},
+	{method: "POST", pattern: "/user/client-errors", operationID: "report_user_client_error", status: 202, example: "{\"accepted\":false}"},
+	{method: "GET", pattern: "/user/email-marketing-consent", operationID: "user_email_marketing_consent_get", status: 200, example: "{\"is_subscribed\":false,\"should_show_prompt\":false,\"status\":\"unknown\"}"},
+	{method: "POST", pattern: "/user/email-marketing-consent/decline", operationID: "user_email_marketing_consent_decline_post", status: 204},
+	{method: "POST", pattern: "/user/email-marketing-consent/request", operationID: "user_email_marketing_consent_request_post", status: 204},
+	{method: "POST", pattern: "/user/email-marketing-consent/seen", operationID: "user_email_marketing_consent_seen_post", status: 204},
+	{method: "GET", pattern: "/user/extended", operationID: "get_user_self_extended", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/user/factory/finishes", operationID: "get_user_factory_finishes", status: 200, example: "[{\"name\":\"string\"}]"},
+	{method: "POST", pattern: "/user/factory/jobs", operationID: "create_user_factory_job", status: 201, example: "{\"id\":\"00000000-0000-4000-8000-000000000000\",\"status\":\"string\"}"},
+	{method: "GET", pattern: "/user/factory/materials", operationID: "get_user_factory_materials", status: 200, example: "[{\"name\":\"string\"}]"},
+	{method: "GET", pattern: "/user/features", operationID: "user_features_get", status: 200, example: "{\"features\":[{\"id\":\"auth_restricted_to_employees\"}]}"},
+	{method: "GET", pattern: "/user/oauth2/apps", operationID: "list_user_oauth2_apps", status: 200, example: "{\"items\":[{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "POST", pattern: "/user/oauth2/apps", operationID: "create_user_oauth2_app", status: 201, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/user/oauth2/apps/{client_id}", operationID: "get_user_oauth2_app", status: 200, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/user/oauth2/apps/{client_id}", operationID: "update_user_oauth2_app", status: 200, example: "{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/user/oauth2/apps/{client_id}", operationID: "delete_user_oauth2_app", status: 204},
+	{method: "GET", pattern: "/user/oauth2/providers", operationID: "get_oauth2_providers_for_user", status: 200, example: "[\"apple\"]"},
+	{method: "GET", pattern: "/user/org", operationID: "get_user_org", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"role\":\"admin\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/user/payment", operationID: "get_payment_information_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/user/payment", operationID: "create_payment_information_for_user", status: 201, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/user/payment", operationID: "update_payment_information_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/user/payment", operationID: "delete_payment_information_for_user", status: 204},
+	{method: "GET", pattern: "/user/payment/balance", operationID: "get_payment_balance_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "POST", pattern: "/user/payment/intent", operationID: "create_payment_intent_for_user", status: 201, example: "{\"client_secret\":\"string\"}"},
+	{method: "GET", pattern: "/user/payment/invoices", operationID: "list_invoices_for_user", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/user/payment/method-portal-link", operationID: "redirect_payment_method_portal_link_for_user", status: 200},
+	{method: "GET", pattern: "/user/payment/methods", operationID: "list_payment_methods_for_user", status: 200, example: "[{\"billing_info\":{},\"created_at\":\"2025-01-01T00:00:00Z\",\"type\":\"card\"}]"},
+	{method: "DELETE", pattern: "/user/payment/methods/{id}", operationID: "delete_payment_method_for_user", status: 204},
+	{method: "POST", pattern: "/user/payment/methods/{id}/default", operationID: "set_default_payment_method_for_user", status: 204},
+	{method: "GET", pattern: "/user/payment/subscriptions", operationID: "get_user_subscription", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "POST", pattern: "/user/payment/subscriptions", operationID: "create_user_subscription", status: 201, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "PUT", pattern: "/user/payment/subscriptions", operationID: "update_user_subscription", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "GET", pattern: "/user/payment/tax", operationID: "validate_customer_tax_information_for_user", status: 204},
+	{method: "GET", pattern: "/user/privacy", operationID: "get_user_privacy_settings", status: 200, example: "{\"can_train_on_data\":false}"},
+	{method: "PUT", pattern: "/user/privacy", operationID: "update_user_privacy_settings", status: 200, example: "{\"can_train_on_data\":false}"},
+	{method: "GET", pattern: "/user/projects", operationID: "list_projects", status: 200, example: "[{\"category_ids\":[\"00000000-0000-4000-8000-000000000000\"],\"created_at\":\"2025-01-01T00:00:00Z\",\"description\":\"string\",\"entrypoint_path\":\"string\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"preview_status\":\"pending\",\"project_toml_path\":\"string\",\"publication\":{\"has_unpublished_changes\":false},\"publication_status\":\"private\",\"revision\":\"string\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}]"},
+	{method: "POST", pattern: "/user/projects", operationID: "create_project", status: 201, example: "{\"category_ids\":[\"00000000-0000-4000-8000-000000000000\"],\"created_at\":\"2025-01-01T00:00:00Z\",\"description\":\"string\",\"entrypoint_path\":\"string\",\"files\":[{\"byte_size\":0,\"content_type\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"file_role\":\"project_toml\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"relative_path\":\"string\",\"sort_order\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}],\"id\":\"00000000-0000-4000-8000-000000000000\",\"preview_status\":\"pending\",\"project_toml_path\":\"string\",\"publication\":{\"has_unpublished_changes\":false},\"publication_status\":\"private\",\"revision\":\"string\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/user/projects/{id}", operationID: "get_project", status: 200, example: "{\"category_ids\":[\"00000000-0000-4000-8000-000000000000\"],\"created_at\":\"2025-01-01T00:00:00Z\",\"description\":\"string\",\"entrypoint_path\":\"string\",\"files\":[{\"byte_size\":0,\"content_type\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"file_role\":\"project_toml\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"relative_path\":\"string\",\"sort_order\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}],\"id\":\"00000000-0000-4000-8000-000000000000\",\"preview_status\":\"pending\",\"project_toml_path\":\"string\",\"publication\":{\"has_unpublished_changes\":false},\"publication_status\":\"private\",\"revision\":\"string\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/user/projects/{id}", operationID: "update_project", status: 200, example: "{\"category_ids\":[\"00000000-0000-4000-8000-000000000000\"],\"created_at\":\"2025-01-01T00:00:00Z\",\"description\":\"string\",\"entrypoint_path\":\"string\",\"files\":[{\"byte_size\":0,\"content_type\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"file_role\":\"project_toml\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"relative_path\":\"string\",\"sort_order\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}],\"id\":\"00000000-0000-4000-8000-000000000000\",\"preview_status\":\"pending\",\"project_toml_path\":\"string\",\"publication\":{\"has_unpublished_changes\":false},\"publication_status\":\"private\",\"revision\":\"string\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "DELETE", pattern: "/user/projects/{id}", operationID: "delete_project", status: 204},
+	{method: "GET", pattern: "/user/projects/{id}/download", operationID: "download_project", status: 200},
+	{method: "POST", pattern: "/user/projects/{id}/publish", operationID: "publish_project", status: 200, example: "{\"category_ids\":[\"00000000-0000-4000-8000-000000000000\"],\"created_at\":\"2025-01-01T00:00:00Z\",\"description\":\"string\",\"entrypoint_path\":\"string\",\"files\":[{\"byte_size\":0,\"content_type\":\"string\",\"created_at\":\"2025-01-01T00:00:00Z\",\"file_role\":\"project_toml\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"relative_path\":\"string\",\"sort_order\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}],\"id\":\"00000000-0000-4000-8000-000000000000\",\"preview_status\":\"pending\",\"project_toml_path\":\"string\",\"publication\":{\"has_unpublished_changes\":false},\"publication_status\":\"private\",\"revision\":\"string\",\"title\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/user/projects/{id}/share-links", operationID: "list_project_share_links", status: 200, example: "[{\"access_mode\":\"anyone_with_link\",\"created_at\":\"2025-01-01T00:00:00Z\",\"key\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"url\":\"https://example.com\"}]"},
+	{method: "POST", pattern: "/user/projects/{id}/share-links", operationID: "create_project_share_link", status: 201, example: "{\"access_mode\":\"anyone_with_link\",\"created_at\":\"2025-01-01T00:00:00Z\",\"key\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"url\":\"https://example.com\"}"},
+	{method: "DELETE", pattern: "/user/projects/{id}/share-links/{key}", operationID: "delete_project_share_link", status: 204},
+	{method: "GET", pattern: "/user/projects/{id}/thumbnail", operationID: "get_project_thumbnail", status: 200},
+	{method: "GET", pattern: "/user/session/{token}", operationID: "get_session_for_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"expires\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"session_token\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "GET", pattern: "/user/shortlinks", operationID: "get_user_shortlinks", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"key\":\"string\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\",\"value\":\"https://example.com\"}]}"},
+	{method: "POST", pattern: "/user/shortlinks", operationID: "create_user_shortlink", status: 201, example: "{\"key\":\"string\",\"url\":\"https://example.com\"}"},
+	{method: "GET", pattern: "/user/shortlinks/{key}", operationID: "redirect_user_shortlink", status: 200},
+	{method: "PUT", pattern: "/user/shortlinks/{key}", operationID: "update_user_shortlink", status: 204},
+	{method: "DELETE", pattern: "/user/shortlinks/{key}", operationID: "delete_user_shortlink", status: 204},
+	{method: "GET", pattern: "/user/text-to-cad", operationID: "list_text_to_cad_parts_for_user", status: 200, example: "{\"items\":[{\"conversation_id\":\"00000000-0000-4000-8000-000000000000\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"model\":\"cad\",\"model_version\":\"string\",\"output_format\":\"fbx\",\"prompt\":\"string\",\"status\":\"queued\",\"type\":\"text_to_cad\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "GET", pattern: "/user/text-to-cad/{id}", operationID: "get_text_to_cad_part_for_user", status: 200, example: "{\"conversation_id\":\"00000000-0000-4000-8000-000000000000\",\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"model\":\"cad\",\"model_version\":\"string\",\"output_format\":\"fbx\",\"prompt\":\"string\",\"status\":\"queued\",\"type\":\"text_to_cad\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}"},
+	{method: "POST", pattern: "/user/text-to-cad/{id}", operationID: "create_text_to_cad_part_feedback", status: 204},
+	{method: "GET", pattern: "/users-extended/{id}", operationID: "get_user_extended", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/users/{id}", operationID: "get_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"image\":\"https://example.com\",\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "GET", pattern: "/users/{id}/admin/details", operationID: "user_admin_details_get", status: 200, example: "{\"active_api_tokens_count\":0,\"active_device_tokens_count\":0,\"active_sessions_count\":0,\"never_block\":false,\"payment_methods\":[{\"billing_info\":{},\"created_at\":\"2025-01-01T00:00:00Z\",\"type\":\"card\"}],\"payment_methods_summary\":[\"string\"]}"},
+	{method: "GET", pattern: "/users/{id}/api-calls", operationID: "list_api_calls_for_user", status: 200, example: "{\"items\":[{\"created_at\":\"2025-01-01T00:00:00Z\",\"id\":\"00000000-0000-4000-8000-000000000000\",\"method\":\"OPTIONS\",\"token\":\"00000000-0000-4000-8000-000000000000\",\"updated_at\":\"2025-01-01T00:00:00Z\",\"user_agent\":\"string\",\"user_id\":\"00000000-0000-4000-8000-000000000000\"}]}"},
+	{method: "GET", pattern: "/users/{id}/oauth2/apps", operationID: "list_oauth2_apps_for_any_user", status: 200, example: "{\"items\":[{\"client_id\":\"00000000-0000-4000-8000-000000000000\",\"client_type\":\"public\",\"created_at\":\"2025-01-01T00:00:00Z\",\"first_party\":false,\"grant_types\":[\"device_code\"],\"is_active\":false,\"mode\":\"development\",\"name\":\"string\",\"redirect_uris\":[\"https://example.com\"],\"updated_at\":\"2025-01-01T00:00:00Z\"}]}"},
+	{method: "GET", pattern: "/users/{id}/payment/balance", operationID: "get_payment_balance_for_any_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/users/{id}/payment/balance", operationID: "update_payment_balance_for_any_user", status: 200, example: "{\"created_at\":\"2025-01-01T00:00:00Z\",\"monthly_api_credits_remaining\":0,\"monthly_api_credits_remaining_monetary_value\":0,\"stable_api_credits_remaining\":0,\"stable_api_credits_remaining_monetary_value\":0,\"updated_at\":\"2025-01-01T00:00:00Z\"}"},
+	{method: "PUT", pattern: "/users/{id}/payment/subscriptions", operationID: "update_subscription_for_user", status: 200, example: "{\"modeling_app\":{\"description\":\"string\",\"name\":\"string\",\"price\":{\"interval\":\"day\",\"price\":0,\"type\":\"flat\"},\"support_tier\":\"community\",\"training_data_behavior\":\"always\",\"type\":{\"type\":\"individual\"}}}"},
+	{method: "PUT", pattern: "/website/email-marketing-consent/request", operationID: "put_public_email_marketing_consent_request", status: 204},
+	{method: "PUT", pattern: "/website/email-marketing-lists/{slug}/subscribe", operationID: "put_public_mailing_list_subscribe", status: 204},
+	{method: "PUT", pattern: "/website/email-marketing-lists/{slug}/unsubscribe", operationID: "put_public_mailing_list_unsubscribe", status: 204},
+	{method: "PUT", pattern: "/website/forms/cad-user-info", operationID: "put_user_cad_user_info_form", status: 204},
+	{method: "PUT", pattern: "/website/forms/sales", operationID: "put_public_sales_form", status: 204},
+	{method: "PUT", pattern: "/website/forms/support", operationID: "put_public_support_form", status: 204},
+}
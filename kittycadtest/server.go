@@ -0,0 +1,339 @@
+// Package kittycadtest provides an in-process fake of the Zoo API, for
+// testing code using the kittycad package without network access or an API
+// token.
+//
+// The server keeps API tokens, the user, the org and its members, shortlinks,
+// projects and async operations in memory. Every other operation answers with
+// an example response built from the API spec.
+//
+// For tests against the real API, Recorder records the interactions to
+// fixture files, and replays them in later runs. EngineReplay replays
+// modeling sessions recorded by kittycad.ModelingRecorder.
+package kittycadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kittycad/kittycad.go"
+)
+
+// handlers are the operations the server implements itself, by operation ID.
+var handlers = map[string]func(s *Server, w http.ResponseWriter, r *http.Request, params map[string]string){
+	"get_user_self":    (*Server).getUserSelf,
+	"update_user_self": (*Server).updateUserSelf,
+	"delete_user_self": (*Server).deleteUserSelf,
+
+	"list_api_tokens_for_user":  (*Server).listAPITokens,
+	"create_api_token_for_user": (*Server).createAPIToken,
+	"get_api_token_for_user":    (*Server).getAPIToken,
+	"delete_api_token_for_user": (*Server).deleteAPIToken,
+
+	"get_org":           (*Server).getOrg,
+	"create_org":        (*Server).createOrg,
+	"update_org":        (*Server).updateOrg,
+	"delete_org":        (*Server).deleteOrg,
+	"get_user_org":      (*Server).getUserOrg,
+	"list_org_members":  (*Server).listOrgMembers,
+	"create_org_member": (*Server).createOrgMember,
+	"get_org_member":    (*Server).getOrgMember,
+	"update_org_member": (*Server).updateOrgMember,
+	"delete_org_member": (*Server).deleteOrgMember,
+
+	"get_user_shortlinks":     (*Server).listShortlinks,
+	"create_user_shortlink":   (*Server).createShortlink,
+	"redirect_user_shortlink": (*Server).redirectShortlink,
+	"update_user_shortlink":   (*Server).updateShortlink,
+	"delete_user_shortlink":   (*Server).deleteShortlink,
+
+	"list_projects":  (*Server).listProjects,
+	"create_project": (*Server).createProject,
+	"get_project":    (*Server).getProject,
+	"update_project": (*Server).updateProject,
+	"delete_project": (*Server).deleteProject,
+
+	"get_async_operation": (*Server).getAsyncOperation,
+}
+
+// Server is a fake Zoo API server, listening on a local address.
+type Server struct {
+	// URL is the base URL of the server, for example http://127.0.0.1:1234.
+	URL string
+
+	server *httptest.Server
+	token  string
+
+	mu          sync.Mutex
+	user        kittycad.UserResponse
+	tokens      []kittycad.APIToken
+	org         *kittycad.Org
+	members     []kittycad.OrgMember
+	shortlinks  []kittycad.Shortlink
+	projects    []kittycad.ProjectResponse
+	operations  map[string]map[string]any
+	autoAdvance bool
+}
+
+// NewServer starts a new fake server, with a user and an API token for it.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	now := kittycad.TimeNow()
+	s := &Server{
+		user: kittycad.UserResponse{
+			CreatedAt: now,
+			Email:     new("user@example.com"),
+			ID:        newID(),
+			Image:     parseURL("https://example.com/avatar.png"),
+			Name:      new("Test User"),
+			UpdatedAt: now,
+		},
+		operations: map[string]map[string]any{},
+	}
+	s.tokens = []kittycad.APIToken{{
+		CreatedAt: now,
+		ID:        newID(),
+		IsValid:   true,
+		Token:     newToken(),
+		UpdatedAt: now,
+		UserID:    s.user.ID,
+	}}
+
+	s.token = s.tokens[0].Token
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// NewClient starts a fake server and returns a client using it. The server
+// is closed when the test finishes.
+func NewClient(t testing.TB) (*kittycad.Client, *Server) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	client, err := s.Client()
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	return client, s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Token returns the API token the server was started with.
+func (s *Server) Token() string {
+	return s.token
+}
+
+// User returns the user the server was started with.
+func (s *Server) User() kittycad.UserResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.user
+}
+
+// Client returns a client for the server, authenticated as its user.
+func (s *Server) Client() (*kittycad.Client, error) {
+	client, err := kittycad.NewClient(s.Token(), "kittycadtest")
+	if err != nil {
+		return nil, err
+	}
+	if err := client.WithBaseURL(s.URL); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// The API returns an ID for every call, for reporting problems.
+	w.Header().Set("X-Api-Call-Id", uuid.NewString())
+	w.Header().Set("Cache-Control", "no-store")
+
+	rt, params, err := matchRoute(r.Method, r.URL.Path)
+	if err != nil {
+		writeError(w, err.status, err.message)
+		return
+	}
+
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, "invalid or missing API token")
+		return
+	}
+
+	if handler, ok := handlers[rt.operationID]; ok {
+		handler(s, w, r, params)
+		return
+	}
+	if rt.asyncType != "" {
+		s.createAsyncOperation(w, rt, params)
+		return
+	}
+
+	if rt.example == "" {
+		w.WriteHeader(rt.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(rt.status)
+	w.Write([]byte(rt.example))
+}
+
+// authorized returns whether the request has a valid API token.
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.tokens {
+		if t.Token == token && t.IsValid {
+			return true
+		}
+	}
+	return false
+}
+
+type routeError struct {
+	status  int
+	message string
+}
+
+// matchRoute returns the route for the request and its path parameters.
+// When several routes match, the one with the most literal segments wins,
+// so /user/api-tokens is preferred over /user/{id}.
+func matchRoute(method string, path string) (route, map[string]string, *routeError) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var best *route
+	var bestParams map[string]string
+	bestLiterals := -1
+	methodMismatch := false
+	for i := range routes {
+		rt := &routes[i]
+		params, literals, ok := matchPattern(rt.pattern, segments)
+		if !ok {
+			continue
+		}
+		if rt.method != method {
+			methodMismatch = true
+			continue
+		}
+		if literals > bestLiterals {
+			best, bestParams, bestLiterals = rt, params, literals
+		}
+	}
+
+	if best == nil {
+		if methodMismatch {
+			return route{}, nil, &routeError{http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed for %s", method, path)}
+		}
+		return route{}, nil, &routeError{http.StatusNotFound, fmt.Sprintf("no operation for %s", path)}
+	}
+	return *best, bestParams, nil
+}
+
+// matchPattern matches the path segments against the pattern, returning the
+// path parameters and the number of literal segments.
+func matchPattern(pattern string, segments []string) (map[string]string, int, bool) {
+	parts := strings.Split(strings.Trim(pattern, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil, 0, false
+	}
+
+	params := map[string]string{}
+	literals := 0
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			params[strings.Trim(part, "{}")] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, kittycad.Error{
+		Message:   message,
+		RequestID: uuid.NewString(),
+	})
+}
+
+// decodeJSON decodes the request body into v, writing a bad request error
+// if it fails.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("decoding the request body failed: %v", err))
+		return false
+	}
+	return true
+}
+
+// paginate returns the page of items selected by the limit, page_token and
+// sort_by query parameters, and the token of the next page if there is one.
+// The items are expected in creation order.
+func paginate[T any](items []T, r *http.Request) ([]T, *string) {
+	query := r.URL.Query()
+
+	sorted := append([]T{}, items...)
+	if query.Get("sort_by") == string(kittycad.CreatedAtSortModeCreatedAtDescending) {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+
+	start, _ := strconv.Atoi(query.Get("page_token"))
+	start = min(max(start, 0), len(sorted))
+	end := len(sorted)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		end = min(start+limit, len(sorted))
+	}
+
+	var next *string
+	if end < len(sorted) {
+		next = new(strconv.Itoa(end))
+	}
+	return sorted[start:end], next
+}
+
+func newID() kittycad.UUID {
+	id := uuid.New()
+	return kittycad.UUID{UUID: &id}
+}
+
+func newToken() string {
+	return "api-" + uuid.NewString()
+}
+
+// parseURL parses a URL from a string, like kittycad.ParseUUID.
+func parseURL(s string) kittycad.URL {
+	u, _ := url.Parse(s)
+	return kittycad.URL{URL: u}
+}
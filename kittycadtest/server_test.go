@@ -0,0 +1,296 @@
+package kittycadtest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+func TestUser(t *testing.T) {
+	client, server := NewClient(t)
+
+	user, err := client.User.GetSelf()
+	if err != nil {
+		t.Fatalf("getting the user failed: %v", err)
+	}
+	if user.ID.String() != server.User().ID.String() {
+		t.Fatalf("got user %s, expected %s", user.ID, server.User().ID)
+	}
+
+	updated, err := client.User.UpdateSelf(kittycad.UpdateUser{
+		Image:     user.Image,
+		FirstName: new("Ada"),
+	})
+	if err != nil {
+		t.Fatalf("updating the user failed: %v", err)
+	}
+	if updated.FirstName == nil || *updated.FirstName != "Ada" {
+		t.Fatalf("the first name was not updated: %v", updated.FirstName)
+	}
+
+	// Fields which are not sent are kept.
+	updated, err = client.User.UpdateSelf(kittycad.UpdateUser{
+		Image:    user.Image,
+		LastName: new("Lovelace"),
+	})
+	if err != nil {
+		t.Fatalf("updating the user failed: %v", err)
+	}
+	if updated.FirstName == nil || *updated.FirstName != "Ada" || updated.LastName == nil || *updated.LastName != "Lovelace" {
+		t.Fatalf("expected Ada Lovelace, got %v %v", updated.FirstName, updated.LastName)
+	}
+	if updated.Image.String() != user.Image.String() {
+		t.Fatalf("the image changed from %s to %s", user.Image, updated.Image)
+	}
+}
+
+func TestOrgPartialUpdate(t *testing.T) {
+	client, _ := NewClient(t)
+
+	if _, err := client.Org.Create(kittycad.OrgDetails{Name: new("Zoo"), Domain: new("zoo.dev"), BillingEmail: new("billing@example.com")}); err != nil {
+		t.Fatalf("creating the org failed: %v", err)
+	}
+	org, err := client.Org.Update(kittycad.OrgDetails{Phone: new("+1 555 0100")})
+	if err != nil {
+		t.Fatalf("updating the org failed: %v", err)
+	}
+	if org.Phone == nil || *org.Phone != "+1 555 0100" {
+		t.Fatalf("the phone was not updated: %v", org.Phone)
+	}
+	if org.Name == nil || *org.Name != "Zoo" || org.Domain == nil || *org.Domain != "zoo.dev" || org.BillingEmail != "billing@example.com" {
+		t.Fatalf("expected the other details to be kept, got %+v", org)
+	}
+}
+
+func TestAPITokens(t *testing.T) {
+	client, server := NewClient(t)
+
+	created, err := client.APIToken.CreateForUser(kittycad.APITokenCreateForUserParams{Label: new("ci")})
+	if err != nil {
+		t.Fatalf("creating the token failed: %v", err)
+	}
+
+	page, err := client.APIToken.ListForUser(kittycad.APITokenListForUserParams{Limit: new(1)})
+	if err != nil {
+		t.Fatalf("listing the tokens failed: %v", err)
+	}
+	if len(page.Items) != 1 || page.NextPage == nil {
+		t.Fatalf("expected a page with one token and a next page, got %d tokens and %v", len(page.Items), page.NextPage)
+	}
+
+	page, err = client.APIToken.ListForUser(kittycad.APITokenListForUserParams{PageToken: page.NextPage})
+	if err != nil {
+		t.Fatalf("listing the next page failed: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Token != created.Token || page.NextPage != nil {
+		t.Fatalf("expected the last page with the created token, got %+v", page)
+	}
+
+	token, err := client.APIToken.GetForUser(created.Token)
+	if err != nil {
+		t.Fatalf("getting the token failed: %v", err)
+	}
+	if token.Label == nil || *token.Label != "ci" {
+		t.Fatalf("the token label is not ci: %v", token.Label)
+	}
+
+	if err := client.APIToken.DeleteForUser(server.Token()); err != nil {
+		t.Fatalf("deleting the token failed: %v", err)
+	}
+	_, err = client.User.GetSelf()
+	var httpErr *kittycad.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an unauthorized error after deleting the token, got %v", err)
+	}
+}
+
+func TestOrgMembers(t *testing.T) {
+	client, _ := NewClient(t)
+
+	if _, err := client.Org.Get(); err == nil {
+		t.Fatalf("expected an error getting the org before creating it")
+	}
+
+	if _, err := client.Org.Create(kittycad.OrgDetails{Name: new("Zoo"), BillingEmail: new("billing@example.com")}); err != nil {
+		t.Fatalf("creating the org failed: %v", err)
+	}
+
+	member, err := client.Org.CreateMember(kittycad.AddOrgMember{Email: "member@example.com", Role: kittycad.UserOrgRoleMember})
+	if err != nil {
+		t.Fatalf("creating the member failed: %v", err)
+	}
+
+	if _, err := client.Org.UpdateMember(member.ID, kittycad.UpdateMemberToOrgBody{Role: kittycad.UserOrgRoleAdmin}); err != nil {
+		t.Fatalf("updating the member failed: %v", err)
+	}
+
+	page, err := client.Org.ListMembers(kittycad.OrgListMembersParams{Role: new(kittycad.UserOrgRoleAdmin)})
+	if err != nil {
+		t.Fatalf("listing the members failed: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Fatalf("expected the creator and the promoted member as admins, got %d members", len(page.Items))
+	}
+
+	info, err := client.Org.GetUser()
+	if err != nil {
+		t.Fatalf("getting the user org failed: %v", err)
+	}
+	if info.Role != kittycad.OrgRoleAdmin {
+		t.Fatalf("expected the creator to be an admin, got %s", info.Role)
+	}
+
+	if err := client.Org.DeleteMember(member.ID); err != nil {
+		t.Fatalf("deleting the member failed: %v", err)
+	}
+	if _, err := client.Org.GetMember(member.ID); err == nil {
+		t.Fatalf("expected an error getting a deleted member")
+	}
+}
+
+func TestShortlinks(t *testing.T) {
+	client, _ := NewClient(t)
+
+	created, err := client.User.CreateShortlink(kittycad.CreateShortlinkRequest{Url: parseURL("https://zoo.dev/docs")})
+	if err != nil {
+		t.Fatalf("creating the shortlink failed: %v", err)
+	}
+
+	if err := client.User.UpdateShortlink(created.Key, kittycad.UpdateShortlinkRequest{RestrictToOrg: true}); err != nil {
+		t.Fatalf("updating the shortlink failed: %v", err)
+	}
+
+	page, err := client.User.GetShortlinks(kittycad.UserGetShortlinksParams{})
+	if err != nil {
+		t.Fatalf("listing the shortlinks failed: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].RestrictToOrg == nil || !*page.Items[0].RestrictToOrg {
+		t.Fatalf("expected the updated shortlink, got %+v", page.Items)
+	}
+
+	if err := client.User.DeleteShortlink(created.Key); err != nil {
+		t.Fatalf("deleting the shortlink failed: %v", err)
+	}
+	if err := client.User.DeleteShortlink(created.Key); err == nil {
+		t.Fatalf("expected an error deleting a deleted shortlink")
+	}
+}
+
+func TestProjects(t *testing.T) {
+	client, _ := NewClient(t)
+
+	form := kittycad.NewMultipartForm()
+	if err := form.WriteJSONField("body", map[string]string{"title": "Cube"}); err != nil {
+		t.Fatalf("writing the body failed: %v", err)
+	}
+	if err := form.WriteFilePart("main.kcl", "main.kcl", "text/plain", []byte("sideLength = 10\n")); err != nil {
+		t.Fatalf("writing the file failed: %v", err)
+	}
+
+	project, err := client.Project.Create(form)
+	if err != nil {
+		t.Fatalf("creating the project failed: %v", err)
+	}
+	if project.Title != "Cube" || len(project.Files) != 1 || project.Files[0].RelativePath != "main.kcl" {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+
+	projects, err := client.Project.List()
+	if err != nil {
+		t.Fatalf("listing the projects failed: %v", err)
+	}
+	if len(*projects) != 1 || (*projects)[0].ID.String() != project.ID.String() {
+		t.Fatalf("expected the created project, got %+v", *projects)
+	}
+
+	if err := client.Project.Delete(project.ID); err != nil {
+		t.Fatalf("deleting the project failed: %v", err)
+	}
+	if _, err := client.Project.Get(project.ID); err == nil {
+		t.Fatalf("expected an error getting a deleted project")
+	}
+}
+
+func TestAsyncOperations(t *testing.T) {
+	client, server := NewClient(t)
+
+	fc, err := client.File.CreateConversion(kittycad.FileImportFormatStl, kittycad.FileExportFormatObj, []byte("solid"))
+	if err != nil {
+		t.Fatalf("creating the conversion failed: %v", err)
+	}
+	if fc.Status != kittycad.APICallStatusQueued || fc.SrcFormat != kittycad.FileImportFormatStl {
+		t.Fatalf("expected a queued stl conversion, got %s %s", fc.Status, fc.SrcFormat)
+	}
+
+	status := func() string {
+		t.Helper()
+		result, err := client.APICall.GetAsyncOperation(fc.ID)
+		if err != nil {
+			t.Fatalf("getting the async operation failed: %v", err)
+		}
+		op := (*result).(map[string]any)
+		if op["type"] != "file_conversion" {
+			t.Fatalf("unexpected async operation type %v", op["type"])
+		}
+		return op["status"].(string)
+	}
+
+	if got := status(); got != "queued" {
+		t.Fatalf("expected the operation to stay queued, got %s", got)
+	}
+
+	if err := server.SetAsyncOperationStatus(fc.ID, kittycad.APICallStatusFailed); err != nil {
+		t.Fatalf("setting the status failed: %v", err)
+	}
+	if got := status(); got != "failed" {
+		t.Fatalf("expected the operation to have failed, got %s", got)
+	}
+
+	if err := server.SetAsyncOperationStatus(fc.ID, kittycad.APICallStatusQueued); err != nil {
+		t.Fatalf("setting the status failed: %v", err)
+	}
+	server.SetAutoAdvance(true)
+	for _, want := range []string{"in_progress", "completed", "completed"} {
+		if got := status(); got != want {
+			t.Fatalf("got status %s, expected %s", got, want)
+		}
+	}
+}
+
+func TestExampleResponses(t *testing.T) {
+	client, _ := NewClient(t)
+
+	if _, err := client.Meta.Ping(); err != nil {
+		t.Fatalf("pinging the server failed: %v", err)
+	}
+}
+
+func TestMatchRoute(t *testing.T) {
+	tests := []struct {
+		method      string
+		path        string
+		operationID string
+		status      int
+	}{
+		{http.MethodGet, "/user/api-tokens", "list_api_tokens_for_user", 0},
+		{http.MethodGet, "/user/api-tokens/abc", "get_api_token_for_user", 0},
+		{http.MethodGet, "/users/abc", "get_user", 0},
+		{http.MethodPatch, "/user/api-tokens", "", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/does/not/exist", "", http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		rt, _, err := matchRoute(test.method, test.path)
+		if err != nil {
+			if err.status != test.status {
+				t.Errorf("%s %s: got status %d, expected %d", test.method, test.path, err.status, test.status)
+			}
+			continue
+		}
+		if rt.operationID != test.operationID {
+			t.Errorf("%s %s: got %s, expected %s", test.method, test.path, rt.operationID, test.operationID)
+		}
+	}
+}
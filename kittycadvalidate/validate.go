@@ -0,0 +1,287 @@
+// Package kittycadvalidate checks the requests sent by the kittycad client,
+// and the responses of the API, against the API spec.
+//
+// It is meant for catching drift between the server and the generated types,
+// for example in staging, before it breaks decoding in production:
+//
+//	doc, err := kittycadvalidate.LoadSpec("spec.json")
+//	...
+//	transport, err := kittycadvalidate.NewTransport(doc)
+//	...
+//	transport.OnDrift = func(err *kittycadvalidate.DriftError) { log.Print(err) }
+//	client.WithTransport(transport)
+package kittycadvalidate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// LoadSpec loads the API spec from a file, for example the spec.json the
+// client was generated from.
+func LoadSpec(path string) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading openAPI spec: %v", err)
+	}
+	return doc, nil
+}
+
+// Issue is a part of a request or response which does not match the spec.
+type Issue struct {
+	// Location is the part of the request or response, for example "body" or
+	// "query parameter \"limit\"".
+	Location string
+	// Pointer is the JSON pointer to the value in the body, if the issue is
+	// in the body. It is empty for the whole body.
+	Pointer string
+	// Message describes the issue.
+	Message string
+}
+
+func (i Issue) String() string {
+	if i.Pointer != "" {
+		return fmt.Sprintf("%s at %s: %s", i.Location, i.Pointer, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", i.Location, i.Message)
+}
+
+// DriftError is returned or reported when a request or response does not
+// match the spec.
+type DriftError struct {
+	// Operation is the ID of the operation in the spec, or the method and path
+	// of the request if no operation matches it.
+	Operation string
+	// Response is whether the response has drifted, rather than the request.
+	Response bool
+	// Issues are the parts which do not match the spec.
+	Issues []Issue
+}
+
+// Error converts the DriftError to a readable string.
+func (err *DriftError) Error() string {
+	kind := "request"
+	if err.Response {
+		kind = "response"
+	}
+
+	issues := []string{}
+	for _, issue := range err.Issues {
+		issues = append(issues, issue.String())
+	}
+	return fmt.Sprintf("%s of %s does not match the spec: %s", kind, err.Operation, strings.Join(issues, "; "))
+}
+
+// Transport is an http.RoundTripper which validates the requests and
+// responses against the spec. Set it as the transport of the client with
+// kittycad.Client.WithTransport.
+type Transport struct {
+	// Base sends the requests. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+	// OnDrift is called with every request or response which does not match
+	// the spec. If nil, the drift is returned as an error by RoundTrip
+	// instead, and requests which do not match are not sent.
+	OnDrift func(err *DriftError)
+
+	router routers.Router
+}
+
+// NewTransport creates a transport validating against the spec. The servers
+// of the spec are ignored, so it works with any base URL.
+func NewTransport(doc *openapi3.T) (*Transport, error) {
+	d := *doc
+	d.Servers = nil
+	router, err := legacy.NewRouter(&d)
+	if err != nil {
+		return nil, fmt.Errorf("creating the router for the spec failed: %v", err)
+	}
+
+	return &Transport{router: router}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	route, pathParams, err := t.router.FindRoute(req)
+	if err != nil {
+		drift := &DriftError{
+			Operation: fmt.Sprintf("%s %s", req.Method, req.URL.Path),
+			Issues:    []Issue{{Location: "path", Message: err.Error()}},
+		}
+		if err := t.report(drift); err != nil {
+			return nil, err
+		}
+		return base.RoundTrip(req)
+	}
+
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the request body failed: %v", err)
+	}
+
+	options := &openapi3filter.Options{
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		ExcludeRequestBody:  !isJSON(req.Header.Get("Content-Type")),
+		ExcludeResponseBody: true,
+		MultiError:          true,
+		SkipSettingDefaults: true,
+	}
+
+	// Validate a copy of the request, so the validation does not consume the body.
+	validated := req.Clone(req.Context())
+	validated.Body = io.NopCloser(bytes.NewReader(body))
+	requestInput := &openapi3filter.RequestValidationInput{
+		Request:    validated,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    options,
+	}
+	if err := openapi3filter.ValidateRequest(context.Background(), requestInput); err != nil {
+		if err := t.report(&DriftError{Operation: route.Operation.OperationID, Issues: issues(err)}); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the response body failed: %v", err)
+	}
+
+	responseOptions := *options
+	responseOptions.ExcludeResponseBody = !isJSON(resp.Header.Get("Content-Type"))
+	responseOptions.IncludeResponseStatus = true
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 resp.StatusCode,
+		Header:                 resp.Header,
+		Options:                &responseOptions,
+	}
+	responseInput.SetBodyBytes(respBody)
+	if err := openapi3filter.ValidateResponse(context.Background(), responseInput); err != nil {
+		if err := t.report(&DriftError{Operation: route.Operation.OperationID, Response: true, Issues: issues(err)}); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// report reports the drift to OnDrift, or returns it if OnDrift is not set.
+func (t *Transport) report(drift *DriftError) error {
+	if t.OnDrift == nil {
+		return drift
+	}
+	t.OnDrift(drift)
+	return nil
+}
+
+// readBody reads the body, replacing it with a reader of the same contents.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// issues converts the validation error to issues.
+func issues(err error) []Issue {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		all := []Issue{}
+		for _, sub := range e {
+			all = append(all, issues(sub)...)
+		}
+		return all
+	case *openapi3filter.RequestError:
+		location := "request"
+		if e.Parameter != nil {
+			location = fmt.Sprintf("%s parameter %q", e.Parameter.In, e.Parameter.Name)
+		} else if e.RequestBody != nil {
+			location = "body"
+		}
+		return withLocation(location, e.Err, e.Error())
+	case *openapi3filter.ResponseError:
+		location := "response"
+		if e.Err != nil {
+			location = "body"
+		}
+		return withLocation(location, e.Err, e.Error())
+	}
+
+	return []Issue{{Location: "request", Message: err.Error()}}
+}
+
+// withLocation returns the issues of the schema errors in err, or a single
+// issue with the message if there are none.
+func withLocation(location string, err error, message string) []Issue {
+	var schemaErrs []*openapi3.SchemaError
+	collectSchemaErrors(err, &schemaErrs)
+	if len(schemaErrs) == 0 {
+		return []Issue{{Location: location, Message: message}}
+	}
+
+	all := []Issue{}
+	for _, e := range schemaErrs {
+		reason := e.Reason
+		if reason == "" {
+			reason = e.Error()
+		}
+		all = append(all, Issue{Location: location, Pointer: jsonPointer(e.JSONPointer()), Message: reason})
+	}
+	return all
+}
+
+func collectSchemaErrors(err error, schemaErrs *[]*openapi3.SchemaError) {
+	if err == nil {
+		return
+	}
+	if multi, ok := err.(openapi3.MultiError); ok {
+		for _, e := range multi {
+			collectSchemaErrors(e, schemaErrs)
+		}
+		return
+	}
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		*schemaErrs = append(*schemaErrs, schemaErr)
+	}
+}
+
+// jsonPointer formats the path as a JSON pointer, as defined in RFC 6901.
+func jsonPointer(path []string) string {
+	pointer := ""
+	for _, p := range path {
+		pointer += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(p)
+	}
+	return pointer
+}
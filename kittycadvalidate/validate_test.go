@@ -0,0 +1,87 @@
+package kittycadvalidate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+	"github.com/kittycad/kittycad.go/kittycadtest"
+)
+
+func newTransport(t *testing.T) *Transport {
+	t.Helper()
+
+	doc, err := LoadSpec("../spec.json")
+	if err != nil {
+		t.Fatalf("loading the spec failed: %v", err)
+	}
+	transport, err := NewTransport(doc)
+	if err != nil {
+		t.Fatalf("creating the transport failed: %v", err)
+	}
+	return transport
+}
+
+func TestTransportAcceptsMatchingResponses(t *testing.T) {
+	client, _ := kittycadtest.NewClient(t)
+	client.WithTransport(newTransport(t))
+
+	if _, err := client.Meta.Ping(); err != nil {
+		t.Fatalf("pinging failed: %v", err)
+	}
+	if _, err := client.User.GetSelf(); err != nil {
+		t.Fatalf("getting the user failed: %v", err)
+	}
+}
+
+func TestTransportReportsResponseDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Api-Call-Id", "1")
+		w.Write([]byte(`{"message": 5}`))
+	}))
+	defer server.Close()
+
+	client, err := kittycad.NewClient("token", "kittycadvalidate")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	client.WithBaseURL(server.URL)
+	transport := newTransport(t)
+	drifts := []*DriftError{}
+	transport.OnDrift = func(err *DriftError) {
+		drifts = append(drifts, err)
+	}
+	client.WithTransport(transport)
+
+	// The client still fails to decode the response, but the drift is reported first.
+	client.Meta.Ping()
+
+	if len(drifts) != 1 {
+		t.Fatalf("expected one drift, got %v", drifts)
+	}
+	drift := drifts[0]
+	if drift.Operation != "ping" || !drift.Response || len(drift.Issues) != 1 {
+		t.Fatalf("unexpected drift: %v", drift)
+	}
+	if issue := drift.Issues[0]; issue.Location != "body" || issue.Pointer != "/message" {
+		t.Fatalf("unexpected issue: %v", issue)
+	}
+}
+
+func TestTransportRejectsRequestDrift(t *testing.T) {
+	client, _ := kittycadtest.NewClient(t)
+	client.WithTransport(newTransport(t))
+
+	_, err := client.APIToken.ListForUser(kittycad.APITokenListForUserParams{SortBy: new(kittycad.CreatedAtSortMode("sideways"))})
+	if err == nil {
+		t.Fatalf("expected the request to be rejected")
+	}
+
+	// The client does not wrap the errors of the transport, so check the message.
+	if !strings.Contains(err.Error(), `request of list_api_tokens_for_user does not match the spec: query parameter "sort_by"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
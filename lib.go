@@ -13,6 +13,8 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	"github.com/gorilla/websocket"
 )
 
 // DefaultServerURL is the default server URL for the KittyCad API.
@@ -129,6 +131,70 @@ func (c *Client) WithToken(token string) {
 	c.token = token
 }
 
+// WithTransport overrides the transport used to send the requests, for
+// example to record and replay them in tests. The user agent and
+// authorization headers are added before the request reaches the transport.
+func (c *Client) WithTransport(transport http.RoundTripper) {
+	uat, _ := c.client.Transport.(userAgentTransport)
+	uat.base = transport
+	uat.client = c
+	c.client.Transport = uat
+}
+
+// BaseURL returns the base URL the requests are sent to, with a trailing
+// slash.
+func (c *Client) BaseURL() string {
+	return c.server
+}
+
+// Transport returns the transport sending the requests, as set with
+// WithTransport. It sends the requests once the user agent and
+// authorization headers were added.
+func (c *Client) Transport() http.RoundTripper {
+	uat, _ := c.client.Transport.(userAgentTransport)
+	if uat.base == nil {
+		return http.DefaultTransport
+	}
+	return uat.base
+}
+
+// WithDialer overrides the dialer used to open websockets, for example to
+// set a proxy or a handshake timeout. The context passed to the websocket
+// methods is used for the handshake.
+func (c *Client) WithDialer(dialer *websocket.Dialer) {
+	c.dialer = dialer
+}
+
+// WithStrictEnums enables or disables strict decoding of the enum values in
+// the responses. By default unknown enum values are accepted, so that new
+// values added to the API do not break older clients. When strict decoding
+// is enabled, a response holding an unknown value returns an error instead,
+// as reported by ValidateEnums.
+func (c *Client) WithStrictEnums(strict bool) {
+	c.strictEnums = strict
+}
+
+// decode decodes the JSON body of a response into v, validating its enum
+// values if the client has strict enums.
+func (c *Client) decode(body io.Reader, v any) error {
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return err
+	}
+	if c.strictEnums {
+		return ValidateEnums(v)
+	}
+	return nil
+}
+
+// websocketDialer returns the dialer for opening websockets.
+func (c *Client) websocketDialer() *websocket.Dialer {
+	if c.dialer == nil {
+		return websocket.DefaultDialer
+	}
+
+	return c.dialer
+}
+
 type userAgentTransport struct {
 	userAgent string
 	base      http.RoundTripper
@@ -327,3 +393,27 @@ func checkResponse(res *http.Response) error {
 		Message:    "",
 	}
 }
+
+// checkHandshake returns the error of a failed websocket handshake. If the
+// server responded to the handshake, the error is an *HTTPError holding the
+// response.
+func checkHandshake(res *http.Response, err error) error {
+	if res == nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if herr := checkResponse(res); herr != nil {
+		return herr
+	}
+
+	// The server responded successfully, but did not upgrade the connection.
+	slurp, _ := io.ReadAll(res.Body)
+	return &HTTPError{
+		URL:        res.Request.URL,
+		StatusCode: res.StatusCode,
+		Message:    err.Error(),
+		Body:       string(slurp),
+		Header:     res.Header,
+	}
+}
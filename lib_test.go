@@ -79,9 +79,9 @@ func createTestTextToCadMultiFileIteration(t *testing.T, client *Client) *TextTo
 	form := NewMultipartForm()
 
 	if err := form.WriteJSONField("body", TextToCadMultiFileIterationBody{
-		KclVersion:   "1.0",
-		ProjectName:  "kittycad.go async operation test",
-		Prompt:       "Add a simple cube to main.kcl and a cylinder to subdir/main.kcl",
+		KclVersion:   new("1.0"),
+		ProjectName:  new("kittycad.go async operation test"),
+		Prompt:       new("Add a simple cube to main.kcl and a cylinder to subdir/main.kcl"),
 		SourceRanges: []SourceRangePrompt{},
 	}); err != nil {
 		t.Fatalf("writing the multipart JSON body failed: %v", err)
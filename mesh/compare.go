@@ -0,0 +1,133 @@
+package mesh
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kittycad/kittycad.go"
+	"github.com/kittycad/kittycad.go/units"
+)
+
+// Tolerance is how far apart two results can be and still agree: by at
+// most Absolute plus Relative times the larger of them, in the units of the
+// local result. Centers of mass are compared by their distance, relative to
+// their distance to the origin.
+type Tolerance struct {
+	// Relative is the relative tolerance, like 1e-3 for 0.1%.
+	Relative float64
+	// Absolute is the absolute tolerance, for results close to zero.
+	Absolute float64
+}
+
+// DefaultTolerance accepts the differences between the triangulations of
+// the API and of the file, and the float32 coordinates of binary STL files.
+var DefaultTolerance = Tolerance{Relative: 1e-3, Absolute: 1e-9}
+
+// DivergenceError is returned by Compare when the local and remote results
+// do not agree.
+type DivergenceError struct {
+	// Property is the property compared, like "volume".
+	Property string
+	// Unit is the unit of the values.
+	Unit string
+	// Local is the local value, or the coordinates of the center of mass.
+	Local []float64
+	// Remote is the remote value, converted to the unit of the local one.
+	Remote []float64
+	// Difference is the difference between the values, or the distance
+	// between the centers of mass.
+	Difference float64
+}
+
+// Error converts the DivergenceError to a readable string.
+func (err *DivergenceError) Error() string {
+	format := func(values []float64) string {
+		s := []string{}
+		for _, v := range values {
+			s = append(s, fmt.Sprintf("%g", v))
+		}
+		return strings.Join(s, ", ")
+	}
+	return fmt.Sprintf("the %s diverges by %g %s: local %s, remote %s", err.Property, err.Difference, err.Unit, format(err.Local), format(err.Remote))
+}
+
+// Compare compares a local result with the result of the API for the same
+// file, converting the remote result to the unit of the local one. Both are
+// the same type among *kittycad.FileVolume, *kittycad.FileSurfaceArea,
+// *kittycad.FileCenterOfMass, *kittycad.FileMass and *kittycad.FileDensity.
+// It returns a *DivergenceError if they do not agree within the tolerance.
+func Compare(local, remote any, tolerance Tolerance) error {
+	switch l := local.(type) {
+	case *kittycad.FileVolume:
+		r, ok := remote.(*kittycad.FileVolume)
+		if !ok {
+			return mismatch(local, remote)
+		}
+		return compareValue("volume", l.Volume, r.Volume, l.OutputUnit, r.OutputUnit, units.Volume, tolerance)
+	case *kittycad.FileSurfaceArea:
+		r, ok := remote.(*kittycad.FileSurfaceArea)
+		if !ok {
+			return mismatch(local, remote)
+		}
+		return compareValue("surface area", l.SurfaceArea, r.SurfaceArea, l.OutputUnit, r.OutputUnit, units.Area, tolerance)
+	case *kittycad.FileMass:
+		r, ok := remote.(*kittycad.FileMass)
+		if !ok {
+			return mismatch(local, remote)
+		}
+		return compareValue("mass", l.Mass, r.Mass, l.OutputUnit, r.OutputUnit, units.Mass, tolerance)
+	case *kittycad.FileDensity:
+		r, ok := remote.(*kittycad.FileDensity)
+		if !ok {
+			return mismatch(local, remote)
+		}
+		return compareValue("density", l.Density, r.Density, l.OutputUnit, r.OutputUnit, units.Density, tolerance)
+	case *kittycad.FileCenterOfMass:
+		r, ok := remote.(*kittycad.FileCenterOfMass)
+		if !ok {
+			return mismatch(local, remote)
+		}
+		if l.CenterOfMass == nil || r.CenterOfMass == nil {
+			return fmt.Errorf("the center of mass is missing: local %v, remote %v", l.CenterOfMass, r.CenterOfMass)
+		}
+
+		a := Vertex{l.CenterOfMass.X, l.CenterOfMass.Y, l.CenterOfMass.Z}
+		var b Vertex
+		for i, c := range []float64{r.CenterOfMass.X, r.CenterOfMass.Y, r.CenterOfMass.Z} {
+			converted, err := units.Length(c, r.OutputUnit, l.OutputUnit)
+			if err != nil {
+				return err
+			}
+			b[i] = converted
+		}
+		distance := norm(sub(a, b))
+		if distance > tolerance.Absolute+tolerance.Relative*math.Max(norm(a), norm(b)) {
+			return &DivergenceError{Property: "center of mass", Unit: string(l.OutputUnit), Local: a[:], Remote: b[:], Difference: distance}
+		}
+		return nil
+	}
+	return fmt.Errorf("cannot compare %T results", local)
+}
+
+// compareValue compares the local value with the remote value, converted to
+// the unit of the local one.
+func compareValue[U ~string](property string, local, remote *float64, localUnit, remoteUnit U, convert func(float64, U, U) (float64, error), tolerance Tolerance) error {
+	if local == nil || remote == nil {
+		return fmt.Errorf("the %s is missing: local %v, remote %v", property, local, remote)
+	}
+	converted, err := convert(*remote, remoteUnit, localUnit)
+	if err != nil {
+		return err
+	}
+
+	difference := math.Abs(*local - converted)
+	if difference > tolerance.Absolute+tolerance.Relative*math.Max(math.Abs(*local), math.Abs(converted)) {
+		return &DivergenceError{Property: property, Unit: string(localUnit), Local: []float64{*local}, Remote: []float64{converted}, Difference: difference}
+	}
+	return nil
+}
+
+func mismatch(local, remote any) error {
+	return fmt.Errorf("cannot compare a local %T with a remote %T", local, remote)
+}
@@ -0,0 +1,129 @@
+package mesh
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+func TestCompare(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		local    any
+		remote   any
+		diverges bool
+	}{
+		{
+			name:   "same volume in other units",
+			local:  &kittycad.FileVolume{Volume: new(1000.0), OutputUnit: kittycad.UnitVolumeMm3},
+			remote: &kittycad.FileVolume{Volume: new(1.0), OutputUnit: kittycad.UnitVolumeCm3},
+		},
+		{
+			name:     "different volume",
+			local:    &kittycad.FileVolume{Volume: new(1000.0), OutputUnit: kittycad.UnitVolumeMm3},
+			remote:   &kittycad.FileVolume{Volume: new(1.1), OutputUnit: kittycad.UnitVolumeCm3},
+			diverges: true,
+		},
+		{
+			name:   "surface area within tolerance",
+			local:  &kittycad.FileSurfaceArea{SurfaceArea: new(600.0), OutputUnit: kittycad.UnitAreaMm2},
+			remote: &kittycad.FileSurfaceArea{SurfaceArea: new(6.0001), OutputUnit: kittycad.UnitAreaCm2},
+		},
+		{
+			name:     "different mass",
+			local:    &kittycad.FileMass{Mass: new(1.0), OutputUnit: kittycad.UnitMasKg},
+			remote:   &kittycad.FileMass{Mass: new(1.0), OutputUnit: kittycad.UnitMasLb},
+			diverges: true,
+		},
+		{
+			name:   "same density",
+			local:  &kittycad.FileDensity{Density: new(1000.0), OutputUnit: kittycad.UnitDensityKgm3},
+			remote: &kittycad.FileDensity{Density: new(62.42796057614462), OutputUnit: kittycad.UnitDensityLbft3},
+		},
+		{
+			name:   "same center of mass",
+			local:  &kittycad.FileCenterOfMass{CenterOfMass: &kittycad.Point3D{X: 10, Y: 0, Z: 5}, OutputUnit: kittycad.UnitLengthMm},
+			remote: &kittycad.FileCenterOfMass{CenterOfMass: &kittycad.Point3D{X: 1, Y: 0.00001, Z: 0.5}, OutputUnit: kittycad.UnitLengthCm},
+		},
+		{
+			name:     "different center of mass",
+			local:    &kittycad.FileCenterOfMass{CenterOfMass: &kittycad.Point3D{X: 10, Y: 0, Z: 5}, OutputUnit: kittycad.UnitLengthMm},
+			remote:   &kittycad.FileCenterOfMass{CenterOfMass: &kittycad.Point3D{X: 10, Y: 1, Z: 5}, OutputUnit: kittycad.UnitLengthMm},
+			diverges: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := Compare(test.local, test.remote, DefaultTolerance)
+			_, diverges := errors.AsType[*DivergenceError](err)
+			if diverges != test.diverges || (err != nil && !diverges) {
+				t.Fatalf("expected divergence %v, got %v", test.diverges, err)
+			}
+		})
+	}
+}
+
+func TestCompareInvalid(t *testing.T) {
+	volume := &kittycad.FileVolume{Volume: new(1.0), OutputUnit: kittycad.UnitVolumeM3}
+	for _, test := range []struct {
+		name   string
+		local  any
+		remote any
+	}{
+		{name: "different types", local: volume, remote: &kittycad.FileMass{Mass: new(1.0)}},
+		{name: "missing value", local: volume, remote: &kittycad.FileVolume{OutputUnit: kittycad.UnitVolumeM3}},
+		{name: "unknown unit", local: volume, remote: &kittycad.FileVolume{Volume: new(1.0), OutputUnit: "furlong3"}},
+		{name: "unsupported type", local: &kittycad.FileConversion{}, remote: &kittycad.FileConversion{}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := Compare(test.local, test.remote, DefaultTolerance)
+			if _, diverges := errors.AsType[*DivergenceError](err); err == nil || diverges {
+				t.Fatalf("expected the comparison to fail, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCompareWithAPI(t *testing.T) {
+	if os.Getenv(kittycad.TokenEnvVar) == "" && os.Getenv("KITTYCAD_API_TOKEN") == "" {
+		t.Skipf("skipping integration test: set %s or KITTYCAD_API_TOKEN", kittycad.TokenEnvVar)
+	}
+	client, err := kittycad.NewClientFromEnv("kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+
+	body, err := os.ReadFile("../assets/testing.stl")
+	if err != nil {
+		t.Fatalf("reading the file failed: %v", err)
+	}
+	m, err := Read(kittycad.FileImportFormatStl, body, kittycad.UnitLengthMm)
+	if err != nil {
+		t.Fatalf("reading the mesh failed: %v", err)
+	}
+
+	remoteVolume, err := client.File.CreateVolume(kittycad.FileCreateVolumeParams{SrcFormat: kittycad.FileImportFormatStl, OutputUnit: new(kittycad.UnitVolumeCm3)}, body)
+	if err != nil {
+		t.Fatalf("getting the volume failed: %v", err)
+	}
+	localVolume, err := m.Volume(kittycad.UnitVolumeMm3)
+	if err != nil {
+		t.Fatalf("computing the volume failed: %v", err)
+	}
+	if err := Compare(localVolume, remoteVolume, DefaultTolerance); err != nil {
+		t.Fatalf("the volumes differ: %v", err)
+	}
+
+	remoteCenter, err := client.File.CreateCenterOfMass(kittycad.FileCreateCenterOfMassParams{SrcFormat: kittycad.FileImportFormatStl, OutputUnit: new(kittycad.UnitLengthMm)}, body)
+	if err != nil {
+		t.Fatalf("getting the center of mass failed: %v", err)
+	}
+	localCenter, err := m.CenterOfMass(kittycad.UnitLengthMm)
+	if err != nil {
+		t.Fatalf("computing the center of mass failed: %v", err)
+	}
+	if err := Compare(localCenter, remoteCenter, DefaultTolerance); err != nil {
+		t.Fatalf("the centers of mass differ: %v", err)
+	}
+}
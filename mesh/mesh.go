@@ -0,0 +1,399 @@
+// Package mesh computes the physical properties of STL, OBJ and PLY meshes
+// locally, in the result types of the kittycad FileService physics
+// endpoints, for offline previews and for checking the API:
+//
+//	m, err := mesh.ReadFile("part.stl", kittycad.UnitLengthMm)
+//	...
+//	volume, err := m.Volume(kittycad.UnitVolumeCm3)
+//
+// The properties are those of the solid enclosed by the mesh, which should
+// be closed with consistently oriented faces, as for the API.
+package mesh
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+// Vertex is a point of a mesh.
+type Vertex [3]float64
+
+// Triangle is a face of a mesh, with its vertices counterclockwise when
+// seen from outside the solid.
+type Triangle [3]Vertex
+
+// Mesh is a triangle mesh read from a file.
+type Mesh struct {
+	// Format is the format of the file the mesh was read from.
+	Format kittycad.FileImportFormat
+	// Units are the units of the coordinates of the vertices.
+	Units kittycad.UnitLength
+	// Triangles are the faces of the mesh. Polygons are split into
+	// triangles.
+	Triangles []Triangle
+}
+
+// ReadFile reads the mesh in the file, whose format is detected from its
+// name and contents with kittycad.DetectImportFormat, and whose coordinates
+// are in the units.
+func ReadFile(name string, units kittycad.UnitLength) (*Mesh, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	format, _, err := kittycad.DetectImportFormat(name, data[:min(len(data), kittycad.DetectHeaderSize)])
+	if err != nil {
+		return nil, err
+	}
+	return Read(format, data, units)
+}
+
+// Read reads a mesh in the format, whose coordinates are in the units. The
+// API reads the meshes in millimeters unless told otherwise.
+func Read(format kittycad.FileImportFormat, data []byte, units kittycad.UnitLength) (*Mesh, error) {
+	if !units.IsValid() {
+		return nil, fmt.Errorf("invalid units %q", units)
+	}
+
+	var triangles []Triangle
+	var err error
+	switch format {
+	case kittycad.FileImportFormatStl:
+		triangles, err = readSTL(data)
+	case kittycad.FileImportFormatObj:
+		triangles, err = readOBJ(data)
+	case kittycad.FileImportFormatPly:
+		triangles, err = readPLY(data)
+	default:
+		return nil, fmt.Errorf("computing the properties of %s files is not supported, only of STL, OBJ and PLY meshes", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the %s mesh failed: %v", format, err)
+	}
+	if len(triangles) == 0 {
+		return nil, fmt.Errorf("the %s mesh has no faces", format)
+	}
+	return &Mesh{Format: format, Units: units, Triangles: triangles}, nil
+}
+
+// readSTL reads a binary or ASCII STL file. Binary files may start with
+// "solid" too, so they are recognized by their size.
+func readSTL(data []byte) ([]Triangle, error) {
+	if len(data) >= 84 {
+		count := binary.LittleEndian.Uint32(data[80:84])
+		if uint64(len(data)) == 84+50*uint64(count) {
+			triangles := make([]Triangle, count)
+			for i := range triangles {
+				// Skip the normal, which is recomputed from the vertices.
+				record := data[84+50*i+12:]
+				for j := range 3 {
+					for k := range 3 {
+						triangles[i][j][k] = float64(math.Float32frombits(binary.LittleEndian.Uint32(record[12*j+4*k:])))
+					}
+				}
+			}
+			return triangles, nil
+		}
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("solid")) {
+		return nil, errors.New("the file is neither a binary STL file, whose size does not match its triangle count, nor an ASCII one")
+	}
+
+	triangles := []Triangle{}
+	var facet []Vertex
+	for n, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "facet":
+			facet = facet[:0]
+		case "vertex":
+			v, err := parseVertex(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n+1, err)
+			}
+			facet = append(facet, v)
+		case "endfacet":
+			if len(facet) != 3 {
+				return nil, fmt.Errorf("line %d: the facet has %d vertices", n+1, len(facet))
+			}
+			triangles = append(triangles, Triangle{facet[0], facet[1], facet[2]})
+		}
+	}
+	return triangles, nil
+}
+
+// readOBJ reads the vertices and faces of an OBJ file.
+func readOBJ(data []byte) ([]Triangle, error) {
+	vertices := []Vertex{}
+	triangles := []Triangle{}
+	for n, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "v":
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("line %d: the vertex has %d coordinates", n+1, len(fields)-1)
+			}
+			v, err := parseVertex(fields[1:4])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n+1, err)
+			}
+			vertices = append(vertices, v)
+		case "f":
+			face := []Vertex{}
+			for _, field := range fields[1:] {
+				// Faces reference vertices as v, v/vt, v//vn or v/vt/vn, with
+				// negative indices counting back from the last vertex.
+				ref, _, _ := strings.Cut(field, "/")
+				i, err := strconv.Atoi(ref)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid vertex reference %q", n+1, field)
+				}
+				if i < 0 {
+					i += len(vertices) + 1
+				}
+				if i < 1 || i > len(vertices) {
+					return nil, fmt.Errorf("line %d: vertex %s does not exist", n+1, ref)
+				}
+				face = append(face, vertices[i-1])
+			}
+			var err error
+			triangles, err = appendPolygon(triangles, face)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n+1, err)
+			}
+		}
+	}
+	return triangles, nil
+}
+
+// plyProperty is a property of a PLY element.
+type plyProperty struct {
+	name string
+	// typ is the type of the property, or of the items of a list.
+	typ string
+	// countType is the type of the length of a list, or "" if the property
+	// is not a list.
+	countType string
+}
+
+// plyElement is an element of a PLY file, like vertex or face.
+type plyElement struct {
+	name       string
+	count      int
+	properties []plyProperty
+}
+
+// readPLY reads the vertices and faces of an ASCII or binary PLY file.
+// Other elements are skipped.
+func readPLY(data []byte) ([]Triangle, error) {
+	reader := bufio.NewReader(bytes.NewReader(data))
+	format := ""
+	elements := []*plyElement{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, errors.New("the header does not end")
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "end_header" {
+			break
+		}
+		switch fields[0] {
+		case "format":
+			if len(fields) < 2 {
+				return nil, errors.New("the format is missing")
+			}
+			format = fields[1]
+		case "element":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("invalid element %q", strings.TrimSpace(line))
+			}
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return nil, fmt.Errorf("invalid element count %q", fields[2])
+			}
+			elements = append(elements, &plyElement{name: fields[1], count: count})
+		case "property":
+			if len(elements) == 0 {
+				return nil, errors.New("a property comes before any element")
+			}
+			element := elements[len(elements)-1]
+			switch {
+			case len(fields) == 5 && fields[1] == "list":
+				element.properties = append(element.properties, plyProperty{name: fields[4], typ: fields[3], countType: fields[2]})
+			case len(fields) == 3:
+				element.properties = append(element.properties, plyProperty{name: fields[2], typ: fields[1]})
+			default:
+				return nil, fmt.Errorf("invalid property %q", strings.TrimSpace(line))
+			}
+		}
+	}
+
+	var next func(typ string) (float64, error)
+	switch format {
+	case "ascii":
+		scanner := bufio.NewScanner(reader)
+		scanner.Split(bufio.ScanWords)
+		next = func(string) (float64, error) {
+			if !scanner.Scan() {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return strconv.ParseFloat(scanner.Text(), 64)
+		}
+	case "binary_little_endian":
+		next = plyBinaryReader(reader, binary.LittleEndian)
+	case "binary_big_endian":
+		next = plyBinaryReader(reader, binary.BigEndian)
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+
+	vertices := []Vertex{}
+	triangles := []Triangle{}
+	for _, element := range elements {
+		for range element.count {
+			var v Vertex
+			var face []Vertex
+			for _, property := range element.properties {
+				if property.countType == "" {
+					value, err := next(property.typ)
+					if err != nil {
+						return nil, fmt.Errorf("reading %s %s failed: %v", element.name, property.name, err)
+					}
+					if element.name == "vertex" {
+						switch property.name {
+						case "x":
+							v[0] = value
+						case "y":
+							v[1] = value
+						case "z":
+							v[2] = value
+						}
+					}
+					continue
+				}
+
+				count, err := next(property.countType)
+				if err != nil {
+					return nil, fmt.Errorf("reading %s %s failed: %v", element.name, property.name, err)
+				}
+				isFace := element.name == "face" && (property.name == "vertex_indices" || property.name == "vertex_index")
+				for range int(count) {
+					value, err := next(property.typ)
+					if err != nil {
+						return nil, fmt.Errorf("reading %s %s failed: %v", element.name, property.name, err)
+					}
+					if !isFace {
+						continue
+					}
+					i := int(value)
+					if i < 0 || i >= len(vertices) {
+						return nil, fmt.Errorf("vertex %d does not exist", i)
+					}
+					face = append(face, vertices[i])
+				}
+			}
+
+			switch element.name {
+			case "vertex":
+				vertices = append(vertices, v)
+			case "face":
+				var err error
+				if triangles, err = appendPolygon(triangles, face); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return triangles, nil
+}
+
+// plyBinaryReader returns a function reading the values of a binary PLY
+// file.
+func plyBinaryReader(r io.Reader, order binary.ByteOrder) func(typ string) (float64, error) {
+	buf := make([]byte, 8)
+	return func(typ string) (float64, error) {
+		var size int
+		switch typ {
+		case "char", "int8", "uchar", "uint8":
+			size = 1
+		case "short", "int16", "ushort", "uint16":
+			size = 2
+		case "int", "int32", "uint", "uint32", "float", "float32":
+			size = 4
+		case "double", "float64":
+			size = 8
+		default:
+			return 0, fmt.Errorf("unknown type %q", typ)
+		}
+		if _, err := io.ReadFull(r, buf[:size]); err != nil {
+			return 0, err
+		}
+
+		b := buf[:size]
+		switch typ {
+		case "char", "int8":
+			return float64(int8(b[0])), nil
+		case "uchar", "uint8":
+			return float64(b[0]), nil
+		case "short", "int16":
+			return float64(int16(order.Uint16(b))), nil
+		case "ushort", "uint16":
+			return float64(order.Uint16(b)), nil
+		case "int", "int32":
+			return float64(int32(order.Uint32(b))), nil
+		case "uint", "uint32":
+			return float64(order.Uint32(b)), nil
+		case "float", "float32":
+			return float64(math.Float32frombits(order.Uint32(b))), nil
+		}
+		return math.Float64frombits(order.Uint64(b)), nil
+	}
+}
+
+// parseVertex parses the coordinates of a vertex.
+func parseVertex(fields []string) (Vertex, error) {
+	var v Vertex
+	if len(fields) < 3 {
+		return v, fmt.Errorf("the vertex has %d coordinates", len(fields))
+	}
+	for i := range 3 {
+		f, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return v, fmt.Errorf("invalid coordinate %q", fields[i])
+		}
+		v[i] = f
+	}
+	return v, nil
+}
+
+// appendPolygon splits the convex polygon into triangles fanning from its
+// first vertex.
+func appendPolygon(triangles []Triangle, polygon []Vertex) ([]Triangle, error) {
+	if len(polygon) < 3 {
+		return nil, fmt.Errorf("the face has %d vertices", len(polygon))
+	}
+	for i := 1; i+1 < len(polygon); i++ {
+		triangles = append(triangles, Triangle{polygon[0], polygon[i], polygon[i+1]})
+	}
+	return triangles, nil
+}
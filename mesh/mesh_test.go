@@ -0,0 +1,368 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+const cubeSTL = `solid cube
+facet normal 0 0 -1
+outer loop
+vertex 0 0 0
+vertex 0 1 0
+vertex 1 1 0
+endloop
+endfacet
+facet normal 0 0 -1
+outer loop
+vertex 0 0 0
+vertex 1 1 0
+vertex 1 0 0
+endloop
+endfacet
+facet normal 0 0 1
+outer loop
+vertex 0 0 1
+vertex 1 0 1
+vertex 1 1 1
+endloop
+endfacet
+facet normal 0 0 1
+outer loop
+vertex 0 0 1
+vertex 1 1 1
+vertex 0 1 1
+endloop
+endfacet
+facet normal 0 -1 0
+outer loop
+vertex 0 0 0
+vertex 1 0 0
+vertex 1 0 1
+endloop
+endfacet
+facet normal 0 -1 0
+outer loop
+vertex 0 0 0
+vertex 1 0 1
+vertex 0 0 1
+endloop
+endfacet
+facet normal 0 1 0
+outer loop
+vertex 0 1 0
+vertex 0 1 1
+vertex 1 1 1
+endloop
+endfacet
+facet normal 0 1 0
+outer loop
+vertex 0 1 0
+vertex 1 1 1
+vertex 1 1 0
+endloop
+endfacet
+facet normal -1 0 0
+outer loop
+vertex 0 0 0
+vertex 0 0 1
+vertex 0 1 1
+endloop
+endfacet
+facet normal -1 0 0
+outer loop
+vertex 0 0 0
+vertex 0 1 1
+vertex 0 1 0
+endloop
+endfacet
+facet normal 1 0 0
+outer loop
+vertex 1 0 0
+vertex 1 1 0
+vertex 1 1 1
+endloop
+endfacet
+facet normal 1 0 0
+outer loop
+vertex 1 0 0
+vertex 1 1 1
+vertex 1 0 1
+endloop
+endfacet
+endsolid cube
+`
+
+// cubeVertices and cubeFaces are the unit cube as quads, for OBJ and PLY.
+var cubeVertices = []Vertex{
+	{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {0, 1, 0},
+	{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 1, 1},
+}
+
+var cubeFaces = [][]int{
+	{0, 3, 2, 1}, {4, 5, 6, 7}, {0, 1, 5, 4},
+	{3, 7, 6, 2}, {0, 4, 7, 3}, {1, 2, 6, 5},
+}
+
+func cubeOBJ() []byte {
+	var b bytes.Buffer
+	b.WriteString("# unit cube\no cube\n")
+	for _, v := range cubeVertices {
+		fmt.Fprintf(&b, "v %g %g %g\n", v[0], v[1], v[2])
+	}
+	b.WriteString("vn 0 0 1\n")
+	for i, f := range cubeFaces {
+		// Mix absolute, relative and normal references.
+		if i%2 == 0 {
+			fmt.Fprintf(&b, "f %d//1 %d//1 %d//1 %d//1\n", f[0]+1, f[1]+1, f[2]+1, f[3]+1)
+		} else {
+			fmt.Fprintf(&b, "f %d %d %d %d\n", f[0]-8, f[1]-8, f[2]-8, f[3]-8)
+		}
+	}
+	return b.Bytes()
+}
+
+func plyHeader(format string) string {
+	return "ply\nformat " + format + " 1.0\ncomment unit cube\n" +
+		"element vertex 8\nproperty float x\nproperty float y\nproperty float z\nproperty uchar red\n" +
+		"element face 6\nproperty list uchar int vertex_indices\nend_header\n"
+}
+
+func cubePLY() []byte {
+	var b bytes.Buffer
+	b.WriteString(plyHeader("ascii"))
+	for _, v := range cubeVertices {
+		fmt.Fprintf(&b, "%g %g %g 255\n", v[0], v[1], v[2])
+	}
+	for _, f := range cubeFaces {
+		fmt.Fprintf(&b, "4 %d %d %d %d\n", f[0], f[1], f[2], f[3])
+	}
+	return b.Bytes()
+}
+
+func cubeBinaryPLY(order binary.ByteOrder, format string) []byte {
+	var b bytes.Buffer
+	b.WriteString(plyHeader(format))
+	for _, v := range cubeVertices {
+		for _, c := range v {
+			binary.Write(&b, order, float32(c))
+		}
+		b.WriteByte(255)
+	}
+	for _, f := range cubeFaces {
+		b.WriteByte(4)
+		for _, i := range f {
+			binary.Write(&b, order, int32(i))
+		}
+	}
+	return b.Bytes()
+}
+
+// binarySTL writes the triangles as a binary STL file whose header starts
+// with "solid", as some exporters do.
+func binarySTL(triangles []Triangle) []byte {
+	var b bytes.Buffer
+	b.Write(append([]byte("solid binary"), make([]byte, 68)...))
+	binary.Write(&b, binary.LittleEndian, uint32(len(triangles)))
+	for _, t := range triangles {
+		binary.Write(&b, binary.LittleEndian, [3]float32{})
+		for _, v := range t {
+			for _, c := range v {
+				binary.Write(&b, binary.LittleEndian, float32(c))
+			}
+		}
+		binary.Write(&b, binary.LittleEndian, uint16(0))
+	}
+	return b.Bytes()
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+func TestReadCube(t *testing.T) {
+	stl, err := Read(kittycad.FileImportFormatStl, []byte(cubeSTL), kittycad.UnitLengthM)
+	if err != nil {
+		t.Fatalf("reading the ascii stl failed: %v", err)
+	}
+
+	for _, test := range []struct {
+		name   string
+		format kittycad.FileImportFormat
+		data   []byte
+	}{
+		{name: "ascii stl", format: kittycad.FileImportFormatStl, data: []byte(cubeSTL)},
+		{name: "binary stl", format: kittycad.FileImportFormatStl, data: binarySTL(stl.Triangles)},
+		{name: "obj", format: kittycad.FileImportFormatObj, data: cubeOBJ()},
+		{name: "ascii ply", format: kittycad.FileImportFormatPly, data: cubePLY()},
+		{name: "little endian ply", format: kittycad.FileImportFormatPly, data: cubeBinaryPLY(binary.LittleEndian, "binary_little_endian")},
+		{name: "big endian ply", format: kittycad.FileImportFormatPly, data: cubeBinaryPLY(binary.BigEndian, "binary_big_endian")},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := Read(test.format, test.data, kittycad.UnitLengthM)
+			if err != nil {
+				t.Fatalf("reading the mesh failed: %v", err)
+			}
+			if len(m.Triangles) != 12 {
+				t.Fatalf("expected 12 triangles, got %d", len(m.Triangles))
+			}
+
+			volume, err := m.Volume(kittycad.UnitVolumeM3)
+			if err != nil {
+				t.Fatalf("computing the volume failed: %v", err)
+			}
+			if !near(*volume.Volume, 1) || volume.SrcFormat != test.format || volume.Status != kittycad.APICallStatusCompleted {
+				t.Fatalf("unexpected volume %+v", volume)
+			}
+
+			area, err := m.SurfaceArea(kittycad.UnitAreaM2)
+			if err != nil {
+				t.Fatalf("computing the surface area failed: %v", err)
+			}
+			if !near(*area.SurfaceArea, 6) {
+				t.Fatalf("expected a surface area of 6, got %v", *area.SurfaceArea)
+			}
+
+			center, err := m.CenterOfMass(kittycad.UnitLengthM)
+			if err != nil {
+				t.Fatalf("computing the center of mass failed: %v", err)
+			}
+			if c := center.CenterOfMass; !near(c.X, 0.5) || !near(c.Y, 0.5) || !near(c.Z, 0.5) {
+				t.Fatalf("expected a center of mass at 0.5, 0.5, 0.5, got %+v", c)
+			}
+		})
+	}
+}
+
+func TestPropertiesUnits(t *testing.T) {
+	m, err := Read(kittycad.FileImportFormatObj, cubeOBJ(), kittycad.UnitLengthCm)
+	if err != nil {
+		t.Fatalf("reading the mesh failed: %v", err)
+	}
+
+	volume, err := m.Volume(kittycad.UnitVolumeMm3)
+	if err != nil {
+		t.Fatalf("computing the volume failed: %v", err)
+	}
+	if !near(*volume.Volume, 1000) || volume.OutputUnit != kittycad.UnitVolumeMm3 {
+		t.Fatalf("expected 1000 mm3, got %v %s", *volume.Volume, volume.OutputUnit)
+	}
+
+	area, err := m.SurfaceArea(kittycad.UnitAreaMm2)
+	if err != nil {
+		t.Fatalf("computing the surface area failed: %v", err)
+	}
+	if !near(*area.SurfaceArea, 600) {
+		t.Fatalf("expected 600 mm2, got %v", *area.SurfaceArea)
+	}
+
+	// 1 cm3 of water weighs 1 g.
+	mass, err := m.Mass(1000, kittycad.UnitDensityKgm3, kittycad.UnitMasG)
+	if err != nil {
+		t.Fatalf("computing the mass failed: %v", err)
+	}
+	if !near(*mass.Mass, 1) || *mass.MaterialDensity != 1000 || mass.MaterialDensityUnit != kittycad.UnitDensityKgm3 {
+		t.Fatalf("unexpected mass %+v", mass)
+	}
+
+	density, err := m.Density(*mass.Mass, kittycad.UnitMasG, kittycad.UnitDensityKgm3)
+	if err != nil {
+		t.Fatalf("computing the density failed: %v", err)
+	}
+	if !near(*density.Density, 1000) || *density.MaterialMass != 1 || density.MaterialMassUnit != kittycad.UnitMasG {
+		t.Fatalf("unexpected density %+v", density)
+	}
+
+	if _, err := m.Volume("furlong3"); err == nil {
+		t.Fatalf("expected the unknown unit to be refused")
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		format kittycad.FileImportFormat
+		data   string
+		want   string
+	}{
+		{name: "step", format: kittycad.FileImportFormatStep, data: "ISO-10303-21;", want: "not supported"},
+		{name: "truncated stl", format: kittycad.FileImportFormatStl, data: "solid x\nfacet\nvertex 0 0 0\nendfacet\n", want: "facet has 1 vertices"},
+		{name: "empty stl", format: kittycad.FileImportFormatStl, data: "solid x\nendsolid x\n", want: "no faces"},
+		{name: "missing obj vertex", format: kittycad.FileImportFormatObj, data: "v 0 0 0\nf 1 2 3\n", want: "vertex 2 does not exist"},
+		{name: "truncated ply", format: kittycad.FileImportFormatPly, data: plyHeader("ascii") + "0 0 0 1\n", want: "reading vertex x failed"},
+		{name: "flat mesh", format: kittycad.FileImportFormatObj, data: "v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n", want: "encloses no volume"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := Read(test.format, []byte(test.data), kittycad.UnitLengthMm)
+			if err == nil {
+				_, err = m.Volume(kittycad.UnitVolumeMm3)
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("expected an error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
+func TestReadTestingSTL(t *testing.T) {
+	m, err := ReadFile("../assets/testing.stl", kittycad.UnitLengthMm)
+	if err != nil {
+		t.Fatalf("reading the file failed: %v", err)
+	}
+	if m.Format != kittycad.FileImportFormatStl || len(m.Triangles) != 5446 {
+		t.Fatalf("expected 5446 stl triangles, got %d %s triangles", len(m.Triangles), m.Format)
+	}
+
+	volume, err := m.Volume(kittycad.UnitVolumeMm3)
+	if err != nil {
+		t.Fatalf("computing the volume failed: %v", err)
+	}
+	area, err := m.SurfaceArea(kittycad.UnitAreaMm2)
+	if err != nil {
+		t.Fatalf("computing the surface area failed: %v", err)
+	}
+	center, err := m.CenterOfMass(kittycad.UnitLengthMm)
+	if err != nil {
+		t.Fatalf("computing the center of mass failed: %v", err)
+	}
+	if err := Compare(volume, &kittycad.FileVolume{Volume: new(4840.78), OutputUnit: kittycad.UnitVolumeMm3}, DefaultTolerance); err != nil {
+		t.Fatalf("unexpected volume: %v", err)
+	}
+	if err := Compare(area, &kittycad.FileSurfaceArea{SurfaceArea: new(2490.31), OutputUnit: kittycad.UnitAreaMm2}, DefaultTolerance); err != nil {
+		t.Fatalf("unexpected surface area: %v", err)
+	}
+	remote := &kittycad.FileCenterOfMass{CenterOfMass: &kittycad.Point3D{X: 13.4, Y: 6.665, Z: 13.4}, OutputUnit: kittycad.UnitLengthMm}
+	if err := Compare(center, remote, DefaultTolerance); err != nil {
+		t.Fatalf("unexpected center of mass: %v", err)
+	}
+
+	// The properties do not depend on where the solid is.
+	moved := &Mesh{Format: m.Format, Units: m.Units}
+	for _, triangle := range m.Triangles {
+		for i, v := range triangle {
+			triangle[i] = Vertex{v[0] + 100, v[1] - 50, v[2] + 25}
+		}
+		moved.Triangles = append(moved.Triangles, triangle)
+	}
+	movedVolume, err := moved.Volume(kittycad.UnitVolumeMm3)
+	if err != nil {
+		t.Fatalf("computing the volume failed: %v", err)
+	}
+	if err := Compare(volume, movedVolume, Tolerance{Relative: 1e-9}); err != nil {
+		t.Fatalf("moving the solid changed its volume: %v", err)
+	}
+	movedCenter, err := moved.CenterOfMass(kittycad.UnitLengthMm)
+	if err != nil {
+		t.Fatalf("computing the center of mass failed: %v", err)
+	}
+	c := movedCenter.CenterOfMass
+	if !near(c.X-100, center.CenterOfMass.X) || !near(c.Y+50, center.CenterOfMass.Y) || !near(c.Z-25, center.CenterOfMass.Z) {
+		t.Fatalf("expected the center of mass to move with the solid, got %+v", c)
+	}
+}
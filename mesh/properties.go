@@ -0,0 +1,222 @@
+package mesh
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/kittycad/kittycad.go"
+	"github.com/kittycad/kittycad.go/units"
+)
+
+// properties are the properties of a mesh, in SI units.
+type properties struct {
+	// volume is the volume enclosed by the mesh, in cubic meters.
+	volume float64
+	// area is the surface area of the mesh, in square meters.
+	area float64
+	// center is the centroid of the enclosed volume, in meters.
+	center Vertex
+}
+
+// properties computes the properties of the mesh. The volume is the sum of
+// the signed volumes of the tetrahedra between the origin and every face,
+// and the centroid their weighted mean, so the origin does not matter for
+// a closed mesh. Meshes whose faces all point inward are handled like
+// those whose faces point outward.
+func (m *Mesh) properties() (properties, error) {
+	scale, err := units.Length(1, m.Units, kittycad.UnitLengthM)
+	if err != nil {
+		return properties{}, err
+	}
+
+	var p properties
+	var moment Vertex
+	for _, t := range m.Triangles {
+		a, b, c := t[0], t[1], t[2]
+		ab := sub(b, a)
+		ac := sub(c, a)
+		p.area += norm(cross(ab, ac)) / 2
+
+		volume := dot(a, cross(b, c)) / 6
+		p.volume += volume
+		for i := range 3 {
+			moment[i] += volume * (a[i] + b[i] + c[i]) / 4
+		}
+	}
+	if p.volume == 0 {
+		return properties{}, fmt.Errorf("the %s mesh encloses no volume", m.Format)
+	}
+	for i := range 3 {
+		p.center[i] = moment[i] / p.volume * scale
+	}
+	p.volume = math.Abs(p.volume) * scale * scale * scale
+	p.area *= scale * scale
+	return p, nil
+}
+
+func sub(a, b Vertex) Vertex {
+	return Vertex{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func cross(a, b Vertex) Vertex {
+	return Vertex{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func dot(a, b Vertex) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func norm(a Vertex) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+// call returns the fields of a completed API call, as the results of the
+// API have.
+func call() (id kittycad.UUID, now kittycad.Time) {
+	u := uuid.New()
+	return kittycad.UUID{UUID: &u}, kittycad.TimeNow()
+}
+
+// Volume computes the volume of the mesh, like FileService.CreateVolume.
+func (m *Mesh) Volume(outputUnit kittycad.UnitVolume) (*kittycad.FileVolume, error) {
+	p, err := m.properties()
+	if err != nil {
+		return nil, err
+	}
+	volume, err := units.Volume(p.volume, kittycad.UnitVolumeM3, outputUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	id, now := call()
+	return &kittycad.FileVolume{
+		ID:          id,
+		Status:      kittycad.APICallStatusCompleted,
+		SrcFormat:   m.Format,
+		OutputUnit:  outputUnit,
+		Volume:      &volume,
+		CreatedAt:   now,
+		StartedAt:   &now,
+		CompletedAt: &now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// SurfaceArea computes the surface area of the mesh, like
+// FileService.CreateSurfaceArea.
+func (m *Mesh) SurfaceArea(outputUnit kittycad.UnitArea) (*kittycad.FileSurfaceArea, error) {
+	p, err := m.properties()
+	if err != nil {
+		return nil, err
+	}
+	area, err := units.Area(p.area, kittycad.UnitAreaM2, outputUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	id, now := call()
+	return &kittycad.FileSurfaceArea{
+		ID:          id,
+		Status:      kittycad.APICallStatusCompleted,
+		SrcFormat:   m.Format,
+		OutputUnit:  outputUnit,
+		SurfaceArea: &area,
+		CreatedAt:   now,
+		StartedAt:   &now,
+		CompletedAt: &now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// CenterOfMass computes the center of mass of the solid enclosed by the
+// mesh, of uniform density, like FileService.CreateCenterOfMass.
+func (m *Mesh) CenterOfMass(outputUnit kittycad.UnitLength) (*kittycad.FileCenterOfMass, error) {
+	p, err := m.properties()
+	if err != nil {
+		return nil, err
+	}
+	var center kittycad.Point3D
+	for i, c := range []*float64{&center.X, &center.Y, &center.Z} {
+		if *c, err = units.Length(p.center[i], kittycad.UnitLengthM, outputUnit); err != nil {
+			return nil, err
+		}
+	}
+
+	id, now := call()
+	return &kittycad.FileCenterOfMass{
+		ID:           id,
+		Status:       kittycad.APICallStatusCompleted,
+		SrcFormat:    m.Format,
+		OutputUnit:   outputUnit,
+		CenterOfMass: &center,
+		CreatedAt:    now,
+		StartedAt:    &now,
+		CompletedAt:  &now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Mass computes the mass of the solid enclosed by the mesh, made of a
+// material of the density, like FileService.CreateMass.
+func (m *Mesh) Mass(density float64, densityUnit kittycad.UnitDensity, outputUnit kittycad.UnitMas) (*kittycad.FileMass, error) {
+	p, err := m.properties()
+	if err != nil {
+		return nil, err
+	}
+	kgm3, err := units.Density(density, densityUnit, kittycad.UnitDensityKgm3)
+	if err != nil {
+		return nil, err
+	}
+	mass, err := units.Mass(kgm3*p.volume, kittycad.UnitMasKg, outputUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	id, now := call()
+	return &kittycad.FileMass{
+		ID:                  id,
+		Status:              kittycad.APICallStatusCompleted,
+		SrcFormat:           m.Format,
+		OutputUnit:          outputUnit,
+		Mass:                &mass,
+		MaterialDensity:     &density,
+		MaterialDensityUnit: densityUnit,
+		CreatedAt:           now,
+		StartedAt:           &now,
+		CompletedAt:         &now,
+		UpdatedAt:           now,
+	}, nil
+}
+
+// Density computes the density of the material of the solid enclosed by
+// the mesh, from its mass, like FileService.CreateDensity.
+func (m *Mesh) Density(mass float64, massUnit kittycad.UnitMas, outputUnit kittycad.UnitDensity) (*kittycad.FileDensity, error) {
+	p, err := m.properties()
+	if err != nil {
+		return nil, err
+	}
+	kg, err := units.Mass(mass, massUnit, kittycad.UnitMasKg)
+	if err != nil {
+		return nil, err
+	}
+	density, err := units.Density(kg/p.volume, kittycad.UnitDensityKgm3, outputUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	id, now := call()
+	return &kittycad.FileDensity{
+		ID:               id,
+		Status:           kittycad.APICallStatusCompleted,
+		SrcFormat:        m.Format,
+		OutputUnit:       outputUnit,
+		Density:          &density,
+		MaterialMass:     &mass,
+		MaterialMassUnit: massUnit,
+		CreatedAt:        now,
+		StartedAt:        &now,
+		CompletedAt:      &now,
+		UpdatedAt:        now,
+	}, nil
+}
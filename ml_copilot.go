@@ -0,0 +1,711 @@
+package kittycad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrCopilotClosed is returned when using a CopilotConversation after it was
+// closed.
+var ErrCopilotClosed = errors.New("the copilot conversation is closed")
+
+// The types of CopilotEvent, named after the field of the
+// MlCopilotServerMessage they come from.
+const (
+	CopilotEventPong            = "pong"
+	CopilotEventSessionData     = "session_data"
+	CopilotEventConversationID  = "conversation_id"
+	CopilotEventDelta           = "delta"
+	CopilotEventToolOutput      = "tool_output"
+	CopilotEventError           = "error"
+	CopilotEventInfo            = "info"
+	CopilotEventModesResponse   = "modes_response"
+	CopilotEventBackendShutdown = "backend_shutdown"
+	CopilotEventProjectUpdated  = "project_updated"
+	CopilotEventReasoning       = "reasoning"
+	CopilotEventReplay          = "replay"
+	CopilotEventEndOfStream     = "end_of_stream"
+	CopilotEventFiles           = "files"
+
+	CopilotEventAttachmentsLoaded = "attachments_loaded"
+)
+
+// CopilotEventUnknown is the type of the messages of the server which could
+// not be decoded, like binary messages other than replays.
+const CopilotEventUnknown = "unknown"
+
+// CopilotEvent is a message sent by the ML copilot server. Only the fields
+// of its Type are set.
+type CopilotEvent struct {
+	// Type is the type of the message, like CopilotEventDelta.
+	Type string
+	// Data is the payload of the JSON message as sent, to decode the types
+	// without a field below.
+	Data json.RawMessage
+
+	// APICallID is the API call of the websocket, for session_data.
+	APICallID string
+	// ConversationID is the ID of the conversation, for conversation_id and
+	// end_of_stream.
+	ConversationID string
+	// Delta is the next chunk of the answer, for delta.
+	Delta string
+	// ToolOutput is the result of a tool call, for tool_output.
+	ToolOutput MlToolResult
+	// Text is the error, the information, the reason of the shutdown or why
+	// the message could not be decoded, for error, info, backend_shutdown and
+	// unknown.
+	Text string
+	// Modes are the available modes, for modes_response.
+	Modes *CopilotModes
+	// ProjectFiles are the updated files of the project, by path, for
+	// project_updated.
+	ProjectFiles map[string]string
+	// Reasoning is the reasoning of the assistant, for reasoning.
+	Reasoning *Reasoning
+	// EndOfStream describes the finished answer, for end_of_stream.
+	EndOfStream *CopilotEndOfStream
+	// Files are the files sent by the server, for files.
+	Files []MlCopilotFile
+	// Replay are the saved messages of the conversation, for replay. Each is
+	// usually the JSON of a MlCopilotServerMessage, which decodes into a
+	// CopilotEvent, or of a MlCopilotClientMessage of type user.
+	Replay [][]byte
+	// Frame is the message as received, for unknown.
+	Frame []byte
+}
+
+// CopilotModes are the modes a copilot conversation can use.
+type CopilotModes struct {
+	// DefaultMode is the mode used when no mode is requested.
+	DefaultMode string `json:"default_mode"`
+	// Modes are the available modes.
+	Modes []MlCopilotModeOption `json:"modes"`
+}
+
+// CopilotEndOfStream marks the end of an answer of the copilot.
+type CopilotEndOfStream struct {
+	// ID is the ID of the prompt.
+	ID *UUID `json:"id,omitempty"`
+	// ConversationID is the ID of the conversation.
+	ConversationID *string `json:"conversation_id,omitempty"`
+	// StartedAt is when the server started processing the prompt.
+	StartedAt *Time `json:"started_at,omitempty"`
+	// CompletedAt is when the server finished processing the prompt.
+	CompletedAt *Time `json:"completed_at,omitempty"`
+	// WholeResponse is the whole answer, if the server sent it.
+	WholeResponse *string `json:"whole_response,omitempty"`
+}
+
+// CopilotError is an error sent by the copilot server.
+type CopilotError struct {
+	// Type is CopilotEventError, or CopilotEventBackendShutdown if the
+	// server is shutting down.
+	Type string
+	// Detail describes the error.
+	Detail string
+}
+
+// Error converts the CopilotError to a readable string.
+func (err *CopilotError) Error() string {
+	if err.Type == CopilotEventBackendShutdown {
+		return fmt.Sprintf("the copilot backend is shutting down: %s", err.Detail)
+	}
+	return fmt.Sprintf("copilot error: %s", err.Detail)
+}
+
+// Err returns the error of an error or backend_shutdown event, or nil for
+// other events.
+func (e CopilotEvent) Err() error {
+	switch e.Type {
+	case CopilotEventError, CopilotEventBackendShutdown:
+		return &CopilotError{Type: e.Type, Detail: e.Text}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes a MlCopilotServerMessage, whose only field names its
+// type.
+func (e *CopilotEvent) UnmarshalJSON(data []byte) error {
+	var message map[string]json.RawMessage
+	if err := json.Unmarshal(data, &message); err != nil {
+		return err
+	}
+	if len(message) != 1 {
+		return fmt.Errorf("copilot message has %d fields instead of 1", len(message))
+	}
+	for t, d := range message {
+		*e = CopilotEvent{Type: t, Data: d}
+	}
+
+	var err error
+	switch e.Type {
+	case CopilotEventSessionData:
+		var v struct {
+			APICallID string `json:"api_call_id"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.APICallID = v.APICallID
+	case CopilotEventConversationID:
+		var v struct {
+			ConversationID string `json:"conversation_id"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.ConversationID = v.ConversationID
+	case CopilotEventDelta:
+		var v struct {
+			Delta string `json:"delta"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.Delta = v.Delta
+	case CopilotEventToolOutput:
+		var v struct {
+			Result MlToolResult `json:"result"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.ToolOutput = v.Result
+	case CopilotEventError:
+		var v struct {
+			Detail string `json:"detail"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.Text = v.Detail
+	case CopilotEventInfo:
+		var v struct {
+			Text string `json:"text"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.Text = v.Text
+	case CopilotEventBackendShutdown:
+		var v struct {
+			Reason *string `json:"reason"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		if v.Reason != nil {
+			e.Text = *v.Reason
+		}
+	case CopilotEventModesResponse:
+		e.Modes = &CopilotModes{}
+		err = json.Unmarshal(e.Data, e.Modes)
+	case CopilotEventProjectUpdated:
+		var v struct {
+			Files map[string]string `json:"files"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.ProjectFiles = v.Files
+	case CopilotEventReasoning:
+		e.Reasoning = &Reasoning{}
+		err = json.Unmarshal(e.Data, e.Reasoning)
+	case CopilotEventEndOfStream:
+		e.EndOfStream = &CopilotEndOfStream{}
+		err = json.Unmarshal(e.Data, e.EndOfStream)
+		if e.EndOfStream.ConversationID != nil {
+			e.ConversationID = *e.EndOfStream.ConversationID
+		}
+	case CopilotEventFiles:
+		var v struct {
+			Files []MlCopilotFile `json:"files"`
+		}
+		err = json.Unmarshal(e.Data, &v)
+		e.Files = v.Files
+	}
+	if err != nil {
+		return fmt.Errorf("decoding copilot message of type %q failed: %v", e.Type, err)
+	}
+	return nil
+}
+
+// decodeCopilotReplay decodes a binary message of the server, which is a
+// MlCopilotServerMessage of type replay encoded with MsgPack.
+func decodeCopilotReplay(data []byte) (CopilotEvent, error) {
+	doc, err := decodeMsgPack(data)
+	if err != nil {
+		return CopilotEvent{}, fmt.Errorf("decoding the binary copilot message failed: %v", err)
+	}
+	message, ok := doc.(map[string]any)
+	if !ok || len(message) != 1 || message[CopilotEventReplay] == nil {
+		return CopilotEvent{}, errors.New("the binary copilot message is not a replay")
+	}
+
+	// The messages are a field of the replay, or its only element when it is
+	// encoded as an array.
+	var messages any
+	switch replay := message[CopilotEventReplay].(type) {
+	case map[string]any:
+		messages = replay["messages"]
+	case []any:
+		if len(replay) == 1 {
+			messages = replay[0]
+		}
+	}
+	list, ok := messages.([]any)
+	if !ok {
+		return CopilotEvent{}, errors.New("the copilot replay has no messages")
+	}
+
+	event := CopilotEvent{Type: CopilotEventReplay, Replay: make([][]byte, len(list))}
+	for i, m := range list {
+		switch m := m.(type) {
+		case []byte:
+			event.Replay[i] = m
+		case []any:
+			event.Replay[i] = make([]byte, len(m))
+			for j, b := range m {
+				n, ok := b.(float64)
+				if !ok || n < 0 || n > 255 || n != math.Trunc(n) {
+					return CopilotEvent{}, fmt.Errorf("the copilot replay message %d is not made of bytes", i)
+				}
+				event.Replay[i][j] = byte(n)
+			}
+		default:
+			return CopilotEvent{}, fmt.Errorf("the copilot replay message %d is not made of bytes", i)
+		}
+	}
+	return event, nil
+}
+
+// CopilotReply is an answer of the copilot, aggregated from the events of a
+// turn with Add.
+type CopilotReply struct {
+	// ConversationID is the ID of the conversation.
+	ConversationID string
+	// PromptID is the ID of the prompt, once the answer ended.
+	PromptID *UUID
+	// Text is the answer, made of the deltas.
+	Text string
+	// Reasoning is the reasoning of the assistant.
+	Reasoning []Reasoning
+	// ToolOutputs are the results of the tools called.
+	ToolOutputs []MlToolResult
+	// Info is the informational text sent along the answer.
+	Info []string
+	// Files are the files sent or updated by the server, by path, ready to
+	// be written with WriteFiles.
+	Files map[string][]byte
+	// Done is whether the answer ended.
+	Done bool
+}
+
+// Add adds the event to the reply.
+func (r *CopilotReply) Add(event CopilotEvent) {
+	if event.ConversationID != "" {
+		r.ConversationID = event.ConversationID
+	}
+
+	switch event.Type {
+	case CopilotEventDelta:
+		r.Text += event.Delta
+	case CopilotEventReasoning:
+		r.Reasoning = append(r.Reasoning, *event.Reasoning)
+	case CopilotEventToolOutput:
+		r.ToolOutputs = append(r.ToolOutputs, event.ToolOutput)
+	case CopilotEventInfo:
+		r.Info = append(r.Info, event.Text)
+	case CopilotEventFiles:
+		for _, file := range event.Files {
+			r.setFile(file.Name, CopilotFileData(file))
+		}
+	case CopilotEventProjectUpdated:
+		for name, contents := range event.ProjectFiles {
+			r.setFile(name, []byte(contents))
+		}
+	case CopilotEventEndOfStream:
+		r.PromptID = event.EndOfStream.ID
+		if event.EndOfStream.WholeResponse != nil {
+			r.Text = *event.EndOfStream.WholeResponse
+		}
+		r.Done = true
+	}
+}
+
+func (r *CopilotReply) setFile(name string, contents []byte) {
+	if r.Files == nil {
+		r.Files = map[string][]byte{}
+	}
+	r.Files[name] = contents
+}
+
+// WriteFiles writes the files of the reply into dir, like
+// WriteExportFiles, and returns the paths of the files written.
+func (r *CopilotReply) WriteFiles(dir string) ([]string, error) {
+	files := []ExportFile{}
+	for _, name := range slices.Sorted(maps.Keys(r.Files)) {
+		files = append(files, ExportFile{Name: name, Contents: Base64{Inner: r.Files[name]}})
+	}
+	return WriteExportFiles(dir, files)
+}
+
+// NewCopilotFile creates a file to attach to a prompt.
+func NewCopilotFile(name, mimetype string, data []byte) MlCopilotFile {
+	return MlCopilotFile{Name: name, Mimetype: mimetype, Data: copilotBytes(data)}
+}
+
+// CopilotFileData returns the contents of the file.
+func CopilotFileData(file MlCopilotFile) []byte {
+	data := make([]byte, len(file.Data))
+	for i, b := range file.Data {
+		data[i] = byte(b)
+	}
+	return data
+}
+
+// copilotBytes converts data to the array of bytes the copilot expects,
+// since []byte would be encoded as base64.
+func copilotBytes(data []byte) []int {
+	ints := make([]int, len(data))
+	for i, b := range data {
+		ints[i] = int(b)
+	}
+	return ints
+}
+
+// copilotFiles converts the files to arrays of bytes.
+func copilotFiles(files map[string][]byte) map[string][]int {
+	if files == nil {
+		return nil
+	}
+	converted := map[string][]int{}
+	for name, data := range files {
+		converted[name] = copilotBytes(data)
+	}
+	return converted
+}
+
+// CopilotPrompt is a prompt of the user to the copilot.
+type CopilotPrompt struct {
+	// Content is the prompt.
+	Content string
+	// Attachments are additional files, like images or PDFs.
+	Attachments []MlCopilotFile
+	// CurrentFiles are the files of the project, by path.
+	CurrentFiles map[string][]byte
+	// ProjectName is the name of the project, if any.
+	ProjectName string
+	// Mode is the mode of the copilot, if not the default one.
+	Mode MlCopilotMode
+	// SourceRanges are the ranges of the source the user wants to change.
+	SourceRanges []SourceRangePrompt
+	// ForcedTools are the tools the copilot has to use.
+	ForcedTools []MlCopilotTool
+}
+
+// copilotUserMessage is the MlCopilotClientMessage sending a prompt.
+type copilotUserMessage struct {
+	Type            string              `json:"type"`
+	Content         string              `json:"content"`
+	AdditionalFiles []MlCopilotFile     `json:"additional_files,omitempty"`
+	CurrentFiles    map[string][]int    `json:"current_files,omitempty"`
+	ProjectName     *string             `json:"project_name,omitempty"`
+	Mode            *MlCopilotMode      `json:"mode,omitempty"`
+	SourceRanges    []SourceRangePrompt `json:"source_ranges,omitempty"`
+	ForcedTools     []MlCopilotTool     `json:"forced_tools,omitempty"`
+}
+
+// copilotProjectContext is the MlCopilotClientMessage updating the project.
+type copilotProjectContext struct {
+	Type         string           `json:"type"`
+	CurrentFiles map[string][]int `json:"current_files,omitempty"`
+	ProjectName  *string          `json:"project_name,omitempty"`
+}
+
+// copilotHeaders is the MlCopilotClientMessage authenticating the websocket.
+type copilotHeaders struct {
+	Type    string            `json:"type"`
+	Headers map[string]string `json:"headers"`
+}
+
+// copilotSystem is the MlCopilotClientMessage sending a system command.
+type copilotSystem struct {
+	Type    string                 `json:"type"`
+	Command MlCopilotSystemCommand `json:"command"`
+}
+
+// copilotMessage is a MlCopilotClientMessage without fields, like ping.
+type copilotMessage struct {
+	Type string `json:"type"`
+}
+
+// CopilotConversation is a conversation with the ML copilot over the
+// CopilotWs websocket. Prompts are sent with Ask or Stream, one turn at a
+// time, and the messages of the server are read with Next.
+type CopilotConversation struct {
+	conn *websocket.Conn
+
+	// writeMu serializes the writes to the websocket.
+	writeMu sync.Mutex
+	// turnMu serializes the readers of the events.
+	turnMu sync.Mutex
+
+	events chan CopilotEvent
+	// closed is closed by Close, so read does not block on events.
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu             sync.Mutex
+	err            error
+	conversationID string
+	apiCallID      string
+}
+
+// NewCopilotConversation opens the copilot websocket and authenticates it.
+func (s *MlService) NewCopilotConversation(ctx context.Context, params MlCopilotWsParams) (*CopilotConversation, error) {
+	conn, err := s.CopilotWs(ctx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewCopilotConversationFromConn(ctx, conn, s.client.token)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewCopilotConversationFromConn starts a conversation over a copilot
+// websocket, opened with MlService.CopilotWs, authenticating it with the
+// token.
+func NewCopilotConversationFromConn(ctx context.Context, conn *websocket.Conn, token string) (*CopilotConversation, error) {
+	c := &CopilotConversation{
+		conn:   conn,
+		events: make(chan CopilotEvent, 64),
+		closed: make(chan struct{}),
+	}
+
+	if err := c.write(ctx, copilotHeaders{Type: "headers", Headers: map[string]string{"Authorization": "Bearer " + token}}); err != nil {
+		return nil, fmt.Errorf("authenticating the copilot websocket failed: %w", err)
+	}
+
+	go c.read()
+	return c, nil
+}
+
+// read decodes the messages of the server until the websocket is closed.
+func (c *CopilotConversation) read() {
+	defer close(c.events)
+
+	for {
+		messageType, message, err := c.conn.ReadMessage()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				err = ErrCopilotClosed
+			} else {
+				err = fmt.Errorf("reading from the copilot websocket failed: %w", err)
+			}
+			c.setErr(err)
+			return
+		}
+
+		// Replays are the only binary messages. The messages which cannot be
+		// decoded are passed on, so the conversation goes on.
+		var event CopilotEvent
+		if messageType == websocket.BinaryMessage {
+			event, err = decodeCopilotReplay(message)
+		} else {
+			err = json.Unmarshal(message, &event)
+		}
+		if err != nil {
+			event = CopilotEvent{Type: CopilotEventUnknown, Text: err.Error(), Frame: message}
+		}
+
+		c.mu.Lock()
+		if event.ConversationID != "" {
+			c.conversationID = event.ConversationID
+		}
+		if event.APICallID != "" {
+			c.apiCallID = event.APICallID
+		}
+		c.mu.Unlock()
+
+		select {
+		case c.events <- event:
+		case <-c.closed:
+			c.setErr(ErrCopilotClosed)
+			return
+		}
+	}
+}
+
+func (c *CopilotConversation) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// write sends the message to the server.
+func (c *CopilotConversation) write(ctx context.Context, message any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		c.conn.SetWriteDeadline(deadline)
+		defer c.conn.SetWriteDeadline(time.Time{})
+	}
+	if err := c.conn.WriteJSON(message); err != nil {
+		if errors.Is(err, net.ErrClosed) {
+			return ErrCopilotClosed
+		}
+		return fmt.Errorf("writing to the copilot websocket failed: %w", err)
+	}
+	return nil
+}
+
+// ConversationID returns the ID of the conversation, once the server sent
+// it.
+func (c *CopilotConversation) ConversationID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conversationID
+}
+
+// APICallID returns the API call of the websocket, once the server sent it.
+func (c *CopilotConversation) APICallID() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.apiCallID
+}
+
+// Next returns the next message of the server. It returns an error once the
+// websocket is closed.
+func (c *CopilotConversation) Next(ctx context.Context) (CopilotEvent, error) {
+	select {
+	case event, ok := <-c.events:
+		if !ok {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			return CopilotEvent{}, c.err
+		}
+		return event, nil
+	case <-ctx.Done():
+		return CopilotEvent{}, ctx.Err()
+	}
+}
+
+// Stream sends the prompt, and yields the messages of the server until the
+// end of the answer, included. Errors of the server end the stream, and are
+// yielded as *CopilotError along with their event.
+func (c *CopilotConversation) Stream(ctx context.Context, prompt CopilotPrompt) iter.Seq2[CopilotEvent, error] {
+	return func(yield func(CopilotEvent, error) bool) {
+		c.turnMu.Lock()
+		defer c.turnMu.Unlock()
+
+		if err := c.Prompt(ctx, prompt); err != nil {
+			yield(CopilotEvent{}, err)
+			return
+		}
+
+		for {
+			event, err := c.Next(ctx)
+			if err != nil {
+				yield(event, err)
+				return
+			}
+			if err := event.Err(); err != nil {
+				yield(event, err)
+				return
+			}
+			if !yield(event, nil) || event.Type == CopilotEventEndOfStream {
+				return
+			}
+		}
+	}
+}
+
+// Ask sends the prompt, and waits for the whole answer. If the server fails,
+// the error is a *CopilotError, and the answer so far is returned with it.
+func (c *CopilotConversation) Ask(ctx context.Context, prompt CopilotPrompt) (*CopilotReply, error) {
+	reply := &CopilotReply{}
+	for event, err := range c.Stream(ctx, prompt) {
+		if err != nil {
+			return reply, err
+		}
+		reply.Add(event)
+	}
+	return reply, nil
+}
+
+// Prompt sends the prompt without waiting for the answer, which is read
+// with Next.
+func (c *CopilotConversation) Prompt(ctx context.Context, prompt CopilotPrompt) error {
+	message := copilotUserMessage{
+		Type:            "user",
+		Content:         prompt.Content,
+		AdditionalFiles: prompt.Attachments,
+		CurrentFiles:    copilotFiles(prompt.CurrentFiles),
+		SourceRanges:    prompt.SourceRanges,
+		ForcedTools:     prompt.ForcedTools,
+	}
+	if prompt.ProjectName != "" {
+		message.ProjectName = &prompt.ProjectName
+	}
+	if prompt.Mode != "" {
+		message.Mode = &prompt.Mode
+	}
+	return c.write(ctx, message)
+}
+
+// SetProjectContext updates the files and the name of the project, without
+// sending a prompt.
+func (c *CopilotConversation) SetProjectContext(ctx context.Context, projectName string, files map[string][]byte) error {
+	message := copilotProjectContext{Type: "project_context", CurrentFiles: copilotFiles(files)}
+	if projectName != "" {
+		message.ProjectName = &projectName
+	}
+	return c.write(ctx, message)
+}
+
+// SendSystem sends the system command, like interrupting the answer.
+func (c *CopilotConversation) SendSystem(ctx context.Context, command MlCopilotSystemCommand) error {
+	return c.write(ctx, copilotSystem{Type: "system", Command: command})
+}
+
+// Ping pings the server, which answers with a pong event.
+func (c *CopilotConversation) Ping(ctx context.Context) error {
+	return c.write(ctx, copilotMessage{Type: "ping"})
+}
+
+// ListModes requests the modes of the copilot, and waits for them. The
+// other messages received meanwhile are dropped, so it should be called
+// between turns.
+func (c *CopilotConversation) ListModes(ctx context.Context) (*CopilotModes, error) {
+	c.turnMu.Lock()
+	defer c.turnMu.Unlock()
+
+	if err := c.write(ctx, copilotMessage{Type: "list_modes"}); err != nil {
+		return nil, err
+	}
+	for {
+		event, err := c.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := event.Err(); err != nil {
+			return nil, err
+		}
+		if event.Type == CopilotEventModesResponse {
+			return event.Modes, nil
+		}
+	}
+}
+
+// Close closes the websocket.
+func (c *CopilotConversation) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	if err := c.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
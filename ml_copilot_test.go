@@ -0,0 +1,183 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// startCopilotStandIn starts a websocket server answering prompts like the
+// copilot, after checking the authentication. A prompt of "fail" fails, and
+// a prompt of "replay" is answered with a MsgPack replay of an earlier turn,
+// followed by a binary message which is not a replay.
+func startCopilotStandIn(t *testing.T) *websocket.Conn {
+	t.Helper()
+
+	return dialStandIn(t, websocketStandIn(func(conn *websocket.Conn) {
+		var auth copilotHeaders
+		if err := conn.ReadJSON(&auth); err != nil || auth.Type != "headers" || auth.Headers["Authorization"] != "Bearer token" {
+			conn.WriteJSON(map[string]any{"error": map[string]any{"detail": "unauthorized"}})
+			return
+		}
+		conn.WriteJSON(map[string]any{"session_data": map[string]any{"api_call_id": "call"}})
+
+		for {
+			var message struct {
+				Type         string           `json:"type"`
+				Content      string           `json:"content"`
+				CurrentFiles map[string][]int `json:"current_files"`
+			}
+			if err := conn.ReadJSON(&message); err != nil {
+				return
+			}
+
+			switch {
+			case message.Type == "list_modes":
+				conn.WriteJSON(map[string]any{"modes_response": map[string]any{
+					"default_mode": "fast",
+					"modes":        []map[string]any{{"id": "fast", "label": "Fast", "description": "Fast results", "icon": "bolt"}},
+				}})
+			case message.Type == "user" && message.Content == "replay":
+				conn.WriteMessage(websocket.BinaryMessage, encodeMsgPack(map[string]any{"replay": map[string]any{"messages": []any{
+					[]byte(`{"type":"user","content":"Add a wheel"}`),
+					[]byte(`{"info":{"text":"Adding a wheel."}}`),
+				}}}))
+				conn.WriteMessage(websocket.BinaryMessage, []byte{0xff, 0x00})
+				conn.WriteJSON(map[string]any{"end_of_stream": map[string]any{}})
+			case message.Type == "user" && message.Content == "fail":
+				conn.WriteJSON(map[string]any{"error": map[string]any{"detail": "no credits left"}})
+			case message.Type == "user":
+				// Answer with the main file of the project, plus a wheel.
+				main := string(CopilotFileData(MlCopilotFile{Data: message.CurrentFiles["main.kcl"]}))
+				conn.WriteJSON(map[string]any{"conversation_id": map[string]any{"conversation_id": "conversation"}})
+				conn.WriteJSON(map[string]any{"reasoning": map[string]any{"type": "text", "content": "Adding a wheel."}})
+				for _, delta := range []string{"Added ", "a ", "wheel."} {
+					conn.WriteJSON(map[string]any{"delta": map[string]any{"delta": delta}})
+				}
+				conn.WriteJSON(map[string]any{"project_updated": map[string]any{"files": map[string]string{"main.kcl": main + "\nwheel()"}}})
+				conn.WriteJSON(map[string]any{"files": map[string]any{"files": []MlCopilotFile{NewCopilotFile("parts/wheel.kcl", "text/plain", []byte("fn wheel() {}"))}}})
+				conn.WriteJSON(map[string]any{"end_of_stream": map[string]any{"conversation_id": "conversation"}})
+			}
+		}
+	}))
+}
+
+func newCopilotStandIn(t *testing.T) *CopilotConversation {
+	t.Helper()
+
+	c, err := NewCopilotConversationFromConn(context.Background(), startCopilotStandIn(t), "token")
+	if err != nil {
+		t.Fatalf("starting the conversation failed: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestCopilotConversationAsk(t *testing.T) {
+	c := newCopilotStandIn(t)
+
+	reply, err := c.Ask(context.Background(), CopilotPrompt{
+		Content:      "Add a wheel",
+		CurrentFiles: map[string][]byte{"main.kcl": []byte("car()")},
+	})
+	if err != nil {
+		t.Fatalf("asking failed: %v", err)
+	}
+	if reply.Text != "Added a wheel." || !reply.Done || reply.ConversationID != "conversation" || len(reply.Reasoning) != 1 {
+		t.Fatalf("unexpected reply %+v", reply)
+	}
+	if c.ConversationID() != "conversation" || c.APICallID() != "call" {
+		t.Fatalf("unexpected conversation %q of API call %q", c.ConversationID(), c.APICallID())
+	}
+
+	dir := t.TempDir()
+	if _, err := reply.WriteFiles(dir); err != nil {
+		t.Fatalf("writing the files failed: %v", err)
+	}
+	for name, want := range map[string]string{"main.kcl": "car()\nwheel()", "parts/wheel.kcl": "fn wheel() {}"} {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(got) != want {
+			t.Fatalf("expected %s to contain %q, got %q (%v)", name, want, got, err)
+		}
+	}
+}
+
+func TestCopilotConversationReplay(t *testing.T) {
+	c := newCopilotStandIn(t)
+
+	types := []string{}
+	var replay, unknown CopilotEvent
+	for event, err := range c.Stream(context.Background(), CopilotPrompt{Content: "replay"}) {
+		if err != nil {
+			t.Fatalf("streaming failed: %v", err)
+		}
+		types = append(types, event.Type)
+		switch event.Type {
+		case CopilotEventReplay:
+			replay = event
+		case CopilotEventUnknown:
+			unknown = event
+		}
+	}
+	if !slices.Equal(types, []string{CopilotEventSessionData, CopilotEventReplay, CopilotEventUnknown, CopilotEventEndOfStream}) {
+		t.Fatalf("unexpected events %v", types)
+	}
+
+	if len(replay.Replay) != 2 {
+		t.Fatalf("expected 2 replayed messages, got %q", replay.Replay)
+	}
+	var info CopilotEvent
+	if err := json.Unmarshal(replay.Replay[1], &info); err != nil || info.Type != CopilotEventInfo || info.Text != "Adding a wheel." {
+		t.Fatalf("unexpected replayed message %+v (%v)", info, err)
+	}
+	if !bytes.Equal(unknown.Frame, []byte{0xff, 0x00}) || unknown.Text == "" {
+		t.Fatalf("unexpected unknown message %+v", unknown)
+	}
+}
+
+func TestDecodeCopilotReplayArrays(t *testing.T) {
+	// The replay may be encoded as an array of its fields, and its messages
+	// as arrays of integers.
+	event, err := decodeCopilotReplay(encodeMsgPack(map[string]any{"replay": []any{[]any{[]any{int('{'), int('}')}}}}))
+	if err != nil {
+		t.Fatalf("decoding failed: %v", err)
+	}
+	if len(event.Replay) != 1 || string(event.Replay[0]) != "{}" {
+		t.Fatalf("unexpected replay %q", event.Replay)
+	}
+
+	if _, err := decodeCopilotReplay(encodeMsgPack(map[string]any{"replay": []any{[]any{[]any{256}}}})); err == nil {
+		t.Fatal("expected a replay message which is not made of bytes to be refused")
+	}
+}
+
+func TestCopilotConversationErrors(t *testing.T) {
+	c := newCopilotStandIn(t)
+
+	_, err := c.Ask(context.Background(), CopilotPrompt{Content: "fail"})
+	var copilotErr *CopilotError
+	if !errors.As(err, &copilotErr) || copilotErr.Detail != "no credits left" {
+		t.Fatalf("expected a *CopilotError, got %v", err)
+	}
+
+	// The conversation goes on after the error.
+	modes, err := c.ListModes(context.Background())
+	if err != nil {
+		t.Fatalf("listing the modes failed: %v", err)
+	}
+	if modes.DefaultMode != "fast" || len(modes.Modes) != 1 {
+		t.Fatalf("unexpected modes %+v", modes)
+	}
+
+	c.Close()
+	if _, err := c.Next(context.Background()); !errors.Is(err, ErrCopilotClosed) {
+		t.Fatalf("expected ErrCopilotClosed, got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package kittycad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// The types of Reasoning, one per variant of ReasoningMessage.
+const (
+	ReasoningText               = "text"
+	ReasoningMarkdown           = "markdown"
+	ReasoningKclDocs            = "kcl_docs"
+	ReasoningKclCodeExamples    = "kcl_code_examples"
+	ReasoningFeatureTreeOutline = "feature_tree_outline"
+	ReasoningDesignPlan         = "design_plan"
+	ReasoningGeneratedKclCode   = "generated_kcl_code"
+	ReasoningKclCodeError       = "kcl_code_error"
+	ReasoningCreatedKclFile     = "created_kcl_file"
+	ReasoningUpdatedKclFile     = "updated_kcl_file"
+	ReasoningDeletedKclFile     = "deleted_kcl_file"
+	ReasoningCreatedProjectFile = "created_project_file"
+	ReasoningUpdatedProjectFile = "updated_project_file"
+	ReasoningDeletedProjectFile = "deleted_project_file"
+)
+
+// Reasoning is a ReasoningMessage of the ML copilot. Only the fields of its
+// Type are set.
+type Reasoning struct {
+	// Type is the type of the reasoning, like ReasoningDesignPlan.
+	Type string `json:"type"`
+	// Content is the text of the reasoning, or the contents of the created
+	// or updated file.
+	Content string `json:"content,omitempty"`
+	// Steps are the steps of a design plan.
+	Steps []PlanStep `json:"steps,omitempty"`
+	// Code is the generated KCL code, which has not been executed yet.
+	Code string `json:"code,omitempty"`
+	// Error is the error executing the KCL code.
+	Error string `json:"error,omitempty"`
+	// FileName is the name of the created, updated or deleted file.
+	FileName string `json:"file_name,omitempty"`
+}
+
+// Markdown renders the reasoning as Markdown, ending with a blank line so
+// reasonings can be concatenated.
+func (r Reasoning) Markdown() string {
+	var b strings.Builder
+	switch r.Type {
+	case ReasoningText, ReasoningMarkdown:
+		b.WriteString(r.Content)
+	case ReasoningKclDocs:
+		b.WriteString("### KCL docs\n\n" + r.Content)
+	case ReasoningKclCodeExamples:
+		b.WriteString("### KCL code examples\n\n" + r.Content)
+	case ReasoningFeatureTreeOutline:
+		b.WriteString("### Feature tree outline\n\n" + r.Content)
+	case ReasoningDesignPlan:
+		b.WriteString("### Design plan\n")
+		for i, step := range r.Steps {
+			fmt.Fprintf(&b, "\n%d. `%s`: %s", i+1, step.FilepathToEdit, step.EditInstructions)
+		}
+	case ReasoningGeneratedKclCode:
+		b.WriteString("### Generated KCL code\n\n" + markdownCode("kcl", r.Code))
+	case ReasoningKclCodeError:
+		b.WriteString("### KCL error\n\n" + markdownCode("", r.Error))
+	case ReasoningCreatedKclFile, ReasoningUpdatedKclFile:
+		fmt.Fprintf(&b, "### %s `%s`\n\n%s", reasoningFileAction(r.Type), r.FileName, markdownCode("kcl", r.Content))
+	case ReasoningCreatedProjectFile, ReasoningUpdatedProjectFile:
+		fmt.Fprintf(&b, "### %s `%s`\n\n%s", reasoningFileAction(r.Type), r.FileName, markdownCode("", r.Content))
+	case ReasoningDeletedKclFile, ReasoningDeletedProjectFile:
+		fmt.Fprintf(&b, "### Deleted `%s`", r.FileName)
+	default:
+		fmt.Fprintf(&b, "### %s\n\n%s", r.Type, r.Content)
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n\n"
+}
+
+func reasoningFileAction(t string) string {
+	if strings.HasPrefix(t, "created_") {
+		return "Created"
+	}
+	return "Updated"
+}
+
+// markdownCode renders the code as a fenced block, with a fence longer than
+// any run of backticks in the code.
+func markdownCode(language, code string) string {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	return fence + language + "\n" + strings.TrimRight(code, "\n") + "\n" + fence
+}
+
+// ReasoningStream reads the reasoning of a text-to-CAD prompt from the
+// ReasoningWs websocket.
+type ReasoningStream struct {
+	conn *websocket.Conn
+	done bool
+}
+
+// NewReasoningStream subscribes to the reasoning of the text-to-CAD prompt
+// with the ID.
+func (s *MlService) NewReasoningStream(ctx context.Context, id UUID) (*ReasoningStream, error) {
+	conn, err := s.ReasoningWs(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewReasoningStreamFromConn(conn), nil
+}
+
+// NewReasoningStreamFromConn reads the reasoning from a websocket opened
+// with MlService.ReasoningWs.
+func NewReasoningStreamFromConn(conn *websocket.Conn) *ReasoningStream {
+	return &ReasoningStream{conn: conn}
+}
+
+// Next returns the next reasoning, or io.EOF once the stream ended. Errors
+// of the server are returned as *CopilotError. If the context is done while
+// waiting, the stream cannot be read anymore.
+func (r *ReasoningStream) Next(ctx context.Context) (Reasoning, error) {
+	if r.done {
+		return Reasoning{}, io.EOF
+	}
+
+	// Interrupt the read when the context is done.
+	stop := context.AfterFunc(ctx, func() { r.conn.SetReadDeadline(time.Now()) })
+	defer stop()
+
+	for {
+		var event CopilotEvent
+		if err := r.conn.ReadJSON(&event); err != nil {
+			if ctx.Err() != nil {
+				return Reasoning{}, ctx.Err()
+			}
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				r.done = true
+				return Reasoning{}, io.EOF
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return Reasoning{}, ErrCopilotClosed
+			}
+			return Reasoning{}, fmt.Errorf("reading from the reasoning websocket failed: %w", err)
+		}
+
+		if err := event.Err(); err != nil {
+			return Reasoning{}, err
+		}
+		switch event.Type {
+		case CopilotEventReasoning:
+			return *event.Reasoning, nil
+		case CopilotEventEndOfStream:
+			r.done = true
+			return Reasoning{}, io.EOF
+		}
+	}
+}
+
+// All yields the reasonings until the stream ends. An error ends it.
+func (r *ReasoningStream) All(ctx context.Context) iter.Seq2[Reasoning, error] {
+	return func(yield func(Reasoning, error) bool) {
+		for {
+			reasoning, err := r.Next(ctx)
+			if err == io.EOF {
+				return
+			}
+			if !yield(reasoning, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// WriteMarkdown renders the reasonings to w as Markdown as they arrive,
+// until the stream ends.
+func (r *ReasoningStream) WriteMarkdown(ctx context.Context, w io.Writer) error {
+	for reasoning, err := range r.All(ctx) {
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, reasoning.Markdown()); err != nil {
+			return fmt.Errorf("writing the reasoning failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// Close closes the websocket.
+func (r *ReasoningStream) Close() error {
+	if err := r.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package kittycad
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// startReasoningStandIn starts a websocket server streaming the messages,
+// then closing the websocket.
+func startReasoningStandIn(t *testing.T, messages ...map[string]any) *ReasoningStream {
+	t.Helper()
+
+	conn := dialStandIn(t, websocketStandIn(func(conn *websocket.Conn) {
+		for _, message := range messages {
+			conn.WriteJSON(message)
+		}
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.ReadMessage()
+	}))
+	stream := NewReasoningStreamFromConn(conn)
+	t.Cleanup(func() { stream.Close() })
+	return stream
+}
+
+func TestReasoningStreamWriteMarkdown(t *testing.T) {
+	stream := startReasoningStandIn(t,
+		map[string]any{"info": map[string]any{"text": "Thinking"}},
+		map[string]any{"reasoning": map[string]any{"type": "text", "content": "A bracket needs two holes."}},
+		map[string]any{"reasoning": map[string]any{"type": "design_plan", "steps": []map[string]any{
+			{"filepath_to_edit": "main.kcl", "edit_instructions": "Sketch the plate"},
+			{"filepath_to_edit": "main.kcl", "edit_instructions": "Drill the holes"},
+		}}},
+		map[string]any{"reasoning": map[string]any{"type": "created_kcl_file", "file_name": "main.kcl", "content": "plate = startSketchOn(XY)\n"}},
+		map[string]any{"reasoning": map[string]any{"type": "deleted_project_file", "file_name": "old.kcl"}},
+		map[string]any{"end_of_stream": map[string]any{}},
+	)
+
+	var b strings.Builder
+	if err := stream.WriteMarkdown(context.Background(), &b); err != nil {
+		t.Fatalf("rendering the reasoning failed: %v", err)
+	}
+
+	want := "A bracket needs two holes.\n\n" +
+		"### Design plan\n\n1. `main.kcl`: Sketch the plate\n2. `main.kcl`: Drill the holes\n\n" +
+		"### Created `main.kcl`\n\n```kcl\nplate = startSketchOn(XY)\n```\n\n" +
+		"### Deleted `old.kcl`\n\n"
+	if b.String() != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, b.String())
+	}
+}
+
+func TestReasoningStreamEnds(t *testing.T) {
+	stream := startReasoningStandIn(t,
+		map[string]any{"reasoning": map[string]any{"type": "generated_kcl_code", "code": "cube(1)"}},
+	)
+
+	reasonings := []Reasoning{}
+	for reasoning, err := range stream.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("reading the reasoning failed: %v", err)
+		}
+		reasonings = append(reasonings, reasoning)
+	}
+	if len(reasonings) != 1 || reasonings[0].Type != ReasoningGeneratedKclCode || reasonings[0].Code != "cube(1)" {
+		t.Fatalf("unexpected reasonings %+v", reasonings)
+	}
+}
+
+func TestReasoningStreamErrors(t *testing.T) {
+	stream := startReasoningStandIn(t, map[string]any{"error": map[string]any{"detail": "prompt not found"}})
+
+	var copilotErr *CopilotError
+	if _, err := stream.Next(context.Background()); !errors.As(err, &copilotErr) || copilotErr.Detail != "prompt not found" {
+		t.Fatalf("expected a *CopilotError, got %v", err)
+	}
+}
+
+func TestMarkdownCodeFence(t *testing.T) {
+	if got := markdownCode("", "a ``` b"); got != "````\na ``` b\n````" {
+		t.Fatalf("unexpected fence %q", got)
+	}
+}
@@ -0,0 +1,139 @@
+package kittycad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// modelingCmdBatchRequest is the WebSocketRequest submitting a batch of
+// modeling commands.
+type modelingCmdBatchRequest struct {
+	Type      string           `json:"type"`
+	BatchID   UUID             `json:"batch_id"`
+	Requests  []ModelingCmdReq `json:"requests"`
+	Responses bool             `json:"responses"`
+}
+
+// ModelingBatchError is returned when a command of a batch fails. The engine
+// skips the commands after the failing one.
+type ModelingBatchError struct {
+	// Index is the index of the failing command in the batch.
+	Index int
+	// CmdID is the ID of the failing command.
+	CmdID UUID
+	// Err is the error of the failing command.
+	Err *ModelingCmdError
+}
+
+// Error converts the ModelingBatchError to a readable string.
+func (err *ModelingBatchError) Error() string {
+	return fmt.Sprintf("command %d of the modeling batch failed: %v", err.Index, err.Err)
+}
+
+// Unwrap returns the error of the failing command.
+func (err *ModelingBatchError) Unwrap() error {
+	return err.Err
+}
+
+// ModelingBatch accumulates modeling commands, to submit them to the engine
+// in a single round-trip. Create one with ModelingSession.NewBatch.
+type ModelingBatch struct {
+	session   *ModelingSession
+	requests  []ModelingCmdReq
+	responses bool
+}
+
+// NewBatch creates an empty batch of commands for the session.
+func (s *ModelingSession) NewBatch() *ModelingBatch {
+	return &ModelingBatch{session: s}
+}
+
+// WithResponses asks the engine for the response of every command. Without
+// it the engine only reports whether the commands succeeded, which is
+// cheaper for commands whose response is not needed.
+func (b *ModelingBatch) WithResponses() *ModelingBatch {
+	b.responses = true
+	return b
+}
+
+// Add adds the command to the batch, and returns its index.
+func (b *ModelingBatch) Add(cmd ModelingCmd) int {
+	b.requests = append(b.requests, ModelingCmdReq{Cmd: cmd, CmdID: newModelingCmdID()})
+	return len(b.requests) - 1
+}
+
+// Len returns the number of commands in the batch.
+func (b *ModelingBatch) Len() int {
+	return len(b.requests)
+}
+
+// Submit sends the commands to the engine as one batch, and waits for their
+// responses, which are returned in the order the commands were added. The
+// responses are nil unless WithResponses was called.
+//
+// If a command fails, the error is a *ModelingBatchError, and the responses
+// of the commands before it are returned if the engine sent them.
+func (b *ModelingBatch) Submit(ctx context.Context) ([]OkModelingCmdResponse, error) {
+	if len(b.requests) == 0 {
+		return nil, nil
+	}
+
+	batchID := newModelingCmdID()
+	ids := []UUID{batchID}
+	for _, req := range b.requests {
+		ids = append(ids, req.CmdID)
+	}
+
+	resp, err := b.session.roundTrip(ctx, ids, modelingCmdBatchRequest{
+		Type:      "modeling_cmd_batch_req",
+		BatchID:   batchID,
+		Requests:  b.requests,
+		Responses: b.responses,
+	})
+	if err != nil {
+		// The engine may fail the batch as a whole, naming the failing
+		// command as the request.
+		if cmdErr, ok := err.(*ModelingCmdError); ok && cmdErr.RequestID != nil {
+			for i, req := range b.requests {
+				if req.CmdID.String() == cmdErr.RequestID.String() {
+					return nil, &ModelingBatchError{Index: i, CmdID: req.CmdID, Err: cmdErr}
+				}
+			}
+		}
+		return nil, err
+	}
+
+	if resp.Resp.Type != "modeling_batch" {
+		return nil, fmt.Errorf("unexpected response of type %q to modeling batch %s", resp.Resp.Type, batchID)
+	}
+	var data struct {
+		Responses map[string]struct {
+			Response OkModelingCmdResponse `json:"response"`
+			Errors   []APIError            `json:"errors"`
+		} `json:"responses"`
+	}
+	if err := json.Unmarshal(resp.Resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("error decoding response to modeling batch %s: %v", batchID, err)
+	}
+
+	results := []OkModelingCmdResponse{}
+	for i, req := range b.requests {
+		r, ok := data.Responses[req.CmdID.String()]
+		if !ok {
+			return results, fmt.Errorf("no response to command %d of modeling batch %s", i, batchID)
+		}
+		if len(r.Errors) > 0 {
+			id := req.CmdID
+			return results, &ModelingBatchError{Index: i, CmdID: id, Err: &ModelingCmdError{RequestID: &id, Errors: r.Errors}}
+		}
+
+		var result OkModelingCmdResponse
+		if b.responses {
+			result = r.Response
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,46 @@
+package kittycad
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestModelingBatchReturnsResponsesInOrder(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	batch := session.NewBatch().WithResponses()
+	for _, cmdType := range []string{"start_path", "extend_path", "extrude", "export"} {
+		batch.Add(map[string]any{"type": cmdType})
+	}
+
+	results, err := batch.Submit(context.Background())
+	if err != nil {
+		t.Fatalf("submitting the batch failed: %v", err)
+	}
+	if len(results) != 4 || results[2].(map[string]any)["type"] != "extrude" {
+		t.Fatalf("unexpected results: %v", results)
+	}
+}
+
+func TestModelingBatchReportsFailingCommand(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	batch := session.NewBatch()
+	batch.Add(map[string]any{"type": "start_path"})
+	failing := batch.Add(map[string]any{"type": "fail"})
+	batch.Add(map[string]any{"type": "extrude"})
+
+	results, err := batch.Submit(context.Background())
+	var batchErr *ModelingBatchError
+	if !errors.As(err, &batchErr) || batchErr.Index != failing {
+		t.Fatalf("expected command %d to fail, got %v", failing, err)
+	}
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "no such entity" {
+		t.Fatalf("expected the error of the engine, got %v", err)
+	}
+	if len(results) != 1 || results[0] != nil {
+		t.Fatalf("expected the empty result of the first command, got %v", results)
+	}
+}
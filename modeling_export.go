@@ -0,0 +1,256 @@
+package kittycad
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// modelingCmdExport3D is the ModelingCmd exporting the scene.
+type modelingCmdExport3D struct {
+	Type      string         `json:"type"`
+	EntityIDs []UUID         `json:"entity_ids"`
+	Format    OutputFormat3D `json:"format"`
+}
+
+// Export3D exports the entities, or the whole scene if none are given, to
+// the format. The files can be written out with WriteExportFiles.
+func (s *ModelingSession) Export3D(ctx context.Context, format OutputFormat3D, entityIDs ...UUID) ([]ExportFile, error) {
+	if entityIDs == nil {
+		entityIDs = []UUID{}
+	}
+
+	resp, err := s.Send(ctx, modelingCmdExport3D{Type: "export3d", EntityIDs: entityIDs, Format: format})
+	if err != nil {
+		return nil, err
+	}
+	return ExportFiles(resp)
+}
+
+// exportCmdTypes are the types of the ModelingCmds the engine answers with
+// exported files, in a binary message.
+var exportCmdTypes = []string{"export", "export2d", "export3d"}
+
+// isExportRequest returns whether the message submits an export command,
+// whichever Go type the command is.
+func isExportRequest(message any) bool {
+	req, ok := message.(modelingCmdRequest)
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(req.Cmd)
+	if err != nil {
+		return false
+	}
+	var cmd struct {
+		Type string `json:"type"`
+	}
+	return json.Unmarshal(data, &cmd) == nil && slices.Contains(exportCmdTypes, cmd.Type)
+}
+
+// rawExport is the data of an export response of the engine, holding
+// RawFiles.
+type rawExport struct {
+	Files []rawFile `json:"files"`
+}
+
+// rawFile is a RawFile, whose contents are decoded from base64 or from an
+// array of bytes.
+type rawFile struct {
+	Name     string          `json:"name"`
+	Contents rawFileContents `json:"contents"`
+}
+
+// rawFileContents are the contents of a RawFile. BSON binary values decode
+// to base64 strings, and arrays of integers to arrays of bytes.
+type rawFileContents []byte
+
+// UnmarshalJSON decodes the contents from either form.
+func (c *rawFileContents) UnmarshalJSON(data []byte) error {
+	var contents []byte
+	if len(data) > 0 && data[0] == '[' {
+		var values []uint8
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		contents = values
+	} else if err := json.Unmarshal(data, &contents); err != nil {
+		return err
+	}
+	*c = contents
+	return nil
+}
+
+// Export returns the files as an Export.
+func (e rawExport) Export() Export {
+	files := []ExportFile{}
+	for _, file := range e.Files {
+		files = append(files, ExportFile{Name: file.Name, Contents: Base64{Inner: file.Contents}})
+	}
+	return Export{Files: files}
+}
+
+// ExportToDir exports the entities, or the whole scene if none are given, to
+// the format, and writes the files into dir. It returns the paths of the
+// files written.
+func (s *ModelingSession) ExportToDir(ctx context.Context, dir string, format OutputFormat3D, entityIDs ...UUID) ([]string, error) {
+	files, err := s.Export3D(ctx, format, entityIDs...)
+	if err != nil {
+		return nil, err
+	}
+	return WriteExportFiles(dir, files)
+}
+
+// ExportFiles returns the files of an export response. The response can be
+// an Export, Export2D or Export3D, a pointer to one of them, or the
+// OkModelingCmdResponse of an export command as returned by
+// ModelingSession.Send.
+func ExportFiles(resp any) ([]ExportFile, error) {
+	switch r := resp.(type) {
+	case Export:
+		return r.Files, nil
+	case *Export:
+		return r.Files, nil
+	case Export2D:
+		return r.Files, nil
+	case *Export2D:
+		return r.Files, nil
+	case Export3D:
+		return r.Files, nil
+	case *Export3D:
+		return r.Files, nil
+	case []ExportFile:
+		return r, nil
+	}
+
+	var r struct {
+		Type string `json:"type"`
+		Data Export `json:"data"`
+	}
+	if err := decodeModelingResponse(resp, &r); err != nil {
+		return nil, fmt.Errorf("decoding the export response failed: %v", err)
+	}
+	switch r.Type {
+	case "export", "export2d", "export3d":
+		return r.Data.Files, nil
+	}
+	return nil, fmt.Errorf("%T of type %q is not an export response", resp, r.Type)
+}
+
+// localFileName returns the file name as a clean slash-separated path, or
+// an error if it would escape the directory it is written into. Backslashes
+// and drive letters are refused on every platform, since the files may be
+// written or unpacked on Windows.
+func localFileName(name string) (string, error) {
+	clean := strings.ReplaceAll(name, `\`, "/")
+	if !filepath.IsLocal(filepath.FromSlash(clean)) || path.IsAbs(clean) || strings.Contains(clean, ":") {
+		return "", fmt.Errorf("file name %q is not a local path", name)
+	}
+	return path.Clean(clean), nil
+}
+
+// WriteExportFiles writes the files into dir, creating it if needed, and
+// returns the paths of the files written. Files named with absolute paths
+// or paths escaping dir are refused, and the files are written through an
+// os.Root so symbolic links in dir cannot be used to escape it either.
+func WriteExportFiles(dir string, files []ExportFile) ([]string, error) {
+	// Check all the names first, so nothing is written for a bad export.
+	names := []string{}
+	for _, file := range files {
+		name, err := localFileName(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating the export directory failed: %v", err)
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("opening the export directory failed: %v", err)
+	}
+	defer root.Close()
+
+	paths := []string{}
+	for i, file := range files {
+		name := filepath.FromSlash(names[i])
+		if parent := filepath.Dir(name); parent != "." {
+			if err := root.MkdirAll(parent, 0o755); err != nil {
+				return paths, fmt.Errorf("creating the directory of export file %q failed: %v", file.Name, err)
+			}
+		}
+		if err := root.WriteFile(name, file.Contents.Inner, 0o644); err != nil {
+			return paths, fmt.Errorf("writing export file %q failed: %v", file.Name, err)
+		}
+		paths = append(paths, filepath.Join(dir, name))
+	}
+
+	return paths, nil
+}
+
+// WriteExportZip writes the files as a zip archive to w, for example to
+// serve them from an HTTP handler.
+func WriteExportZip(w io.Writer, files []ExportFile) error {
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		name, err := localFileName(file.Name)
+		if err != nil {
+			return err
+		}
+
+		f, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return fmt.Errorf("adding export file %q to the zip archive failed: %v", file.Name, err)
+		}
+		if _, err := f.Write(file.Contents.Inner); err != nil {
+			return fmt.Errorf("writing export file %q to the zip archive failed: %v", file.Name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("writing the zip archive failed: %v", err)
+	}
+	return nil
+}
+
+// WriteExportTar writes the files as a tar archive to w, for example to
+// serve them from an HTTP handler.
+func WriteExportTar(w io.Writer, files []ExportFile) error {
+	archive := tar.NewWriter(w)
+	for _, file := range files {
+		name, err := localFileName(file.Name)
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Size:     int64(len(file.Contents.Inner)),
+			Mode:     0o644,
+			ModTime:  time.Now(),
+		}
+		if err := archive.WriteHeader(header); err != nil {
+			return fmt.Errorf("adding export file %q to the tar archive failed: %v", file.Name, err)
+		}
+		if _, err := archive.Write(file.Contents.Inner); err != nil {
+			return fmt.Errorf("writing export file %q to the tar archive failed: %v", file.Name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("writing the tar archive failed: %v", err)
+	}
+	return nil
+}
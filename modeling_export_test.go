@@ -0,0 +1,134 @@
+package kittycad
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+func TestModelingSessionExportToDir(t *testing.T) {
+	session := newEngineStandIn(t)
+	dir := t.TempDir()
+
+	paths, err := session.ExportToDir(context.Background(), dir, map[string]any{"type": "gltf"})
+	if err != nil {
+		t.Fatalf("exporting failed: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != filepath.Join(dir, "output.gltf") {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+
+	contents, err := os.ReadFile(paths[1])
+	if err != nil {
+		t.Fatalf("reading the export failed: %v", err)
+	}
+	if !bytes.Equal(contents, []byte{0, 1, 2}) {
+		t.Fatalf("unexpected contents %v", contents)
+	}
+}
+
+func TestModelingSessionExportWithoutRequestID(t *testing.T) {
+	engine := startEngineStandIn(t)
+	engine.anonymousExports.Store(true)
+	conn, err := engine.dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	session := NewModelingSession(conn)
+	defer session.Close()
+
+	files, err := session.Export3D(context.Background(), map[string]any{"type": "gltf"})
+	if err != nil {
+		t.Fatalf("exporting failed: %v", err)
+	}
+	if len(files) != 2 || files[1].Name != "output.bin" || !bytes.Equal(files[1].Contents.Inner, []byte{0, 1, 2}) {
+		t.Fatalf("unexpected files %+v", files)
+	}
+
+	// Exports sent with Send are matched whichever type holds them.
+	for _, cmd := range []any{
+		ModelingCmdExport{Type: "export"},
+		ModelingCmdExport2D{Type: "export2d"},
+		ModelingCmdExport3D{Type: "export3d"},
+		map[string]any{"type": "export3d", "entity_ids": []UUID{}, "format": map[string]any{"type": "gltf"}},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		resp, err := session.Send(ctx, cmd)
+		cancel()
+		if err != nil {
+			t.Fatalf("sending %T failed: %v", cmd, err)
+		}
+		if files, err := ExportFiles(resp); err != nil || len(files) != 2 {
+			t.Fatalf("unexpected files %+v for %T (%v)", files, cmd, err)
+		}
+	}
+}
+
+func TestDecodeWebSocketResponseBinaryRequestID(t *testing.T) {
+	id := uuid.MustParse("11111111-1111-4111-8111-111111111111")
+	resp, err := decodeWebSocketResponse(websocket.BinaryMessage, encodeBSON(map[string]any{
+		"success":    true,
+		"request_id": id[:],
+		"resp":       map[string]any{"type": "export", "data": map[string]any{"files": []any{}}},
+	}))
+	if err != nil {
+		t.Fatalf("decoding failed: %v", err)
+	}
+	if resp.RequestID == nil || resp.RequestID.String() != id.String() || resp.Resp.Type != "export" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+}
+
+func TestWriteExportFilesRefusesTraversal(t *testing.T) {
+	for _, name := range []string{"../escape.stl", "/etc/passwd", `..\escape.stl`, "a/../../escape.stl", "", "C:escape.stl"} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := []ExportFile{{Name: "ok.stl", Contents: Base64{Inner: []byte("solid")}}, {Name: name}}
+			if _, err := WriteExportFiles(filepath.Join(dir, "out"), files); err == nil {
+				t.Fatalf("expected %q to be refused", name)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "out", "ok.stl")); !os.IsNotExist(err) {
+				t.Fatalf("expected nothing to be written")
+			}
+		})
+	}
+}
+
+func TestWriteExportZip(t *testing.T) {
+	files, err := ExportFiles(map[string]any{
+		"type": "export",
+		"data": map[string]any{"files": []any{map[string]any{"name": "parts/gear.step", "contents": "SVNPLTEwMzAzLTIxOw"}}},
+	})
+	if err != nil {
+		t.Fatalf("reading the export response failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteExportZip(&buf, files); err != nil {
+		t.Fatalf("writing the zip archive failed: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("reading the zip archive failed: %v", err)
+	}
+	if len(archive.File) != 1 || archive.File[0].Name != "parts/gear.step" {
+		t.Fatalf("unexpected zip archive %v", archive.File)
+	}
+	f, err := archive.File[0].Open()
+	if err != nil {
+		t.Fatalf("opening the zipped file failed: %v", err)
+	}
+	defer f.Close()
+	if contents, _ := io.ReadAll(f); string(contents) != "ISO-10303-21;" {
+		t.Fatalf("unexpected contents %q", contents)
+	}
+}
@@ -0,0 +1,110 @@
+package kittycad
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// ModelingRecorder records every message sent and received on CommandsWs
+// websockets as JSON lines, one WebsocketFrame per line, for debugging and
+// for replaying with kittycadtest.EngineReplay. Commands and responses are
+// recorded as JSON, and binary messages in base64, with their type. It is
+// safe for concurrent use.
+type ModelingRecorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	// err is the first error writing the recording.
+	err error
+}
+
+// NewModelingRecorder creates a recorder writing to w.
+func NewModelingRecorder(w io.Writer) *ModelingRecorder {
+	return &ModelingRecorder{w: w}
+}
+
+// CreateModelingRecording creates a recorder writing to the file at path,
+// truncating it if it exists. Close the recorder to close the file.
+func CreateModelingRecording(path string) (*ModelingRecorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating the modeling recording failed: %v", err)
+	}
+
+	r := NewModelingRecorder(f)
+	r.closer = f
+	return r, nil
+}
+
+// Dialer returns a dialer opening websockets with dialer, or
+// websocket.DefaultDialer if it is nil, which records the messages of
+// CommandsWs websockets. Set it with Client.WithDialer, so sessions opened
+// with ModelingService.NewSession are recorded, reconnections included.
+func (r *ModelingRecorder) Dialer(dialer *websocket.Dialer) *websocket.Dialer {
+	return RecordingDialer(dialer, func(u *url.URL) func(WebsocketFrame) {
+		if !strings.HasSuffix(u.Path, "/ws/modeling/commands") {
+			return nil
+		}
+		return r.record
+	})
+}
+
+// record writes the frame. Errors writing the recording are returned by
+// Close, so recording never fails the websocket.
+func (r *ModelingRecorder) record(frame WebsocketFrame) {
+	line, err := json.Marshal(frame)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	if err != nil {
+		r.err = fmt.Errorf("encoding the modeling frame failed: %v", err)
+		return
+	}
+	// Write every frame right away, so the recording is complete up to a
+	// crash.
+	if _, err := r.w.Write(append(line, '\n')); err != nil {
+		r.err = fmt.Errorf("writing the modeling recording failed: %v", err)
+	}
+}
+
+// Close closes the file if the recorder created it. It returns the first
+// error writing the recording.
+func (r *ModelingRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closer != nil {
+		if err := r.closer.Close(); err != nil && r.err == nil {
+			r.err = fmt.Errorf("closing the modeling recording failed: %v", err)
+		}
+		r.closer = nil
+	}
+
+	return r.err
+}
+
+// ReadModelingRecording reads the frames of a recording written by a
+// ModelingRecorder.
+func ReadModelingRecording(r io.Reader) ([]WebsocketFrame, error) {
+	frames := []WebsocketFrame{}
+	decoder := json.NewDecoder(r)
+	for {
+		var frame WebsocketFrame
+		if err := decoder.Decode(&frame); err == io.EOF {
+			return frames, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("decoding frame %d of the modeling recording failed: %v", len(frames)+1, err)
+		}
+		frames = append(frames, frame)
+	}
+}
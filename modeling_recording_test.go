@@ -0,0 +1,60 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestModelingRecorder(t *testing.T) {
+	engine := startEngineStandIn(t)
+
+	var buf bytes.Buffer
+	recorder := NewModelingRecorder(&buf)
+	conn, _, err := recorder.Dialer(nil).DialContext(context.Background(), engine.url+"/ws/modeling/commands", nil)
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	session := NewModelingSession(conn)
+	if _, err := session.Send(context.Background(), map[string]any{"type": "garble"}); err != nil {
+		t.Fatalf("sending the command failed: %v", err)
+	}
+	session.Close()
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("recording failed: %v", err)
+	}
+
+	// The commands are recorded as JSON, not in base64.
+	if line, _, _ := strings.Cut(buf.String(), "\n"); !strings.Contains(line, `"type":"modeling_cmd_req"`) {
+		t.Fatalf("expected the command to be recorded as JSON, got %s", line)
+	}
+
+	frames, err := ReadModelingRecording(&buf)
+	if err != nil {
+		t.Fatalf("reading the recording failed: %v", err)
+	}
+	if len(frames) != 3 || frames[0].Direction != WebsocketSent || frames[1].Direction != WebsocketReceived || frames[2].Direction != WebsocketReceived {
+		t.Fatalf("expected the command, the binary message and the response, got %+v", frames)
+	}
+
+	var sent struct {
+		Type  string `json:"type"`
+		CmdID string `json:"cmd_id"`
+	}
+	var received struct {
+		RequestID string `json:"request_id"`
+	}
+	if err := json.Unmarshal(frames[0].Data, &sent); err != nil || frames[0].Type != websocket.TextMessage || sent.Type != "modeling_cmd_req" {
+		t.Fatalf("unexpected sent frame %+v", frames[0])
+	}
+	if frames[1].Type != websocket.BinaryMessage || !bytes.Equal(frames[1].Data, []byte{0xff, 0x00}) {
+		t.Fatalf("expected the binary message to be recorded as it was, got %+v", frames[1])
+	}
+	if err := json.Unmarshal(frames[2].Data, &received); err != nil || received.RequestID != sent.CmdID {
+		t.Fatalf("unexpected received frame %s", frames[2].Data)
+	}
+}
@@ -0,0 +1,787 @@
+package kittycad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// ErrSessionClosed is returned when using a ModelingSession after it was closed.
+var ErrSessionClosed = errors.New("the modeling session is closed")
+
+// ErrConnectionLost is returned for the commands in flight when the websocket
+// of a ModelingSession is lost, unless they are resubmitted.
+var ErrConnectionLost = errors.New("the modeling websocket connection was lost")
+
+// ModelingCmdError is returned when the engine fails to execute a modeling
+// command. The errors of the engine can be matched with errors.As.
+type ModelingCmdError struct {
+	// RequestID is the ID of the failed request. It is nil if the engine did
+	// not say which request failed.
+	RequestID *UUID
+	// Errors are the errors returned by the engine.
+	Errors []APIError
+}
+
+// Error converts the ModelingCmdError to a readable string.
+func (err *ModelingCmdError) Error() string {
+	messages := []string{}
+	for _, e := range err.Errors {
+		messages = append(messages, e.Error())
+	}
+
+	if err.RequestID == nil {
+		return fmt.Sprintf("modeling request failed: %s", strings.Join(messages, "; "))
+	}
+	return fmt.Sprintf("modeling request %s failed: %s", err.RequestID, strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors returned by the engine.
+func (err *ModelingCmdError) Unwrap() []error {
+	errs := []error{}
+	for _, e := range err.Errors {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// webSocketResponse is a message sent by the engine, either a
+// SuccessWebSocketResponse or a FailureWebSocketResponse.
+type webSocketResponse struct {
+	Success   bool                  `json:"success"`
+	RequestID *UUID                 `json:"request_id,omitempty"`
+	Resp      webSocketResponseData `json:"resp"`
+	Errors    []APIError            `json:"errors"`
+}
+
+// webSocketResponseData is the OkWebSocketResponseData of a successful
+// response, with the data left to be decoded by its type.
+type webSocketResponseData struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// modelingCmdRequest is the WebSocketRequest submitting a modeling command.
+type modelingCmdRequest struct {
+	Type string `json:"type"`
+	ModelingCmdReq
+}
+
+// pingRequest is the WebSocketRequest keeping the websocket alive.
+type pingRequest struct {
+	Type string `json:"type"`
+}
+
+// metricsResponse is the WebSocketRequest answering a metrics request of the
+// engine.
+type metricsResponse struct {
+	Type    string        `json:"type"`
+	Metrics ClientMetrics `json:"metrics"`
+}
+
+// ModelingSessionState is the state of the connection of a ModelingSession.
+type ModelingSessionState int
+
+const (
+	// ModelingSessionConnected is the state while the websocket is connected.
+	ModelingSessionConnected ModelingSessionState = iota
+	// ModelingSessionReconnecting is the state while a lost websocket is
+	// being reconnected.
+	ModelingSessionReconnecting
+	// ModelingSessionClosed is the state once the session has ended.
+	ModelingSessionClosed
+)
+
+// String returns the name of the state.
+func (state ModelingSessionState) String() string {
+	switch state {
+	case ModelingSessionConnected:
+		return "connected"
+	case ModelingSessionReconnecting:
+		return "reconnecting"
+	case ModelingSessionClosed:
+		return "closed"
+	}
+	return fmt.Sprintf("ModelingSessionState(%d)", int(state))
+}
+
+// ModelingSessionOptions configures the keepalive and reconnection of a
+// ModelingSession. The zero value neither pings nor reconnects.
+type ModelingSessionOptions struct {
+	// PingInterval is how often a ping is sent to the engine. Zero disables
+	// pings.
+	PingInterval time.Duration
+	// PongTimeout is how long to wait for the pong of the engine before the
+	// websocket is considered dead. It defaults to PingInterval.
+	PongTimeout time.Duration
+
+	// Reconnect enables reconnecting when the websocket is lost.
+	//
+	// The engine starts a new session on the new websocket, so the state
+	// built by earlier commands is not carried over.
+	Reconnect bool
+	// Dial opens a new websocket when reconnecting. ModelingService.NewSession
+	// sets it to open a CommandsWs websocket with the same parameters.
+	Dial func(ctx context.Context) (*websocket.Conn, error)
+	// MaxReconnectAttempts is how many times reconnecting is attempted before
+	// the session ends. Zero means no limit.
+	MaxReconnectAttempts int
+	// ReconnectBackoff is the wait before the first reconnection attempt,
+	// doubled after every failed attempt. It defaults to half a second.
+	ReconnectBackoff time.Duration
+	// MaxReconnectBackoff caps the wait between reconnection attempts. It
+	// defaults to 30 seconds.
+	MaxReconnectBackoff time.Duration
+	// ResubmitInFlight resubmits the commands which were sent but not
+	// answered when the websocket was lost. Otherwise they fail with
+	// ErrConnectionLost.
+	ResubmitInFlight bool
+
+	// Metrics returns the metrics sent when the engine requests them. If nil,
+	// empty metrics are sent, which suits clients not consuming the video
+	// stream. Clients consuming it should return its WebRTC stats.
+	Metrics func() ClientMetrics
+
+	// OnStateChange is called when the state of the session changes, with
+	// the error causing the change, if any. It is called from the goroutine
+	// of the session, so it must not block.
+	OnStateChange func(state ModelingSessionState, err error)
+	// OnUnhandledMessage is called with the messages of the engine which
+	// are not responses, and are skipped. It is called from the goroutine of
+	// the session, so it must not block.
+	OnUnhandledMessage func(messageType int, data []byte)
+}
+
+// ModelingSession sends modeling commands to the engine over a CommandsWs
+// websocket, and matches the responses of the engine to them. It is safe for
+// concurrent use.
+type ModelingSession struct {
+	opts   ModelingSessionOptions
+	writes chan sessionWrite
+	pongs  chan struct{}
+
+	// ctx is canceled when the session is closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	conn *websocket.Conn
+	// connected is closed while the websocket is connected.
+	connected chan struct{}
+	pending   map[string]*pendingRequest
+	// err is why the session ended, set before done is closed.
+	err error
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// pendingRequest is a request waiting for its response.
+type pendingRequest struct {
+	message any
+	results chan sessionResult
+	// sent is whether the message was written to the current websocket.
+	sent bool
+	// export is whether the message is an export, whose files may come back
+	// without a request ID.
+	export bool
+}
+
+// sessionResult is the outcome of a request.
+type sessionResult struct {
+	resp webSocketResponse
+	err  error
+}
+
+// sessionWrite is a message waiting to be written by the write pump. A nil
+// message writes nothing, to wait for the earlier messages to be written.
+type sessionWrite struct {
+	message any
+	written func()
+	result  chan error
+}
+
+// NewSession opens a CommandsWs websocket and starts a ModelingSession on it.
+// If opts.Reconnect is set, lost websockets are reopened with the same
+// parameters.
+func (s *ModelingService) NewSession(ctx context.Context, params ModelingCommandsWsParams, opts ModelingSessionOptions) (*ModelingSession, error) {
+	conn, err := s.CommandsWs(ctx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Dial == nil {
+		opts.Dial = func(ctx context.Context) (*websocket.Conn, error) {
+			return s.CommandsWs(ctx, params, nil)
+		}
+	}
+	return NewModelingSessionWithOptions(conn, opts), nil
+}
+
+// NewModelingSession starts a ModelingSession on an open websocket, for
+// example one to a local stand-in for the engine. The session owns the
+// connection from then on, and closes it when the session is closed.
+func NewModelingSession(conn *websocket.Conn) *ModelingSession {
+	return NewModelingSessionWithOptions(conn, ModelingSessionOptions{})
+}
+
+// NewModelingSessionWithOptions starts a ModelingSession on an open websocket,
+// with keepalive and reconnection configured by opts.
+func NewModelingSessionWithOptions(conn *websocket.Conn, opts ModelingSessionOptions) *ModelingSession {
+	if opts.PongTimeout <= 0 {
+		opts.PongTimeout = opts.PingInterval
+	}
+	if opts.ReconnectBackoff <= 0 {
+		opts.ReconnectBackoff = 500 * time.Millisecond
+	}
+	if opts.MaxReconnectBackoff <= 0 {
+		opts.MaxReconnectBackoff = 30 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	connected := make(chan struct{})
+	close(connected)
+	session := &ModelingSession{
+		opts:      opts,
+		writes:    make(chan sessionWrite),
+		pongs:     make(chan struct{}, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+		conn:      conn,
+		connected: connected,
+		pending:   map[string]*pendingRequest{},
+		done:      make(chan struct{}),
+	}
+
+	go session.run(conn)
+	go session.writePump()
+
+	return session
+}
+
+// Send sends the modeling command to the engine and waits for its response.
+// If the engine fails to execute the command, the error is a
+// *ModelingCmdError. The files of exports, which the engine sends back in a
+// binary message, are returned as an Export.
+func (s *ModelingSession) Send(ctx context.Context, cmd ModelingCmd) (OkModelingCmdResponse, error) {
+	id := newModelingCmdID()
+	resp, err := s.roundTrip(ctx, []UUID{id}, modelingCmdRequest{
+		Type:           "modeling_cmd_req",
+		ModelingCmdReq: ModelingCmdReq{Cmd: cmd, CmdID: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Resp.Type == "export" {
+		var export rawExport
+		if err := json.Unmarshal(resp.Resp.Data, &export); err != nil {
+			return nil, fmt.Errorf("error decoding the files exported by modeling command %s: %v", id, err)
+		}
+		return export.Export(), nil
+	}
+	if resp.Resp.Type != "modeling" {
+		return nil, fmt.Errorf("unexpected response of type %q to modeling command %s", resp.Resp.Type, id)
+	}
+	var data struct {
+		ModelingResponse OkModelingCmdResponse `json:"modeling_response"`
+	}
+	if err := json.Unmarshal(resp.Resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("error decoding response to modeling command %s: %v", id, err)
+	}
+
+	return data.ModelingResponse, nil
+}
+
+// decodeModelingResponse decodes a response returned by Send into v. The
+// responses are decoded generically, so it is encoded again to decode it.
+func decodeModelingResponse(resp OkModelingCmdResponse, v any) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Done returns a channel which is closed when the session ends, because it
+// was closed or the websocket was lost for good.
+func (s *ModelingSession) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns why the session ended, or nil if it has not ended.
+func (s *ModelingSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close closes the websocket, and fails the commands waiting for a response
+// with ErrSessionClosed.
+func (s *ModelingSession) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.fail(ErrSessionClosed)
+		s.cancel()
+
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+
+		// Tell the engine we are going away, but do not wait for it to agree.
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		if err = conn.Close(); errors.Is(err, net.ErrClosed) {
+			// The websocket was lost before.
+			err = nil
+		}
+	})
+
+	<-s.done
+	return err
+}
+
+// roundTrip writes the message and waits for the response to the request.
+// The response may be to any of the IDs, so a batch can be answered for the
+// batch or for one of its commands.
+func (s *ModelingSession) roundTrip(ctx context.Context, ids []UUID, message any) (webSocketResponse, error) {
+	req := &pendingRequest{message: message, results: make(chan sessionResult, 1), export: isExportRequest(message)}
+
+	s.mu.Lock()
+	if s.err != nil {
+		s.mu.Unlock()
+		return webSocketResponse{}, s.err
+	}
+	for _, id := range ids {
+		s.pending[id.String()] = req
+	}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		for _, id := range ids {
+			delete(s.pending, id.String())
+		}
+		s.mu.Unlock()
+	}()
+
+	if err := s.write(ctx, message, s.markSent(req)); err != nil {
+		return webSocketResponse{}, err
+	}
+
+	select {
+	case result := <-req.results:
+		return result.resp, result.err
+	case <-ctx.Done():
+		return webSocketResponse{}, ctx.Err()
+	case <-s.done:
+		// The response may have arrived just before the session ended.
+		select {
+		case result := <-req.results:
+			return result.resp, result.err
+		default:
+			return webSocketResponse{}, s.Err()
+		}
+	}
+}
+
+// markSent returns a function marking the request as written to the
+// websocket, so it is failed or resubmitted if the websocket is lost.
+func (s *ModelingSession) markSent(req *pendingRequest) func() {
+	return func() {
+		s.mu.Lock()
+		req.sent = true
+		s.mu.Unlock()
+	}
+}
+
+// write hands the message to the write pump once the websocket is
+// connected, and waits for it to be written. written is called by the write
+// pump once the message is written.
+func (s *ModelingSession) write(ctx context.Context, message any, written func()) error {
+	s.mu.Lock()
+	connected := s.connected
+	s.mu.Unlock()
+
+	select {
+	case <-connected:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return s.Err()
+	}
+
+	w := sessionWrite{message: message, written: written, result: make(chan error, 1)}
+	select {
+	case s.writes <- w:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return s.Err()
+	}
+
+	select {
+	case err := <-w.result:
+		if err != nil {
+			return fmt.Errorf("writing to the modeling websocket failed: %v", err)
+		}
+		return nil
+	case <-s.done:
+		return s.Err()
+	}
+}
+
+// writePump writes the messages, so only one goroutine writes to the
+// websocket at a time.
+func (s *ModelingSession) writePump() {
+	for {
+		select {
+		case w := <-s.writes:
+			if w.message == nil {
+				w.result <- nil
+				continue
+			}
+
+			s.mu.Lock()
+			conn := s.conn
+			s.mu.Unlock()
+
+			err := conn.WriteJSON(w.message)
+			if err == nil && w.written != nil {
+				w.written()
+			}
+			w.result <- err
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// run serves the websocket until it is lost, then reconnects if enabled,
+// until the session ends.
+func (s *ModelingSession) run(conn *websocket.Conn) {
+	defer close(s.done)
+	defer func() {
+		s.setState(ModelingSessionClosed, s.Err())
+	}()
+
+	for {
+		err := s.serve(conn)
+		conn.Close()
+		if s.ctx.Err() != nil {
+			return
+		}
+		if !s.opts.Reconnect || s.opts.Dial == nil {
+			s.fail(err)
+			return
+		}
+
+		s.disconnected(err)
+		conn, err = s.redial()
+		if err != nil {
+			s.fail(err)
+			return
+		}
+		if !s.reconnected(conn) {
+			return
+		}
+	}
+}
+
+// serve reads the responses of the engine from the websocket and hands them
+// to the requests waiting for them, until the websocket fails or is closed.
+// Meanwhile the engine is pinged, if enabled.
+func (s *ModelingSession) serve(conn *websocket.Conn) error {
+	dead := make(chan error, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	if s.opts.PingInterval > 0 {
+		go s.keepalive(conn, dead, stop)
+	}
+
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			// Prefer the reason the keepalive gave up on the websocket.
+			select {
+			case err := <-dead:
+				return err
+			default:
+			}
+			return fmt.Errorf("reading from the modeling websocket failed: %w", err)
+		}
+
+		resp, err := decodeWebSocketResponse(messageType, message)
+		if err != nil {
+			// Skip messages which are not responses, the engine may send
+			// other kinds of messages in the future.
+			if s.opts.OnUnhandledMessage != nil {
+				s.opts.OnUnhandledMessage(messageType, message)
+			}
+			continue
+		}
+		s.dispatch(resp)
+	}
+}
+
+// keepalive pings the engine every PingInterval, and closes the websocket if
+// the engine does not answer within PongTimeout.
+func (s *ModelingSession) keepalive(conn *websocket.Conn, dead chan<- error, stop <-chan struct{}) {
+	ticker := time.NewTicker(s.opts.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		// Forget pongs to earlier pings.
+		select {
+		case <-s.pongs:
+		default:
+		}
+
+		timeout := time.NewTimer(s.opts.PongTimeout)
+		if err := s.write(s.ctx, pingRequest{Type: "ping"}, nil); err != nil {
+			timeout.Stop()
+			dead <- fmt.Errorf("%w: pinging the engine failed: %v", ErrConnectionLost, err)
+			conn.Close()
+			return
+		}
+
+		select {
+		case <-s.pongs:
+			timeout.Stop()
+		case <-timeout.C:
+			dead <- fmt.Errorf("%w: no pong from the engine within %s", ErrConnectionLost, s.opts.PongTimeout)
+			conn.Close()
+			return
+		case <-stop:
+			timeout.Stop()
+			return
+		}
+	}
+}
+
+// disconnected holds new writes until the websocket is reconnected, and
+// fails the requests in flight unless they are to be resubmitted.
+func (s *ModelingSession) disconnected(cause error) {
+	s.mu.Lock()
+	s.connected = make(chan struct{})
+	s.mu.Unlock()
+
+	// Wait for the write pump to finish the writes it was handed, so every
+	// request written to the lost websocket is marked as sent.
+	barrier := sessionWrite{result: make(chan error, 1)}
+	select {
+	case s.writes <- barrier:
+		<-barrier.result
+	case <-s.done:
+	}
+
+	if !s.opts.ResubmitInFlight {
+		s.mu.Lock()
+		for _, req := range s.pending {
+			if req.sent {
+				deliver(req.results, sessionResult{err: fmt.Errorf("%w: %v", ErrConnectionLost, cause)})
+			}
+		}
+		s.mu.Unlock()
+	}
+
+	s.setState(ModelingSessionReconnecting, cause)
+}
+
+// redial opens a new websocket, backing off between failed attempts.
+func (s *ModelingSession) redial() (*websocket.Conn, error) {
+	backoff := s.opts.ReconnectBackoff
+	var err error
+	for attempt := 1; s.opts.MaxReconnectAttempts <= 0 || attempt <= s.opts.MaxReconnectAttempts; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-s.ctx.Done():
+			return nil, ErrSessionClosed
+		}
+
+		var conn *websocket.Conn
+		conn, err = s.opts.Dial(s.ctx)
+		if err == nil {
+			return conn, nil
+		}
+
+		backoff = min(2*backoff, s.opts.MaxReconnectBackoff)
+	}
+
+	return nil, fmt.Errorf("%w: reconnecting failed after %d attempts: %v", ErrConnectionLost, s.opts.MaxReconnectAttempts, err)
+}
+
+// reconnected switches the session to the new websocket and resubmits the
+// requests in flight if enabled. It returns false if the session was closed
+// meanwhile.
+func (s *ModelingSession) reconnected(conn *websocket.Conn) bool {
+	s.mu.Lock()
+	if s.ctx.Err() != nil {
+		s.mu.Unlock()
+		conn.Close()
+		return false
+	}
+	s.conn = conn
+
+	// Requests can be pending under several IDs, resubmit each once.
+	resubmit := []*pendingRequest{}
+	seen := map[*pendingRequest]bool{}
+	for _, req := range s.pending {
+		if req.sent && !seen[req] {
+			seen[req] = true
+			req.sent = false
+			resubmit = append(resubmit, req)
+		}
+	}
+	close(s.connected)
+	s.mu.Unlock()
+
+	s.setState(ModelingSessionConnected, nil)
+
+	if s.opts.ResubmitInFlight {
+		go func() {
+			for _, req := range resubmit {
+				// A failed write means the websocket was lost again, and
+				// the request is handled then.
+				s.write(s.ctx, req.message, s.markSent(req))
+			}
+		}()
+	}
+
+	return true
+}
+
+// dispatch hands the response to the request waiting for it. Failures which
+// are not for a specific request are handed to every waiting request.
+func (s *ModelingSession) dispatch(resp webSocketResponse) {
+	if resp.Success && resp.Resp.Type == "pong" {
+		select {
+		case s.pongs <- struct{}{}:
+		default:
+		}
+		return
+	}
+	if resp.Success && resp.Resp.Type == "metrics_request" {
+		// Answer without holding up the responses behind the request.
+		go s.sendMetrics()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := sessionResult{resp: resp, err: checkWebSocketResponse(resp)}
+	if resp.RequestID == nil {
+		if resp.Success {
+			// Hand exported files to the export waiting for them.
+			if resp.Resp.Type == "export" {
+				for _, req := range s.pending {
+					if req.sent && req.export {
+						deliver(req.results, result)
+						return
+					}
+				}
+			}
+			return
+		}
+		// The failure can only be about a command the engine received.
+		for _, req := range s.pending {
+			if req.sent {
+				deliver(req.results, result)
+			}
+		}
+		return
+	}
+
+	if req, ok := s.pending[resp.RequestID.String()]; ok {
+		deliver(req.results, result)
+	}
+}
+
+// sendMetrics answers a metrics request of the engine.
+func (s *ModelingSession) sendMetrics() {
+	metrics := ClientMetrics{}
+	if s.opts.Metrics != nil {
+		metrics = s.opts.Metrics()
+	}
+
+	// A failed write means the websocket was lost, which is handled by the
+	// read pump.
+	s.write(s.ctx, metricsResponse{Type: "metrics_response", Metrics: metrics}, nil)
+}
+
+// setState reports the state change to OnStateChange.
+func (s *ModelingSession) setState(state ModelingSessionState, err error) {
+	if s.opts.OnStateChange != nil {
+		s.opts.OnStateChange(state, err)
+	}
+}
+
+// fail records why the session ended, unless it already ended.
+func (s *ModelingSession) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// deliver sends the result without blocking. Only the first result of a
+// request is kept.
+func deliver(results chan sessionResult, result sessionResult) {
+	select {
+	case results <- result:
+	default:
+	}
+}
+
+// decodeWebSocketResponse decodes a response of the engine. Text messages
+// are JSON, binary messages, which carry exported files, are BSON.
+func decodeWebSocketResponse(messageType int, message []byte) (webSocketResponse, error) {
+	var resp webSocketResponse
+	if messageType == websocket.BinaryMessage {
+		doc, err := decodeBSON(message)
+		if err != nil {
+			return resp, err
+		}
+		// Request IDs may be sent as their 16 bytes rather than as strings.
+		if id, ok := doc["request_id"].([]byte); ok && len(id) == 16 {
+			doc["request_id"] = uuid.UUID(id).String()
+		}
+		if message, err = json.Marshal(doc); err != nil {
+			return resp, err
+		}
+	}
+
+	err := json.Unmarshal(message, &resp)
+	return resp, err
+}
+
+// checkWebSocketResponse returns a *ModelingCmdError if the response is a
+// failure.
+func checkWebSocketResponse(resp webSocketResponse) error {
+	if resp.Success {
+		return nil
+	}
+
+	return &ModelingCmdError{RequestID: resp.RequestID, Errors: resp.Errors}
+}
+
+// newModelingCmdID returns a new random ID for a modeling command.
+func newModelingCmdID() UUID {
+	id := uuid.New()
+	return UUID{&id}
+}
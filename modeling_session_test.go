@@ -0,0 +1,417 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// engineStandIn is a websocket server answering modeling commands and
+// batches like the engine. A command of type "fail" fails, any other command
+// responds with its own type, so responses can be told apart. Exports
+// respond with a glTF file and its buffer in a binary BSON message, and
+// commands of type "garble" are preceded by a binary message which is not a
+// response.
+type engineStandIn struct {
+	url string
+	// drop makes the stand-in drop the connection instead of answering the
+	// next command.
+	drop atomic.Bool
+	// ignorePings stops answering pings.
+	ignorePings atomic.Bool
+	// requestMetrics makes the stand-in request metrics from new connections.
+	requestMetrics atomic.Bool
+	// metrics receives the metrics sent by clients.
+	metrics chan ClientMetrics
+	// anonymousExports sends exported files without a request ID, and their
+	// contents as arrays of integers.
+	anonymousExports atomic.Bool
+}
+
+func startEngineStandIn(t *testing.T) *engineStandIn {
+	t.Helper()
+
+	engine := &engineStandIn{metrics: make(chan ClientMetrics, 1)}
+	url := startStandIn(t, websocketStandIn(func(conn *websocket.Conn) {
+		var mu sync.Mutex
+		if engine.requestMetrics.Load() {
+			conn.WriteJSON(map[string]any{"success": true, "resp": map[string]any{"type": "metrics_request", "data": map[string]any{}}})
+		}
+		for {
+			var req struct {
+				Type     string         `json:"type"`
+				Cmd      map[string]any `json:"cmd"`
+				CmdID    string         `json:"cmd_id"`
+				BatchID  string         `json:"batch_id"`
+				Requests []struct {
+					Cmd   map[string]any `json:"cmd"`
+					CmdID string         `json:"cmd_id"`
+				} `json:"requests"`
+				Responses bool          `json:"responses"`
+				Metrics   ClientMetrics `json:"metrics"`
+			}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			if req.Type == "ping" {
+				if !engine.ignorePings.Load() {
+					mu.Lock()
+					conn.WriteJSON(map[string]any{"success": true, "resp": map[string]any{"type": "pong", "data": map[string]any{}}})
+					mu.Unlock()
+				}
+				continue
+			}
+			if req.Type == "metrics_response" {
+				engine.metrics <- req.Metrics
+				continue
+			}
+			if engine.drop.Swap(false) {
+				return
+			}
+
+			// Answer concurrently, so responses arrive out of order.
+			go func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if req.Type == "modeling_cmd_batch_req" {
+					responses := map[string]any{}
+					for _, r := range req.Requests {
+						if r.Cmd["type"] == "fail" {
+							responses[r.CmdID] = map[string]any{"errors": []map[string]any{{"error_code": "bad_request", "message": "no such entity"}}}
+							break
+						}
+						response := map[string]any{"type": "empty"}
+						if req.Responses {
+							response = map[string]any{"type": r.Cmd["type"]}
+						}
+						responses[r.CmdID] = map[string]any{"response": response}
+					}
+					conn.WriteJSON(map[string]any{
+						"success":    true,
+						"request_id": req.BatchID,
+						"resp":       map[string]any{"type": "modeling_batch", "data": map[string]any{"responses": responses}},
+					})
+					return
+				}
+
+				if req.Cmd["type"] == "fail" {
+					conn.WriteJSON(map[string]any{
+						"success":    false,
+						"request_id": req.CmdID,
+						"errors":     []map[string]any{{"error_code": "bad_request", "message": "no such entity"}},
+					})
+					return
+				}
+				if req.Cmd["type"] == "garble" {
+					conn.WriteMessage(websocket.BinaryMessage, []byte{0xff, 0x00})
+				}
+				if t := req.Cmd["type"]; t == "export" || t == "export2d" || t == "export3d" {
+					// Exported files come back as a BSON export response.
+					export := map[string]any{
+						"success":    true,
+						"request_id": uuid.MustParse(req.CmdID),
+						"resp": map[string]any{"type": "export", "data": map[string]any{"files": []any{
+							map[string]any{"name": "output.gltf", "contents": []byte(`{"asset":{}}`)},
+							map[string]any{"name": "output.bin", "contents": []byte{0, 1, 2}},
+						}}},
+					}
+					if engine.anonymousExports.Load() {
+						delete(export, "request_id")
+						export["resp"].(map[string]any)["data"].(map[string]any)["files"].([]any)[1].(map[string]any)["contents"] = []any{0, 1, 2}
+					}
+					conn.WriteMessage(websocket.BinaryMessage, encodeBSON(export))
+					return
+				}
+				response := map[string]any{"type": req.Cmd["type"]}
+				if req.Cmd["type"] == "take_snapshot" {
+					response["data"] = map[string]any{"contents": Base64{Inner: standInSnapshot(req.Cmd["format"])}}
+				}
+				conn.WriteJSON(map[string]any{
+					"success":    true,
+					"request_id": req.CmdID,
+					"resp": map[string]any{
+						"type": "modeling",
+						"data": map[string]any{"modeling_response": response},
+					},
+				})
+			}()
+		}
+	}))
+	engine.url = strings.Replace(url, "http://", "ws://", 1)
+
+	return engine
+}
+
+// standInSnapshot encodes the 2x1 snapshot of the engine stand-in, a red
+// pixel next to a blue one.
+func standInSnapshot(format any) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+	var buf bytes.Buffer
+	if format == string(ImageFormatJpeg) {
+		jpeg.Encode(&buf, img, nil)
+	} else {
+		png.Encode(&buf, img)
+	}
+	return buf.Bytes()
+}
+
+// dial opens a websocket to the engine stand-in.
+func (e *engineStandIn) dial(ctx context.Context) (*websocket.Conn, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, e.url, nil)
+	return conn, err
+}
+
+// newEngineStandIn starts an engine stand-in and a session to it.
+func newEngineStandIn(t *testing.T) *ModelingSession {
+	t.Helper()
+
+	conn, err := startEngineStandIn(t).dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	session := NewModelingSession(conn)
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func TestModelingSessionCorrelatesResponses(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	var wg sync.WaitGroup
+	for _, cmdType := range []string{"start_path", "extend_path", "close_path", "extrude"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := session.Send(context.Background(), map[string]any{"type": cmdType})
+			if err != nil {
+				t.Errorf("sending %s failed: %v", cmdType, err)
+				return
+			}
+			if got := resp.(map[string]any)["type"]; got != cmdType {
+				t.Errorf("expected the response to %s, got the response to %v", cmdType, got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestModelingSessionReturnsEngineErrors(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	_, err := session.Send(context.Background(), map[string]any{"type": "fail"})
+	var cmdErr *ModelingCmdError
+	if !errors.As(err, &cmdErr) || cmdErr.RequestID == nil {
+		t.Fatalf("expected a *ModelingCmdError, got %v", err)
+	}
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode != ErrorCodeBadRequest || apiErr.Message != "no such entity" {
+		t.Fatalf("expected the error of the engine, got %v", err)
+	}
+}
+
+func TestModelingSessionFailsOnlySentCommandsWithoutRequestID(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	sent := &pendingRequest{results: make(chan sessionResult, 1), sent: true}
+	queued := &pendingRequest{results: make(chan sessionResult, 1)}
+	session.mu.Lock()
+	session.pending["sent"] = sent
+	session.pending["queued"] = queued
+	session.mu.Unlock()
+
+	session.dispatch(webSocketResponse{Errors: []APIError{{ErrorCode: ErrorCodeInternalEngine, Message: "engine failed"}}})
+
+	select {
+	case result := <-sent.results:
+		var cmdErr *ModelingCmdError
+		if !errors.As(result.err, &cmdErr) || cmdErr.RequestID != nil {
+			t.Fatalf("expected a *ModelingCmdError without a request ID, got %v", result.err)
+		}
+	default:
+		t.Fatalf("the sent command was not failed")
+	}
+	select {
+	case result := <-queued.results:
+		t.Fatalf("the queued command was failed: %v", result.err)
+	default:
+	}
+}
+
+func TestModelingSessionReportsUnhandledMessages(t *testing.T) {
+	conn, err := startEngineStandIn(t).dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	unhandled := make(chan []byte, 1)
+	session := NewModelingSessionWithOptions(conn, ModelingSessionOptions{
+		OnUnhandledMessage: func(messageType int, data []byte) {
+			if messageType == websocket.BinaryMessage {
+				unhandled <- data
+			}
+		},
+	})
+	defer session.Close()
+
+	if _, err := session.Send(context.Background(), map[string]any{"type": "garble"}); err != nil {
+		t.Fatalf("sending the command failed: %v", err)
+	}
+	select {
+	case data := <-unhandled:
+		if !bytes.Equal(data, []byte{0xff, 0x00}) {
+			t.Fatalf("unexpected unhandled message: %v", data)
+		}
+	default:
+		t.Fatalf("the binary message was not reported")
+	}
+}
+
+func TestModelingSessionClose(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	if err := session.Close(); err != nil {
+		t.Fatalf("closing the session failed: %v", err)
+	}
+	if _, err := session.Send(context.Background(), map[string]any{"type": "extrude"}); !errors.Is(err, ErrSessionClosed) {
+		t.Fatalf("expected ErrSessionClosed, got %v", err)
+	}
+}
+
+func TestModelingSessionReconnectsAndResubmits(t *testing.T) {
+	engine := startEngineStandIn(t)
+	conn, err := engine.dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+
+	var mu sync.Mutex
+	states := []ModelingSessionState{}
+	session := NewModelingSessionWithOptions(conn, ModelingSessionOptions{
+		Reconnect:        true,
+		Dial:             engine.dial,
+		ReconnectBackoff: time.Millisecond,
+		ResubmitInFlight: true,
+		OnStateChange: func(state ModelingSessionState, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			states = append(states, state)
+		},
+	})
+	defer session.Close()
+
+	engine.drop.Store(true)
+
+	resp, err := session.Send(context.Background(), map[string]any{"type": "extrude"})
+	if err != nil {
+		t.Fatalf("sending the command failed: %v", err)
+	}
+	if resp.(map[string]any)["type"] != "extrude" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(states) < 2 || states[0] != ModelingSessionReconnecting || states[1] != ModelingSessionConnected {
+		t.Fatalf("expected the session to reconnect, got %v", states)
+	}
+}
+
+func TestModelingSessionFailsInFlightCommands(t *testing.T) {
+	engine := startEngineStandIn(t)
+	conn, err := engine.dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	session := NewModelingSessionWithOptions(conn, ModelingSessionOptions{
+		Reconnect:        true,
+		Dial:             engine.dial,
+		ReconnectBackoff: time.Millisecond,
+	})
+	defer session.Close()
+
+	engine.drop.Store(true)
+	if _, err := session.Send(context.Background(), map[string]any{"type": "extrude"}); !errors.Is(err, ErrConnectionLost) {
+		t.Fatalf("expected ErrConnectionLost, got %v", err)
+	}
+
+	// The session is usable again once reconnected.
+	if _, err := session.Send(context.Background(), map[string]any{"type": "extrude"}); err != nil {
+		t.Fatalf("sending the command after reconnecting failed: %v", err)
+	}
+}
+
+func TestModelingSessionDetectsDeadConnection(t *testing.T) {
+	engine := startEngineStandIn(t)
+	conn, err := engine.dial(context.Background())
+	if err != nil {
+		t.Fatalf("dialing the engine stand-in failed: %v", err)
+	}
+	session := NewModelingSessionWithOptions(conn, ModelingSessionOptions{
+		PingInterval: 10 * time.Millisecond,
+	})
+	defer session.Close()
+
+	// Pongs keep the session alive.
+	time.Sleep(50 * time.Millisecond)
+	if err := session.Err(); err != nil {
+		t.Fatalf("the session ended while the engine answered pings: %v", err)
+	}
+
+	engine.ignorePings.Store(true)
+	select {
+	case <-session.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the session did not detect the dead connection")
+	}
+	if !errors.Is(session.Err(), ErrConnectionLost) {
+		t.Fatalf("expected ErrConnectionLost, got %v", session.Err())
+	}
+}
+
+func TestModelingSessionAnswersMetricsRequests(t *testing.T) {
+	engine := startEngineStandIn(t)
+	engine.requestMetrics.Store(true)
+
+	for _, test := range []struct {
+		name    string
+		metrics func() ClientMetrics
+		want    *int
+	}{
+		{name: "default"},
+		{name: "hook", metrics: func() ClientMetrics { return ClientMetrics{RtcFramesReceived: new(42)} }, want: new(42)},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conn, err := engine.dial(context.Background())
+			if err != nil {
+				t.Fatalf("dialing the engine stand-in failed: %v", err)
+			}
+			session := NewModelingSessionWithOptions(conn, ModelingSessionOptions{Metrics: test.metrics})
+			defer session.Close()
+
+			select {
+			case metrics := <-engine.metrics:
+				if got := metrics.RtcFramesReceived; (got == nil) != (test.want == nil) || (got != nil && *got != *test.want) {
+					t.Fatalf("unexpected metrics: %+v", metrics)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("the session did not answer the metrics request")
+			}
+		})
+	}
+}
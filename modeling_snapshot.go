@@ -0,0 +1,138 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// modelingCmdTakeSnapshot is the ModelingCmd taking a snapshot of the view.
+type modelingCmdTakeSnapshot struct {
+	Type   string      `json:"type"`
+	Format ImageFormat `json:"format"`
+}
+
+// modelingCmdViewIsometric is the ModelingCmd fitting the view to the scene
+// with an isometric view.
+type modelingCmdViewIsometric struct {
+	Type    string  `json:"type"`
+	Padding float64 `json:"padding"`
+}
+
+// modelingCmdZoomToFit is the ModelingCmd fitting the view to objects.
+type modelingCmdZoomToFit struct {
+	Type      string  `json:"type"`
+	ObjectIDs []UUID  `json:"object_ids"`
+	Padding   float64 `json:"padding"`
+	Animated  bool    `json:"animated"`
+}
+
+// modelingCmdDefaultCameraLookAt is the ModelingCmd moving the default
+// camera.
+type modelingCmdDefaultCameraLookAt struct {
+	Type    string  `json:"type"`
+	Vantage Point3D `json:"vantage"`
+	Center  Point3D `json:"center"`
+	Up      Point3D `json:"up"`
+}
+
+// ViewIsometricCmd returns the command fitting the view to the whole scene,
+// seen from an isometric angle. Padding is a fraction of the size of the
+// scene, 0 fitting it tightly.
+func ViewIsometricCmd(padding float64) ModelingCmd {
+	return modelingCmdViewIsometric{Type: "view_isometric", Padding: padding}
+}
+
+// ZoomToFitCmd returns the command fitting the view to the objects, or to
+// the whole scene if none are given, keeping the current angle.
+func ZoomToFitCmd(padding float64, objectIDs ...UUID) ModelingCmd {
+	if objectIDs == nil {
+		objectIDs = []UUID{}
+	}
+	return modelingCmdZoomToFit{Type: "zoom_to_fit", ObjectIDs: objectIDs, Padding: padding}
+}
+
+// LookAtCmd returns the command placing the default camera at vantage,
+// looking at center, with up pointing upwards in the view.
+func LookAtCmd(vantage, center, up Point3D) ModelingCmd {
+	return modelingCmdDefaultCameraLookAt{Type: "default_camera_look_at", Vantage: vantage, Center: center, Up: up}
+}
+
+// SnapshotBytes moves the camera with the commands, if any, then takes a
+// snapshot and returns the encoded image. The camera commands are sent as
+// one batch before the snapshot.
+func (s *ModelingSession) SnapshotBytes(ctx context.Context, format ImageFormat, camera ...ModelingCmd) ([]byte, error) {
+	if len(camera) > 0 {
+		batch := s.NewBatch()
+		for _, cmd := range camera {
+			batch.Add(cmd)
+		}
+		if _, err := batch.Submit(ctx); err != nil {
+			return nil, fmt.Errorf("moving the camera for the snapshot failed: %w", err)
+		}
+	}
+
+	resp, err := s.Send(ctx, modelingCmdTakeSnapshot{Type: "take_snapshot", Format: format})
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot struct {
+		Type string       `json:"type"`
+		Data TakeSnapshot `json:"data"`
+	}
+	if err := decodeModelingResponse(resp, &snapshot); err != nil {
+		return nil, fmt.Errorf("decoding the snapshot failed: %v", err)
+	}
+	if snapshot.Type != "take_snapshot" {
+		return nil, fmt.Errorf("unexpected response of type %q to the snapshot", snapshot.Type)
+	}
+	return snapshot.Data.Contents.Inner, nil
+}
+
+// Snapshot moves the camera with the commands, if any, then takes a snapshot
+// and decodes it.
+func (s *ModelingSession) Snapshot(ctx context.Context, format ImageFormat, camera ...ModelingCmd) (image.Image, error) {
+	contents, err := s.SnapshotBytes(ctx, format, camera...)
+	if err != nil {
+		return nil, err
+	}
+
+	var img image.Image
+	switch format {
+	case ImageFormatPng:
+		img, err = png.Decode(bytes.NewReader(contents))
+	case ImageFormatJpeg:
+		img, err = jpeg.Decode(bytes.NewReader(contents))
+	default:
+		img, _, err = image.Decode(bytes.NewReader(contents))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding the %s snapshot failed: %v", format, err)
+	}
+	return img, nil
+}
+
+// WriteSnapshot moves the camera with the commands, if any, then takes a
+// snapshot and writes the encoded image to w, as is.
+func (s *ModelingSession) WriteSnapshot(ctx context.Context, w io.Writer, format ImageFormat, camera ...ModelingCmd) error {
+	contents, err := s.SnapshotBytes(ctx, format, camera...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(contents); err != nil {
+		return fmt.Errorf("writing the snapshot failed: %v", err)
+	}
+	return nil
+}
+
+// SnapshotModel fits the view to the whole model from an isometric angle,
+// then takes a snapshot and decodes it.
+func (s *ModelingSession) SnapshotModel(ctx context.Context, format ImageFormat) (image.Image, error) {
+	return s.Snapshot(ctx, format, ViewIsometricCmd(0.1))
+}
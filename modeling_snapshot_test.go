@@ -0,0 +1,44 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestModelingSessionSnapshot(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	img, err := session.Snapshot(context.Background(), ImageFormatPng, ViewIsometricCmd(0.1), LookAtCmd(Point3D{X: 10, Y: 10, Z: 10}, Point3D{}, Point3D{Z: 1}))
+	if err != nil {
+		t.Fatalf("taking the snapshot failed: %v", err)
+	}
+	if size := img.Bounds().Size(); size.X != 2 || size.Y != 1 {
+		t.Fatalf("unexpected snapshot size %v", size)
+	}
+	if r, _, b, _ := img.At(0, 0).RGBA(); r != 0xffff || b != 0 {
+		t.Fatalf("unexpected pixel %v", img.At(0, 0))
+	}
+
+	img, err = session.SnapshotModel(context.Background(), ImageFormatJpeg)
+	if err != nil {
+		t.Fatalf("taking the JPEG snapshot failed: %v", err)
+	}
+	if _, ok := img.At(0, 0).(color.YCbCr); !ok {
+		t.Fatalf("expected a JPEG image, got %T", img.At(0, 0))
+	}
+}
+
+func TestModelingSessionWriteSnapshot(t *testing.T) {
+	session := newEngineStandIn(t)
+
+	var buf bytes.Buffer
+	if err := session.WriteSnapshot(context.Background(), &buf, ImageFormatPng, ZoomToFitCmd(0)); err != nil {
+		t.Fatalf("writing the snapshot failed: %v", err)
+	}
+	if _, err := png.Decode(&buf); err != nil {
+		t.Fatalf("the snapshot is not a PNG: %v", err)
+	}
+}
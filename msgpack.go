@@ -0,0 +1,155 @@
+package kittycad
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// errShortMsgPack is returned when a MsgPack value ends early.
+var errShortMsgPack = errors.New("unexpected end of MsgPack value")
+
+// msgPackWidths are the widths of the big-endian sizes or numbers following
+// the MsgPack types which are not fixed.
+var msgPackWidths = map[byte]int{
+	0xc4: 1, 0xc5: 2, 0xc6: 4, // bin
+	0xca: 4, 0xcb: 8, // float
+	0xcc: 1, 0xcd: 2, 0xce: 4, 0xcf: 8, // uint
+	0xd0: 1, 0xd1: 2, 0xd2: 4, 0xd3: 8, // int
+	0xd9: 1, 0xda: 2, 0xdb: 4, // str
+	0xdc: 2, 0xdd: 4, // array
+	0xde: 2, 0xdf: 4, // map
+}
+
+// decodeMsgPack decodes a MsgPack value into the values encoding/json would
+// decode its JSON form into, except that binary values are decoded as
+// []byte, and map keys which are not strings are formatted with fmt.Sprint.
+// Extension types are not supported.
+func decodeMsgPack(data []byte) (any, error) {
+	value, rest, err := decodeMsgPackValue(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%d bytes after the MsgPack value", len(rest))
+	}
+	return value, nil
+}
+
+// decodeMsgPackValue decodes the value at the start of data, and returns the
+// bytes after it.
+func decodeMsgPackValue(data []byte) (any, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, errShortMsgPack
+	}
+	kind, data := data[0], data[1:]
+
+	switch {
+	case kind <= 0x7f: // positive fixint
+		return float64(kind), data, nil
+	case kind >= 0xe0: // negative fixint
+		return float64(int8(kind)), data, nil
+	case kind&0xf0 == 0x80: // fixmap
+		return decodeMsgPackMap(int(kind&0x0f), data)
+	case kind&0xf0 == 0x90: // fixarray
+		return decodeMsgPackArray(int(kind&0x0f), data)
+	case kind&0xe0 == 0xa0: // fixstr
+		return decodeMsgPackString(int(kind&0x1f), data)
+	}
+
+	switch kind {
+	case 0xc0:
+		return nil, data, nil
+	case 0xc2:
+		return false, data, nil
+	case 0xc3:
+		return true, data, nil
+	}
+	width, ok := msgPackWidths[kind]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported MsgPack type 0x%02x", kind)
+	}
+	if len(data) < width {
+		return nil, nil, errShortMsgPack
+	}
+	var n uint64
+	for _, b := range data[:width] {
+		n = n<<8 | uint64(b)
+	}
+	data = data[width:]
+
+	switch kind {
+	case 0xc4, 0xc5, 0xc6:
+		if n > uint64(len(data)) {
+			return nil, nil, fmt.Errorf("invalid MsgPack binary size %d", n)
+		}
+		return append([]byte{}, data[:n]...), data[n:], nil
+	case 0xca:
+		return float64(math.Float32frombits(uint32(n))), data, nil
+	case 0xcb:
+		return math.Float64frombits(n), data, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return float64(n), data, nil
+	case 0xd0:
+		return float64(int8(n)), data, nil
+	case 0xd1:
+		return float64(int16(n)), data, nil
+	case 0xd2:
+		return float64(int32(n)), data, nil
+	case 0xd3:
+		return float64(int64(n)), data, nil
+	case 0xd9, 0xda, 0xdb:
+		return decodeMsgPackString(int(n), data)
+	case 0xdc, 0xdd:
+		return decodeMsgPackArray(int(n), data)
+	default: // 0xde, 0xdf
+		return decodeMsgPackMap(int(n), data)
+	}
+}
+
+func decodeMsgPackString(size int, data []byte) (any, []byte, error) {
+	if size < 0 || size > len(data) {
+		return nil, nil, fmt.Errorf("invalid MsgPack string size %d", size)
+	}
+	return string(data[:size]), data[size:], nil
+}
+
+func decodeMsgPackArray(size int, data []byte) (any, []byte, error) {
+	// Every value takes at least a byte.
+	if size < 0 || size > len(data) {
+		return nil, nil, fmt.Errorf("invalid MsgPack array size %d", size)
+	}
+	values := make([]any, size)
+	for i := range values {
+		value, rest, err := decodeMsgPackValue(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding MsgPack array index %d failed: %v", i, err)
+		}
+		values[i], data = value, rest
+	}
+	return values, data, nil
+}
+
+func decodeMsgPackMap(size int, data []byte) (any, []byte, error) {
+	// Every key and value takes at least a byte.
+	if size < 0 || 2*size > len(data) {
+		return nil, nil, fmt.Errorf("invalid MsgPack map size %d", size)
+	}
+	values := make(map[string]any, size)
+	for range size {
+		key, rest, err := decodeMsgPackValue(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding MsgPack map key failed: %v", err)
+		}
+		name, ok := key.(string)
+		if !ok {
+			name = fmt.Sprint(key)
+		}
+		value, rest, err := decodeMsgPackValue(rest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding MsgPack map value %q failed: %v", name, err)
+		}
+		values[name], data = value, rest
+	}
+	return values, data, nil
+}
@@ -0,0 +1,125 @@
+package kittycad
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// encodeMsgPack encodes the value as MsgPack, with the smallest types
+// holding it. Ints are encoded as integers, and floats as doubles.
+func encodeMsgPack(value any) []byte {
+	var out []byte
+	switch v := value.(type) {
+	case nil:
+		out = append(out, 0xc0)
+	case bool:
+		if v {
+			out = append(out, 0xc3)
+		} else {
+			out = append(out, 0xc2)
+		}
+	case int:
+		switch {
+		case v >= 0 && v <= 0x7f:
+			out = append(out, byte(v))
+		case v < 0 && v >= -32:
+			out = append(out, byte(int8(v)))
+		default:
+			out = binary.BigEndian.AppendUint64(append(out, 0xd3), uint64(v))
+		}
+	case float64:
+		out = binary.BigEndian.AppendUint64(append(out, 0xcb), math.Float64bits(v))
+	case string:
+		if len(v) < 32 {
+			out = append(out, 0xa0|byte(len(v)))
+		} else {
+			out = binary.BigEndian.AppendUint32(append(out, 0xdb), uint32(len(v)))
+		}
+		out = append(out, v...)
+	case []byte:
+		if len(v) < 256 {
+			out = append(out, 0xc4, byte(len(v)))
+		} else {
+			out = binary.BigEndian.AppendUint32(append(out, 0xc6), uint32(len(v)))
+		}
+		out = append(out, v...)
+	case []any:
+		if len(v) < 16 {
+			out = append(out, 0x90|byte(len(v)))
+		} else {
+			out = binary.BigEndian.AppendUint32(append(out, 0xdd), uint32(len(v)))
+		}
+		for _, item := range v {
+			out = append(out, encodeMsgPack(item)...)
+		}
+	case map[string]any:
+		if len(v) < 16 {
+			out = append(out, 0x80|byte(len(v)))
+		} else {
+			out = binary.BigEndian.AppendUint32(append(out, 0xdf), uint32(len(v)))
+		}
+		keys := []string{}
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			out = append(out, encodeMsgPack(k)...)
+			out = append(out, encodeMsgPack(v[k])...)
+		}
+	default:
+		panic("cannot encode " + reflect.TypeOf(value).String() + " as MsgPack")
+	}
+	return out
+}
+
+func TestDecodeMsgPack(t *testing.T) {
+	long := string(make([]byte, 40))
+	value, err := decodeMsgPack(encodeMsgPack(map[string]any{
+		"replay": map[string]any{"messages": []any{[]byte(`{"info":{}}`), []any{1, 2}}},
+		"long":   long,
+		"many":   []any{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		"small":  -3,
+		"large":  -70000,
+		"scale":  1.5,
+		"done":   true,
+		"none":   nil,
+	}))
+	if err != nil {
+		t.Fatalf("decoding failed: %v", err)
+	}
+
+	want := map[string]any{
+		"replay": map[string]any{"messages": []any{[]byte(`{"info":{}}`), []any{1.0, 2.0}}},
+		"long":   long,
+		"many":   []any{0.0, 1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 11.0, 12.0, 13.0, 14.0, 15.0, 16.0},
+		"small":  -3.0,
+		"large":  -70000.0,
+		"scale":  1.5,
+		"done":   true,
+		"none":   nil,
+	}
+	if !reflect.DeepEqual(value, want) {
+		t.Fatalf("got %#v, want %#v", value, want)
+	}
+}
+
+func TestDecodeMsgPackRefusesBadValues(t *testing.T) {
+	valid := encodeMsgPack(map[string]any{"name": "part.stl"})
+	for name, data := range map[string][]byte{
+		"empty":       {},
+		"truncated":   valid[:len(valid)-2],
+		"trailing":    append(append([]byte{}, valid...), 0),
+		"extension":   {0xd4, 0x01, 0x00},
+		"bad size":    {0xc6, 0xff, 0xff, 0xff, 0x7f},
+		"bad array":   {0xdd, 0xff, 0xff, 0xff, 0x7f},
+		"short float": {0xcb, 0x00},
+	} {
+		if _, err := decodeMsgPack(data); err == nil {
+			t.Errorf("expected the %s value to be refused", name)
+		}
+	}
+}
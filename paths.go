@@ -4,6 +4,7 @@ package kittycad
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -74,7 +75,7 @@ func (s *MetaService) GetIpinfo() (*IpAddrInfo, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded IpAddrInfo
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -83,7 +84,14 @@ func (s *MetaService) GetIpinfo() (*IpAddrInfo, error) {
 
 }
 
+// MlCreateTextToCadParams holds the query parameters for MlService.CreateTextToCad.
+type MlCreateTextToCadParams struct {
+	// Kcl is the `kcl` query parameter.
+	Kcl *bool
+}
+
 // CreateTextToCad: Generate a CAD model from text.
+//
 // Prefer the ML copilot websocket (`/ws/ml/copilot`) for new integrations. This REST endpoint is kept for existing Text-to-CAD clients, but it is no longer the recommended way to generate CAD models from a prompt.
 //
 // Because our source of truth for the resulting model is a STEP file, you will always have STEP file contents when you list your generated parts. Any other formats you request here will also be returned when you list your generated parts.
@@ -95,9 +103,9 @@ func (s *MetaService) GetIpinfo() (*IpAddrInfo, error) {
 // Parameters
 //
 //   - `outputFormat`: The valid types of output file formats.
-//   - `kcl`
+//   - `params`: The query parameters, see MlCreateTextToCadParams.
 //   - `body`: Body for generating parts from text.
-func (s *MlService) CreateTextToCad(outputFormat FileExportFormat, kcl bool, body TextToCadCreateBody) (*TextToCad, error) {
+func (s *MlService) CreateTextToCad(outputFormat FileExportFormat, params MlCreateTextToCadParams, body TextToCadCreateBody) (*TextToCad, error) {
 	// Create the url.
 	path := "/ai/text-to-cad/{{.output_format}}"
 	targetURL := resolveRelative(s.client.server, path)
@@ -118,10 +126,13 @@ func (s *MlService) CreateTextToCad(outputFormat FileExportFormat, kcl bool, bod
 	req.Header.Add("Content-Type", "application/json")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
+	values := map[string]string{
 		"output_format": string(outputFormat),
-		"kcl":           strconv.FormatBool(kcl),
-	}); err != nil {
+	}
+	if params.Kcl != nil {
+		values["kcl"] = strconv.FormatBool(*params.Kcl)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -142,7 +153,7 @@ func (s *MlService) CreateTextToCad(outputFormat FileExportFormat, kcl bool, bod
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded TextToCad
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -181,7 +192,7 @@ func (s *MetaService) GetAnnouncements() (*AnnouncementList, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AnnouncementList
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -235,7 +246,7 @@ func (s *APICallService) Get(id UUID) (*APICallWithPrice, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPrice
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -321,7 +332,7 @@ func (s *AppService) GithubConsent() (*AppClientInfo, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AppClientInfo
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -416,7 +427,7 @@ func (s *APICallService) GetAsyncOperation(id UUID) (*any, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded any
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -455,7 +466,7 @@ func (s *HiddenService) AuthAPIKey() (*AuthAPIKeyResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AuthAPIKeyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -505,7 +516,7 @@ func (s *HiddenService) AuthEmail(body EmailAuthenticationForm) (*VerificationTo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded VerificationTokenResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -555,13 +566,22 @@ func (s *HiddenService) AuthEmailMarketingConfirmCreate(body EmailMarketingConfi
 
 }
 
+// HiddenAuthEmailCallbackParams holds the query parameters for HiddenService.AuthEmailCallback.
+type HiddenAuthEmailCallbackParams struct {
+	// CallbackUrl is the `callback_url` query parameter.
+	CallbackUrl *URL
+	// Token is the `token` query parameter.
+	Token string
+	// Email is the `email` query parameter.
+	Email string
+}
+
 // AuthEmailCallback: Listen for callbacks for email authentication for users.
+//
 // Parameters
 //
-//   - `callbackUrl`
-//   - `token`
-//   - `email`
-func (s *HiddenService) AuthEmailCallback(callbackUrl URL, token string, email string) error {
+//   - `params`: The query parameters, see HiddenAuthEmailCallbackParams.
+func (s *HiddenService) AuthEmailCallback(params HiddenAuthEmailCallbackParams) error {
 	// Create the url.
 	path := "/auth/email/callback"
 	targetURL := resolveRelative(s.client.server, path)
@@ -573,11 +593,14 @@ func (s *HiddenService) AuthEmailCallback(callbackUrl URL, token string, email s
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"callback_url": callbackUrl.String(),
-		"token":        token,
-		"email":        email,
-	}); err != nil {
+	values := map[string]string{
+		"token": params.Token,
+		"email": params.Email,
+	}
+	if params.CallbackUrl != nil {
+		values["callback_url"] = params.CallbackUrl.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -598,14 +621,21 @@ func (s *HiddenService) AuthEmailCallback(callbackUrl URL, token string, email s
 
 }
 
+// HiddenGetAuthSamlByOrgParams holds the query parameters for HiddenService.GetAuthSamlByOrg.
+type HiddenGetAuthSamlByOrgParams struct {
+	// CallbackUrl is the `callback_url` query parameter.
+	CallbackUrl *URL
+}
+
 // GetAuthSamlByOrg: GET /auth/saml/{org_id}
+//
 // Redirects the browser straight to the org’s SAML IdP.
 //
 // Parameters
 //
 //   - `orgId`: A UUID usually v4 or v7
-//   - `callbackUrl`
-func (s *HiddenService) GetAuthSamlByOrg(orgId UUID, callbackUrl URL) error {
+//   - `params`: The query parameters, see HiddenGetAuthSamlByOrgParams.
+func (s *HiddenService) GetAuthSamlByOrg(orgId UUID, params HiddenGetAuthSamlByOrgParams) error {
 	// Create the url.
 	path := "/auth/saml/org/{{.org_id}}/login"
 	targetURL := resolveRelative(s.client.server, path)
@@ -617,10 +647,13 @@ func (s *HiddenService) GetAuthSamlByOrg(orgId UUID, callbackUrl URL) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"org_id":       orgId.String(),
-		"callback_url": callbackUrl.String(),
-	}); err != nil {
+	values := map[string]string{
+		"org_id": orgId.String(),
+	}
+	if params.CallbackUrl != nil {
+		values["callback_url"] = params.CallbackUrl.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -641,14 +674,21 @@ func (s *HiddenService) GetAuthSamlByOrg(orgId UUID, callbackUrl URL) error {
 
 }
 
+// HiddenGetAuthSamlParams holds the query parameters for HiddenService.GetAuthSaml.
+type HiddenGetAuthSamlParams struct {
+	// CallbackUrl is the `callback_url` query parameter.
+	CallbackUrl *URL
+}
+
 // GetAuthSaml: Get a redirect straight to the SAML IdP.
+//
 // The UI uses this to avoid having to ask the API anything about the IdP. It already knows the SAML IdP ID from the path, so it can just link to this path and rely on the API to redirect to the actual IdP.
 //
 // Parameters
 //
 //   - `providerId`: A UUID usually v4 or v7
-//   - `callbackUrl`
-func (s *HiddenService) GetAuthSaml(providerId UUID, callbackUrl URL) error {
+//   - `params`: The query parameters, see HiddenGetAuthSamlParams.
+func (s *HiddenService) GetAuthSaml(providerId UUID, params HiddenGetAuthSamlParams) error {
 	// Create the url.
 	path := "/auth/saml/provider/{{.provider_id}}/login"
 	targetURL := resolveRelative(s.client.server, path)
@@ -660,10 +700,13 @@ func (s *HiddenService) GetAuthSaml(providerId UUID, callbackUrl URL) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"provider_id":  providerId.String(),
-		"callback_url": callbackUrl.String(),
-	}); err != nil {
+	values := map[string]string{
+		"provider_id": providerId.String(),
+	}
+	if params.CallbackUrl != nil {
+		values["callback_url"] = params.CallbackUrl.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -770,7 +813,16 @@ func (s *MetaService) CommunitySso(sso string, sig string) error {
 
 }
 
+// FileCreateCenterOfMassParams holds the query parameters for FileService.CreateCenterOfMass.
+type FileCreateCenterOfMassParams struct {
+	// SrcFormat: The valid types of source file formats.
+	SrcFormat FileImportFormat
+	// OutputUnit: The valid types of length units.
+	OutputUnit *UnitLength
+}
+
 // CreateCenterOfMass: Get CAD file center of mass.
+//
 // We assume any file given to us has one consistent unit throughout. We also assume the file is at the proper scale.
 //
 // This endpoint returns the cartesian coordinate in world space measure units.
@@ -783,10 +835,9 @@ func (s *MetaService) CommunitySso(sso string, sig string) error {
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of length units.
+//   - `params`: The query parameters, see FileCreateCenterOfMassParams.
 //   - `body`
-func (s *FileService) CreateCenterOfMass(srcFormat FileImportFormat, outputUnit UnitLength, body []byte) (*FileCenterOfMass, error) {
+func (s *FileService) CreateCenterOfMass(params FileCreateCenterOfMassParams, body []byte) (*FileCenterOfMass, error) {
 	// Create the url.
 	path := "/file/center-of-mass"
 	targetURL := resolveRelative(s.client.server, path)
@@ -803,10 +854,13 @@ func (s *FileService) CreateCenterOfMass(srcFormat FileImportFormat, outputUnit
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"src_format":  string(srcFormat),
-		"output_unit": string(outputUnit),
-	}); err != nil {
+	values := map[string]string{
+		"src_format": string(params.SrcFormat),
+	}
+	if params.OutputUnit != nil {
+		values["output_unit"] = string(*params.OutputUnit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -827,7 +881,7 @@ func (s *FileService) CreateCenterOfMass(srcFormat FileImportFormat, outputUnit
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileCenterOfMass
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -885,7 +939,7 @@ func (s *FileService) CreateConversionOptions(body *MultipartForm) (*FileConvers
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -949,7 +1003,7 @@ func (s *FileService) CreateConversion(srcFormat FileImportFormat, outputFormat
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -958,7 +1012,20 @@ func (s *FileService) CreateConversion(srcFormat FileImportFormat, outputFormat
 
 }
 
+// FileCreateDensityParams holds the query parameters for FileService.CreateDensity.
+type FileCreateDensityParams struct {
+	// SrcFormat: The valid types of source file formats.
+	SrcFormat FileImportFormat
+	// MaterialMass is the `material_mass` query parameter.
+	MaterialMass float64
+	// MaterialMassUnit: The valid types of mass units.
+	MaterialMassUnit *UnitMas
+	// OutputUnit: The valid types for density units.
+	OutputUnit *UnitDensity
+}
+
 // CreateDensity: Get CAD file density.
+//
 // We assume any file given to us has one consistent unit throughout. We also assume the file is at the proper scale.
 //
 // This endpoint assumes if you are giving a material mass in a specific mass units, we return a density in mass unit per cubic measure unit.
@@ -971,12 +1038,9 @@ func (s *FileService) CreateConversion(srcFormat FileImportFormat, outputFormat
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `materialMass`
-//   - `materialMassUnit`: The valid types of mass units.
-//   - `outputUnit`: The valid types for density units.
+//   - `params`: The query parameters, see FileCreateDensityParams.
 //   - `body`
-func (s *FileService) CreateDensity(srcFormat FileImportFormat, materialMass float64, materialMassUnit UnitMas, outputUnit UnitDensity, body []byte) (*FileDensity, error) {
+func (s *FileService) CreateDensity(params FileCreateDensityParams, body []byte) (*FileDensity, error) {
 	// Create the url.
 	path := "/file/density"
 	targetURL := resolveRelative(s.client.server, path)
@@ -993,12 +1057,17 @@ func (s *FileService) CreateDensity(srcFormat FileImportFormat, materialMass flo
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"src_format":         string(srcFormat),
-		"material_mass":      fmt.Sprintf("%f", materialMass),
-		"material_mass_unit": string(materialMassUnit),
-		"output_unit":        string(outputUnit),
-	}); err != nil {
+	values := map[string]string{
+		"src_format":    string(params.SrcFormat),
+		"material_mass": fmt.Sprintf("%f", params.MaterialMass),
+	}
+	if params.MaterialMassUnit != nil {
+		values["material_mass_unit"] = string(*params.MaterialMassUnit)
+	}
+	if params.OutputUnit != nil {
+		values["output_unit"] = string(*params.OutputUnit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1019,7 +1088,7 @@ func (s *FileService) CreateDensity(srcFormat FileImportFormat, materialMass flo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileDensity
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1028,7 +1097,14 @@ func (s *FileService) CreateDensity(srcFormat FileImportFormat, materialMass flo
 
 }
 
+// ExecutorCreateFileExecutionParams holds the query parameters for ExecutorService.CreateFileExecution.
+type ExecutorCreateFileExecutionParams struct {
+	// Output is the `output` query parameter.
+	Output *string
+}
+
 // CreateFileExecution: Execute a Zoo program in a specific language.
+//
 // Parameters
 //
 //   - `lang`: The language code is written in.
@@ -1037,10 +1113,10 @@ func (s *FileService) CreateDensity(srcFormat FileImportFormat, materialMass flo
 //
 //     ```json { "description": "The language code is written in.", "oneOf": [ { "description": "The `go` programming language.", "type": "string", "enum": [ "go" ] }, { "description": "The `python` programming language.", "type": "string", "enum": [ "python" ] }, { "description": "The `node` programming language.", "type": "string", "enum": [ "node" ] } ] } ``` </details>
 //
-//   - `output`
+//   - `params`: The query parameters, see ExecutorCreateFileExecutionParams.
 //
 //   - `body`
-func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, output string, body []byte) (*CodeOutput, error) {
+func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, params ExecutorCreateFileExecutionParams, body []byte) (*CodeOutput, error) {
 	// Create the url.
 	path := "/file/execute/{{.lang}}"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1057,10 +1133,13 @@ func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, output string,
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"lang":   string(lang),
-		"output": output,
-	}); err != nil {
+	values := map[string]string{
+		"lang": string(lang),
+	}
+	if params.Output != nil {
+		values["output"] = *params.Output
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1081,7 +1160,7 @@ func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, output string,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CodeOutput
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1090,7 +1169,20 @@ func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, output string,
 
 }
 
+// FileCreateMassParams holds the query parameters for FileService.CreateMass.
+type FileCreateMassParams struct {
+	// SrcFormat: The valid types of source file formats.
+	SrcFormat FileImportFormat
+	// MaterialDensity is the `material_density` query parameter.
+	MaterialDensity float64
+	// MaterialDensityUnit: The valid types for density units.
+	MaterialDensityUnit *UnitDensity
+	// OutputUnit: The valid types of mass units.
+	OutputUnit *UnitMas
+}
+
 // CreateMass: Get CAD file mass.
+//
 // We assume any file given to us has one consistent unit throughout. We also assume the file is at the proper scale.
 //
 // This endpoint assumes if you are giving a material density in a specific mass unit per cubic measure unit, we return a mass in mass units. The same mass units as passed in the material density.
@@ -1103,12 +1195,9 @@ func (s *ExecutorService) CreateFileExecution(lang CodeLanguage, output string,
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `materialDensity`
-//   - `materialDensityUnit`: The valid types for density units.
-//   - `outputUnit`: The valid types of mass units.
+//   - `params`: The query parameters, see FileCreateMassParams.
 //   - `body`
-func (s *FileService) CreateMass(srcFormat FileImportFormat, materialDensity float64, materialDensityUnit UnitDensity, outputUnit UnitMas, body []byte) (*FileMass, error) {
+func (s *FileService) CreateMass(params FileCreateMassParams, body []byte) (*FileMass, error) {
 	// Create the url.
 	path := "/file/mass"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1125,12 +1214,17 @@ func (s *FileService) CreateMass(srcFormat FileImportFormat, materialDensity flo
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"src_format":            string(srcFormat),
-		"material_density":      fmt.Sprintf("%f", materialDensity),
-		"material_density_unit": string(materialDensityUnit),
-		"output_unit":           string(outputUnit),
-	}); err != nil {
+	values := map[string]string{
+		"src_format":       string(params.SrcFormat),
+		"material_density": fmt.Sprintf("%f", params.MaterialDensity),
+	}
+	if params.MaterialDensityUnit != nil {
+		values["material_density_unit"] = string(*params.MaterialDensityUnit)
+	}
+	if params.OutputUnit != nil {
+		values["output_unit"] = string(*params.OutputUnit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1151,7 +1245,7 @@ func (s *FileService) CreateMass(srcFormat FileImportFormat, materialDensity flo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileMass
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1160,7 +1254,16 @@ func (s *FileService) CreateMass(srcFormat FileImportFormat, materialDensity flo
 
 }
 
+// FileCreateSurfaceAreaParams holds the query parameters for FileService.CreateSurfaceArea.
+type FileCreateSurfaceAreaParams struct {
+	// SrcFormat: The valid types of source file formats.
+	SrcFormat FileImportFormat
+	// OutputUnit: The valid types of area units.
+	OutputUnit *UnitArea
+}
+
 // CreateSurfaceArea: Get CAD file surface area.
+//
 // We assume any file given to us has one consistent unit throughout. We also assume the file is at the proper scale.
 //
 // This endpoint returns the square measure units.
@@ -1173,10 +1276,9 @@ func (s *FileService) CreateMass(srcFormat FileImportFormat, materialDensity flo
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of area units.
+//   - `params`: The query parameters, see FileCreateSurfaceAreaParams.
 //   - `body`
-func (s *FileService) CreateSurfaceArea(srcFormat FileImportFormat, outputUnit UnitArea, body []byte) (*FileSurfaceArea, error) {
+func (s *FileService) CreateSurfaceArea(params FileCreateSurfaceAreaParams, body []byte) (*FileSurfaceArea, error) {
 	// Create the url.
 	path := "/file/surface-area"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1193,10 +1295,13 @@ func (s *FileService) CreateSurfaceArea(srcFormat FileImportFormat, outputUnit U
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"src_format":  string(srcFormat),
-		"output_unit": string(outputUnit),
-	}); err != nil {
+	values := map[string]string{
+		"src_format": string(params.SrcFormat),
+	}
+	if params.OutputUnit != nil {
+		values["output_unit"] = string(*params.OutputUnit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1217,7 +1322,7 @@ func (s *FileService) CreateSurfaceArea(srcFormat FileImportFormat, outputUnit U
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileSurfaceArea
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1226,7 +1331,16 @@ func (s *FileService) CreateSurfaceArea(srcFormat FileImportFormat, outputUnit U
 
 }
 
+// FileCreateVolumeParams holds the query parameters for FileService.CreateVolume.
+type FileCreateVolumeParams struct {
+	// SrcFormat: The valid types of source file formats.
+	SrcFormat FileImportFormat
+	// OutputUnit: The valid types of volume units.
+	OutputUnit *UnitVolume
+}
+
 // CreateVolume: Get CAD file volume.
+//
 // We assume any file given to us has one consistent unit throughout. We also assume the file is at the proper scale.
 //
 // This endpoint returns the cubic measure units.
@@ -1239,10 +1353,9 @@ func (s *FileService) CreateSurfaceArea(srcFormat FileImportFormat, outputUnit U
 //
 // Parameters
 //
-//   - `srcFormat`: The valid types of source file formats.
-//   - `outputUnit`: The valid types of volume units.
+//   - `params`: The query parameters, see FileCreateVolumeParams.
 //   - `body`
-func (s *FileService) CreateVolume(srcFormat FileImportFormat, outputUnit UnitVolume, body []byte) (*FileVolume, error) {
+func (s *FileService) CreateVolume(params FileCreateVolumeParams, body []byte) (*FileVolume, error) {
 	// Create the url.
 	path := "/file/volume"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1259,10 +1372,13 @@ func (s *FileService) CreateVolume(srcFormat FileImportFormat, outputUnit UnitVo
 	req.Header.Add("Content-Type", "application/octet-stream")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"src_format":  string(srcFormat),
-		"output_unit": string(outputUnit),
-	}); err != nil {
+	values := map[string]string{
+		"src_format": string(params.SrcFormat),
+	}
+	if params.OutputUnit != nil {
+		values["output_unit"] = string(*params.OutputUnit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1283,7 +1399,7 @@ func (s *FileService) CreateVolume(srcFormat FileImportFormat, outputUnit UnitVo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FileVolume
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1335,7 +1451,7 @@ func (s *MetaService) InternalGetAPITokenForDiscordUser(discordId string) (*APIT
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APIToken
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1374,21 +1490,28 @@ func (s *HiddenService) Logout() error {
 
 }
 
+// MlListConversationsForUserParams holds the query parameters for MlService.ListConversationsForUser.
+type MlListConversationsForUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListConversationsForUser: List conversations
+//
 // This endpoint requires authentication by any Zoo user. It returns the conversations for the authenticated user.
 //
 // The conversations are returned in order of creation, with the most recently created conversations first.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *MlService) ListConversationsForUser(limit int, pageToken string, sortBy CreatedAtSortMode) (*ConversationResultsPage, error) {
+//   - `params`: The query parameters, see MlListConversationsForUserParams.
+func (s *MlService) ListConversationsForUser(params MlListConversationsForUserParams) (*ConversationResultsPage, error) {
 	// Create the url.
 	path := "/ml/conversations"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1400,11 +1523,17 @@ func (s *MlService) ListConversationsForUser(limit int, pageToken string, sortBy
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1425,7 +1554,7 @@ func (s *MlService) ListConversationsForUser(limit int, pageToken string, sortBy
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ConversationResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1434,7 +1563,18 @@ func (s *MlService) ListConversationsForUser(limit int, pageToken string, sortBy
 
 }
 
+// MlCreateProprietaryToKclParams holds the query parameters for MlService.CreateProprietaryToKcl.
+type MlCreateProprietaryToKclParams struct {
+	// CodeOption: `CodeOption`
+	//
+	// <details><summary>JSON schema</summary>
+	//
+	// ```json { "type": "string", "enum": [ "parse", "mock_execute", "execute" ] } ``` </details>
+	CodeOption *CodeOption
+}
+
 // CreateProprietaryToKcl: Converts a proprietary CAD format to KCL.
+//
 // This endpoint is used to convert a proprietary CAD format to KCL. The file passed MUST have feature tree data.
 //
 // A STEP file does not have feature tree data, so it will not work. A sldprt file does have feature tree data, so it will work.
@@ -1451,14 +1591,9 @@ func (s *MlService) ListConversationsForUser(limit int, pageToken string, sortBy
 //
 // Parameters
 //
-//   - `codeOption`: `CodeOption`
-//
-//     <details><summary>JSON schema</summary>
-//
-//     ```json { "type": "string", "enum": [ "parse", "mock_execute", "execute" ] } ``` </details>
-//
+//   - `params`: The query parameters, see MlCreateProprietaryToKclParams.
 //   - `body`
-func (s *MlService) CreateProprietaryToKcl(codeOption CodeOption, body *MultipartForm) (*KclModel, error) {
+func (s *MlService) CreateProprietaryToKcl(params MlCreateProprietaryToKclParams, body *MultipartForm) (*KclModel, error) {
 	// Create the url.
 	path := "/ml/convert/proprietary-to-kcl"
 	targetURL := resolveRelative(s.client.server, path)
@@ -1481,9 +1616,11 @@ func (s *MlService) CreateProprietaryToKcl(codeOption CodeOption, body *Multipar
 	req.Header.Set("Content-Type", body.ContentType())
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"code_option": string(codeOption),
-	}); err != nil {
+	values := map[string]string{}
+	if params.CodeOption != nil {
+		values["code_option"] = string(*params.CodeOption)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -1504,7 +1641,7 @@ func (s *MlService) CreateProprietaryToKcl(codeOption CodeOption, body *Multipar
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded KclModel
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1556,7 +1693,7 @@ func (s *MlService) CreateCustomModel(body CreateCustomModel) (*CustomModel, err
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomModel
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1604,7 +1741,7 @@ func (s *MlService) GetCustomModel(id UUID) (*CustomModel, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomModel
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1662,7 +1799,7 @@ func (s *MlService) UpdateCustomModel(id UUID, body UpdateCustomModel) (*CustomM
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomModel
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1710,7 +1847,7 @@ func (s *MlService) ListOrgDatasetsForModel(id UUID) (*[]OrgDataset, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []OrgDataset
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1760,7 +1897,7 @@ func (s *MlService) CreateKclCodeCompletions(body KclCodeCompletionRequest) (*Kc
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded KclCodeCompletionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1820,7 +1957,7 @@ func (s *MlService) CreateTextToCadIteration(body TextToCadIterationBody) (*Text
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded TextToCadIteration
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1884,7 +2021,7 @@ func (s *MlService) CreateTextToCadMultiFileIteration(body *MultipartForm) (*Tex
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded TextToCadMultiFileIteration
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1932,7 +2069,7 @@ func (s *Oauth2Service) GetAuthorizationRequest(requestId UUID) (*Oauth2Authoriz
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AuthorizationRequestResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -1980,7 +2117,7 @@ func (s *Oauth2Service) ApproveAuthorizationRequest(requestId UUID) (*Oauth2Auth
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AuthorizationDecisionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2028,7 +2165,7 @@ func (s *Oauth2Service) DenyAuthorizationRequest(requestId UUID) (*Oauth2Authori
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AuthorizationDecisionResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2037,17 +2174,30 @@ func (s *Oauth2Service) DenyAuthorizationRequest(requestId UUID) (*Oauth2Authori
 
 }
 
+// Oauth2AuthorizeParams holds the query parameters for Oauth2Service.Authorize.
+type Oauth2AuthorizeParams struct {
+	// ResponseType: The OAuth 2.0 authorization response type.
+	ResponseType Oauth2AuthorizationResponseType
+	// ClientID is the `client_id` query parameter.
+	ClientID UUID
+	// RedirectUri is the `redirect_uri` query parameter.
+	RedirectUri URL
+	// State is the `state` query parameter.
+	State string
+	// Scope: OAuth 2.0 scopes encoded as a space-delimited string.
+	Scope *string
+	// CodeChallenge is the `code_challenge` query parameter.
+	CodeChallenge string
+	// CodeChallengeMethod: The PKCE code challenge method.
+	CodeChallengeMethod Oauth2CodeChallengeMethod
+}
+
 // Authorize: Start an OAuth 2.0 authorization code flow with PKCE.
+//
 // Parameters
 //
-//   - `responseType`: The OAuth 2.0 authorization response type.
-//   - `clientId`
-//   - `redirectUri`
-//   - `state`
-//   - `scope`: OAuth 2.0 scopes encoded as a space-delimited string.
-//   - `codeChallenge`
-//   - `codeChallengeMethod`: The PKCE code challenge method.
-func (s *Oauth2Service) Authorize(responseType Oauth2AuthorizationResponseType, clientId UUID, redirectUri URL, state string, scope string, codeChallenge string, codeChallengeMethod Oauth2CodeChallengeMethod) error {
+//   - `params`: The query parameters, see Oauth2AuthorizeParams.
+func (s *Oauth2Service) Authorize(params Oauth2AuthorizeParams) error {
 	// Create the url.
 	path := "/oauth2/authorize"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2059,15 +2209,18 @@ func (s *Oauth2Service) Authorize(responseType Oauth2AuthorizationResponseType,
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"response_type":         string(responseType),
-		"client_id":             clientId.String(),
-		"redirect_uri":          redirectUri.String(),
-		"state":                 state,
-		"scope":                 scope,
-		"code_challenge":        codeChallenge,
-		"code_challenge_method": string(codeChallengeMethod),
-	}); err != nil {
+	values := map[string]string{
+		"response_type":         string(params.ResponseType),
+		"client_id":             params.ClientID.String(),
+		"redirect_uri":          params.RedirectUri.String(),
+		"state":                 params.State,
+		"code_challenge":        params.CodeChallenge,
+		"code_challenge_method": string(params.CodeChallengeMethod),
+	}
+	if params.Scope != nil {
+		values["scope"] = *params.Scope
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2223,14 +2376,22 @@ func (s *Oauth2Service) DeviceAccessToken(body DeviceAccessTokenRequestForm) err
 
 }
 
+// Oauth2DeviceAuthVerifyParams holds the query parameters for Oauth2Service.DeviceAuthVerify.
+type Oauth2DeviceAuthVerifyParams struct {
+	// UserCode is the `user_code` query parameter.
+	UserCode string
+	// AppName is the `app_name` query parameter.
+	AppName *string
+}
+
 // DeviceAuthVerify: Verify an OAuth 2.0 Device Authorization Grant.
+//
 // This endpoint should be accessed in a full user agent (e.g., a browser). If the user is not logged in, we redirect them to the login page and use the `callback_url` parameter to get them to the UI verification form upon logging in. If they are logged in, we redirect them to the UI verification form on the website.
 //
 // Parameters
 //
-//   - `userCode`
-//   - `appName`
-func (s *Oauth2Service) DeviceAuthVerify(userCode string, appName string) error {
+//   - `params`: The query parameters, see Oauth2DeviceAuthVerifyParams.
+func (s *Oauth2Service) DeviceAuthVerify(params Oauth2DeviceAuthVerifyParams) error {
 	// Create the url.
 	path := "/oauth2/device/verify"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2242,10 +2403,13 @@ func (s *Oauth2Service) DeviceAuthVerify(userCode string, appName string) error
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"user_code": userCode,
-		"app_name":  appName,
-	}); err != nil {
+	values := map[string]string{
+		"user_code": params.UserCode,
+	}
+	if params.AppName != nil {
+		values["app_name"] = *params.AppName
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2266,15 +2430,25 @@ func (s *Oauth2Service) DeviceAuthVerify(userCode string, appName string) error
 
 }
 
+// Oauth2ProviderCallbackParams holds the query parameters for Oauth2Service.ProviderCallback.
+type Oauth2ProviderCallbackParams struct {
+	// Code is the `code` query parameter.
+	Code *string
+	// State is the `state` query parameter.
+	State *string
+	// IdToken is the `id_token` query parameter.
+	IdToken *string
+	// User is the `user` query parameter.
+	User *string
+}
+
 // ProviderCallback: Listen for callbacks for the OAuth 2.0 provider.
+//
 // Parameters
 //
 //   - `provider`: An account provider.
-//   - `code`
-//   - `state`
-//   - `idToken`
-//   - `user`
-func (s *Oauth2Service) ProviderCallback(provider AccountProvider, code string, state string, idToken string, user string) error {
+//   - `params`: The query parameters, see Oauth2ProviderCallbackParams.
+func (s *Oauth2Service) ProviderCallback(provider AccountProvider, params Oauth2ProviderCallbackParams) error {
 	// Create the url.
 	path := "/oauth2/provider/{{.provider}}/callback"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2286,13 +2460,22 @@ func (s *Oauth2Service) ProviderCallback(provider AccountProvider, code string,
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
+	values := map[string]string{
 		"provider": string(provider),
-		"code":     code,
-		"state":    state,
-		"id_token": idToken,
-		"user":     user,
-	}); err != nil {
+	}
+	if params.Code != nil {
+		values["code"] = *params.Code
+	}
+	if params.State != nil {
+		values["state"] = *params.State
+	}
+	if params.IdToken != nil {
+		values["id_token"] = *params.IdToken
+	}
+	if params.User != nil {
+		values["user"] = *params.User
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2367,12 +2550,19 @@ func (s *Oauth2Service) ProviderCallbackCreate(provider AccountProvider, body Au
 
 }
 
+// Oauth2ProviderConsentParams holds the query parameters for Oauth2Service.ProviderConsent.
+type Oauth2ProviderConsentParams struct {
+	// CallbackUrl is the `callback_url` query parameter.
+	CallbackUrl *string
+}
+
 // ProviderConsent: Get the consent URL and other information for the OAuth 2.0 provider.
+//
 // Parameters
 //
 //   - `provider`: An account provider.
-//   - `callbackUrl`
-func (s *Oauth2Service) ProviderConsent(provider AccountProvider, callbackUrl string) (*Oauth2ClientInfo, error) {
+//   - `params`: The query parameters, see Oauth2ProviderConsentParams.
+func (s *Oauth2Service) ProviderConsent(provider AccountProvider, params Oauth2ProviderConsentParams) (*Oauth2ClientInfo, error) {
 	// Create the url.
 	path := "/oauth2/provider/{{.provider}}/consent"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2384,10 +2574,13 @@ func (s *Oauth2Service) ProviderConsent(provider AccountProvider, callbackUrl st
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"provider":     string(provider),
-		"callback_url": callbackUrl,
-	}); err != nil {
+	values := map[string]string{
+		"provider": string(provider),
+	}
+	if params.CallbackUrl != nil {
+		values["callback_url"] = *params.CallbackUrl
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2408,7 +2601,7 @@ func (s *Oauth2Service) ProviderConsent(provider AccountProvider, callbackUrl st
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2ClientInfo
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2507,14 +2700,22 @@ func (s *Oauth2Service) TokenRevoke(body TokenRevokeRequestForm) error {
 
 }
 
+// Oauth2VerifyOauthAccountLinkingParams holds the query parameters for Oauth2Service.VerifyOauthAccountLinking.
+type Oauth2VerifyOauthAccountLinkingParams struct {
+	// Token is the `token` query parameter.
+	Token string
+	// CallbackUrl is the `callback_url` query parameter.
+	CallbackUrl *string
+}
+
 // VerifyOauthAccountLinking: Verify OAuth account linking and complete the authentication.
+//
 // This endpoint is called when a user clicks the verification link sent to their email after attempting to log in with OAuth when an existing account with the same email was found. This endpoint validates the token, links the OAuth account to the user, and creates a session.
 //
 // Parameters
 //
-//   - `token`
-//   - `callbackUrl`
-func (s *Oauth2Service) VerifyOauthAccountLinking(token string, callbackUrl string) error {
+//   - `params`: The query parameters, see Oauth2VerifyOauthAccountLinkingParams.
+func (s *Oauth2Service) VerifyOauthAccountLinking(params Oauth2VerifyOauthAccountLinkingParams) error {
 	// Create the url.
 	path := "/oauth2/verify-account-linking"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2526,10 +2727,13 @@ func (s *Oauth2Service) VerifyOauthAccountLinking(token string, callbackUrl stri
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"token":        token,
-		"callback_url": callbackUrl,
-	}); err != nil {
+	values := map[string]string{
+		"token": params.Token,
+	}
+	if params.CallbackUrl != nil {
+		values["callback_url"] = *params.CallbackUrl
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2580,7 +2784,7 @@ func (s *OrgService) Get() (*Org, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Org
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2632,7 +2836,7 @@ func (s *OrgService) Create(body OrgDetails) (*Org, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Org
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2684,7 +2888,7 @@ func (s *OrgService) Update(body OrgDetails) (*Org, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Org
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2727,7 +2931,20 @@ func (s *OrgService) Delete() error {
 
 }
 
+// APICallOrgListParams holds the query parameters for APICallService.OrgList.
+type APICallOrgListParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // OrgList: List API calls for your org.
+//
 // This includes all API calls that were made by users in the org.
 //
 // This endpoint requires authentication by an org admin. It returns the API calls for the authenticated user's org.
@@ -2736,14 +2953,8 @@ func (s *OrgService) Delete() error {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *APICallService) OrgList(limit int, pageToken string, sortBy CreatedAtSortMode) (*APICallWithPriceResultsPage, error) {
+//   - `params`: The query parameters, see APICallOrgListParams.
+func (s *APICallService) OrgList(params APICallOrgListParams) (*APICallWithPriceResultsPage, error) {
 	// Create the url.
 	path := "/org/api-calls"
 	targetURL := resolveRelative(s.client.server, path)
@@ -2755,11 +2966,17 @@ func (s *APICallService) OrgList(limit int, pageToken string, sortBy CreatedAtSo
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -2780,7 +2997,7 @@ func (s *APICallService) OrgList(limit int, pageToken string, sortBy CreatedAtSo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPriceResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2830,7 +3047,7 @@ func (s *APICallService) GetForOrg(id UUID) (*APICallWithPrice, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPrice
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2868,7 +3085,7 @@ func (s *PaymentService) GetOrgUsageCollectionThreshold() (*AggregateUsageCollec
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2918,7 +3135,7 @@ func (s *PaymentService) SetOrgUsageCollectionThreshold(body AggregateUsageColle
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -2966,7 +3183,7 @@ func (s *PaymentService) ResetOrgUsageCollectionThreshold(expectedVersion int) (
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3016,7 +3233,7 @@ func (s *OrgService) DatasetS3Policies(uri string, roleArn string) (*DatasetS3Po
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded DatasetS3Policies
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3025,17 +3242,24 @@ func (s *OrgService) DatasetS3Policies(uri string, roleArn string) (*DatasetS3Po
 
 }
 
+// OrgListDatasetsParams holds the query parameters for OrgService.ListDatasets.
+type OrgListDatasetsParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListDatasets: List every dataset that belongs to the caller's organization.
-// Parameters
-//
-//   - `limit`
-//
-//   - `pageToken`
 //
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
+// Parameters
 //
-//     Currently, we only support scanning in ascending order.
-func (s *OrgService) ListDatasets(limit int, pageToken string, sortBy CreatedAtSortMode) (*OrgDatasetResultsPage, error) {
+//   - `params`: The query parameters, see OrgListDatasetsParams.
+func (s *OrgService) ListDatasets(params OrgListDatasetsParams) (*OrgDatasetResultsPage, error) {
 	// Create the url.
 	path := "/org/datasets"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3047,11 +3271,17 @@ func (s *OrgService) ListDatasets(limit int, pageToken string, sortBy CreatedAtS
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3072,7 +3302,7 @@ func (s *OrgService) ListDatasets(limit int, pageToken string, sortBy CreatedAtS
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDatasetResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3124,7 +3354,7 @@ func (s *OrgService) CreateDataset(body CreateOrgDataset) (*OrgDataset, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDataset
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3172,7 +3402,7 @@ func (s *OrgService) GetDataset(id UUID) (*OrgDataset, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDataset
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3232,7 +3462,7 @@ func (s *OrgService) UpdateDataset(id UUID, body UpdateOrgDataset) (*OrgDataset,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDataset
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3323,19 +3553,31 @@ func (s *OrgService) DownloadDatasetSuccessfulKclBulk(id UUID) error {
 
 }
 
+// OrgListDatasetConversionsParams holds the query parameters for OrgService.ListDatasetConversions.
+type OrgListDatasetConversionsParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported sort modes for org dataset conversions.
+	SortBy *ConversionSortMode
+	// Filter is the `filter` query parameter.
+	Filter *string
+	// Q is the `q` query parameter.
+	Q *string
+	// Phase is the `phase` query parameter.
+	Phase *string
+}
+
 // ListDatasetConversions: List the file conversions that have been processed for a given dataset owned by the caller's org.
+//
 // This endpoint returns lightweight conversion summaries only (including `phase`), and intentionally omits converted KCL output and snapshot image payloads for speed. Use the optional `filter` query parameter to filter results (example: `?filter=status:success`). Use `q` to search by conversion id or file path and `phase` to narrow the pipeline stage.
 //
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `limit`
-//   - `pageToken`
-//   - `sortBy`: Supported sort modes for org dataset conversions.
-//   - `filter`
-//   - `q`
-//   - `phase`
-func (s *OrgService) ListDatasetConversions(id UUID, limit int, pageToken string, sortBy ConversionSortMode, filter string, q string, phase string) (*OrgDatasetFileConversionSummaryResultsPage, error) {
+//   - `params`: The query parameters, see OrgListDatasetConversionsParams.
+func (s *OrgService) ListDatasetConversions(id UUID, params OrgListDatasetConversionsParams) (*OrgDatasetFileConversionSummaryResultsPage, error) {
 	// Create the url.
 	path := "/org/datasets/{{.id}}/conversions"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3347,15 +3589,28 @@ func (s *OrgService) ListDatasetConversions(id UUID, limit int, pageToken string
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":         id.String(),
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-		"filter":     filter,
-		"q":          q,
-		"phase":      phase,
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if params.Filter != nil {
+		values["filter"] = *params.Filter
+	}
+	if params.Q != nil {
+		values["q"] = *params.Q
+	}
+	if params.Phase != nil {
+		values["phase"] = *params.Phase
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3376,7 +3631,7 @@ func (s *OrgService) ListDatasetConversions(id UUID, limit int, pageToken string
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDatasetFileConversionSummaryResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3428,7 +3683,7 @@ func (s *OrgService) GetDatasetConversion(id UUID, conversionId UUID) (*OrgDatas
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDatasetFileConversionDetails
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3519,12 +3774,19 @@ func (s *OrgService) RetriggerDatasetConversion(id UUID, conversionId UUID) erro
 
 }
 
+// OrgRetriggerDatasetParams holds the query parameters for OrgService.RetriggerDataset.
+type OrgRetriggerDatasetParams struct {
+	// Statuses is the `statuses` query parameter.
+	Statuses *string
+}
+
 // RetriggerDataset: Request a retrigger of conversions for a dataset that belongs to the caller's org.
+//
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `statuses`
-func (s *OrgService) RetriggerDataset(id UUID, statuses string) error {
+//   - `params`: The query parameters, see OrgRetriggerDatasetParams.
+func (s *OrgService) RetriggerDataset(id UUID, params OrgRetriggerDatasetParams) error {
 	// Create the url.
 	path := "/org/datasets/{{.id}}/retrigger"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3536,10 +3798,13 @@ func (s *OrgService) RetriggerDataset(id UUID, statuses string) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":       id.String(),
-		"statuses": statuses,
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Statuses != nil {
+		values["statuses"] = *params.Statuses
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3560,19 +3825,31 @@ func (s *OrgService) RetriggerDataset(id UUID, statuses string) error {
 
 }
 
+// OrgSearchDatasetConversionsParams holds the query parameters for OrgService.SearchDatasetConversions.
+type OrgSearchDatasetConversionsParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// Q is the `q` query parameter.
+	Q *string
+	// SortBy: Supported sort modes for org dataset conversions.
+	SortBy *ConversionSortMode
+	// Filter is the `filter` query parameter.
+	Filter *string
+	// Phase is the `phase` query parameter.
+	Phase *string
+}
+
 // SearchDatasetConversions: Search dataset conversions by conversion ID or file path.
+//
 // Supports exact conversion-ID matching and fuzzy file-path matching.
 //
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `limit`
-//   - `pageToken`
-//   - `q`
-//   - `sortBy`: Supported sort modes for org dataset conversions.
-//   - `filter`
-//   - `phase`
-func (s *OrgService) SearchDatasetConversions(id UUID, limit int, pageToken string, q string, sortBy ConversionSortMode, filter string, phase string) (*OrgDatasetFileConversionSummaryResultsPage, error) {
+//   - `params`: The query parameters, see OrgSearchDatasetConversionsParams.
+func (s *OrgService) SearchDatasetConversions(id UUID, params OrgSearchDatasetConversionsParams) (*OrgDatasetFileConversionSummaryResultsPage, error) {
 	// Create the url.
 	path := "/org/datasets/{{.id}}/search/conversions"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3584,15 +3861,28 @@ func (s *OrgService) SearchDatasetConversions(id UUID, limit int, pageToken stri
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":         id.String(),
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"q":          q,
-		"sort_by":    string(sortBy),
-		"filter":     filter,
-		"phase":      phase,
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.Q != nil {
+		values["q"] = *params.Q
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if params.Filter != nil {
+		values["filter"] = *params.Filter
+	}
+	if params.Phase != nil {
+		values["phase"] = *params.Phase
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3613,7 +3903,7 @@ func (s *OrgService) SearchDatasetConversions(id UUID, limit int, pageToken stri
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDatasetFileConversionSummaryResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3622,15 +3912,23 @@ func (s *OrgService) SearchDatasetConversions(id UUID, limit int, pageToken stri
 
 }
 
+// OrgSearchDatasetSemanticParams holds the query parameters for OrgService.SearchDatasetSemantic.
+type OrgSearchDatasetSemanticParams struct {
+	// Q is the `q` query parameter.
+	Q string
+	// Limit is the `limit` query parameter.
+	Limit *int
+}
+
 // SearchDatasetSemantic: Run semantic search across chunked conversion outputs for a dataset.
+//
 // This embeds the query text with the org-dataset embedding model and returns top chunk matches ranked by cosine similarity.
 //
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `q`
-//   - `limit`
-func (s *OrgService) SearchDatasetSemantic(id UUID, q string, limit int) (*[]OrgDatasetSemanticSearchMatch, error) {
+//   - `params`: The query parameters, see OrgSearchDatasetSemanticParams.
+func (s *OrgService) SearchDatasetSemantic(id UUID, params OrgSearchDatasetSemanticParams) (*[]OrgDatasetSemanticSearchMatch, error) {
 	// Create the url.
 	path := "/org/datasets/{{.id}}/search/semantic"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3642,11 +3940,14 @@ func (s *OrgService) SearchDatasetSemantic(id UUID, q string, limit int) (*[]Org
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":    id.String(),
-		"q":     q,
-		"limit": strconv.Itoa(limit),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+		"q":  params.Q,
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3667,7 +3968,7 @@ func (s *OrgService) SearchDatasetSemantic(id UUID, q string, limit int) (*[]Org
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []OrgDatasetSemanticSearchMatch
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3715,7 +4016,7 @@ func (s *OrgService) GetDatasetConversionStats(id UUID) (*OrgDatasetConversionSt
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgDatasetConversionStatsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3777,7 +4078,7 @@ func (s *OrgService) UploadDatasetFiles(id UUID, body *MultipartForm) (*UploadOr
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UploadOrgDatasetFilesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3786,21 +4087,28 @@ func (s *OrgService) UploadDatasetFiles(id UUID, body *MultipartForm) (*UploadOr
 
 }
 
+// OrgListMembersParams holds the query parameters for OrgService.ListMembers.
+type OrgListMembersParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+	// Role: The roles for users in an organization.
+	Role *UserOrgRole
+}
+
 // ListMembers: List members of your org.
+//
 // This endpoint requires authentication by an org admin. It lists the members of the authenticated user's org.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-//
-//   - `role`: The roles for users in an organization.
-func (s *OrgService) ListMembers(limit int, pageToken string, sortBy CreatedAtSortMode, role UserOrgRole) (*OrgMemberResultsPage, error) {
+//   - `params`: The query parameters, see OrgListMembersParams.
+func (s *OrgService) ListMembers(params OrgListMembersParams) (*OrgMemberResultsPage, error) {
 	// Create the url.
 	path := "/org/members"
 	targetURL := resolveRelative(s.client.server, path)
@@ -3812,12 +4120,20 @@ func (s *OrgService) ListMembers(limit int, pageToken string, sortBy CreatedAtSo
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-		"role":       string(role),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if params.Role != nil {
+		values["role"] = string(*params.Role)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -3838,7 +4154,7 @@ func (s *OrgService) ListMembers(limit int, pageToken string, sortBy CreatedAtSo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgMemberResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3898,7 +4214,7 @@ func (s *OrgService) CreateMember(body AddOrgMember) (*OrgMember, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgMember
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -3948,7 +4264,7 @@ func (s *OrgService) GetMember(userId UUID) (*OrgMember, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgMember
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4008,7 +4324,7 @@ func (s *OrgService) UpdateMember(userId UUID, body UpdateMemberToOrgBody) (*Org
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded OrgMember
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4058,19 +4374,26 @@ func (s *OrgService) DeleteMember(userId UUID) error {
 
 }
 
+// Oauth2ListOrgAppsParams holds the query parameters for Oauth2Service.ListOrgApps.
+type Oauth2ListOrgAppsParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListOrgApps: List org OAuth apps.
+//
 // This endpoint requires authentication by an org member. It lists the organization's active public OAuth apps.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *Oauth2Service) ListOrgApps(limit int, pageToken string, sortBy CreatedAtSortMode) (*Oauth2AppResponseResultsPage, error) {
+//   - `params`: The query parameters, see Oauth2ListOrgAppsParams.
+func (s *Oauth2Service) ListOrgApps(params Oauth2ListOrgAppsParams) (*Oauth2AppResponseResultsPage, error) {
 	// Create the url.
 	path := "/org/oauth2/apps"
 	targetURL := resolveRelative(s.client.server, path)
@@ -4082,11 +4405,17 @@ func (s *Oauth2Service) ListOrgApps(limit int, pageToken string, sortBy CreatedA
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -4107,7 +4436,7 @@ func (s *Oauth2Service) ListOrgApps(limit int, pageToken string, sortBy CreatedA
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponseResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4159,7 +4488,7 @@ func (s *Oauth2Service) CreateOrgApp(body CreateOauth2AppRequest) (*Oauth2AppRes
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4209,7 +4538,7 @@ func (s *Oauth2Service) GetOrgApp(clientId UUID) (*Oauth2AppResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4269,7 +4598,7 @@ func (s *Oauth2Service) UpdateOrgApp(clientId UUID, body UpdateOauth2AppRequest)
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4351,7 +4680,7 @@ func (s *PaymentService) GetInformationForOrg() (*Customer, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4405,7 +4734,7 @@ func (s *PaymentService) CreateInformationForOrg(body BillingInfo) (*Customer, e
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4459,7 +4788,7 @@ func (s *PaymentService) UpdateInformationForOrg(body BillingInfo) (*Customer, e
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4500,13 +4829,20 @@ func (s *PaymentService) DeleteInformationForOrg() error {
 
 }
 
+// PaymentGetBalanceForOrgParams holds the query parameters for PaymentService.GetBalanceForOrg.
+type PaymentGetBalanceForOrgParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // GetBalanceForOrg: Get balance for your org.
+//
 // This endpoint requires authentication by any member of an org. It gets the balance information for the authenticated user's org.
 //
 // Parameters
 //
-//   - `includeTotalDue`
-func (s *PaymentService) GetBalanceForOrg(includeTotalDue bool) (*CustomerBalance, error) {
+//   - `params`: The query parameters, see PaymentGetBalanceForOrgParams.
+func (s *PaymentService) GetBalanceForOrg(params PaymentGetBalanceForOrgParams) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/org/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -4518,9 +4854,11 @@ func (s *PaymentService) GetBalanceForOrg(includeTotalDue bool) (*CustomerBalanc
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-	}); err != nil {
+	values := map[string]string{}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -4541,7 +4879,7 @@ func (s *PaymentService) GetBalanceForOrg(includeTotalDue bool) (*CustomerBalanc
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4580,7 +4918,7 @@ func (s *PaymentService) CreateIntentForOrg() (*PaymentIntent, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PaymentIntent
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4589,14 +4927,22 @@ func (s *PaymentService) CreateIntentForOrg() (*PaymentIntent, error) {
 
 }
 
+// PaymentListInvoicesForOrgParams holds the query parameters for PaymentService.ListInvoicesForOrg.
+type PaymentListInvoicesForOrgParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+}
+
 // ListInvoicesForOrg: List invoices for your org.
+//
 // This endpoint requires authentication by an org admin. It lists invoices for the authenticated user's org.
 //
 // Parameters
 //
-//   - `limit`
-//   - `pageToken`
-func (s *PaymentService) ListInvoicesForOrg(limit int, pageToken string) (*InvoiceResultsPage, error) {
+//   - `params`: The query parameters, see PaymentListInvoicesForOrgParams.
+func (s *PaymentService) ListInvoicesForOrg(params PaymentListInvoicesForOrgParams) (*InvoiceResultsPage, error) {
 	// Create the url.
 	path := "/org/payment/invoices"
 	targetURL := resolveRelative(s.client.server, path)
@@ -4608,10 +4954,14 @@ func (s *PaymentService) ListInvoicesForOrg(limit int, pageToken string) (*Invoi
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -4632,7 +4982,7 @@ func (s *PaymentService) ListInvoicesForOrg(limit int, pageToken string) (*Invoi
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded InvoiceResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4641,13 +4991,20 @@ func (s *PaymentService) ListInvoicesForOrg(limit int, pageToken string) (*Invoi
 
 }
 
+// PaymentRedirectMethodPortalLinkForOrgParams holds the query parameters for PaymentService.RedirectMethodPortalLinkForOrg.
+type PaymentRedirectMethodPortalLinkForOrgParams struct {
+	// ReturnUrl is the `return_url` query parameter.
+	ReturnUrl *URL
+}
+
 // RedirectMethodPortalLinkForOrg: Redirect to a fresh Stripe-hosted payment-method update link for your org.
+//
 // If the request is not authenticated, this redirects to website login with a callback back to this endpoint. If authenticated as an org admin, it creates a fresh hosted Stripe portal session and redirects the browser to it.
 //
 // Parameters
 //
-//   - `returnUrl`
-func (s *PaymentService) RedirectMethodPortalLinkForOrg(returnUrl URL) error {
+//   - `params`: The query parameters, see PaymentRedirectMethodPortalLinkForOrgParams.
+func (s *PaymentService) RedirectMethodPortalLinkForOrg(params PaymentRedirectMethodPortalLinkForOrgParams) error {
 	// Create the url.
 	path := "/org/payment/method-portal-link"
 	targetURL := resolveRelative(s.client.server, path)
@@ -4659,9 +5016,11 @@ func (s *PaymentService) RedirectMethodPortalLinkForOrg(returnUrl URL) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"return_url": returnUrl.String(),
-	}); err != nil {
+	values := map[string]string{}
+	if params.ReturnUrl != nil {
+		values["return_url"] = params.ReturnUrl.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -4712,7 +5071,7 @@ func (s *PaymentService) ListMethodsForOrg() (*[]PaymentMethod, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []PaymentMethod
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4792,7 +5151,7 @@ func (s *PaymentService) GetOrgSubscription() (*ZooProductSubscriptions, error)
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4844,7 +5203,7 @@ func (s *PaymentService) CreateOrgSubscription(body ZooProductSubscriptionsOrgRe
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4896,7 +5255,7 @@ func (s *PaymentService) UpdateOrgSubscription(body ZooProductSubscriptionsOrgRe
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -4965,7 +5324,7 @@ func (s *OrgService) GetPrivacySettings() (*PrivacySettings, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PrivacySettings
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5017,7 +5376,7 @@ func (s *OrgService) UpdatePrivacySettings(body PrivacySettings) (*PrivacySettin
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PrivacySettings
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5056,7 +5415,7 @@ func (s *OrgService) GetSamlIdp() (*SamlIdentityProvider, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded SamlIdentityProvider
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5108,7 +5467,7 @@ func (s *OrgService) CreateSamlIdp(body SamlIdentityProviderCreate) (*SamlIdenti
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded SamlIdentityProvider
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5160,7 +5519,7 @@ func (s *OrgService) UpdateSamlIdp(body SamlIdentityProviderCreate) (*SamlIdenti
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded SamlIdentityProvider
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5199,21 +5558,28 @@ func (s *OrgService) DeleteSamlIdp() error {
 
 }
 
+// ServiceAccountListForOrgParams holds the query parameters for ServiceAccountService.ListForOrg.
+type ServiceAccountListForOrgParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListForOrg: List service accounts for your org.
+//
 // This endpoint requires authentication by an org member. It returns the service accounts for the organization.
 //
 // The service accounts are returned in order of creation, with the most recently created service accounts first.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *ServiceAccountService) ListForOrg(limit int, pageToken string, sortBy CreatedAtSortMode) (*ServiceAccountResultsPage, error) {
+//   - `params`: The query parameters, see ServiceAccountListForOrgParams.
+func (s *ServiceAccountService) ListForOrg(params ServiceAccountListForOrgParams) (*ServiceAccountResultsPage, error) {
 	// Create the url.
 	path := "/org/service-accounts"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5225,11 +5591,17 @@ func (s *ServiceAccountService) ListForOrg(limit int, pageToken string, sortBy C
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5250,7 +5622,7 @@ func (s *ServiceAccountService) ListForOrg(limit int, pageToken string, sortBy C
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ServiceAccountResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5259,13 +5631,20 @@ func (s *ServiceAccountService) ListForOrg(limit int, pageToken string, sortBy C
 
 }
 
+// ServiceAccountCreateForOrgParams holds the query parameters for ServiceAccountService.CreateForOrg.
+type ServiceAccountCreateForOrgParams struct {
+	// Label is the `label` query parameter.
+	Label *string
+}
+
 // CreateForOrg: Create a new service account for your org.
+//
 // This endpoint requires authentication by an org member. It creates a new service account for the organization.
 //
 // Parameters
 //
-//   - `label`
-func (s *ServiceAccountService) CreateForOrg(label string) (*ServiceAccount, error) {
+//   - `params`: The query parameters, see ServiceAccountCreateForOrgParams.
+func (s *ServiceAccountService) CreateForOrg(params ServiceAccountCreateForOrgParams) (*ServiceAccount, error) {
 	// Create the url.
 	path := "/org/service-accounts"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5277,9 +5656,11 @@ func (s *ServiceAccountService) CreateForOrg(label string) (*ServiceAccount, err
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"label": label,
-	}); err != nil {
+	values := map[string]string{}
+	if params.Label != nil {
+		values["label"] = *params.Label
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5300,7 +5681,7 @@ func (s *ServiceAccountService) CreateForOrg(label string) (*ServiceAccount, err
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ServiceAccount
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5350,7 +5731,7 @@ func (s *ServiceAccountService) GetForOrg(token string) (*ServiceAccount, error)
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ServiceAccount
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5404,19 +5785,26 @@ func (s *ServiceAccountService) DeleteForOrg(token string) error {
 
 }
 
+// OrgGetShortlinksParams holds the query parameters for OrgService.GetShortlinks.
+type OrgGetShortlinksParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // GetShortlinks: Get the shortlinks for an org.
+//
 // This endpoint requires authentication by an org admin. It gets the shortlinks for the authenticated user's org.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *OrgService) GetShortlinks(limit int, pageToken string, sortBy CreatedAtSortMode) (*ShortlinkResultsPage, error) {
+//   - `params`: The query parameters, see OrgGetShortlinksParams.
+func (s *OrgService) GetShortlinks(params OrgGetShortlinksParams) (*ShortlinkResultsPage, error) {
 	// Create the url.
 	path := "/org/shortlinks"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5426,13 +5814,19 @@ func (s *OrgService) GetShortlinks(limit int, pageToken string, sortBy CreatedAt
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %v", err)
 	}
-
-	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+
+	// Add the parameters to the url.
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5453,7 +5847,7 @@ func (s *OrgService) GetShortlinks(limit int, pageToken string, sortBy CreatedAt
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ShortlinkResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5491,7 +5885,7 @@ func (s *OrgService) ListSkills() (*[]OrgSkillResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []OrgSkillResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5541,7 +5935,7 @@ func (s *OrgService) GetBillingContractForAny(id UUID) (*BillingContractView, er
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded BillingContractView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5601,7 +5995,7 @@ func (s *OrgService) UpsertBillingContractForAny(id UUID, body BillingContractUp
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded BillingContractView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5610,21 +6004,27 @@ func (s *OrgService) UpsertBillingContractForAny(id UUID, body BillingContractUp
 
 }
 
+// Oauth2ListAppsForAnyOrgParams holds the query parameters for Oauth2Service.ListAppsForAnyOrg.
+type Oauth2ListAppsForAnyOrgParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListAppsForAnyOrg: List OAuth 2.0 apps owned by an organization.
+//
 // This endpoint requires Zoo admin authentication. It returns the target organization's active OAuth apps for admin dashboard inspection.
 //
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *Oauth2Service) ListAppsForAnyOrg(id UUID, limit int, pageToken string, sortBy CreatedAtSortMode) (*Oauth2AppResponseResultsPage, error) {
+//   - `params`: The query parameters, see Oauth2ListAppsForAnyOrgParams.
+func (s *Oauth2Service) ListAppsForAnyOrg(id UUID, params Oauth2ListAppsForAnyOrgParams) (*Oauth2AppResponseResultsPage, error) {
 	// Create the url.
 	path := "/orgs/{{.id}}/oauth2/apps"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5636,12 +6036,19 @@ func (s *Oauth2Service) ListAppsForAnyOrg(id UUID, limit int, pageToken string,
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":         id.String(),
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5662,7 +6069,7 @@ func (s *Oauth2Service) ListAppsForAnyOrg(id UUID, limit int, pageToken string,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponseResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5671,14 +6078,21 @@ func (s *Oauth2Service) ListAppsForAnyOrg(id UUID, limit int, pageToken string,
 
 }
 
+// PaymentGetBalanceForAnyOrgParams holds the query parameters for PaymentService.GetBalanceForAnyOrg.
+type PaymentGetBalanceForAnyOrgParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // GetBalanceForAnyOrg: Get balance for an org.
+//
 // This endpoint requires authentication by a Zoo employee. It gets the balance information for the specified org.
 //
 // Parameters
 //
-//   - `includeTotalDue`
 //   - `id`: A UUID usually v4 or v7
-func (s *PaymentService) GetBalanceForAnyOrg(includeTotalDue bool, id UUID) (*CustomerBalance, error) {
+//   - `params`: The query parameters, see PaymentGetBalanceForAnyOrgParams.
+func (s *PaymentService) GetBalanceForAnyOrg(id UUID, params PaymentGetBalanceForAnyOrgParams) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/orgs/{{.id}}/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5690,10 +6104,13 @@ func (s *PaymentService) GetBalanceForAnyOrg(includeTotalDue bool, id UUID) (*Cu
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-		"id":                id.String(),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5714,7 +6131,7 @@ func (s *PaymentService) GetBalanceForAnyOrg(includeTotalDue bool, id UUID) (*Cu
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5723,15 +6140,22 @@ func (s *PaymentService) GetBalanceForAnyOrg(includeTotalDue bool, id UUID) (*Cu
 
 }
 
+// PaymentUpdateBalanceForAnyOrgParams holds the query parameters for PaymentService.UpdateBalanceForAnyOrg.
+type PaymentUpdateBalanceForAnyOrgParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // UpdateBalanceForAnyOrg: Update balance for an org.
+//
 // This endpoint requires authentication by a Zoo employee. It updates the balance information for the specified org.
 //
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentUpdateBalanceForAnyOrgParams.
 //   - `body`: Payload for updating a user's balance.
-func (s *PaymentService) UpdateBalanceForAnyOrg(id UUID, includeTotalDue bool, body UpdatePaymentBalance) (*CustomerBalance, error) {
+func (s *PaymentService) UpdateBalanceForAnyOrg(id UUID, params PaymentUpdateBalanceForAnyOrgParams, body UpdatePaymentBalance) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/orgs/{{.id}}/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -5752,10 +6176,13 @@ func (s *PaymentService) UpdateBalanceForAnyOrg(id UUID, includeTotalDue bool, b
 	req.Header.Add("Content-Type", "application/json")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":                id.String(),
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -5776,7 +6203,7 @@ func (s *PaymentService) UpdateBalanceForAnyOrg(id UUID, includeTotalDue bool, b
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5836,7 +6263,7 @@ func (s *PaymentService) UpdateOrgSubscriptionForAnyOrg(id UUID, body ZooProduct
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5874,7 +6301,7 @@ func (s *MetaService) Ping() (*Pong, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Pong
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5913,7 +6340,7 @@ func (s *MetaService) GetPricingSubscriptions() (*map[string][]ZooProductSubscri
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded map[string][]ZooProductSubscription
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5951,7 +6378,7 @@ func (s *ProjectService) ListCategories() (*[]ProjectCategoryResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []ProjectCategoryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -5989,7 +6416,7 @@ func (s *ProjectService) ListPublic() (*[]PublicProjectResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []PublicProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6037,7 +6464,7 @@ func (s *ProjectService) GetPublic(id UUID) (*PublicProjectResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PublicProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6046,12 +6473,19 @@ func (s *ProjectService) GetPublic(id UUID) (*PublicProjectResponse, error) {
 
 }
 
+// ProjectDownloadPublicParams holds the query parameters for ProjectService.DownloadPublic.
+type ProjectDownloadPublicParams struct {
+	// Format: Archive formats supported by project download endpoints.
+	Format *ProjectArchiveFormat
+}
+
 // DownloadPublic: Download a published public project as a tar archive.
+//
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `format`: Archive formats supported by project download endpoints.
-func (s *ProjectService) DownloadPublic(id UUID, format ProjectArchiveFormat) error {
+//   - `params`: The query parameters, see ProjectDownloadPublicParams.
+func (s *ProjectService) DownloadPublic(id UUID, params ProjectDownloadPublicParams) error {
 	// Create the url.
 	path := "/projects/public/{{.id}}/download"
 	targetURL := resolveRelative(s.client.server, path)
@@ -6063,10 +6497,13 @@ func (s *ProjectService) DownloadPublic(id UUID, format ProjectArchiveFormat) er
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":     id.String(),
-		"format": string(format),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Format != nil {
+		values["format"] = string(*params.Format)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -6165,7 +6602,7 @@ func (s *ProjectService) CreatePublicVote(id UUID) (*PublicProjectVoteResponse,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PublicProjectVoteResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6213,7 +6650,7 @@ func (s *ProjectService) DeletePublicVote(id UUID) (*PublicProjectVoteResponse,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PublicProjectVoteResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6222,12 +6659,19 @@ func (s *ProjectService) DeletePublicVote(id UUID) (*PublicProjectVoteResponse,
 
 }
 
+// HiddenDownloadSharedProjectParams holds the query parameters for HiddenService.DownloadSharedProject.
+type HiddenDownloadSharedProjectParams struct {
+	// Format: Archive formats supported by project download endpoints.
+	Format *ProjectArchiveFormat
+}
+
 // DownloadSharedProject: Download a project using a share link.
+//
 // Parameters
 //
 //   - `key`
-//   - `format`: Archive formats supported by project download endpoints.
-func (s *HiddenService) DownloadSharedProject(key string, format ProjectArchiveFormat) error {
+//   - `params`: The query parameters, see HiddenDownloadSharedProjectParams.
+func (s *HiddenService) DownloadSharedProject(key string, params HiddenDownloadSharedProjectParams) error {
 	// Create the url.
 	path := "/projects/shared/{{.key}}/download"
 	targetURL := resolveRelative(s.client.server, path)
@@ -6239,10 +6683,13 @@ func (s *HiddenService) DownloadSharedProject(key string, format ProjectArchiveF
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"key":    key,
-		"format": string(format),
-	}); err != nil {
+	values := map[string]string{
+		"key": key,
+	}
+	if params.Format != nil {
+		values["format"] = string(*params.Format)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -6306,7 +6753,7 @@ func (s *StoreService) CreateCoupon(body StoreCouponParams) (*DiscountCode, erro
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded DiscountCode
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6366,7 +6813,7 @@ func (s *PaymentService) UpsertSubscriptionPlanPrice(slug string, body PriceUpse
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded SubscriptionPlanPriceRecord
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6420,7 +6867,7 @@ func (s *UnitService) GetAngleConversion(inputUnit UnitAngle, outputUnit UnitAng
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitAngleConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6474,7 +6921,7 @@ func (s *UnitService) GetAreaConversion(inputUnit UnitArea, outputUnit UnitArea,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitAreaConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6528,7 +6975,7 @@ func (s *UnitService) GetCurrentConversion(inputUnit UnitCurrent, outputUnit Uni
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitCurrentConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6582,7 +7029,7 @@ func (s *UnitService) GetEnergyConversion(inputUnit UnitEnergy, outputUnit UnitE
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitEnergyConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6636,7 +7083,7 @@ func (s *UnitService) GetForceConversion(inputUnit UnitForce, outputUnit UnitFor
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitForceConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6690,7 +7137,7 @@ func (s *UnitService) GetFrequencyConversion(inputUnit UnitFrequency, outputUnit
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitFrequencyConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6744,7 +7191,7 @@ func (s *UnitService) GetLengthConversion(inputUnit UnitLength, outputUnit UnitL
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitLengthConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6798,7 +7245,7 @@ func (s *UnitService) GetMassConversion(inputUnit UnitMas, outputUnit UnitMas, v
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitMassConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6852,7 +7299,7 @@ func (s *UnitService) GetPowerConversion(inputUnit UnitPower, outputUnit UnitPow
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitPowerConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6906,7 +7353,7 @@ func (s *UnitService) GetPressureConversion(inputUnit UnitPressure, outputUnit U
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitPressureConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -6960,7 +7407,7 @@ func (s *UnitService) GetTemperatureConversion(inputUnit UnitTemperature, output
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitTemperatureConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7014,7 +7461,7 @@ func (s *UnitService) GetTorqueConversion(inputUnit UnitTorque, outputUnit UnitT
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitTorqueConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7068,7 +7515,7 @@ func (s *UnitService) GetVolumeConversion(inputUnit UnitVolume, outputUnit UnitV
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UnitVolumeConversion
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7109,7 +7556,7 @@ func (s *UserService) GetSelf() (*UserResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7161,7 +7608,7 @@ func (s *UserService) UpdateSelf(body UpdateUser) (*UserResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7202,21 +7649,28 @@ func (s *UserService) DeleteSelf() error {
 
 }
 
+// APICallUserListParams holds the query parameters for APICallService.UserList.
+type APICallUserListParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // UserList: List API calls for your user.
+//
 // This endpoint requires authentication by any Zoo user. It returns the API calls for the authenticated user.
 //
 // The API calls are returned in order of creation, with the most recently created API calls first.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *APICallService) UserList(limit int, pageToken string, sortBy CreatedAtSortMode) (*APICallWithPriceResultsPage, error) {
+//   - `params`: The query parameters, see APICallUserListParams.
+func (s *APICallService) UserList(params APICallUserListParams) (*APICallWithPriceResultsPage, error) {
 	// Create the url.
 	path := "/user/api-calls"
 	targetURL := resolveRelative(s.client.server, path)
@@ -7228,11 +7682,17 @@ func (s *APICallService) UserList(limit int, pageToken string, sortBy CreatedAtS
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -7253,7 +7713,7 @@ func (s *APICallService) UserList(limit int, pageToken string, sortBy CreatedAtS
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPriceResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7303,7 +7763,7 @@ func (s *APICallService) GetForUser(id UUID) (*APICallWithPrice, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPrice
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7312,21 +7772,28 @@ func (s *APICallService) GetForUser(id UUID) (*APICallWithPrice, error) {
 
 }
 
+// APITokenListForUserParams holds the query parameters for APITokenService.ListForUser.
+type APITokenListForUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListForUser: List API tokens for your user.
+//
 // This endpoint requires authentication by any Zoo user. It returns the API tokens for the authenticated user.
 //
 // The API tokens are returned in order of creation, with the most recently created API tokens first.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *APITokenService) ListForUser(limit int, pageToken string, sortBy CreatedAtSortMode) (*APITokenResultsPage, error) {
+//   - `params`: The query parameters, see APITokenListForUserParams.
+func (s *APITokenService) ListForUser(params APITokenListForUserParams) (*APITokenResultsPage, error) {
 	// Create the url.
 	path := "/user/api-tokens"
 	targetURL := resolveRelative(s.client.server, path)
@@ -7338,11 +7805,17 @@ func (s *APITokenService) ListForUser(limit int, pageToken string, sortBy Create
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -7363,7 +7836,7 @@ func (s *APITokenService) ListForUser(limit int, pageToken string, sortBy Create
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APITokenResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7372,13 +7845,20 @@ func (s *APITokenService) ListForUser(limit int, pageToken string, sortBy Create
 
 }
 
+// APITokenCreateForUserParams holds the query parameters for APITokenService.CreateForUser.
+type APITokenCreateForUserParams struct {
+	// Label is the `label` query parameter.
+	Label *string
+}
+
 // CreateForUser: Create a new API token for your user.
+//
 // This endpoint requires authentication by any Zoo user. It creates a new API token for the authenticated user.
 //
 // Parameters
 //
-//   - `label`
-func (s *APITokenService) CreateForUser(label string) (*APITokenWithFullToken, error) {
+//   - `params`: The query parameters, see APITokenCreateForUserParams.
+func (s *APITokenService) CreateForUser(params APITokenCreateForUserParams) (*APITokenWithFullToken, error) {
 	// Create the url.
 	path := "/user/api-tokens"
 	targetURL := resolveRelative(s.client.server, path)
@@ -7390,9 +7870,11 @@ func (s *APITokenService) CreateForUser(label string) (*APITokenWithFullToken, e
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"label": label,
-	}); err != nil {
+	values := map[string]string{}
+	if params.Label != nil {
+		values["label"] = *params.Label
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -7413,7 +7895,7 @@ func (s *APITokenService) CreateForUser(label string) (*APITokenWithFullToken, e
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APITokenWithFullToken
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7463,7 +7945,7 @@ func (s *APITokenService) GetForUser(token string) (*APIToken, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APIToken
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7547,7 +8029,7 @@ func (s *PaymentService) GetUserUsageCollectionThreshold() (*AggregateUsageColle
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7597,7 +8079,7 @@ func (s *PaymentService) SetUserUsageCollectionThreshold(body AggregateUsageColl
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7645,7 +8127,7 @@ func (s *PaymentService) ResetUserUsageCollectionThreshold(expectedVersion int)
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded AggregateUsageCollectionThresholdView
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7683,7 +8165,7 @@ func (s *UserService) GetCadInfoForm() (*WebsiteCadUserInfoForm, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded WebsiteCadUserInfoForm
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7735,7 +8217,7 @@ func (s *UserService) ReportClientError(body ClientErrorReport) (*ClientErrorRep
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ClientErrorReportAccepted
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7773,7 +8255,7 @@ func (s *UserService) EmailMarketingConsentList() (*EmailMarketingConsentState,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded EmailMarketingConsentState
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7901,7 +8383,7 @@ func (s *UserService) GetSelfExtended() (*ExtendedUser, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ExtendedUser
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -7940,7 +8422,7 @@ func (s *FactoryService) GetUserFinishes() (*[]FactoryCustomerCatalogOption, err
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []FactoryCustomerCatalogOption
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8004,7 +8486,7 @@ func (s *FactoryService) CreateUserJob(body *MultipartForm) (*FactoryJobResponse
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded FactoryJobResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8043,7 +8525,7 @@ func (s *FactoryService) GetUserMaterials() (*[]FactoryCustomerCatalogOption, er
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []FactoryCustomerCatalogOption
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8082,7 +8564,7 @@ func (s *UserService) FeaturesList() (*UserFeatureList, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserFeatureList
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8091,19 +8573,26 @@ func (s *UserService) FeaturesList() (*UserFeatureList, error) {
 
 }
 
+// Oauth2ListUserAppsParams holds the query parameters for Oauth2Service.ListUserApps.
+type Oauth2ListUserAppsParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListUserApps: List personal OAuth apps.
+//
 // This endpoint requires authentication by any Zoo user. It lists the authenticated user's active public OAuth apps.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *Oauth2Service) ListUserApps(limit int, pageToken string, sortBy CreatedAtSortMode) (*Oauth2AppResponseResultsPage, error) {
+//   - `params`: The query parameters, see Oauth2ListUserAppsParams.
+func (s *Oauth2Service) ListUserApps(params Oauth2ListUserAppsParams) (*Oauth2AppResponseResultsPage, error) {
 	// Create the url.
 	path := "/user/oauth2/apps"
 	targetURL := resolveRelative(s.client.server, path)
@@ -8115,11 +8604,17 @@ func (s *Oauth2Service) ListUserApps(limit int, pageToken string, sortBy Created
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -8140,7 +8635,7 @@ func (s *Oauth2Service) ListUserApps(limit int, pageToken string, sortBy Created
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponseResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8192,7 +8687,7 @@ func (s *Oauth2Service) CreateUserApp(body CreateOauth2AppRequest) (*Oauth2AppRe
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8242,7 +8737,7 @@ func (s *Oauth2Service) GetUserApp(clientId UUID) (*Oauth2AppResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8302,7 +8797,7 @@ func (s *Oauth2Service) UpdateUserApp(clientId UUID, body UpdateOauth2AppRequest
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8384,7 +8879,7 @@ func (s *UserService) GetOauth2ProvidersFor() (*[]AccountProvider, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []AccountProvider
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8425,7 +8920,7 @@ func (s *OrgService) GetUser() (*UserOrgInfo, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserOrgInfo
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8466,7 +8961,7 @@ func (s *PaymentService) GetInformationForUser() (*Customer, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8520,7 +9015,7 @@ func (s *PaymentService) CreateInformationForUser(body BillingInfo) (*Customer,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8574,7 +9069,7 @@ func (s *PaymentService) UpdateInformationForUser(body BillingInfo) (*Customer,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Customer
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8615,13 +9110,20 @@ func (s *PaymentService) DeleteInformationForUser() error {
 
 }
 
+// PaymentGetBalanceForUserParams holds the query parameters for PaymentService.GetBalanceForUser.
+type PaymentGetBalanceForUserParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // GetBalanceForUser: Get balance for your user.
+//
 // This endpoint requires authentication by any Zoo user. It gets the balance information for the authenticated user.
 //
 // Parameters
 //
-//   - `includeTotalDue`
-func (s *PaymentService) GetBalanceForUser(includeTotalDue bool) (*CustomerBalance, error) {
+//   - `params`: The query parameters, see PaymentGetBalanceForUserParams.
+func (s *PaymentService) GetBalanceForUser(params PaymentGetBalanceForUserParams) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/user/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -8633,9 +9135,11 @@ func (s *PaymentService) GetBalanceForUser(includeTotalDue bool) (*CustomerBalan
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-	}); err != nil {
+	values := map[string]string{}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -8656,7 +9160,7 @@ func (s *PaymentService) GetBalanceForUser(includeTotalDue bool) (*CustomerBalan
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8695,7 +9199,7 @@ func (s *PaymentService) CreateIntentForUser() (*PaymentIntent, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PaymentIntent
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8704,14 +9208,22 @@ func (s *PaymentService) CreateIntentForUser() (*PaymentIntent, error) {
 
 }
 
+// PaymentListInvoicesForUserParams holds the query parameters for PaymentService.ListInvoicesForUser.
+type PaymentListInvoicesForUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+}
+
 // ListInvoicesForUser: List invoices for your user.
+//
 // This endpoint requires authentication by any Zoo user. It lists invoices for the authenticated user.
 //
 // Parameters
 //
-//   - `limit`
-//   - `pageToken`
-func (s *PaymentService) ListInvoicesForUser(limit int, pageToken string) (*InvoiceResultsPage, error) {
+//   - `params`: The query parameters, see PaymentListInvoicesForUserParams.
+func (s *PaymentService) ListInvoicesForUser(params PaymentListInvoicesForUserParams) (*InvoiceResultsPage, error) {
 	// Create the url.
 	path := "/user/payment/invoices"
 	targetURL := resolveRelative(s.client.server, path)
@@ -8723,10 +9235,14 @@ func (s *PaymentService) ListInvoicesForUser(limit int, pageToken string) (*Invo
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -8747,7 +9263,7 @@ func (s *PaymentService) ListInvoicesForUser(limit int, pageToken string) (*Invo
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded InvoiceResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8756,13 +9272,20 @@ func (s *PaymentService) ListInvoicesForUser(limit int, pageToken string) (*Invo
 
 }
 
+// PaymentRedirectMethodPortalLinkForUserParams holds the query parameters for PaymentService.RedirectMethodPortalLinkForUser.
+type PaymentRedirectMethodPortalLinkForUserParams struct {
+	// ReturnUrl is the `return_url` query parameter.
+	ReturnUrl *URL
+}
+
 // RedirectMethodPortalLinkForUser: Redirect to a fresh Stripe-hosted payment-method update link for your user.
+//
 // If the request is not authenticated, this redirects to website login with a callback back to this endpoint. If authenticated, it creates a fresh hosted Stripe portal session and redirects the browser to it.
 //
 // Parameters
 //
-//   - `returnUrl`
-func (s *PaymentService) RedirectMethodPortalLinkForUser(returnUrl URL) error {
+//   - `params`: The query parameters, see PaymentRedirectMethodPortalLinkForUserParams.
+func (s *PaymentService) RedirectMethodPortalLinkForUser(params PaymentRedirectMethodPortalLinkForUserParams) error {
 	// Create the url.
 	path := "/user/payment/method-portal-link"
 	targetURL := resolveRelative(s.client.server, path)
@@ -8774,9 +9297,11 @@ func (s *PaymentService) RedirectMethodPortalLinkForUser(returnUrl URL) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"return_url": returnUrl.String(),
-	}); err != nil {
+	values := map[string]string{}
+	if params.ReturnUrl != nil {
+		values["return_url"] = params.ReturnUrl.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -8827,7 +9352,7 @@ func (s *PaymentService) ListMethodsForUser() (*[]PaymentMethod, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []PaymentMethod
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -8948,7 +9473,7 @@ func (s *PaymentService) GetUserSubscription() (*ZooProductSubscriptions, error)
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9000,7 +9525,7 @@ func (s *PaymentService) CreateUserSubscription(body ZooProductSubscriptionsUser
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9052,7 +9577,7 @@ func (s *PaymentService) UpdateUserSubscription(body ZooProductSubscriptionsUser
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9121,7 +9646,7 @@ func (s *UserService) GetPrivacySettings() (*PrivacySettings, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PrivacySettings
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9173,7 +9698,7 @@ func (s *UserService) UpdatePrivacySettings(body PrivacySettings) (*PrivacySetti
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded PrivacySettings
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9211,7 +9736,7 @@ func (s *ProjectService) List() (*[]ProjectSummaryResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []ProjectSummaryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9263,7 +9788,7 @@ func (s *ProjectService) Create(body *MultipartForm) (*ProjectResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9311,7 +9836,7 @@ func (s *ProjectService) Get(id UUID) (*ProjectResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9371,7 +9896,7 @@ func (s *ProjectService) Update(id UUID, body *MultipartForm) (*ProjectResponse,
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9419,12 +9944,19 @@ func (s *ProjectService) Delete(id UUID) error {
 
 }
 
+// ProjectDownloadParams holds the query parameters for ProjectService.Download.
+type ProjectDownloadParams struct {
+	// Format: Archive formats supported by project download endpoints.
+	Format *ProjectArchiveFormat
+}
+
 // Download: Download one of the authenticated user's projects as a tar archive.
+//
 // Parameters
 //
 //   - `id`: A UUID usually v4 or v7
-//   - `format`: Archive formats supported by project download endpoints.
-func (s *ProjectService) Download(id UUID, format ProjectArchiveFormat) error {
+//   - `params`: The query parameters, see ProjectDownloadParams.
+func (s *ProjectService) Download(id UUID, params ProjectDownloadParams) error {
 	// Create the url.
 	path := "/user/projects/{{.id}}/download"
 	targetURL := resolveRelative(s.client.server, path)
@@ -9436,10 +9968,13 @@ func (s *ProjectService) Download(id UUID, format ProjectArchiveFormat) error {
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":     id.String(),
-		"format": string(format),
-	}); err != nil {
+	values := map[string]string{
+		"id": id.String(),
+	}
+	if params.Format != nil {
+		values["format"] = string(*params.Format)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -9499,7 +10034,7 @@ func (s *ProjectService) Publish(id UUID) (*ProjectResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9547,7 +10082,7 @@ func (s *ProjectService) ListShareLinks(id UUID) (*[]ProjectShareLinkResponse, e
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded []ProjectShareLinkResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9605,7 +10140,7 @@ func (s *ProjectService) CreateShareLink(id UUID, body CreateProjectShareLinkReq
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ProjectShareLinkResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9735,7 +10270,7 @@ func (s *UserService) GetSessionFor(token string) (*Session, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Session
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9744,19 +10279,26 @@ func (s *UserService) GetSessionFor(token string) (*Session, error) {
 
 }
 
+// UserGetShortlinksParams holds the query parameters for UserService.GetShortlinks.
+type UserGetShortlinksParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // GetShortlinks: Get the shortlinks for a user.
+//
 // This endpoint requires authentication by any Zoo user. It gets the shortlinks for the user.
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *UserService) GetShortlinks(limit int, pageToken string, sortBy CreatedAtSortMode) (*ShortlinkResultsPage, error) {
+//   - `params`: The query parameters, see UserGetShortlinksParams.
+func (s *UserService) GetShortlinks(params UserGetShortlinksParams) (*ShortlinkResultsPage, error) {
 	// Create the url.
 	path := "/user/shortlinks"
 	targetURL := resolveRelative(s.client.server, path)
@@ -9768,11 +10310,17 @@ func (s *UserService) GetShortlinks(limit int, pageToken string, sortBy CreatedA
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -9793,7 +10341,7 @@ func (s *UserService) GetShortlinks(limit int, pageToken string, sortBy CreatedA
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ShortlinkResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9845,7 +10393,7 @@ func (s *UserService) CreateShortlink(body CreateShortlinkRequest) (*CreateShort
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CreateShortlinkResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -9989,7 +10537,26 @@ func (s *UserService) DeleteShortlink(key string) error {
 
 }
 
+// MlListTextToCadPartsForUserParams holds the query parameters for MlService.ListTextToCadPartsForUser.
+type MlListTextToCadPartsForUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+	// NoModels is the `no_models` query parameter.
+	NoModels *bool
+	// NoParts is the `no_parts` query parameter.
+	NoParts *bool
+	// ConversationID: A UUID usually v4 or v7
+	ConversationID *UUID
+}
+
 // ListTextToCadPartsForUser: List text-to-CAD parts you've generated.
+//
 // This will always return the STEP file contents as well as the format the user originally requested.
 //
 // This endpoint requires authentication by any Zoo user. It returns the text-to-CAD parts for the authenticated user.
@@ -9998,20 +10565,8 @@ func (s *UserService) DeleteShortlink(key string) error {
 //
 // Parameters
 //
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-//
-//   - `noModels`
-//
-//   - `noParts`
-//
-//   - `conversationId`: A UUID usually v4 or v7
-func (s *MlService) ListTextToCadPartsForUser(limit int, pageToken string, sortBy CreatedAtSortMode, noModels bool, noParts bool, conversationId UUID) (*TextToCadResponseResultsPage, error) {
+//   - `params`: The query parameters, see MlListTextToCadPartsForUserParams.
+func (s *MlService) ListTextToCadPartsForUser(params MlListTextToCadPartsForUserParams) (*TextToCadResponseResultsPage, error) {
 	// Create the url.
 	path := "/user/text-to-cad"
 	targetURL := resolveRelative(s.client.server, path)
@@ -10023,14 +10578,26 @@ func (s *MlService) ListTextToCadPartsForUser(limit int, pageToken string, sortB
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"limit":           strconv.Itoa(limit),
-		"page_token":      pageToken,
-		"sort_by":         string(sortBy),
-		"no_models":       strconv.FormatBool(noModels),
-		"no_parts":        strconv.FormatBool(noParts),
-		"conversation_id": conversationId.String(),
-	}); err != nil {
+	values := map[string]string{}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if params.NoModels != nil {
+		values["no_models"] = strconv.FormatBool(*params.NoModels)
+	}
+	if params.NoParts != nil {
+		values["no_parts"] = strconv.FormatBool(*params.NoParts)
+	}
+	if params.ConversationID != nil {
+		values["conversation_id"] = params.ConversationID.String()
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -10051,7 +10618,7 @@ func (s *MlService) ListTextToCadPartsForUser(limit int, pageToken string, sortB
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded TextToCadResponseResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10101,7 +10668,7 @@ func (s *MlService) GetTextToCadPartForUser(id UUID) (*any, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded any
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10198,7 +10765,7 @@ func (s *UserService) GetExtended(id string) (*ExtendedUser, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ExtendedUser
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10250,7 +10817,7 @@ func (s *UserService) Get(id string) (*UserResponse, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserResponse
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10300,7 +10867,7 @@ func (s *UserService) AdminDetailsList(id string) (*UserAdminDetails, error) {
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded UserAdminDetails
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10309,7 +10876,20 @@ func (s *UserService) AdminDetailsList(id string) (*UserAdminDetails, error) {
 
 }
 
+// APICallListForUserParams holds the query parameters for APICallService.ListForUser.
+type APICallListForUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListForUser: List API calls for a user.
+//
 // This endpoint requires authentication by any Zoo user. It returns the API calls for the authenticated user if "me" is passed as the user id.
 //
 // Alternatively, you can use the `/user/api-calls` endpoint to get the API calls for your user.
@@ -10321,15 +10901,8 @@ func (s *UserService) AdminDetailsList(id string) (*UserAdminDetails, error) {
 // Parameters
 //
 //   - `id`
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *APICallService) ListForUser(id string, limit int, pageToken string, sortBy CreatedAtSortMode) (*APICallWithPriceResultsPage, error) {
+//   - `params`: The query parameters, see APICallListForUserParams.
+func (s *APICallService) ListForUser(id string, params APICallListForUserParams) (*APICallWithPriceResultsPage, error) {
 	// Create the url.
 	path := "/users/{{.id}}/api-calls"
 	targetURL := resolveRelative(s.client.server, path)
@@ -10341,12 +10914,19 @@ func (s *APICallService) ListForUser(id string, limit int, pageToken string, sor
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":         id,
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{
+		"id": id,
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -10367,7 +10947,7 @@ func (s *APICallService) ListForUser(id string, limit int, pageToken string, sor
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded APICallWithPriceResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10376,21 +10956,27 @@ func (s *APICallService) ListForUser(id string, limit int, pageToken string, sor
 
 }
 
+// Oauth2ListAppsForAnyUserParams holds the query parameters for Oauth2Service.ListAppsForAnyUser.
+type Oauth2ListAppsForAnyUserParams struct {
+	// Limit is the `limit` query parameter.
+	Limit *int
+	// PageToken is the `page_token` query parameter.
+	PageToken *string
+	// SortBy: Supported set of sort modes for scanning by created_at only.
+	//
+	// Currently, we only support scanning in ascending order.
+	SortBy *CreatedAtSortMode
+}
+
 // ListAppsForAnyUser: List OAuth 2.0 apps owned by a user.
+//
 // This endpoint requires Zoo admin authentication. It returns the target user's active OAuth apps so the admin dashboard can inspect them without impersonating the user.
 //
 // Parameters
 //
 //   - `id`
-//
-//   - `limit`
-//
-//   - `pageToken`
-//
-//   - `sortBy`: Supported set of sort modes for scanning by created_at only.
-//
-//     Currently, we only support scanning in ascending order.
-func (s *Oauth2Service) ListAppsForAnyUser(id string, limit int, pageToken string, sortBy CreatedAtSortMode) (*Oauth2AppResponseResultsPage, error) {
+//   - `params`: The query parameters, see Oauth2ListAppsForAnyUserParams.
+func (s *Oauth2Service) ListAppsForAnyUser(id string, params Oauth2ListAppsForAnyUserParams) (*Oauth2AppResponseResultsPage, error) {
 	// Create the url.
 	path := "/users/{{.id}}/oauth2/apps"
 	targetURL := resolveRelative(s.client.server, path)
@@ -10402,12 +10988,19 @@ func (s *Oauth2Service) ListAppsForAnyUser(id string, limit int, pageToken strin
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":         id,
-		"limit":      strconv.Itoa(limit),
-		"page_token": pageToken,
-		"sort_by":    string(sortBy),
-	}); err != nil {
+	values := map[string]string{
+		"id": id,
+	}
+	if params.Limit != nil {
+		values["limit"] = strconv.Itoa(*params.Limit)
+	}
+	if params.PageToken != nil {
+		values["page_token"] = *params.PageToken
+	}
+	if params.SortBy != nil {
+		values["sort_by"] = string(*params.SortBy)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -10428,7 +11021,7 @@ func (s *Oauth2Service) ListAppsForAnyUser(id string, limit int, pageToken strin
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded Oauth2AppResponseResultsPage
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10437,14 +11030,21 @@ func (s *Oauth2Service) ListAppsForAnyUser(id string, limit int, pageToken strin
 
 }
 
+// PaymentGetBalanceForAnyUserParams holds the query parameters for PaymentService.GetBalanceForAnyUser.
+type PaymentGetBalanceForAnyUserParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // GetBalanceForAnyUser: Get balance for an user.
+//
 // This endpoint requires authentication by a Zoo employee. It gets the balance information for the specified user.
 //
 // Parameters
 //
 //   - `id`
-//   - `includeTotalDue`
-func (s *PaymentService) GetBalanceForAnyUser(id string, includeTotalDue bool) (*CustomerBalance, error) {
+//   - `params`: The query parameters, see PaymentGetBalanceForAnyUserParams.
+func (s *PaymentService) GetBalanceForAnyUser(id string, params PaymentGetBalanceForAnyUserParams) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/users/{{.id}}/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -10456,10 +11056,13 @@ func (s *PaymentService) GetBalanceForAnyUser(id string, includeTotalDue bool) (
 	}
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":                id,
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-	}); err != nil {
+	values := map[string]string{
+		"id": id,
+	}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -10480,7 +11083,7 @@ func (s *PaymentService) GetBalanceForAnyUser(id string, includeTotalDue bool) (
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10489,15 +11092,22 @@ func (s *PaymentService) GetBalanceForAnyUser(id string, includeTotalDue bool) (
 
 }
 
+// PaymentUpdateBalanceForAnyUserParams holds the query parameters for PaymentService.UpdateBalanceForAnyUser.
+type PaymentUpdateBalanceForAnyUserParams struct {
+	// IncludeTotalDue is the `include_total_due` query parameter.
+	IncludeTotalDue *bool
+}
+
 // UpdateBalanceForAnyUser: Update balance for an user.
+//
 // This endpoint requires authentication by a Zoo employee. It updates the balance information for the specified user.
 //
 // Parameters
 //
 //   - `id`
-//   - `includeTotalDue`
+//   - `params`: The query parameters, see PaymentUpdateBalanceForAnyUserParams.
 //   - `body`: Payload for updating a user's balance.
-func (s *PaymentService) UpdateBalanceForAnyUser(id string, includeTotalDue bool, body UpdatePaymentBalance) (*CustomerBalance, error) {
+func (s *PaymentService) UpdateBalanceForAnyUser(id string, params PaymentUpdateBalanceForAnyUserParams, body UpdatePaymentBalance) (*CustomerBalance, error) {
 	// Create the url.
 	path := "/users/{{.id}}/payment/balance"
 	targetURL := resolveRelative(s.client.server, path)
@@ -10518,10 +11128,13 @@ func (s *PaymentService) UpdateBalanceForAnyUser(id string, includeTotalDue bool
 	req.Header.Add("Content-Type", "application/json")
 
 	// Add the parameters to the url.
-	if err := expandURL(req.URL, map[string]string{
-		"id":                id,
-		"include_total_due": strconv.FormatBool(includeTotalDue),
-	}); err != nil {
+	values := map[string]string{
+		"id": id,
+	}
+	if params.IncludeTotalDue != nil {
+		values["include_total_due"] = strconv.FormatBool(*params.IncludeTotalDue)
+	}
+	if err := expandURL(req.URL, values); err != nil {
 		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
 	}
 
@@ -10542,7 +11155,7 @@ func (s *PaymentService) UpdateBalanceForAnyUser(id string, includeTotalDue bool
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded CustomerBalance
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10602,7 +11215,7 @@ func (s *UserService) UpdateSubscriptionFor(id string, body ZooProductSubscripti
 		return nil, errors.New("request returned an empty body in the response")
 	}
 	var decoded ZooProductSubscriptions
-	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+	if err := s.client.decode(resp.Body, &decoded); err != nil {
 		return nil, fmt.Errorf("error decoding response body: %v", err)
 	}
 
@@ -10879,42 +11492,88 @@ func (s *UserService) PutPublicSupportForm(body WebsiteSupportForm) error {
 
 // CreateTerm: Create a terminal.
 // Attach to a docker container to create an interactive terminal.
-func (s *ExecutorService) CreateTerm() (*websocket.Conn, error) {
+func (s *ExecutorService) CreateTerm(ctx context.Context) (*websocket.Conn, error) {
 	// Create the url.
 	path := "/ws/executor/term"
 	targetURL := resolveRelative(s.client.server, path)
 
+	// Create the request, so the handshake is bound to the context.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	// Use the websocket scheme matching the scheme of the server.
+	if err := websocketURL(req.URL); err != nil {
+		return nil, err
+	}
+
 	headers := http.Header{}
 	headers["Authorization"] = []string{fmt.Sprintf("Bearer %s", s.client.token)}
 
-	conn, _, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(targetURL, "https://", "wss://"), headers)
+	conn, resp, err := s.client.websocketDialer().DialContext(ctx, req.URL.String(), headers)
 	if err != nil {
-		return nil, err
+		return nil, checkHandshake(resp, err)
 	}
 
 	return conn, nil
 }
 
+// MlCopilotWsParams holds the query parameters for MlService.CopilotWs.
+type MlCopilotWsParams struct {
+	// Replay is the `replay` query parameter.
+	Replay *bool
+	// ConversationID is the `conversation_id` query parameter.
+	ConversationID *UUID
+	// Pr is the `pr` query parameter.
+	Pr *int
+}
+
 // CopilotWs: Open a websocket to prompt the ML copilot.
+//
 // This endpoint accepts typed query parameters via `MlCopilotQuery`. See the field documentation on that struct for details, including replay behavior and wire format.
 //
 // Parameters
 //
-//   - `replay`
-//   - `conversationId`
-//   - `pr`
+//   - `params`: The query parameters, see MlCopilotWsParams.
 //   - `body`: The types of messages that can be sent by the client to the server.
-func (s *MlService) CopilotWs(replay bool, conversationId UUID, pr int, body any) (*websocket.Conn, error) {
+func (s *MlService) CopilotWs(ctx context.Context, params MlCopilotWsParams, body any) (*websocket.Conn, error) {
 	// Create the url.
 	path := "/ws/ml/copilot"
 	targetURL := resolveRelative(s.client.server, path)
 
+	// Create the request, so the handshake is bound to the context.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	// Add the parameters to the url.
+	values := map[string]string{}
+	if params.Replay != nil {
+		values["replay"] = strconv.FormatBool(*params.Replay)
+	}
+	if params.ConversationID != nil {
+		values["conversation_id"] = params.ConversationID.String()
+	}
+	if params.Pr != nil {
+		values["pr"] = strconv.Itoa(*params.Pr)
+	}
+	if err := expandURL(req.URL, values); err != nil {
+		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
+	}
+
+	// Use the websocket scheme matching the scheme of the server.
+	if err := websocketURL(req.URL); err != nil {
+		return nil, err
+	}
+
 	headers := http.Header{}
 	headers["Authorization"] = []string{fmt.Sprintf("Bearer %s", s.client.token)}
 
-	conn, _, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(targetURL, "https://", "wss://"), headers)
+	conn, resp, err := s.client.websocketDialer().DialContext(ctx, req.URL.String(), headers)
 	if err != nil {
-		return nil, err
+		return nil, checkHandshake(resp, err)
 	}
 
 	return conn, nil
@@ -10925,51 +11584,140 @@ func (s *MlService) CopilotWs(replay bool, conversationId UUID, pr int, body any
 //
 //   - `id`
 //   - `body`: The types of messages that can be sent by the client to the server.
-func (s *MlService) ReasoningWs(id UUID, body any) (*websocket.Conn, error) {
+func (s *MlService) ReasoningWs(ctx context.Context, id UUID, body any) (*websocket.Conn, error) {
 	// Create the url.
 	path := "/ws/ml/reasoning/{{.id}}"
 	targetURL := resolveRelative(s.client.server, path)
 
+	// Create the request, so the handshake is bound to the context.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	// Add the parameters to the url.
+	if err := expandURL(req.URL, map[string]string{
+		"id": id.String(),
+	}); err != nil {
+		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
+	}
+
+	// Use the websocket scheme matching the scheme of the server.
+	if err := websocketURL(req.URL); err != nil {
+		return nil, err
+	}
+
 	headers := http.Header{}
 	headers["Authorization"] = []string{fmt.Sprintf("Bearer %s", s.client.token)}
 
-	conn, _, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(targetURL, "https://", "wss://"), headers)
+	conn, resp, err := s.client.websocketDialer().DialContext(ctx, req.URL.String(), headers)
 	if err != nil {
-		return nil, err
+		return nil, checkHandshake(resp, err)
 	}
 
 	return conn, nil
 }
 
+// ModelingCommandsWsParams holds the query parameters for ModelingService.CommandsWs.
+type ModelingCommandsWsParams struct {
+	// VideoResWidth is the `video_res_width` query parameter.
+	VideoResWidth *int
+	// VideoResHeight is the `video_res_height` query parameter.
+	VideoResHeight *int
+	// Fps is the `fps` query parameter.
+	Fps *int
+	// UnlockedFramerate is the `unlocked_framerate` query parameter.
+	UnlockedFramerate *bool
+	// PostEffect: Post effect type
+	PostEffect *PostEffectType
+	// Webrtc is the `webrtc` query parameter.
+	Webrtc *bool
+	// Pool is the `pool` query parameter.
+	Pool *string
+	// ShowGrid is the `show_grid` query parameter.
+	ShowGrid *bool
+	// Replay is the `replay` query parameter.
+	Replay *string
+	// APICallID is the `api_call_id` query parameter.
+	APICallID *string
+	// OrderIndependentTransparency is the `order_independent_transparency` query parameter.
+	OrderIndependentTransparency *bool
+	// Pr is the `pr` query parameter.
+	Pr *int
+}
+
 // CommandsWs: Open a websocket which accepts modeling commands.
+//
 // Pass those commands to the engine via websocket, and pass responses back to the client. Basically, this is a websocket proxy between the frontend/client and the engine.
 //
 // Parameters
 //
-//   - `videoResWidth`
-//   - `videoResHeight`
-//   - `fps`
-//   - `unlockedFramerate`
-//   - `postEffect`: Post effect type
-//   - `webrtc`
-//   - `pool`
-//   - `showGrid`
-//   - `replay`
-//   - `apicallId`
-//   - `orderIndependentTransparency`
-//   - `pr`
+//   - `params`: The query parameters, see ModelingCommandsWsParams.
 //   - `body`: The websocket messages the server receives.
-func (s *ModelingService) CommandsWs(videoResWidth int, videoResHeight int, fps int, unlockedFramerate bool, postEffect PostEffectType, webrtc bool, pool string, showGrid bool, replay string, apicallId string, orderIndependentTransparency bool, pr int, body any) (*websocket.Conn, error) {
+func (s *ModelingService) CommandsWs(ctx context.Context, params ModelingCommandsWsParams, body any) (*websocket.Conn, error) {
 	// Create the url.
 	path := "/ws/modeling/commands"
 	targetURL := resolveRelative(s.client.server, path)
 
+	// Create the request, so the handshake is bound to the context.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	// Add the parameters to the url.
+	values := map[string]string{}
+	if params.VideoResWidth != nil {
+		values["video_res_width"] = strconv.Itoa(*params.VideoResWidth)
+	}
+	if params.VideoResHeight != nil {
+		values["video_res_height"] = strconv.Itoa(*params.VideoResHeight)
+	}
+	if params.Fps != nil {
+		values["fps"] = strconv.Itoa(*params.Fps)
+	}
+	if params.UnlockedFramerate != nil {
+		values["unlocked_framerate"] = strconv.FormatBool(*params.UnlockedFramerate)
+	}
+	if params.PostEffect != nil {
+		values["post_effect"] = string(*params.PostEffect)
+	}
+	if params.Webrtc != nil {
+		values["webrtc"] = strconv.FormatBool(*params.Webrtc)
+	}
+	if params.Pool != nil {
+		values["pool"] = *params.Pool
+	}
+	if params.ShowGrid != nil {
+		values["show_grid"] = strconv.FormatBool(*params.ShowGrid)
+	}
+	if params.Replay != nil {
+		values["replay"] = *params.Replay
+	}
+	if params.APICallID != nil {
+		values["api_call_id"] = *params.APICallID
+	}
+	if params.OrderIndependentTransparency != nil {
+		values["order_independent_transparency"] = strconv.FormatBool(*params.OrderIndependentTransparency)
+	}
+	if params.Pr != nil {
+		values["pr"] = strconv.Itoa(*params.Pr)
+	}
+	if err := expandURL(req.URL, values); err != nil {
+		return nil, fmt.Errorf("expanding URL with parameters failed: %v", err)
+	}
+
+	// Use the websocket scheme matching the scheme of the server.
+	if err := websocketURL(req.URL); err != nil {
+		return nil, err
+	}
+
 	headers := http.Header{}
 	headers["Authorization"] = []string{fmt.Sprintf("Bearer %s", s.client.token)}
 
-	conn, _, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(targetURL, "https://", "wss://"), headers)
+	conn, resp, err := s.client.websocketDialer().DialContext(ctx, req.URL.String(), headers)
 	if err != nil {
-		return nil, err
+		return nil, checkHandshake(resp, err)
 	}
 
 	return conn, nil
@@ -1,8 +1,15 @@
 package kittycad
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/gorilla/websocket"
 )
 
 func TestFileConversionWithEmptyCompletedAt(t *testing.T) {
@@ -13,3 +20,174 @@ func TestFileConversionWithEmptyCompletedAt(t *testing.T) {
 		t.Errorf("Error unmarshalling json: %v", err)
 	}
 }
+
+func TestUpdateUserOmitsUnsetFields(t *testing.T) {
+	body, err := json.Marshal(UpdateUser{
+		FirstName:   new("Ada"),
+		IsOnboarded: new(false),
+	})
+	if err != nil {
+		t.Fatalf("Error marshalling json: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("Error unmarshalling json: %v", err)
+	}
+
+	if got["first_name"] != "Ada" {
+		t.Errorf("expected first_name to be sent, got %s", body)
+	}
+	if got["is_onboarded"] != false {
+		t.Errorf("expected is_onboarded to be sent as false, got %s", body)
+	}
+	for _, field := range []string{"company", "discord", "github", "last_name", "phone", "username", "allow_pay_as_you_go"} {
+		if _, ok := got[field]; ok {
+			t.Errorf("expected %s to be omitted, got %s", field, body)
+		}
+	}
+}
+
+func TestEnumParse(t *testing.T) {
+	format, err := ParseFileImportFormat("stl")
+	if err != nil {
+		t.Fatalf("parsing a known format failed: %v", err)
+	}
+	if format != FileImportFormatStl {
+		t.Errorf("expected %q, got %q", FileImportFormatStl, format)
+	}
+
+	if _, err := ParseFileImportFormat("doc"); err == nil {
+		t.Errorf("expected an error parsing an unknown format")
+	}
+
+	for _, format := range AllFileImportFormats() {
+		if !format.IsValid() {
+			t.Errorf("expected %q to be valid", format)
+		}
+	}
+}
+
+func TestClientWithStrictEnums(t *testing.T) {
+	client := newStandInClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"input_unit":"parsecs","output_unit":"mm","status":"completed"}`))
+	})
+
+	// Unknown values are accepted by default.
+	conversion, err := client.Unit.GetLengthConversion(UnitLengthM, UnitLengthMm, 1)
+	if err != nil {
+		t.Fatalf("converting failed: %v", err)
+	}
+	if conversion.InputUnit != "parsecs" {
+		t.Errorf("expected the unknown value to be kept, got %q", conversion.InputUnit)
+	}
+
+	client.WithStrictEnums(true)
+	if _, err := client.Unit.GetLengthConversion(UnitLengthM, UnitLengthMm, 1); err == nil || !strings.Contains(err.Error(), `invalid UnitLength "parsecs" at input_unit`) {
+		t.Errorf("expected an error decoding an unknown value with strict enums, got %v", err)
+	}
+}
+
+func TestParamsOnlySendsSetQueryParameters(t *testing.T) {
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+
+	if _, err := client.Org.ListDatasetConversions(ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), OrgListDatasetConversionsParams{
+		Limit: new(10),
+		Phase: new("snapshot"),
+	}); err != nil {
+		t.Fatalf("listing the dataset conversions failed: %v", err)
+	}
+
+	if len(query) != 2 || query["limit"][0] != "10" || query["phase"][0] != "snapshot" {
+		t.Errorf("expected only limit and phase to be sent, got %v", query)
+	}
+}
+
+func TestWebsocketExpandsParameters(t *testing.T) {
+	var path, query, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		query = r.URL.RawQuery
+		authorization = r.Header.Get("Authorization")
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	// The server is plain HTTP, so the websocket must be dialed without TLS.
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+
+	conn, err := client.Modeling.CommandsWs(context.Background(), ModelingCommandsWsParams{
+		VideoResWidth: new(1280),
+		Pool:          new("gpu"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("opening the websocket failed: %v", err)
+	}
+	conn.Close()
+
+	if path != "/ws/modeling/commands" || query != "pool=gpu&video_res_width=1280" {
+		t.Errorf("unexpected url: %s?%s", path, query)
+	}
+	if authorization != "Bearer token" {
+		t.Errorf("unexpected authorization header: %q", authorization)
+	}
+
+	conn, err = client.Ml.ReasoningWs(context.Background(), ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), nil)
+	if err != nil {
+		t.Fatalf("opening the websocket failed: %v", err)
+	}
+	conn.Close()
+
+	if path != "/ws/ml/reasoning/6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Errorf("unexpected path: %s", path)
+	}
+}
+
+func TestWebsocketHandshakeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error_code":"unauthorized","message":"invalid token","request_id":"1"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+
+	_, err = client.Executor.CreateTerm(context.Background())
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected an *HTTPError, got %v", err)
+	}
+	if httpErr.StatusCode != http.StatusUnauthorized || httpErr.Message != "invalid token" {
+		t.Errorf("unexpected error: %v", httpErr)
+	}
+}
@@ -0,0 +1,61 @@
+package kittycad
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// startStandIn starts a server answering with the handler until the test
+// ends, and returns its URL.
+func startStandIn(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newStandInClient starts a server answering with the handler until the
+// test ends, and returns a client sending its requests to it.
+func newStandInClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(startStandIn(t, handler)); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+	return client
+}
+
+// websocketStandIn returns a handler upgrading the requests to websockets
+// served by serve, which are closed once it returns.
+func websocketStandIn(serve func(conn *websocket.Conn)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		serve(conn)
+	}
+}
+
+// dialStandIn starts a server answering with the handler until the test
+// ends, and dials a websocket to it.
+func dialStandIn(t *testing.T, handler http.HandlerFunc) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(startStandIn(t, handler), "http://", "ws://", 1), nil)
+	if err != nil {
+		t.Fatalf("dialing the stand-in failed: %v", err)
+	}
+	return conn
+}
@@ -2,6 +2,8 @@
 
 package kittycad
 
+import "fmt"
+
 // APICallStatus: The status of an async API call.
 type APICallStatus string
 
@@ -18,43 +20,84 @@ const (
 	APICallStatusFailed APICallStatus = "failed"
 )
 
+// AllAPICallStatuses returns all the known values of APICallStatus.
+func AllAPICallStatuses() []APICallStatus {
+	return []APICallStatus{
+		APICallStatusQueued,
+		APICallStatusUploaded,
+		APICallStatusInProgress,
+		APICallStatusCompleted,
+		APICallStatusFailed,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of APICallStatus.
+func (v APICallStatus) IsValid() bool {
+	switch v {
+	case APICallStatusQueued, APICallStatusUploaded, APICallStatusInProgress, APICallStatusCompleted, APICallStatusFailed:
+		return true
+	}
+	return false
+}
+
+// ParseAPICallStatus parses a APICallStatus from a string, returning an error if the value is not known.
+func ParseAPICallStatus(s string) (APICallStatus, error) {
+	v := APICallStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid APICallStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v APICallStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *APICallStatus) UnmarshalText(text []byte) error {
+	*v = APICallStatus(text)
+	return nil
+}
+
 // APICallWithPrice: An API call with the price.
 // This is a join of the `ApiCall` and `ApiCallPrice` tables.
 type APICallWithPrice struct {
 	// CompletedAt: The date and time the API call completed billing.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The date and time the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Duration: The duration of the API call.
-	Duration int `json:"duration" yaml:"duration" schema:"duration"`
+	Duration *int `json:"duration,omitempty" yaml:"duration,omitempty" schema:"duration,omitempty"`
 	// Email: The user's email address.
-	Email string `json:"email" yaml:"email" schema:"email"`
+	Email *string `json:"email,omitempty" yaml:"email,omitempty" schema:"email,omitempty"`
 	// Endpoint: The endpoint requested by the API call.
-	Endpoint string `json:"endpoint" yaml:"endpoint" schema:"endpoint"`
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty" schema:"endpoint,omitempty"`
 	// ID: The unique identifier for the API call.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// IPAddress: The ip address of the origin.
-	IPAddress IP `json:"ip_address" yaml:"ip_address" schema:"ip_address"`
+	IPAddress *IP `json:"ip_address,omitempty" yaml:"ip_address,omitempty" schema:"ip_address,omitempty"`
 	// Method: The HTTP method requested by the API call.
 	Method Method `json:"method" yaml:"method" schema:"method,required"`
 	// Minutes: The number of minutes the API call was billed for.
-	Minutes int `json:"minutes" yaml:"minutes" schema:"minutes"`
+	Minutes *int `json:"minutes,omitempty" yaml:"minutes,omitempty" schema:"minutes,omitempty"`
 	// OrgID: The organization ID of the API call if it is billable through an organization.
-	OrgID UUID `json:"org_id" yaml:"org_id" schema:"org_id"`
+	OrgID *UUID `json:"org_id,omitempty" yaml:"org_id,omitempty" schema:"org_id,omitempty"`
 	// Origin: The origin of the API call.
-	Origin string `json:"origin" yaml:"origin" schema:"origin"`
+	Origin *string `json:"origin,omitempty" yaml:"origin,omitempty" schema:"origin,omitempty"`
 	// Price: The price of the API call.
-	Price float64 `json:"price" yaml:"price" schema:"price"`
+	Price *float64 `json:"price,omitempty" yaml:"price,omitempty" schema:"price,omitempty"`
 	// RequestQueryParams: The request query params sent by the API call.
-	RequestQueryParams string `json:"request_query_params" yaml:"request_query_params" schema:"request_query_params"`
+	RequestQueryParams *string `json:"request_query_params,omitempty" yaml:"request_query_params,omitempty" schema:"request_query_params,omitempty"`
 	// Seconds: The number of seconds the API call was billed for.
-	Seconds int `json:"seconds" yaml:"seconds" schema:"seconds"`
+	Seconds *int `json:"seconds,omitempty" yaml:"seconds,omitempty" schema:"seconds,omitempty"`
 	// StartedAt: The date and time the API call started billing.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// StatusCode: The status code returned by the API call.
-	StatusCode int `json:"status_code" yaml:"status_code" schema:"status_code"`
+	StatusCode *int `json:"status_code,omitempty" yaml:"status_code,omitempty" schema:"status_code,omitempty"`
 	// StripeInvoiceItemID: The Stripe invoice item ID of the API call if it is billable.
-	StripeInvoiceItemID string `json:"stripe_invoice_item_id" yaml:"stripe_invoice_item_id" schema:"stripe_invoice_item_id"`
+	StripeInvoiceItemID *string `json:"stripe_invoice_item_id,omitempty" yaml:"stripe_invoice_item_id,omitempty" schema:"stripe_invoice_item_id,omitempty"`
 	// Token: The API token that made the API call.
 	Token UUID `json:"token" yaml:"token" schema:"token,required"`
 	// UpdatedAt: The date and time the API call was last updated.
@@ -70,7 +113,7 @@ type APICallWithPriceResultsPage struct {
 	// Items: list of items on this page of results
 	Items []APICallWithPrice `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // APIEndpoint: Types of API endpoints.
@@ -85,6 +128,45 @@ const (
 	APIendpointFile APIEndpoint = "file"
 )
 
+// AllAPIEndpoints returns all the known values of APIEndpoint.
+func AllAPIEndpoints() []APIEndpoint {
+	return []APIEndpoint{
+		APIendpointModeling,
+		APIendpointMl,
+		APIendpointFile,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of APIEndpoint.
+func (v APIEndpoint) IsValid() bool {
+	switch v {
+	case APIendpointModeling, APIendpointMl, APIendpointFile:
+		return true
+	}
+	return false
+}
+
+// ParseAPIEndpoint parses a APIEndpoint from a string, returning an error if the value is not known.
+func ParseAPIEndpoint(s string) (APIEndpoint, error) {
+	v := APIEndpoint(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid APIEndpoint %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v APIEndpoint) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *APIEndpoint) UnmarshalText(text []byte) error {
+	*v = APIEndpoint(text)
+	return nil
+}
+
 // APIError: An error.
 type APIError struct {
 	// ErrorCode: The error code.
@@ -93,6 +175,11 @@ type APIError struct {
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 }
 
+// Error converts the APIError to a readable string.
+func (err APIError) Error() string {
+	return fmt.Sprintf("%s: %s", err.ErrorCode, err.Message)
+}
+
 // APIToken: An API token.
 // These are used to authenticate users with Bearer authentication.
 type APIToken struct {
@@ -103,7 +190,7 @@ type APIToken struct {
 	// IsValid: If the token is valid. We never delete API tokens, but we can mark them as invalid. We save them for ever to preserve the history of the API token.
 	IsValid bool `json:"is_valid" yaml:"is_valid" schema:"is_valid,required"`
 	// Label: An optional label for the API token.
-	Label string `json:"label" yaml:"label" schema:"label"`
+	Label *string `json:"label,omitempty" yaml:"label,omitempty" schema:"label,omitempty"`
 	// Token: The API token itself. Note: This is partially obfuscated when serialized to JSON responses, as API tokens are too sensitive to return in full. Only the last 4 characters are shown to allow users to identify their tokens.
 	Token string `json:"token" yaml:"token" schema:"token,required"`
 	// UpdatedAt: The date and time the API token was last updated.
@@ -117,7 +204,7 @@ type APITokenResultsPage struct {
 	// Items: list of items on this page of results
 	Items []APIToken `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // APITokenWithFullToken: An API token with the full, unobfuscated token value.
@@ -130,7 +217,7 @@ type APITokenWithFullToken struct {
 	// IsValid: If the token is valid.
 	IsValid bool `json:"is_valid" yaml:"is_valid" schema:"is_valid,required"`
 	// Label: An optional label for the API token.
-	Label string `json:"label" yaml:"label" schema:"label"`
+	Label *string `json:"label,omitempty" yaml:"label,omitempty" schema:"label,omitempty"`
 	// Token: The API token itself - unobfuscated.
 	Token string `json:"token" yaml:"token" schema:"token,required"`
 	// UpdatedAt: The date and time the API token was last updated.
@@ -161,6 +248,50 @@ const (
 	AccountProviderTestProvider AccountProvider = "test_provider"
 )
 
+// AllAccountProviders returns all the known values of AccountProvider.
+func AllAccountProviders() []AccountProvider {
+	return []AccountProvider{
+		AccountProviderApple,
+		AccountProviderDiscord,
+		AccountProviderGoogle,
+		AccountProviderZooCorp,
+		AccountProviderGithub,
+		AccountProviderMicrosoft,
+		AccountProviderSaml,
+		AccountProviderTestProvider,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AccountProvider.
+func (v AccountProvider) IsValid() bool {
+	switch v {
+	case AccountProviderApple, AccountProviderDiscord, AccountProviderGoogle, AccountProviderZooCorp, AccountProviderGithub, AccountProviderMicrosoft, AccountProviderSaml, AccountProviderTestProvider:
+		return true
+	}
+	return false
+}
+
+// ParseAccountProvider parses a AccountProvider from a string, returning an error if the value is not known.
+func ParseAccountProvider(s string) (AccountProvider, error) {
+	v := AccountProvider(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AccountProvider %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AccountProvider) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AccountProvider) UnmarshalText(text []byte) error {
+	*v = AccountProvider(text)
+	return nil
+}
+
 // AddHoleFromOffset: The response from the `AddHoleFromOffset` command.
 type AddHoleFromOffset struct {
 	// EntityIds: If the offset path splits into multiple paths, this will contain the UUIDs of the new paths. If the offset path remains as a single path, this will be empty, and the resulting ID of the (single) new path will be the ID of the `AddHoleFromOffset` command.
@@ -178,7 +309,7 @@ type AddOrgMember struct {
 // Address: An address for a user.
 type Address struct {
 	// City: The city component.
-	City string `json:"city" yaml:"city" schema:"city"`
+	City *string `json:"city,omitempty" yaml:"city,omitempty" schema:"city,omitempty"`
 	// Country: The country component. This is a two-letter ISO country code.
 	Country string `json:"country" yaml:"country" schema:"country,required"`
 	// CreatedAt: The time and date the address was created.
@@ -186,45 +317,45 @@ type Address struct {
 	// ID: The unique identifier of the address.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// State: The state component.
-	State string `json:"state" yaml:"state" schema:"state"`
+	State *string `json:"state,omitempty" yaml:"state,omitempty" schema:"state,omitempty"`
 	// Street1: The first street component.
-	Street1 string `json:"street1" yaml:"street1" schema:"street1"`
+	Street1 *string `json:"street1,omitempty" yaml:"street1,omitempty" schema:"street1,omitempty"`
 	// Street2: The second street component.
-	Street2 string `json:"street2" yaml:"street2" schema:"street2"`
+	Street2 *string `json:"street2,omitempty" yaml:"street2,omitempty" schema:"street2,omitempty"`
 	// UpdatedAt: The time and date the address was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 	// UserID: The user ID that this address belongs to.
 	UserID UUID `json:"user_id" yaml:"user_id" schema:"user_id,required"`
 	// Zip: The zip component.
-	Zip string `json:"zip" yaml:"zip" schema:"zip"`
+	Zip *string `json:"zip,omitempty" yaml:"zip,omitempty" schema:"zip,omitempty"`
 }
 
 // AddressDetails: Address details.
 type AddressDetails struct {
 	// City: The city component.
-	City string `json:"city" yaml:"city" schema:"city"`
+	City *string `json:"city,omitempty" yaml:"city,omitempty" schema:"city,omitempty"`
 	// Country: The country component. This is a two-letter ISO country code.
 	Country string `json:"country" yaml:"country" schema:"country,required"`
 	// State: The state component.
-	State string `json:"state" yaml:"state" schema:"state"`
+	State *string `json:"state,omitempty" yaml:"state,omitempty" schema:"state,omitempty"`
 	// Street1: The first street component.
-	Street1 string `json:"street1" yaml:"street1" schema:"street1"`
+	Street1 *string `json:"street1,omitempty" yaml:"street1,omitempty" schema:"street1,omitempty"`
 	// Street2: The second street component.
-	Street2 string `json:"street2" yaml:"street2" schema:"street2"`
+	Street2 *string `json:"street2,omitempty" yaml:"street2,omitempty" schema:"street2,omitempty"`
 	// Zip: The zip component.
-	Zip string `json:"zip" yaml:"zip" schema:"zip"`
+	Zip *string `json:"zip,omitempty" yaml:"zip,omitempty" schema:"zip,omitempty"`
 }
 
 // AdjacencyInfo: Edge info struct (useful for maintaining mappings between edges and faces and adjacent/opposite edges).
 type AdjacencyInfo struct {
 	// AdjacentInfo: Next adjacent edge and face info.
-	AdjacentInfo EdgeInfo `json:"adjacent_info" yaml:"adjacent_info" schema:"adjacent_info"`
+	AdjacentInfo *EdgeInfo `json:"adjacent_info,omitempty" yaml:"adjacent_info,omitempty" schema:"adjacent_info,omitempty"`
 	// OppositeInfo: Opposite edge and face info.
-	OppositeInfo EdgeInfo `json:"opposite_info" yaml:"opposite_info" schema:"opposite_info"`
+	OppositeInfo *EdgeInfo `json:"opposite_info,omitempty" yaml:"opposite_info,omitempty" schema:"opposite_info,omitempty"`
 	// OriginalInfo: Original edge id and face info.
-	OriginalInfo EdgeInfo `json:"original_info" yaml:"original_info" schema:"original_info"`
+	OriginalInfo *EdgeInfo `json:"original_info,omitempty" yaml:"original_info,omitempty" schema:"original_info,omitempty"`
 	// PreviousAdjacentInfo: Previous adjacent edge and face info.
-	PreviousAdjacentInfo EdgeInfo `json:"previous_adjacent_info" yaml:"previous_adjacent_info" schema:"previous_adjacent_info"`
+	PreviousAdjacentInfo *EdgeInfo `json:"previous_adjacent_info,omitempty" yaml:"previous_adjacent_info,omitempty" schema:"previous_adjacent_info,omitempty"`
 }
 
 // AdminBounds: Inclusive amount bounds for a threshold authority.
@@ -263,14 +394,53 @@ const (
 	AggregateUsageCollectionThresholdSourceSystemDefault AggregateUsageCollectionThresholdSource = "system_default"
 )
 
+// AllAggregateUsageCollectionThresholdSources returns all the known values of AggregateUsageCollectionThresholdSource.
+func AllAggregateUsageCollectionThresholdSources() []AggregateUsageCollectionThresholdSource {
+	return []AggregateUsageCollectionThresholdSource{
+		AggregateUsageCollectionThresholdSourceAdmin,
+		AggregateUsageCollectionThresholdSourceCustomer,
+		AggregateUsageCollectionThresholdSourceSystemDefault,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AggregateUsageCollectionThresholdSource.
+func (v AggregateUsageCollectionThresholdSource) IsValid() bool {
+	switch v {
+	case AggregateUsageCollectionThresholdSourceAdmin, AggregateUsageCollectionThresholdSourceCustomer, AggregateUsageCollectionThresholdSourceSystemDefault:
+		return true
+	}
+	return false
+}
+
+// ParseAggregateUsageCollectionThresholdSource parses a AggregateUsageCollectionThresholdSource from a string, returning an error if the value is not known.
+func ParseAggregateUsageCollectionThresholdSource(s string) (AggregateUsageCollectionThresholdSource, error) {
+	v := AggregateUsageCollectionThresholdSource(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AggregateUsageCollectionThresholdSource %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AggregateUsageCollectionThresholdSource) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AggregateUsageCollectionThresholdSource) UnmarshalText(text []byte) error {
+	*v = AggregateUsageCollectionThresholdSource(text)
+	return nil
+}
+
 // AggregateUsageCollectionThresholdView: Configured and effective aggregate-usage collection-threshold state.
 type AggregateUsageCollectionThresholdView struct {
 	// AdminAmount: Zoo-controlled override. When present, customer mutations are disabled.
-	AdminAmount float64 `json:"admin_amount" yaml:"admin_amount" schema:"admin_amount"`
+	AdminAmount *float64 `json:"admin_amount,omitempty" yaml:"admin_amount,omitempty" schema:"admin_amount,omitempty"`
 	// AdminBounds: Inclusive amount bounds for a threshold authority.
 	AdminBounds AggregateUsageCollectionThresholdBounds `json:"admin_bounds" yaml:"admin_bounds" schema:"admin_bounds,required"`
 	// CustomerAmount: Customer preference used when no Zoo-controlled override is active.
-	CustomerAmount float64 `json:"customer_amount" yaml:"customer_amount" schema:"customer_amount"`
+	CustomerAmount *float64 `json:"customer_amount,omitempty" yaml:"customer_amount,omitempty" schema:"customer_amount,omitempty"`
 	// CustomerBounds: Inclusive amount bounds for a threshold authority.
 	CustomerBounds AggregateUsageCollectionThresholdBounds `json:"customer_bounds" yaml:"customer_bounds" schema:"customer_bounds,required"`
 	// DefaultAmount:
@@ -294,7 +464,7 @@ type Angle struct {
 // AnnotationBasicDimension: Parameters for defining an MBD Basic Dimension Annotation state which is measured between two positions in 3D
 type AnnotationBasicDimension struct {
 	// ArrowScale: The scale of the dimension arrows. Defaults to 1.
-	ArrowScale float64 `json:"arrow_scale" yaml:"arrow_scale" schema:"arrow_scale"`
+	ArrowScale *float64 `json:"arrow_scale,omitempty" yaml:"arrow_scale,omitempty" schema:"arrow_scale,omitempty"`
 	// Dimension: Basic dimension parameters (symbol and tolerance)
 	Dimension AnnotationMbdBasicDimension `json:"dimension" yaml:"dimension" schema:"dimension,required"`
 	// FontPointSize: The point size of the fonts used to generate the annotation label.  Very large values can negatively affect performance.
@@ -302,9 +472,9 @@ type AnnotationBasicDimension struct {
 	// FontScale: The scale of the font label in 3D space
 	FontScale float64 `json:"font_scale" yaml:"font_scale" schema:"font_scale,required"`
 	// FromEdgeReference: Edge reference to use to measure the dimension from If both `from_entity_id` and `from_edge_reference` are provided, `from_edge_reference` takes precedence.
-	FromEdgeReference EdgeSpecifier `json:"from_edge_reference" yaml:"from_edge_reference" schema:"from_edge_reference"`
+	FromEdgeReference *EdgeSpecifier `json:"from_edge_reference,omitempty" yaml:"from_edge_reference,omitempty" schema:"from_edge_reference,omitempty"`
 	// FromEntityID: Entity to measure the dimension from
-	FromEntityID UUID `json:"from_entity_id" yaml:"from_entity_id" schema:"from_entity_id"`
+	FromEntityID *UUID `json:"from_entity_id,omitempty" yaml:"from_entity_id,omitempty" schema:"from_entity_id,omitempty"`
 	// FromEntityPos: Normalized position within the entity to position the dimension from
 	FromEntityPos Point2D `json:"from_entity_pos" yaml:"from_entity_pos" schema:"from_entity_pos,required"`
 	// Offset: 2D Position offset of the annotation within the plane.
@@ -314,9 +484,9 @@ type AnnotationBasicDimension struct {
 	// Precision: Number of decimal places to use when displaying tolerance and dimension values
 	Precision int `json:"precision" yaml:"precision" schema:"precision,required"`
 	// ToEdgeReference: Edge reference to use to measure the dimension from If both `to_entity_id` and `to_edge_reference` are provided, `to_edge_reference` takes precedence.
-	ToEdgeReference EdgeSpecifier `json:"to_edge_reference" yaml:"to_edge_reference" schema:"to_edge_reference"`
+	ToEdgeReference *EdgeSpecifier `json:"to_edge_reference,omitempty" yaml:"to_edge_reference,omitempty" schema:"to_edge_reference,omitempty"`
 	// ToEntityID: Entity to measure the dimension to
-	ToEntityID UUID `json:"to_entity_id" yaml:"to_entity_id" schema:"to_entity_id"`
+	ToEntityID *UUID `json:"to_entity_id,omitempty" yaml:"to_entity_id,omitempty" schema:"to_entity_id,omitempty"`
 	// ToEntityPos: Normalized position within the entity to position the dimension to
 	ToEntityPos Point2D `json:"to_entity_pos" yaml:"to_entity_pos" schema:"to_entity_pos,required"`
 }
@@ -324,15 +494,15 @@ type AnnotationBasicDimension struct {
 // AnnotationFeatureControl: Parameters for defining an MBD Feature Control Annotation state
 type AnnotationFeatureControl struct {
 	// ControlFrame: MBD Control frame for geometric control
-	ControlFrame AnnotationMbdControlFrame `json:"control_frame" yaml:"control_frame" schema:"control_frame"`
+	ControlFrame *AnnotationMbdControlFrame `json:"control_frame,omitempty" yaml:"control_frame,omitempty" schema:"control_frame,omitempty"`
 	// DefinedDatum: Set if this annotation is defining a datum
-	DefinedDatum string `json:"defined_datum" yaml:"defined_datum" schema:"defined_datum"`
+	DefinedDatum *string `json:"defined_datum,omitempty" yaml:"defined_datum,omitempty" schema:"defined_datum,omitempty"`
 	// Dimension: Basic dimensions
-	Dimension AnnotationMbdBasicDimension `json:"dimension" yaml:"dimension" schema:"dimension"`
+	Dimension *AnnotationMbdBasicDimension `json:"dimension,omitempty" yaml:"dimension,omitempty" schema:"dimension,omitempty"`
 	// EdgeReference: Edge reference to use to place the annotation leader from If both `entity_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeReference EdgeSpecifier `json:"edge_reference" yaml:"edge_reference" schema:"edge_reference"`
+	EdgeReference *EdgeSpecifier `json:"edge_reference,omitempty" yaml:"edge_reference,omitempty" schema:"edge_reference,omitempty"`
 	// EntityID: Entity to place the annotation leader from
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 	// EntityPos: Normalized position within the entity to position the annotation leader from
 	EntityPos Point2D `json:"entity_pos" yaml:"entity_pos" schema:"entity_pos,required"`
 	// FontPointSize: The point size of the fonts used to generate the annotation label.  Very large values can negatively affect performance.
@@ -340,7 +510,7 @@ type AnnotationFeatureControl struct {
 	// FontScale: The scale of the font label in 3D space
 	FontScale float64 `json:"font_scale" yaml:"font_scale" schema:"font_scale,required"`
 	// LeaderScale: The scale of the leader (dot or arrow). Defaults to 1.
-	LeaderScale float64 `json:"leader_scale" yaml:"leader_scale" schema:"leader_scale"`
+	LeaderScale *float64 `json:"leader_scale,omitempty" yaml:"leader_scale,omitempty" schema:"leader_scale,omitempty"`
 	// LeaderType: Type of leader to use
 	LeaderType AnnotationLineEnd `json:"leader_type" yaml:"leader_type" schema:"leader_type,required"`
 	// Offset: 2D Position offset of the annotation within the plane.
@@ -350,17 +520,17 @@ type AnnotationFeatureControl struct {
 	// Precision: Number of decimal places to use when displaying tolerance and dimension values
 	Precision int `json:"precision" yaml:"precision" schema:"precision,required"`
 	// Prefix: Prefix text which will appear before the basic dimension
-	Prefix string `json:"prefix" yaml:"prefix" schema:"prefix"`
+	Prefix *string `json:"prefix,omitempty" yaml:"prefix,omitempty" schema:"prefix,omitempty"`
 	// Suffix: Suffix text which will appear after the basic dimension
-	Suffix string `json:"suffix" yaml:"suffix" schema:"suffix"`
+	Suffix *string `json:"suffix,omitempty" yaml:"suffix,omitempty" schema:"suffix,omitempty"`
 }
 
 // AnnotationFeatureTag: Parameters for defining an MBD Feature Tag Annotation state
 type AnnotationFeatureTag struct {
 	// EdgeReference: Edge reference to use to place the annotation leader from If both `entity_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeReference EdgeSpecifier `json:"edge_reference" yaml:"edge_reference" schema:"edge_reference"`
+	EdgeReference *EdgeSpecifier `json:"edge_reference,omitempty" yaml:"edge_reference,omitempty" schema:"edge_reference,omitempty"`
 	// EntityID: Entity to place the annotation leader from
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 	// EntityPos: Normalized position within the entity to position the annotation leader from
 	EntityPos Point2D `json:"entity_pos" yaml:"entity_pos" schema:"entity_pos,required"`
 	// FontPointSize: The point size of the fonts used to generate the annotation label.  Very large values can negatively affect performance.
@@ -370,7 +540,7 @@ type AnnotationFeatureTag struct {
 	// Key: Tag key
 	Key string `json:"key" yaml:"key" schema:"key,required"`
 	// LeaderScale: The scale of the leader (dot or arrow). Defaults to 1.
-	LeaderScale float64 `json:"leader_scale" yaml:"leader_scale" schema:"leader_scale"`
+	LeaderScale *float64 `json:"leader_scale,omitempty" yaml:"leader_scale,omitempty" schema:"leader_scale,omitempty"`
 	// LeaderType: Type of leader to use
 	LeaderType AnnotationLineEnd `json:"leader_type" yaml:"leader_type" schema:"leader_type,required"`
 	// Offset: 2D Position offset of the annotation within the plane.
@@ -395,6 +565,45 @@ const (
 	AnnotationLineEndDot AnnotationLineEnd = "dot"
 )
 
+// AllAnnotationLineEnds returns all the known values of AnnotationLineEnd.
+func AllAnnotationLineEnds() []AnnotationLineEnd {
+	return []AnnotationLineEnd{
+		AnnotationLineEndNone,
+		AnnotationLineEndArrow,
+		AnnotationLineEndDot,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AnnotationLineEnd.
+func (v AnnotationLineEnd) IsValid() bool {
+	switch v {
+	case AnnotationLineEndNone, AnnotationLineEndArrow, AnnotationLineEndDot:
+		return true
+	}
+	return false
+}
+
+// ParseAnnotationLineEnd parses a AnnotationLineEnd from a string, returning an error if the value is not known.
+func ParseAnnotationLineEnd(s string) (AnnotationLineEnd, error) {
+	v := AnnotationLineEnd(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AnnotationLineEnd %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AnnotationLineEnd) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AnnotationLineEnd) UnmarshalText(text []byte) error {
+	*v = AnnotationLineEnd(text)
+	return nil
+}
+
 // AnnotationLineEndOptions: Options for annotation text
 type AnnotationLineEndOptions struct {
 	// End: How to style the end of the annotation line.
@@ -406,9 +615,9 @@ type AnnotationLineEndOptions struct {
 // AnnotationMbdBasicDimension: Parameters for defining an MBD basic dimension
 type AnnotationMbdBasicDimension struct {
 	// Dimension: The explicitly defined dimension.  Only required if the measurement is not automatically calculated.
-	Dimension float64 `json:"dimension" yaml:"dimension" schema:"dimension"`
+	Dimension *float64 `json:"dimension,omitempty" yaml:"dimension,omitempty" schema:"dimension,omitempty"`
 	// Symbol: Type of symbol to use for this dimension (if required)
-	Symbol MbdSymbol `json:"symbol" yaml:"symbol" schema:"symbol"`
+	Symbol *MbdSymbol `json:"symbol,omitempty" yaml:"symbol,omitempty" schema:"symbol,omitempty"`
 	// Tolerance: The tolerance of the dimension
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 }
@@ -416,17 +625,17 @@ type AnnotationMbdBasicDimension struct {
 // AnnotationMbdControlFrame: Parameters for defining an MBD Geometric control frame
 type AnnotationMbdControlFrame struct {
 	// DiameterSymbol: Diameter symbol (if required) whether the geometric control requires a cylindrical or diameter tolerance
-	DiameterSymbol MbdSymbol `json:"diameter_symbol" yaml:"diameter_symbol" schema:"diameter_symbol"`
+	DiameterSymbol *MbdSymbol `json:"diameter_symbol,omitempty" yaml:"diameter_symbol,omitempty" schema:"diameter_symbol,omitempty"`
 	// Modifier: Feature of size or tolerance modifiers
-	Modifier MbdSymbol `json:"modifier" yaml:"modifier" schema:"modifier"`
+	Modifier *MbdSymbol `json:"modifier,omitempty" yaml:"modifier,omitempty" schema:"modifier,omitempty"`
 	// PrimaryDatum: Primary datum
-	PrimaryDatum string `json:"primary_datum" yaml:"primary_datum" schema:"primary_datum"`
+	PrimaryDatum *string `json:"primary_datum,omitempty" yaml:"primary_datum,omitempty" schema:"primary_datum,omitempty"`
 	// SecondaryDatum: Secondary datum
-	SecondaryDatum string `json:"secondary_datum" yaml:"secondary_datum" schema:"secondary_datum"`
+	SecondaryDatum *string `json:"secondary_datum,omitempty" yaml:"secondary_datum,omitempty" schema:"secondary_datum,omitempty"`
 	// Symbol: Geometric symbol, the type of geometric control specified
 	Symbol MbdSymbol `json:"symbol" yaml:"symbol" schema:"symbol,required"`
 	// TertiaryDatum: Tertiary datum
-	TertiaryDatum string `json:"tertiary_datum" yaml:"tertiary_datum" schema:"tertiary_datum"`
+	TertiaryDatum *string `json:"tertiary_datum,omitempty" yaml:"tertiary_datum,omitempty" schema:"tertiary_datum,omitempty"`
 	// Tolerance: Tolerance value - the total tolerance of the geometric control.  The unit is based on the drawing standard.
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 }
@@ -434,23 +643,23 @@ type AnnotationMbdControlFrame struct {
 // AnnotationOptions: Options for annotations
 type AnnotationOptions struct {
 	// Color: Color to render the annotation
-	Color Color `json:"color" yaml:"color" schema:"color"`
+	Color *Color `json:"color,omitempty" yaml:"color,omitempty" schema:"color,omitempty"`
 	// Dimension: Set as an MBD measured basic dimension annotation
-	Dimension AnnotationBasicDimension `json:"dimension" yaml:"dimension" schema:"dimension"`
+	Dimension *AnnotationBasicDimension `json:"dimension,omitempty" yaml:"dimension,omitempty" schema:"dimension,omitempty"`
 	// FeatureControl: Set as an MBD Feature control annotation
-	FeatureControl AnnotationFeatureControl `json:"feature_control" yaml:"feature_control" schema:"feature_control"`
+	FeatureControl *AnnotationFeatureControl `json:"feature_control,omitempty" yaml:"feature_control,omitempty" schema:"feature_control,omitempty"`
 	// FeatureTag: Set as a feature tag annotation
-	FeatureTag AnnotationFeatureTag `json:"feature_tag" yaml:"feature_tag" schema:"feature_tag"`
+	FeatureTag *AnnotationFeatureTag `json:"feature_tag,omitempty" yaml:"feature_tag,omitempty" schema:"feature_tag,omitempty"`
 	// LineEnds: How to style the start and end of the line
-	LineEnds AnnotationLineEndOptions `json:"line_ends" yaml:"line_ends" schema:"line_ends"`
+	LineEnds *AnnotationLineEndOptions `json:"line_ends,omitempty" yaml:"line_ends,omitempty" schema:"line_ends,omitempty"`
 	// LineWidth: Width of the annotation's line
-	LineWidth float64 `json:"line_width" yaml:"line_width" schema:"line_width"`
+	LineWidth *float64 `json:"line_width,omitempty" yaml:"line_width,omitempty" schema:"line_width,omitempty"`
 	// Position: Position to put the annotation
-	Position Point3D `json:"position" yaml:"position" schema:"position"`
+	Position *Point3D `json:"position,omitempty" yaml:"position,omitempty" schema:"position,omitempty"`
 	// Text: Text displayed on the annotation
-	Text AnnotationTextOptions `json:"text" yaml:"text" schema:"text"`
+	Text *AnnotationTextOptions `json:"text,omitempty" yaml:"text,omitempty" schema:"text,omitempty"`
 	// Units: Length Units to use for this individual annotation.  If not provided, the units set by SetSceneUnits will be used.
-	Units UnitLength `json:"units" yaml:"units" schema:"units"`
+	Units *UnitLength `json:"units,omitempty" yaml:"units,omitempty" schema:"units,omitempty"`
 }
 
 // AnnotationTextAlignmentX: Horizontal Text alignment
@@ -465,6 +674,45 @@ const (
 	AnnotationTextAlignmentXRight AnnotationTextAlignmentX = "right"
 )
 
+// AllAnnotationTextAlignmentXs returns all the known values of AnnotationTextAlignmentX.
+func AllAnnotationTextAlignmentXs() []AnnotationTextAlignmentX {
+	return []AnnotationTextAlignmentX{
+		AnnotationTextAlignmentXLeft,
+		AnnotationTextAlignmentXCenter,
+		AnnotationTextAlignmentXRight,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AnnotationTextAlignmentX.
+func (v AnnotationTextAlignmentX) IsValid() bool {
+	switch v {
+	case AnnotationTextAlignmentXLeft, AnnotationTextAlignmentXCenter, AnnotationTextAlignmentXRight:
+		return true
+	}
+	return false
+}
+
+// ParseAnnotationTextAlignmentX parses a AnnotationTextAlignmentX from a string, returning an error if the value is not known.
+func ParseAnnotationTextAlignmentX(s string) (AnnotationTextAlignmentX, error) {
+	v := AnnotationTextAlignmentX(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AnnotationTextAlignmentX %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AnnotationTextAlignmentX) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AnnotationTextAlignmentX) UnmarshalText(text []byte) error {
+	*v = AnnotationTextAlignmentX(text)
+	return nil
+}
+
 // AnnotationTextAlignmentY: Vertical Text alignment
 type AnnotationTextAlignmentY string
 
@@ -477,6 +725,45 @@ const (
 	AnnotationTextAlignmentYTop AnnotationTextAlignmentY = "top"
 )
 
+// AllAnnotationTextAlignmentYs returns all the known values of AnnotationTextAlignmentY.
+func AllAnnotationTextAlignmentYs() []AnnotationTextAlignmentY {
+	return []AnnotationTextAlignmentY{
+		AnnotationTextAlignmentYBottom,
+		AnnotationTextAlignmentYCenter,
+		AnnotationTextAlignmentYTop,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AnnotationTextAlignmentY.
+func (v AnnotationTextAlignmentY) IsValid() bool {
+	switch v {
+	case AnnotationTextAlignmentYBottom, AnnotationTextAlignmentYCenter, AnnotationTextAlignmentYTop:
+		return true
+	}
+	return false
+}
+
+// ParseAnnotationTextAlignmentY parses a AnnotationTextAlignmentY from a string, returning an error if the value is not known.
+func ParseAnnotationTextAlignmentY(s string) (AnnotationTextAlignmentY, error) {
+	v := AnnotationTextAlignmentY(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AnnotationTextAlignmentY %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AnnotationTextAlignmentY) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AnnotationTextAlignmentY) UnmarshalText(text []byte) error {
+	*v = AnnotationTextAlignmentY(text)
+	return nil
+}
+
 // AnnotationTextOptions: Options for annotation text
 type AnnotationTextOptions struct {
 	// PointSize: Text font's point size
@@ -499,18 +786,56 @@ const (
 	AnnotationTypeT3D AnnotationType = "t3d"
 )
 
+// AllAnnotationTypes returns all the known values of AnnotationType.
+func AllAnnotationTypes() []AnnotationType {
+	return []AnnotationType{
+		AnnotationTypeT2D,
+		AnnotationTypeT3D,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of AnnotationType.
+func (v AnnotationType) IsValid() bool {
+	switch v {
+	case AnnotationTypeT2D, AnnotationTypeT3D:
+		return true
+	}
+	return false
+}
+
+// ParseAnnotationType parses a AnnotationType from a string, returning an error if the value is not known.
+func ParseAnnotationType(s string) (AnnotationType, error) {
+	v := AnnotationType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid AnnotationType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v AnnotationType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *AnnotationType) UnmarshalText(text []byte) error {
+	*v = AnnotationType(text)
+	return nil
+}
+
 // Announcement: An announcement broadcast to all clients.
 type Announcement struct {
 	// Active:
 	Active bool `json:"active" yaml:"active" schema:"active,required"`
 	// Body:
-	Body string `json:"body" yaml:"body" schema:"body"`
+	Body *string `json:"body,omitempty" yaml:"body,omitempty" schema:"body,omitempty"`
 	// CreatedAt:
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// ID: A UUID usually v4 or v7
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Tag:
-	Tag string `json:"tag" yaml:"tag" schema:"tag"`
+	Tag *string `json:"tag,omitempty" yaml:"tag,omitempty" schema:"tag,omitempty"`
 	// Title:
 	Title string `json:"title" yaml:"title" schema:"title,required"`
 	// UpdatedAt:
@@ -526,7 +851,7 @@ type AnnouncementList struct {
 // AppClientInfo: Information about a third party app client.
 type AppClientInfo struct {
 	// Url: The URL for consent.
-	Url string `json:"url" yaml:"url" schema:"url"`
+	Url *string `json:"url,omitempty" yaml:"url,omitempty" schema:"url,omitempty"`
 }
 
 // AsyncAPICallOutput: AsyncAPICallOutput: The output from the async API call.
@@ -535,11 +860,11 @@ type AsyncAPICallOutput any
 // AsyncAPICallOutputCompletedAt: A file conversion.
 type AsyncAPICallOutputCompletedAt struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -547,15 +872,15 @@ type AsyncAPICallOutputCompletedAt struct {
 	// OutputFormat: The output format of the file conversion.
 	OutputFormat FileExportFormat `json:"output_format" yaml:"output_format" schema:"output_format,required"`
 	// OutputFormatOptions: The output format options of the file conversion.
-	OutputFormatOptions any `json:"output_format_options" yaml:"output_format_options" schema:"output_format_options"`
+	OutputFormatOptions any `json:"output_format_options,omitempty" yaml:"output_format_options,omitempty" schema:"output_format_options,omitempty"`
 	// Outputs: The converted files (if multiple file conversion), if completed, base64 encoded. The key of the map is the path of the output file.
-	Outputs map[string]Base64 `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]Base64 `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// SrcFormat: The source format of the file conversion.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// SrcFormatOptions: The source format options of the file conversion.
-	SrcFormatOptions any `json:"src_format_options" yaml:"src_format_options" schema:"src_format_options"`
+	SrcFormatOptions any `json:"src_format_options,omitempty" yaml:"src_format_options,omitempty" schema:"src_format_options,omitempty"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -569,13 +894,13 @@ type AsyncAPICallOutputCompletedAt struct {
 // AsyncAPICallOutputCreatedAt: File center of mass.
 type AsyncAPICallOutputCreatedAt struct {
 	// CenterOfMass: The resulting center of mass.
-	CenterOfMass Point3D `json:"center_of_mass" yaml:"center_of_mass" schema:"center_of_mass"`
+	CenterOfMass *Point3D `json:"center_of_mass,omitempty" yaml:"center_of_mass,omitempty" schema:"center_of_mass,omitempty"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -585,7 +910,7 @@ type AsyncAPICallOutputCreatedAt struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -599,19 +924,19 @@ type AsyncAPICallOutputCreatedAt struct {
 // AsyncAPICallOutputError: A file mass.
 type AsyncAPICallOutputError struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Mass: The resulting mass.
-	Mass float64 `json:"mass" yaml:"mass" schema:"mass"`
+	Mass *float64 `json:"mass,omitempty" yaml:"mass,omitempty" schema:"mass,omitempty"`
 	// MaterialDensity: The material density as denoted by the user.
-	MaterialDensity float64 `json:"material_density" yaml:"material_density" schema:"material_density"`
+	MaterialDensity *float64 `json:"material_density,omitempty" yaml:"material_density,omitempty" schema:"material_density,omitempty"`
 	// MaterialDensityUnit: The material density unit.
 	MaterialDensityUnit UnitDensity `json:"material_density_unit" yaml:"material_density_unit" schema:"material_density_unit,required"`
 	// OutputUnit: The output unit for the mass.
@@ -619,7 +944,7 @@ type AsyncAPICallOutputError struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -633,11 +958,11 @@ type AsyncAPICallOutputError struct {
 // AsyncAPICallOutputID: A file volume.
 type AsyncAPICallOutputID struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -647,7 +972,7 @@ type AsyncAPICallOutputID struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -657,25 +982,25 @@ type AsyncAPICallOutputID struct {
 	// UserID: The user ID of the user who created the API call.
 	UserID UUID `json:"user_id" yaml:"user_id" schema:"user_id,required"`
 	// Volume: The resulting volume.
-	Volume float64 `json:"volume" yaml:"volume" schema:"volume"`
+	Volume *float64 `json:"volume,omitempty" yaml:"volume,omitempty" schema:"volume,omitempty"`
 }
 
 // AsyncAPICallOutputOutputFormat: A file density.
 type AsyncAPICallOutputOutputFormat struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Density: The resulting density.
-	Density float64 `json:"density" yaml:"density" schema:"density"`
+	Density *float64 `json:"density,omitempty" yaml:"density,omitempty" schema:"density,omitempty"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// MaterialMass: The material mass as denoted by the user.
-	MaterialMass float64 `json:"material_mass" yaml:"material_mass" schema:"material_mass"`
+	MaterialMass *float64 `json:"material_mass,omitempty" yaml:"material_mass,omitempty" schema:"material_mass,omitempty"`
 	// MaterialMassUnit: The material mass unit.
 	MaterialMassUnit UnitMas `json:"material_mass_unit" yaml:"material_mass_unit" schema:"material_mass_unit,required"`
 	// OutputUnit: The output unit for the density.
@@ -683,7 +1008,7 @@ type AsyncAPICallOutputOutputFormat struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -697,11 +1022,11 @@ type AsyncAPICallOutputOutputFormat struct {
 // AsyncAPICallOutputOutputFormatOptions: A file surface area.
 type AsyncAPICallOutputOutputFormatOptions struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -711,11 +1036,11 @@ type AsyncAPICallOutputOutputFormatOptions struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// SurfaceArea: The resulting surface area.
-	SurfaceArea float64 `json:"surface_area" yaml:"surface_area" schema:"surface_area"`
+	SurfaceArea *float64 `json:"surface_area,omitempty" yaml:"surface_area,omitempty" schema:"surface_area,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -727,23 +1052,23 @@ type AsyncAPICallOutputOutputFormatOptions struct {
 // AsyncAPICallOutputOutputs: Text to CAD.
 type AsyncAPICallOutputOutputs struct {
 	// Code: The code for the model. This is optional but will be required in the future once we are at v1.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl requested.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
@@ -751,11 +1076,11 @@ type AsyncAPICallOutputOutputs struct {
 	// OutputFormat: The output format of the model.
 	OutputFormat FileExportFormat `json:"output_format" yaml:"output_format" schema:"output_format,required"`
 	// Outputs: The output of the model in the given file format the user requested, base64 encoded. The key of the map is the path of the output file.
-	Outputs map[string]Base64 `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]Base64 `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// Prompt: The prompt.
 	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -771,15 +1096,15 @@ type AsyncAPICallOutputSrcFormat struct {
 	// Code: The code for the new model.
 	Code string `json:"code" yaml:"code" schema:"code,required"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -791,11 +1116,11 @@ type AsyncAPICallOutputSrcFormat struct {
 	// OriginalSourceCode: The original source code for the model, previous to the changes.
 	OriginalSourceCode string `json:"original_source_code" yaml:"original_source_code" schema:"original_source_code,required"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -809,35 +1134,35 @@ type AsyncAPICallOutputSrcFormat struct {
 // AsyncAPICallOutputSrcFormatOptions: Text to CAD multi-file iteration.
 type AsyncAPICallOutputSrcFormatOptions struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
 	ModelVersion string `json:"model_version" yaml:"model_version" schema:"model_version,required"`
 	// Outputs: The output files. Returns a map of the file name to the file contents. The file contents are not encoded since kcl files are not binary.
-	Outputs map[string]string `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges. This will apply to all the files.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -851,7 +1176,7 @@ type AsyncAPICallOutputSrcFormatOptions struct {
 // AttachmentsLoaded is the type definition for a AttachmentsLoaded.
 type AttachmentsLoaded struct {
 	// RequestID: Optional backend-provided identifier to correlate request/response pairs.
-	RequestID string `json:"request_id" yaml:"request_id" schema:"request_id"`
+	RequestID *string `json:"request_id,omitempty" yaml:"request_id,omitempty" schema:"request_id,omitempty"`
 }
 
 // AuthAPIKeyResponse: The response from the `/auth/api-key` endpoint.
@@ -863,13 +1188,13 @@ type AuthAPIKeyResponse struct {
 // AuthCallback: The authentication callback from the OAuth 2.0 client. This is typically posted to the redirect URL as query params after authenticating.
 type AuthCallback struct {
 	// Code: The authorization code.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// IdToken: For Apple only, a JSON web token containing the user’s identity information.
-	IdToken string `json:"id_token" yaml:"id_token" schema:"id_token"`
+	IdToken *string `json:"id_token,omitempty" yaml:"id_token,omitempty" schema:"id_token,omitempty"`
 	// State: The state that we had passed in through the user consent URL.
-	State string `json:"state" yaml:"state" schema:"state"`
+	State *string `json:"state,omitempty" yaml:"state,omitempty" schema:"state,omitempty"`
 	// User: For Apple only, a JSON string containing the data requested in the scope property. The returned data is in the following format: `{ "name": { "firstName": string, "lastName": string }, "email": string }`
-	User string `json:"user" yaml:"user" schema:"user"`
+	User *string `json:"user,omitempty" yaml:"user,omitempty" schema:"user,omitempty"`
 }
 
 // Axi: Co-ordinate axis specifier.
@@ -885,6 +1210,44 @@ const (
 	AxiZ Axi = "z"
 )
 
+// AllAxis returns all the known values of Axi.
+func AllAxis() []Axi {
+	return []Axi{
+		AxiY,
+		AxiZ,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Axi.
+func (v Axi) IsValid() bool {
+	switch v {
+	case AxiY, AxiZ:
+		return true
+	}
+	return false
+}
+
+// ParseAxi parses a Axi from a string, returning an error if the value is not known.
+func ParseAxi(s string) (Axi, error) {
+	v := Axi(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Axi %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Axi) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Axi) UnmarshalText(text []byte) error {
+	*v = Axi(text)
+	return nil
+}
+
 // Axis is the type definition for a Axis.
 type Axis struct {
 	// Axis: Axis to use as mirror.
@@ -904,7 +1267,7 @@ type AxisDirectionPair struct {
 // BackendShutdown is the type definition for a BackendShutdown.
 type BackendShutdown struct {
 	// Reason: The reason given for the backend shutdown.
-	Reason string `json:"reason" yaml:"reason" schema:"reason"`
+	Reason *string `json:"reason,omitempty" yaml:"reason,omitempty" schema:"reason,omitempty"`
 }
 
 // BatchResponse: BatchResponse: Websocket responses can either be successful or unsuccessful. Slightly different schemas in either case.
@@ -940,6 +1303,46 @@ const (
 	BillingCadenceManual BillingCadence = "manual"
 )
 
+// AllBillingCadences returns all the known values of BillingCadence.
+func AllBillingCadences() []BillingCadence {
+	return []BillingCadence{
+		BillingCadenceAnnual,
+		BillingCadenceQuarterly,
+		BillingCadenceMonthly,
+		BillingCadenceManual,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingCadence.
+func (v BillingCadence) IsValid() bool {
+	switch v {
+	case BillingCadenceAnnual, BillingCadenceQuarterly, BillingCadenceMonthly, BillingCadenceManual:
+		return true
+	}
+	return false
+}
+
+// ParseBillingCadence parses a BillingCadence from a string, returning an error if the value is not known.
+func ParseBillingCadence(s string) (BillingCadence, error) {
+	v := BillingCadence(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingCadence %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingCadence) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingCadence) UnmarshalText(text []byte) error {
+	*v = BillingCadence(text)
+	return nil
+}
+
 // BillingCommitmentScope: How commitment funds are shared across contract items.
 type BillingCommitmentScope string
 
@@ -950,24 +1353,62 @@ const (
 	BillingCommitmentScopePerItem BillingCommitmentScope = "per_item"
 )
 
+// AllBillingCommitmentScopes returns all the known values of BillingCommitmentScope.
+func AllBillingCommitmentScopes() []BillingCommitmentScope {
+	return []BillingCommitmentScope{
+		BillingCommitmentScopePooled,
+		BillingCommitmentScopePerItem,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingCommitmentScope.
+func (v BillingCommitmentScope) IsValid() bool {
+	switch v {
+	case BillingCommitmentScopePooled, BillingCommitmentScopePerItem:
+		return true
+	}
+	return false
+}
+
+// ParseBillingCommitmentScope parses a BillingCommitmentScope from a string, returning an error if the value is not known.
+func ParseBillingCommitmentScope(s string) (BillingCommitmentScope, error) {
+	v := BillingCommitmentScope(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingCommitmentScope %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingCommitmentScope) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingCommitmentScope) UnmarshalText(text []byte) error {
+	*v = BillingCommitmentScope(text)
+	return nil
+}
+
 // BillingContractItemInput: Serialized line-item payload for a contract definition.
 type BillingContractItemInput struct {
 	// Active: Whether the item should participate in billing decisions immediately.
-	Active bool `json:"active" yaml:"active" schema:"active"`
+	Active *bool `json:"active,omitempty" yaml:"active,omitempty" schema:"active,omitempty"`
 	// BillingUnitGranularity: Optional normalization rule used before rating usage.
-	BillingUnitGranularity BillingUnitGranularity `json:"billing_unit_granularity" yaml:"billing_unit_granularity" schema:"billing_unit_granularity"`
+	BillingUnitGranularity *BillingUnitGranularity `json:"billing_unit_granularity,omitempty" yaml:"billing_unit_granularity,omitempty" schema:"billing_unit_granularity,omitempty"`
 	// Code: Canonical item code so later metering can find the right price row.
 	Code BillingItemCode `json:"code" yaml:"code" schema:"code,required"`
 	// DisplayName: Human-readable name shown in finance tooling.
 	DisplayName string `json:"display_name" yaml:"display_name" schema:"display_name,required"`
 	// FixedFeeAmount: Fixed fee charged for the item when the kind is `fixed_fee`.
-	FixedFeeAmount float64 `json:"fixed_fee_amount" yaml:"fixed_fee_amount" schema:"fixed_fee_amount"`
+	FixedFeeAmount *float64 `json:"fixed_fee_amount,omitempty" yaml:"fixed_fee_amount,omitempty" schema:"fixed_fee_amount,omitempty"`
 	// IsCommitmentEligible: Whether usage from this item may burn down contract commitment.
-	IsCommitmentEligible bool `json:"is_commitment_eligible" yaml:"is_commitment_eligible" schema:"is_commitment_eligible"`
+	IsCommitmentEligible *bool `json:"is_commitment_eligible,omitempty" yaml:"is_commitment_eligible,omitempty" schema:"is_commitment_eligible,omitempty"`
 	// Kind: Pricing model for this item.
 	Kind BillingItemKind `json:"kind" yaml:"kind" schema:"kind,required"`
 	// RateTiers: Pricing tiers for usage-rated items.
-	RateTiers []BillingRateTierInput `json:"rate_tiers" yaml:"rate_tiers" schema:"rate_tiers"`
+	RateTiers []BillingRateTierInput `json:"rate_tiers,omitempty" yaml:"rate_tiers,omitempty" schema:"rate_tiers,omitempty"`
 	// Unit: Base measurement unit for pricing and usage.
 	Unit BillingUnit `json:"unit" yaml:"unit" schema:"unit,required"`
 }
@@ -977,13 +1418,13 @@ type BillingContractItemView struct {
 	// Active: Whether the item is active.
 	Active bool `json:"active" yaml:"active" schema:"active,required"`
 	// BillingUnitGranularity: Optional normalization rule for usage.
-	BillingUnitGranularity BillingUnitGranularity `json:"billing_unit_granularity" yaml:"billing_unit_granularity" schema:"billing_unit_granularity"`
+	BillingUnitGranularity *BillingUnitGranularity `json:"billing_unit_granularity,omitempty" yaml:"billing_unit_granularity,omitempty" schema:"billing_unit_granularity,omitempty"`
 	// Code: Canonical item code.
 	Code BillingItemCode `json:"code" yaml:"code" schema:"code,required"`
 	// DisplayName: Human-readable item name.
 	DisplayName string `json:"display_name" yaml:"display_name" schema:"display_name,required"`
 	// FixedFeeAmount: Fixed fee charged for the item when applicable.
-	FixedFeeAmount float64 `json:"fixed_fee_amount" yaml:"fixed_fee_amount" schema:"fixed_fee_amount"`
+	FixedFeeAmount *float64 `json:"fixed_fee_amount,omitempty" yaml:"fixed_fee_amount,omitempty" schema:"fixed_fee_amount,omitempty"`
 	// ID: Database identifier for the contract item row.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// IsCommitmentEligible: Whether this item can consume commitment.
@@ -1012,7 +1453,50 @@ const (
 	BillingContractStatusCanceled BillingContractStatus = "canceled"
 )
 
+// AllBillingContractStatuses returns all the known values of BillingContractStatus.
+func AllBillingContractStatuses() []BillingContractStatus {
+	return []BillingContractStatus{
+		BillingContractStatusDraft,
+		BillingContractStatusScheduled,
+		BillingContractStatusActive,
+		BillingContractStatusClosed,
+		BillingContractStatusCanceled,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingContractStatus.
+func (v BillingContractStatus) IsValid() bool {
+	switch v {
+	case BillingContractStatusDraft, BillingContractStatusScheduled, BillingContractStatusActive, BillingContractStatusClosed, BillingContractStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// ParseBillingContractStatus parses a BillingContractStatus from a string, returning an error if the value is not known.
+func ParseBillingContractStatus(s string) (BillingContractStatus, error) {
+	v := BillingContractStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingContractStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingContractStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingContractStatus) UnmarshalText(text []byte) error {
+	*v = BillingContractStatus(text)
+	return nil
+}
+
 // BillingContractUpsert: Complete contract payload used to create or replace an org's contract.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type BillingContractUpsert struct {
 	// BillingCadence: Operational cadence used for finance workflows.
 	BillingCadence BillingCadence `json:"billing_cadence" yaml:"billing_cadence" schema:"billing_cadence,required"`
@@ -1021,17 +1505,17 @@ type BillingContractUpsert struct {
 	// Currency: Contract currency shared by every money field in this definition.
 	Currency string `json:"currency" yaml:"currency" schema:"currency,required"`
 	// DiscountDescription: Free-form finance note for discounts or negotiated pricing.
-	DiscountDescription string `json:"discount_description" yaml:"discount_description" schema:"discount_description"`
+	DiscountDescription *string `json:"discount_description,omitempty" yaml:"discount_description,omitempty" schema:"discount_description,omitempty"`
 	// EffectiveAt: Timestamp when the contract starts to apply.
 	EffectiveAt Time `json:"effective_at" yaml:"effective_at" schema:"effective_at,required"`
 	// ExternalCustomerID: Provider-owned customer reference, when one already exists.
-	ExternalCustomerID string `json:"external_customer_id" yaml:"external_customer_id" schema:"external_customer_id"`
+	ExternalCustomerID *string `json:"external_customer_id,omitempty" yaml:"external_customer_id,omitempty" schema:"external_customer_id,omitempty"`
 	// Items: Billable items attached to the contract.
 	Items []BillingContractItemInput `json:"items" yaml:"items" schema:"items,required"`
 	// Name: Human-readable contract label.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// Notes: Internal notes about the contract.
-	Notes string `json:"notes" yaml:"notes" schema:"notes"`
+	Notes *string `json:"notes,omitempty" yaml:"notes,omitempty" schema:"notes,omitempty"`
 	// Periods: Period schedule for the contract term.
 	Periods []BillingPeriodInput `json:"periods" yaml:"periods" schema:"periods,required"`
 	// Provider: Downstream provider responsible for collecting the invoice.
@@ -1057,17 +1541,17 @@ type BillingContractView struct {
 	// Currency: Currency shared by every money field in the contract.
 	Currency string `json:"currency" yaml:"currency" schema:"currency,required"`
 	// DiscountDescription: Discount note associated with the contract.
-	DiscountDescription string `json:"discount_description" yaml:"discount_description" schema:"discount_description"`
+	DiscountDescription *string `json:"discount_description,omitempty" yaml:"discount_description,omitempty" schema:"discount_description,omitempty"`
 	// EffectiveAt: Timestamp when the contract started applying.
 	EffectiveAt Time `json:"effective_at" yaml:"effective_at" schema:"effective_at,required"`
 	// ExternalCustomerID: Provider-owned customer reference, when one exists.
-	ExternalCustomerID string `json:"external_customer_id" yaml:"external_customer_id" schema:"external_customer_id"`
+	ExternalCustomerID *string `json:"external_customer_id,omitempty" yaml:"external_customer_id,omitempty" schema:"external_customer_id,omitempty"`
 	// Items: Billable items attached to the contract.
 	Items []BillingContractItemView `json:"items" yaml:"items" schema:"items,required"`
 	// Name: Human-readable contract label.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// Notes: Internal notes for the contract.
-	Notes string `json:"notes" yaml:"notes" schema:"notes"`
+	Notes *string `json:"notes,omitempty" yaml:"notes,omitempty" schema:"notes,omitempty"`
 	// Periods: Period schedule for the contract.
 	Periods []BillingPeriodView `json:"periods" yaml:"periods" schema:"periods,required"`
 	// Provider: Downstream invoice provider.
@@ -1081,13 +1565,15 @@ type BillingContractView struct {
 }
 
 // BillingInfo: The billing information for payments.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type BillingInfo struct {
 	// Address: The address of the customer.
-	Address AddressDetails `json:"address" yaml:"address" schema:"address"`
+	Address *AddressDetails `json:"address,omitempty" yaml:"address,omitempty" schema:"address,omitempty"`
 	// Name: The name of the customer.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The phone for the customer.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 }
 
 // BillingItemCode: Canonical product or service code for a contract item.
@@ -1106,6 +1592,47 @@ const (
 	BillingItemCodeLicensedAPICredits BillingItemCode = "licensed_api_credits"
 )
 
+// AllBillingItemCodes returns all the known values of BillingItemCode.
+func AllBillingItemCodes() []BillingItemCode {
+	return []BillingItemCode{
+		BillingItemCodeEnterpriseSupport,
+		BillingItemCodeFde,
+		BillingItemCodeGovcloudManagement,
+		BillingItemCodeFileIngestionConversion,
+		BillingItemCodeLicensedAPICredits,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingItemCode.
+func (v BillingItemCode) IsValid() bool {
+	switch v {
+	case BillingItemCodeEnterpriseSupport, BillingItemCodeFde, BillingItemCodeGovcloudManagement, BillingItemCodeFileIngestionConversion, BillingItemCodeLicensedAPICredits:
+		return true
+	}
+	return false
+}
+
+// ParseBillingItemCode parses a BillingItemCode from a string, returning an error if the value is not known.
+func ParseBillingItemCode(s string) (BillingItemCode, error) {
+	v := BillingItemCode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingItemCode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingItemCode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingItemCode) UnmarshalText(text []byte) error {
+	*v = BillingItemCode(text)
+	return nil
+}
+
 // BillingItemKind: Pricing model used by a contract item.
 type BillingItemKind string
 
@@ -1118,6 +1645,45 @@ const (
 	BillingItemKindUsageCommitmentBucket BillingItemKind = "usage_commitment_bucket"
 )
 
+// AllBillingItemKinds returns all the known values of BillingItemKind.
+func AllBillingItemKinds() []BillingItemKind {
+	return []BillingItemKind{
+		BillingItemKindFixedFee,
+		BillingItemKindUsageTiered,
+		BillingItemKindUsageCommitmentBucket,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingItemKind.
+func (v BillingItemKind) IsValid() bool {
+	switch v {
+	case BillingItemKindFixedFee, BillingItemKindUsageTiered, BillingItemKindUsageCommitmentBucket:
+		return true
+	}
+	return false
+}
+
+// ParseBillingItemKind parses a BillingItemKind from a string, returning an error if the value is not known.
+func ParseBillingItemKind(s string) (BillingItemKind, error) {
+	v := BillingItemKind(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingItemKind %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingItemKind) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingItemKind) UnmarshalText(text []byte) error {
+	*v = BillingItemKind(text)
+	return nil
+}
+
 // BillingPeriodInput: Serialized billing period payload for a contract definition.
 type BillingPeriodInput struct {
 	// CommitmentAmount: New commitment funded for this period.
@@ -1129,11 +1695,11 @@ type BillingPeriodInput struct {
 	// PeriodStartAt: Inclusive period start timestamp.
 	PeriodStartAt Time `json:"period_start_at" yaml:"period_start_at" schema:"period_start_at,required"`
 	// RolloverInAmount: Commitment carried in from an earlier period.
-	RolloverInAmount float64 `json:"rollover_in_amount" yaml:"rollover_in_amount" schema:"rollover_in_amount"`
+	RolloverInAmount *float64 `json:"rollover_in_amount,omitempty" yaml:"rollover_in_amount,omitempty" schema:"rollover_in_amount,omitempty"`
 	// RolloverOutAmount: Commitment intentionally rolled out to a later period.
-	RolloverOutAmount float64 `json:"rollover_out_amount" yaml:"rollover_out_amount" schema:"rollover_out_amount"`
+	RolloverOutAmount *float64 `json:"rollover_out_amount,omitempty" yaml:"rollover_out_amount,omitempty" schema:"rollover_out_amount,omitempty"`
 	// Status: Operational status for the period.
-	Status BillingPeriodStatus `json:"status" yaml:"status" schema:"status"`
+	Status *BillingPeriodStatus `json:"status,omitempty" yaml:"status,omitempty" schema:"status,omitempty"`
 }
 
 // BillingPeriodStatus: Operational status for a contract billing period.
@@ -1146,6 +1712,44 @@ const (
 	BillingPeriodStatusClosed BillingPeriodStatus = "closed"
 )
 
+// AllBillingPeriodStatuses returns all the known values of BillingPeriodStatus.
+func AllBillingPeriodStatuses() []BillingPeriodStatus {
+	return []BillingPeriodStatus{
+		BillingPeriodStatusOpen,
+		BillingPeriodStatusClosed,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingPeriodStatus.
+func (v BillingPeriodStatus) IsValid() bool {
+	switch v {
+	case BillingPeriodStatusOpen, BillingPeriodStatusClosed:
+		return true
+	}
+	return false
+}
+
+// ParseBillingPeriodStatus parses a BillingPeriodStatus from a string, returning an error if the value is not known.
+func ParseBillingPeriodStatus(s string) (BillingPeriodStatus, error) {
+	v := BillingPeriodStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingPeriodStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingPeriodStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingPeriodStatus) UnmarshalText(text []byte) error {
+	*v = BillingPeriodStatus(text)
+	return nil
+}
+
 // BillingPeriodView: Serialized billing period returned from a stored contract.
 type BillingPeriodView struct {
 	// CommitmentAmount: New commitment funded for this period.
@@ -1176,10 +1780,48 @@ const (
 	BillingProviderManualInvoice BillingProvider = "manual_invoice"
 )
 
+// AllBillingProviders returns all the known values of BillingProvider.
+func AllBillingProviders() []BillingProvider {
+	return []BillingProvider{
+		BillingProviderStripe,
+		BillingProviderManualInvoice,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingProvider.
+func (v BillingProvider) IsValid() bool {
+	switch v {
+	case BillingProviderStripe, BillingProviderManualInvoice:
+		return true
+	}
+	return false
+}
+
+// ParseBillingProvider parses a BillingProvider from a string, returning an error if the value is not known.
+func ParseBillingProvider(s string) (BillingProvider, error) {
+	v := BillingProvider(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingProvider %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingProvider) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingProvider) UnmarshalText(text []byte) error {
+	*v = BillingProvider(text)
+	return nil
+}
+
 // BillingRateTierInput: Serialized rate tier payload for a usage-rated contract item.
 type BillingRateTierInput struct {
 	// TierEndExclusive: Exclusive upper bound for the tier, or `None` when the tier is open-ended.
-	TierEndExclusive int `json:"tier_end_exclusive" yaml:"tier_end_exclusive" schema:"tier_end_exclusive"`
+	TierEndExclusive *int `json:"tier_end_exclusive,omitempty" yaml:"tier_end_exclusive,omitempty" schema:"tier_end_exclusive,omitempty"`
 	// TierStartInclusive: First billable quantity in this tier.
 	TierStartInclusive int `json:"tier_start_inclusive" yaml:"tier_start_inclusive" schema:"tier_start_inclusive,required"`
 	// UnitPrice: Price to charge for each unit that lands in this tier.
@@ -1191,7 +1833,7 @@ type BillingRateTierView struct {
 	// ID: Database identifier for the tier row.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// TierEndExclusive: Exclusive upper bound for the tier, or `None` when the tier is open-ended.
-	TierEndExclusive int `json:"tier_end_exclusive" yaml:"tier_end_exclusive" schema:"tier_end_exclusive"`
+	TierEndExclusive *int `json:"tier_end_exclusive,omitempty" yaml:"tier_end_exclusive,omitempty" schema:"tier_end_exclusive,omitempty"`
 	// TierStartInclusive: First billable quantity in this tier.
 	TierStartInclusive int `json:"tier_start_inclusive" yaml:"tier_start_inclusive" schema:"tier_start_inclusive,required"`
 	// UnitPrice: Price charged for each unit in the tier.
@@ -1208,6 +1850,44 @@ const (
 	BillingRolloverPolicyYear1ToYear2Once BillingRolloverPolicy = "year1_to_year2_once"
 )
 
+// AllBillingRolloverPolicies returns all the known values of BillingRolloverPolicy.
+func AllBillingRolloverPolicies() []BillingRolloverPolicy {
+	return []BillingRolloverPolicy{
+		BillingRolloverPolicyNone,
+		BillingRolloverPolicyYear1ToYear2Once,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingRolloverPolicy.
+func (v BillingRolloverPolicy) IsValid() bool {
+	switch v {
+	case BillingRolloverPolicyNone, BillingRolloverPolicyYear1ToYear2Once:
+		return true
+	}
+	return false
+}
+
+// ParseBillingRolloverPolicy parses a BillingRolloverPolicy from a string, returning an error if the value is not known.
+func ParseBillingRolloverPolicy(s string) (BillingRolloverPolicy, error) {
+	v := BillingRolloverPolicy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingRolloverPolicy %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingRolloverPolicy) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingRolloverPolicy) UnmarshalText(text []byte) error {
+	*v = BillingRolloverPolicy(text)
+	return nil
+}
+
 // BillingUnit: Base unit that measured usage or pricing is expressed in.
 type BillingUnit string
 
@@ -1224,6 +1904,47 @@ const (
 	BillingUnitPeriod BillingUnit = "period"
 )
 
+// AllBillingUnits returns all the known values of BillingUnit.
+func AllBillingUnits() []BillingUnit {
+	return []BillingUnit{
+		BillingUnitFile,
+		BillingUnitMinute,
+		BillingUnitSecond,
+		BillingUnitYear,
+		BillingUnitPeriod,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingUnit.
+func (v BillingUnit) IsValid() bool {
+	switch v {
+	case BillingUnitFile, BillingUnitMinute, BillingUnitSecond, BillingUnitYear, BillingUnitPeriod:
+		return true
+	}
+	return false
+}
+
+// ParseBillingUnit parses a BillingUnit from a string, returning an error if the value is not known.
+func ParseBillingUnit(s string) (BillingUnit, error) {
+	v := BillingUnit(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingUnit %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingUnit) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingUnit) UnmarshalText(text []byte) error {
+	*v = BillingUnit(text)
+	return nil
+}
+
 // BillingUnitGranularity: Optional finer-grained measurement rule for a billed unit.
 type BillingUnitGranularity string
 
@@ -1234,6 +1955,44 @@ const (
 	BillingUnitGranularitySecond BillingUnitGranularity = "second"
 )
 
+// AllBillingUnitGranularities returns all the known values of BillingUnitGranularity.
+func AllBillingUnitGranularities() []BillingUnitGranularity {
+	return []BillingUnitGranularity{
+		BillingUnitGranularityMinute,
+		BillingUnitGranularitySecond,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BillingUnitGranularity.
+func (v BillingUnitGranularity) IsValid() bool {
+	switch v {
+	case BillingUnitGranularityMinute, BillingUnitGranularitySecond:
+		return true
+	}
+	return false
+}
+
+// ParseBillingUnitGranularity parses a BillingUnitGranularity from a string, returning an error if the value is not known.
+func ParseBillingUnitGranularity(s string) (BillingUnitGranularity, error) {
+	v := BillingUnitGranularity(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BillingUnitGranularity %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BillingUnitGranularity) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BillingUnitGranularity) UnmarshalText(text []byte) error {
+	*v = BillingUnitGranularity(text)
+	return nil
+}
+
 // BlendType: What kind of blend to do
 type BlendType string
 
@@ -1242,6 +2001,43 @@ const (
 	BlendTypeTangent BlendType = "tangent"
 )
 
+// AllBlendTypes returns all the known values of BlendType.
+func AllBlendTypes() []BlendType {
+	return []BlendType{
+		BlendTypeTangent,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BlendType.
+func (v BlendType) IsValid() bool {
+	switch v {
+	case BlendTypeTangent:
+		return true
+	}
+	return false
+}
+
+// ParseBlendType parses a BlendType from a string, returning an error if the value is not known.
+func ParseBlendType(s string) (BlendType, error) {
+	v := BlendType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BlendType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BlendType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BlendType) UnmarshalText(text []byte) error {
+	*v = BlendType(text)
+	return nil
+}
+
 // BlockReason: The reason for blocking a user.
 type BlockReason string
 
@@ -1260,6 +2056,48 @@ const (
 	BlockReasonAdmin BlockReason = "admin"
 )
 
+// AllBlockReasons returns all the known values of BlockReason.
+func AllBlockReasons() []BlockReason {
+	return []BlockReason{
+		BlockReasonMissingPaymentMethod,
+		BlockReasonPaymentMethodFailed,
+		BlockReasonBillingThresholdReached,
+		BlockReasonPayAsYouGoDisabled,
+		BlockReasonUpgradeDowngradeAbuse,
+		BlockReasonAdmin,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BlockReason.
+func (v BlockReason) IsValid() bool {
+	switch v {
+	case BlockReasonMissingPaymentMethod, BlockReasonPaymentMethodFailed, BlockReasonBillingThresholdReached, BlockReasonPayAsYouGoDisabled, BlockReasonUpgradeDowngradeAbuse, BlockReasonAdmin:
+		return true
+	}
+	return false
+}
+
+// ParseBlockReason parses a BlockReason from a string, returning an error if the value is not known.
+func ParseBlockReason(s string) (BlockReason, error) {
+	v := BlockReason(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BlockReason %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BlockReason) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BlockReason) UnmarshalText(text []byte) error {
+	*v = BlockReason(text)
+	return nil
+}
+
 // BodiesCreated: List of bodies that were created by an operation.
 type BodiesCreated struct {
 	// Bodies: All bodies created by this operation.
@@ -1290,6 +2128,44 @@ const (
 	BodyTypeSurface BodyType = "surface"
 )
 
+// AllBodyTypes returns all the known values of BodyType.
+func AllBodyTypes() []BodyType {
+	return []BodyType{
+		BodyTypeSolid,
+		BodyTypeSurface,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of BodyType.
+func (v BodyType) IsValid() bool {
+	switch v {
+	case BodyTypeSolid, BodyTypeSurface:
+		return true
+	}
+	return false
+}
+
+// ParseBodyType parses a BodyType from a string, returning an error if the value is not known.
+func ParseBodyType(s string) (BodyType, error) {
+	v := BodyType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid BodyType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v BodyType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *BodyType) UnmarshalText(text []byte) error {
+	*v = BodyType(text)
+	return nil
+}
+
 // BodyUpdated: Details of a body that was updated.
 type BodyUpdated struct {
 	// ID: The body's ID.
@@ -1301,33 +2177,33 @@ type BodyUpdated struct {
 // BooleanImprint: The response from the 'BooleanImprint'.
 type BooleanImprint struct {
 	// AnyIntersections: If the operation involved any intersecting solids.
-	AnyIntersections bool `json:"any_intersections" yaml:"any_intersections" schema:"any_intersections"`
+	AnyIntersections *bool `json:"any_intersections,omitempty" yaml:"any_intersections,omitempty" schema:"any_intersections,omitempty"`
 	// ExtraSolidIds: If the operation produced just one body, then its ID will be the ID of the modeling command request. But if any extra bodies are produced, then their IDs will be included here.
-	ExtraSolidIds []UUID `json:"extra_solid_ids" yaml:"extra_solid_ids" schema:"extra_solid_ids"`
+	ExtraSolidIds []UUID `json:"extra_solid_ids,omitempty" yaml:"extra_solid_ids,omitempty" schema:"extra_solid_ids,omitempty"`
 }
 
 // BooleanIntersection: The response from the 'BooleanIntersection'.
 type BooleanIntersection struct {
 	// AnyIntersections: If the operation involved any intersecting solids.
-	AnyIntersections bool `json:"any_intersections" yaml:"any_intersections" schema:"any_intersections"`
+	AnyIntersections *bool `json:"any_intersections,omitempty" yaml:"any_intersections,omitempty" schema:"any_intersections,omitempty"`
 	// ExtraSolidIds: If the operation produced just one solid, then its ID will be the ID of the modeling command request. But if any extra solids are produced, then their IDs will be included here.
-	ExtraSolidIds []UUID `json:"extra_solid_ids" yaml:"extra_solid_ids" schema:"extra_solid_ids"`
+	ExtraSolidIds []UUID `json:"extra_solid_ids,omitempty" yaml:"extra_solid_ids,omitempty" schema:"extra_solid_ids,omitempty"`
 }
 
 // BooleanSubtract: The response from the 'BooleanSubtract'.
 type BooleanSubtract struct {
 	// AnyIntersections: If the operation involved any intersecting solids.
-	AnyIntersections bool `json:"any_intersections" yaml:"any_intersections" schema:"any_intersections"`
+	AnyIntersections *bool `json:"any_intersections,omitempty" yaml:"any_intersections,omitempty" schema:"any_intersections,omitempty"`
 	// ExtraSolidIds: If the operation produced just one solid, then its ID will be the ID of the modeling command request. But if any extra solids are produced, then their IDs will be included here.
-	ExtraSolidIds []UUID `json:"extra_solid_ids" yaml:"extra_solid_ids" schema:"extra_solid_ids"`
+	ExtraSolidIds []UUID `json:"extra_solid_ids,omitempty" yaml:"extra_solid_ids,omitempty" schema:"extra_solid_ids,omitempty"`
 }
 
 // BooleanUnion: The response from the 'BooleanUnion'.
 type BooleanUnion struct {
 	// AnyIntersections: If the operation involved any intersecting solids.
-	AnyIntersections bool `json:"any_intersections" yaml:"any_intersections" schema:"any_intersections"`
+	AnyIntersections *bool `json:"any_intersections,omitempty" yaml:"any_intersections,omitempty" schema:"any_intersections,omitempty"`
 	// ExtraSolidIds: If the operation produced just one solid, then its ID will be the ID of the modeling command request. But if any extra solids are produced, then their IDs will be included here.
-	ExtraSolidIds []UUID `json:"extra_solid_ids" yaml:"extra_solid_ids" schema:"extra_solid_ids"`
+	ExtraSolidIds []UUID `json:"extra_solid_ids,omitempty" yaml:"extra_solid_ids,omitempty" schema:"extra_solid_ids,omitempty"`
 }
 
 // BoundingBox: The response from the 'BoundingBox'.
@@ -1352,6 +2228,46 @@ const (
 	CadDesignWorkflowHybridApproach CadDesignWorkflow = "hybrid_approach"
 )
 
+// AllCadDesignWorkflows returns all the known values of CadDesignWorkflow.
+func AllCadDesignWorkflows() []CadDesignWorkflow {
+	return []CadDesignWorkflow{
+		CadDesignWorkflowSketching,
+		CadDesignWorkflowCoding,
+		CadDesignWorkflowAi,
+		CadDesignWorkflowHybridApproach,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CadDesignWorkflow.
+func (v CadDesignWorkflow) IsValid() bool {
+	switch v {
+	case CadDesignWorkflowSketching, CadDesignWorkflowCoding, CadDesignWorkflowAi, CadDesignWorkflowHybridApproach:
+		return true
+	}
+	return false
+}
+
+// ParseCadDesignWorkflow parses a CadDesignWorkflow from a string, returning an error if the value is not known.
+func ParseCadDesignWorkflow(s string) (CadDesignWorkflow, error) {
+	v := CadDesignWorkflow(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CadDesignWorkflow %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CadDesignWorkflow) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CadDesignWorkflow) UnmarshalText(text []byte) error {
+	*v = CadDesignWorkflow(text)
+	return nil
+}
+
 // CadDiscoverySource: Strict acquisition-source enum used by the website CAD user info form.
 type CadDiscoverySource string
 
@@ -1378,6 +2294,52 @@ const (
 	CadDiscoverySourceOther CadDiscoverySource = "other"
 )
 
+// AllCadDiscoverySources returns all the known values of CadDiscoverySource.
+func AllCadDiscoverySources() []CadDiscoverySource {
+	return []CadDiscoverySource{
+		CadDiscoverySourceGoogle,
+		CadDiscoverySourceX,
+		CadDiscoverySourceTiktok,
+		CadDiscoverySourceReddit,
+		CadDiscoverySourcePayloadSpace,
+		CadDiscoverySourceYoutube,
+		CadDiscoverySourceInstagram,
+		CadDiscoverySourceFacebook,
+		CadDiscoverySourceWordOfMouth,
+		CadDiscoverySourceOther,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CadDiscoverySource.
+func (v CadDiscoverySource) IsValid() bool {
+	switch v {
+	case CadDiscoverySourceGoogle, CadDiscoverySourceX, CadDiscoverySourceTiktok, CadDiscoverySourceReddit, CadDiscoverySourcePayloadSpace, CadDiscoverySourceYoutube, CadDiscoverySourceInstagram, CadDiscoverySourceFacebook, CadDiscoverySourceWordOfMouth, CadDiscoverySourceOther:
+		return true
+	}
+	return false
+}
+
+// ParseCadDiscoverySource parses a CadDiscoverySource from a string, returning an error if the value is not known.
+func ParseCadDiscoverySource(s string) (CadDiscoverySource, error) {
+	v := CadDiscoverySource(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CadDiscoverySource %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CadDiscoverySource) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CadDiscoverySource) UnmarshalText(text []byte) error {
+	*v = CadDiscoverySource(text)
+	return nil
+}
+
 // CadExperienceLevel: Strict CAD/API experience-level enum for onboarding/CRM form submissions.
 type CadExperienceLevel string
 
@@ -1390,6 +2352,45 @@ const (
 	CadExperienceLevelAdvanced CadExperienceLevel = "advanced"
 )
 
+// AllCadExperienceLevels returns all the known values of CadExperienceLevel.
+func AllCadExperienceLevels() []CadExperienceLevel {
+	return []CadExperienceLevel{
+		CadExperienceLevelBeginner,
+		CadExperienceLevelIntermediate,
+		CadExperienceLevelAdvanced,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CadExperienceLevel.
+func (v CadExperienceLevel) IsValid() bool {
+	switch v {
+	case CadExperienceLevelBeginner, CadExperienceLevelIntermediate, CadExperienceLevelAdvanced:
+		return true
+	}
+	return false
+}
+
+// ParseCadExperienceLevel parses a CadExperienceLevel from a string, returning an error if the value is not known.
+func ParseCadExperienceLevel(s string) (CadExperienceLevel, error) {
+	v := CadExperienceLevel(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CadExperienceLevel %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CadExperienceLevel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CadExperienceLevel) UnmarshalText(text []byte) error {
+	*v = CadExperienceLevel(text)
+	return nil
+}
+
 // CadIndustry: Strict CAD industry enum for onboarding/CRM form submissions.
 type CadIndustry string
 
@@ -1416,6 +2417,52 @@ const (
 	CadIndustryOther CadIndustry = "other"
 )
 
+// AllCadIndustries returns all the known values of CadIndustry.
+func AllCadIndustries() []CadIndustry {
+	return []CadIndustry{
+		CadIndustryMechanicalEngineering,
+		CadIndustryManufacturing,
+		CadIndustryAutomotive,
+		CadIndustryAerospace,
+		CadIndustryCivilEngineering,
+		CadIndustryElectricalEngineering,
+		CadIndustryConstruction,
+		CadIndustryProductDesign,
+		CadIndustryArchitecture,
+		CadIndustryOther,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CadIndustry.
+func (v CadIndustry) IsValid() bool {
+	switch v {
+	case CadIndustryMechanicalEngineering, CadIndustryManufacturing, CadIndustryAutomotive, CadIndustryAerospace, CadIndustryCivilEngineering, CadIndustryElectricalEngineering, CadIndustryConstruction, CadIndustryProductDesign, CadIndustryArchitecture, CadIndustryOther:
+		return true
+	}
+	return false
+}
+
+// ParseCadIndustry parses a CadIndustry from a string, returning an error if the value is not known.
+func ParseCadIndustry(s string) (CadIndustry, error) {
+	v := CadIndustry(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CadIndustry %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CadIndustry) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CadIndustry) UnmarshalText(text []byte) error {
+	*v = CadIndustry(text)
+	return nil
+}
+
 // CadUserType: Strict CAD user-type enum for onboarding/CRM form submissions.
 type CadUserType string
 
@@ -1428,6 +2475,45 @@ const (
 	CadUserTypeProfessional CadUserType = "professional"
 )
 
+// AllCadUserTypes returns all the known values of CadUserType.
+func AllCadUserTypes() []CadUserType {
+	return []CadUserType{
+		CadUserTypeStudentOrResearcher,
+		CadUserTypeHobbyist,
+		CadUserTypeProfessional,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CadUserType.
+func (v CadUserType) IsValid() bool {
+	switch v {
+	case CadUserTypeStudentOrResearcher, CadUserTypeHobbyist, CadUserTypeProfessional:
+		return true
+	}
+	return false
+}
+
+// ParseCadUserType parses a CadUserType from a string, returning an error if the value is not known.
+func ParseCadUserType(s string) (CadUserType, error) {
+	v := CadUserType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CadUserType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CadUserType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CadUserType) UnmarshalText(text []byte) error {
+	*v = CadUserType(text)
+	return nil
+}
+
 // CameraDragEnd: The response from the `CameraDragEnd` command.
 type CameraDragEnd struct {
 	// Settings: Camera settings
@@ -1448,6 +2534,46 @@ const (
 	CameraDragInteractionTypeZoom CameraDragInteractionType = "zoom"
 )
 
+// AllCameraDragInteractionTypes returns all the known values of CameraDragInteractionType.
+func AllCameraDragInteractionTypes() []CameraDragInteractionType {
+	return []CameraDragInteractionType{
+		CameraDragInteractionTypePan,
+		CameraDragInteractionTypeRotate,
+		CameraDragInteractionTypeRotatetrackball,
+		CameraDragInteractionTypeZoom,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CameraDragInteractionType.
+func (v CameraDragInteractionType) IsValid() bool {
+	switch v {
+	case CameraDragInteractionTypePan, CameraDragInteractionTypeRotate, CameraDragInteractionTypeRotatetrackball, CameraDragInteractionTypeZoom:
+		return true
+	}
+	return false
+}
+
+// ParseCameraDragInteractionType parses a CameraDragInteractionType from a string, returning an error if the value is not known.
+func ParseCameraDragInteractionType(s string) (CameraDragInteractionType, error) {
+	v := CameraDragInteractionType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CameraDragInteractionType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CameraDragInteractionType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CameraDragInteractionType) UnmarshalText(text []byte) error {
+	*v = CameraDragInteractionType(text)
+	return nil
+}
+
 // CameraDragMove: The response from the `CameraDragMove` command. Note this is an "unreliable" channel message, so this data may need more data like a "sequence"
 type CameraDragMove struct {
 	// Settings: Camera settings
@@ -1468,18 +2594,56 @@ const (
 	CameraMovementNone CameraMovement = "none"
 )
 
+// AllCameraMovements returns all the known values of CameraMovement.
+func AllCameraMovements() []CameraMovement {
+	return []CameraMovement{
+		CameraMovementVantage,
+		CameraMovementNone,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CameraMovement.
+func (v CameraMovement) IsValid() bool {
+	switch v {
+	case CameraMovementVantage, CameraMovementNone:
+		return true
+	}
+	return false
+}
+
+// ParseCameraMovement parses a CameraMovement from a string, returning an error if the value is not known.
+func ParseCameraMovement(s string) (CameraMovement, error) {
+	v := CameraMovement(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CameraMovement %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CameraMovement) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CameraMovement) UnmarshalText(text []byte) error {
+	*v = CameraMovement(text)
+	return nil
+}
+
 // CameraSettings: Camera settings including position, center, fov etc
 type CameraSettings struct {
 	// Center: Camera's look-at center (center-pos gives viewing vector)
 	Center Point3D `json:"center" yaml:"center" schema:"center,required"`
 	// FovY: Camera's field-of-view angle (if ortho is false)
-	FovY float64 `json:"fov_y" yaml:"fov_y" schema:"fov_y"`
+	FovY *float64 `json:"fov_y,omitempty" yaml:"fov_y,omitempty" schema:"fov_y,omitempty"`
 	// Orientation: The Camera's orientation (in the form of a quaternion)
 	Orientation Point4D `json:"orientation" yaml:"orientation" schema:"orientation,required"`
 	// Ortho: Whether or not the camera is in ortho mode
 	Ortho bool `json:"ortho" yaml:"ortho" schema:"ortho,required"`
 	// OrthoScale: The camera's ortho scale (derived from viewing distance if ortho is true)
-	OrthoScale float64 `json:"ortho_scale" yaml:"ortho_scale" schema:"ortho_scale"`
+	OrthoScale *float64 `json:"ortho_scale,omitempty" yaml:"ortho_scale,omitempty" schema:"ortho_scale,omitempty"`
 	// Pos: Camera position (vantage)
 	Pos Point3D `json:"pos" yaml:"pos" schema:"pos,required"`
 	// Up: Camera's world-space up vector
@@ -1511,23 +2675,23 @@ type CardDetails struct {
 	// Brand: Card brand.
 	//
 	// Can be `amex`, `diners`, `discover`, `jcb`, `mastercard`, `unionpay`, `visa`, or `unknown`.
-	Brand string `json:"brand" yaml:"brand" schema:"brand"`
+	Brand *string `json:"brand,omitempty" yaml:"brand,omitempty" schema:"brand,omitempty"`
 	// Checks: Checks on Card address and CVC if provided.
-	Checks PaymentMethodCardChecks `json:"checks" yaml:"checks" schema:"checks"`
+	Checks *PaymentMethodCardChecks `json:"checks,omitempty" yaml:"checks,omitempty" schema:"checks,omitempty"`
 	// Country: Two-letter ISO code representing the country of the card.
-	Country string `json:"country" yaml:"country" schema:"country"`
+	Country *string `json:"country,omitempty" yaml:"country,omitempty" schema:"country,omitempty"`
 	// ExpMonth: Two-digit number representing the card's expiration month.
-	ExpMonth int `json:"exp_month" yaml:"exp_month" schema:"exp_month"`
+	ExpMonth *int `json:"exp_month,omitempty" yaml:"exp_month,omitempty" schema:"exp_month,omitempty"`
 	// ExpYear: Four-digit number representing the card's expiration year.
-	ExpYear int `json:"exp_year" yaml:"exp_year" schema:"exp_year"`
+	ExpYear *int `json:"exp_year,omitempty" yaml:"exp_year,omitempty" schema:"exp_year,omitempty"`
 	// Fingerprint: Uniquely identifies this particular card number.
-	Fingerprint string `json:"fingerprint" yaml:"fingerprint" schema:"fingerprint"`
+	Fingerprint *string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty" schema:"fingerprint,omitempty"`
 	// Funding: Card funding type.
 	//
 	// Can be `credit`, `debit`, `prepaid`, or `unknown`.
-	Funding string `json:"funding" yaml:"funding" schema:"funding"`
+	Funding *string `json:"funding,omitempty" yaml:"funding,omitempty" schema:"funding,omitempty"`
 	// Last4: The last four digits of the card.
-	Last4 string `json:"last4" yaml:"last4" schema:"last4"`
+	Last4 *string `json:"last4,omitempty" yaml:"last4,omitempty" schema:"last4,omitempty"`
 }
 
 // CenterOfMass: The center of mass response.
@@ -1541,11 +2705,11 @@ type CenterOfMass struct {
 // Chamfer is the type definition for a Chamfer.
 type Chamfer struct {
 	// Angle: The angle of the chamfer, default is 45deg.
-	Angle Angle `json:"angle" yaml:"angle" schema:"angle"`
+	Angle *Angle `json:"angle,omitempty" yaml:"angle,omitempty" schema:"angle,omitempty"`
 	// Distance: The distance from the edge to cut on each face.
 	Distance float64 `json:"distance" yaml:"distance" schema:"distance,required"`
 	// SecondDistance: The second distance affects the edge length of the second face of the cut.
-	SecondDistance float64 `json:"second_distance" yaml:"second_distance" schema:"second_distance"`
+	SecondDistance *float64 `json:"second_distance,omitempty" yaml:"second_distance,omitempty" schema:"second_distance,omitempty"`
 	// Swap: If true, the second distance or angle is applied to the other face of the cut.
 	Swap bool `json:"swap" yaml:"swap" schema:"swap,required"`
 }
@@ -1555,17 +2719,17 @@ type ClientErrorReport struct {
 	// Client: Stable identifier for the client application reporting the error.
 	Client string `json:"client" yaml:"client" schema:"client,required"`
 	// Code: Optional application-defined error code or fingerprint.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// ErrorName: Optional JavaScript/runtime error name.
-	ErrorName string `json:"error_name" yaml:"error_name" schema:"error_name"`
+	ErrorName *string `json:"error_name,omitempty" yaml:"error_name,omitempty" schema:"error_name,omitempty"`
 	// Message: Human-readable error message.
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 	// Release: Client release/version string.
 	Release string `json:"release" yaml:"release" schema:"release,required"`
 	// Route: Optional route/path where the error occurred.
-	Route string `json:"route" yaml:"route" schema:"route"`
+	Route *string `json:"route,omitempty" yaml:"route,omitempty" schema:"route,omitempty"`
 	// Stack: Optional stack trace or equivalent debug context.
-	Stack string `json:"stack" yaml:"stack" schema:"stack"`
+	Stack *string `json:"stack,omitempty" yaml:"stack,omitempty" schema:"stack,omitempty"`
 }
 
 // ClientErrorReportAccepted: Response acknowledging that the error report was accepted for logging.
@@ -1579,65 +2743,65 @@ type ClientMetrics struct {
 	// RtcFrameHeight: The height of the inbound video stream in pixels.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-frameheight
-	RtcFrameHeight int `json:"rtc_frame_height" yaml:"rtc_frame_height" schema:"rtc_frame_height"`
+	RtcFrameHeight *int `json:"rtc_frame_height,omitempty" yaml:"rtc_frame_height,omitempty" schema:"rtc_frame_height,omitempty"`
 	// RtcFrameWidth: The width of the inbound video stream in pixels.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-framewidth
-	RtcFrameWidth int `json:"rtc_frame_width" yaml:"rtc_frame_width" schema:"rtc_frame_width"`
+	RtcFrameWidth *int `json:"rtc_frame_width,omitempty" yaml:"rtc_frame_width,omitempty" schema:"rtc_frame_width,omitempty"`
 	// RtcFramesDecoded: Counter of the number of WebRTC frames that the client has decoded from the inbound video stream.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-freezecount
-	RtcFramesDecoded int `json:"rtc_frames_decoded" yaml:"rtc_frames_decoded" schema:"rtc_frames_decoded"`
+	RtcFramesDecoded *int `json:"rtc_frames_decoded,omitempty" yaml:"rtc_frames_decoded,omitempty" schema:"rtc_frames_decoded,omitempty"`
 	// RtcFramesDropped: Counter of the number of WebRTC frames the client has dropped from the inbound video stream.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-framesdropped
-	RtcFramesDropped int `json:"rtc_frames_dropped" yaml:"rtc_frames_dropped" schema:"rtc_frames_dropped"`
+	RtcFramesDropped *int `json:"rtc_frames_dropped,omitempty" yaml:"rtc_frames_dropped,omitempty" schema:"rtc_frames_dropped,omitempty"`
 	// RtcFramesPerSecond: Current number of frames being rendered in the last second. A good target is 60 frames per second, but it can fluctuate depending on network conditions.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-freezecount
-	RtcFramesPerSecond int `json:"rtc_frames_per_second" yaml:"rtc_frames_per_second" schema:"rtc_frames_per_second"`
+	RtcFramesPerSecond *int `json:"rtc_frames_per_second,omitempty" yaml:"rtc_frames_per_second,omitempty" schema:"rtc_frames_per_second,omitempty"`
 	// RtcFramesReceived: Counter of the number of WebRTC frames that the client has received from the inbound video stream.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-freezecount
-	RtcFramesReceived int `json:"rtc_frames_received" yaml:"rtc_frames_received" schema:"rtc_frames_received"`
+	RtcFramesReceived *int `json:"rtc_frames_received,omitempty" yaml:"rtc_frames_received,omitempty" schema:"rtc_frames_received,omitempty"`
 	// RtcFreezeCount: Number of times the inbound video playback has frozen. This is usually due to network conditions.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-freezecount
-	RtcFreezeCount int `json:"rtc_freeze_count" yaml:"rtc_freeze_count" schema:"rtc_freeze_count"`
+	RtcFreezeCount *int `json:"rtc_freeze_count,omitempty" yaml:"rtc_freeze_count,omitempty" schema:"rtc_freeze_count,omitempty"`
 	// RtcJitterSec: Amount of "jitter" in the inbound video stream. Network latency is the time it takes a packet to traverse the network. The amount that the latency varies is the jitter. Video latency is the time it takes to render a frame sent by the server (including network latency). A low jitter means the video latency can be reduced without impacting smooth playback. High jitter means clients will increase video latency to ensure smooth playback.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcreceivedrtpstreamstats-jitter
-	RtcJitterSec float64 `json:"rtc_jitter_sec" yaml:"rtc_jitter_sec" schema:"rtc_jitter_sec"`
+	RtcJitterSec *float64 `json:"rtc_jitter_sec,omitempty" yaml:"rtc_jitter_sec,omitempty" schema:"rtc_jitter_sec,omitempty"`
 	// RtcKeyframesDecoded: Number of "key frames" decoded in the inbound h.264 stream. A key frame is an expensive (bandwidth-wise) "full image" of the video frame. Data after the keyframe become -- effectively -- "diff" operations on that key frame. The Engine will only send a keyframe if required, which is an indication that some of the "diffs" have been lost, usually an indication of poor network conditions. We like this metric to understand times when the connection has had to recover.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-keyframesdecoded
-	RtcKeyframesDecoded int `json:"rtc_keyframes_decoded" yaml:"rtc_keyframes_decoded" schema:"rtc_keyframes_decoded"`
+	RtcKeyframesDecoded *int `json:"rtc_keyframes_decoded,omitempty" yaml:"rtc_keyframes_decoded,omitempty" schema:"rtc_keyframes_decoded,omitempty"`
 	// RtcPacketsLost: Amount of packets lost in the inbound video stream.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcreceivedrtpstreamstats-packetslost
-	RtcPacketsLost int `json:"rtc_packets_lost" yaml:"rtc_packets_lost" schema:"rtc_packets_lost"`
+	RtcPacketsLost *int `json:"rtc_packets_lost,omitempty" yaml:"rtc_packets_lost,omitempty" schema:"rtc_packets_lost,omitempty"`
 	// RtcPauseCount: Count of the total number of video pauses experienced by this receiver.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-pausecount
-	RtcPauseCount int `json:"rtc_pause_count" yaml:"rtc_pause_count" schema:"rtc_pause_count"`
+	RtcPauseCount *int `json:"rtc_pause_count,omitempty" yaml:"rtc_pause_count,omitempty" schema:"rtc_pause_count,omitempty"`
 	// RtcPliCount: Count the total number of Picture Loss Indication (PLI) packets.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-plicount
-	RtcPliCount int `json:"rtc_pli_count" yaml:"rtc_pli_count" schema:"rtc_pli_count"`
+	RtcPliCount *int `json:"rtc_pli_count,omitempty" yaml:"rtc_pli_count,omitempty" schema:"rtc_pli_count,omitempty"`
 	// RtcStunRttSec: Total duration of pauses in seconds.
 	//
 	// This is the "ping" between the client and the STUN server. Not to be confused with the E2E RTT documented [here](https://www.w3.org/TR/webrtc-stats/#dom-rtcremoteinboundrtpstreamstats-roundtriptime)
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcicecandidatepairstats-currentroundtriptime
-	RtcStunRttSec float64 `json:"rtc_stun_rtt_sec" yaml:"rtc_stun_rtt_sec" schema:"rtc_stun_rtt_sec"`
+	RtcStunRttSec *float64 `json:"rtc_stun_rtt_sec,omitempty" yaml:"rtc_stun_rtt_sec,omitempty" schema:"rtc_stun_rtt_sec,omitempty"`
 	// RtcTotalFreezesDurationSec: Number of seconds of frozen video the user has been subjected to.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-totalfreezesduration
-	RtcTotalFreezesDurationSec float64 `json:"rtc_total_freezes_duration_sec" yaml:"rtc_total_freezes_duration_sec" schema:"rtc_total_freezes_duration_sec"`
+	RtcTotalFreezesDurationSec *float64 `json:"rtc_total_freezes_duration_sec,omitempty" yaml:"rtc_total_freezes_duration_sec,omitempty" schema:"rtc_total_freezes_duration_sec,omitempty"`
 	// RtcTotalPausesDurationSec: Count of the total number of video pauses experienced by this receiver.
 	//
 	// https://www.w3.org/TR/webrtc-stats/#dom-rtcinboundrtpstreamstats-totalpausesduration
-	RtcTotalPausesDurationSec float64 `json:"rtc_total_pauses_duration_sec" yaml:"rtc_total_pauses_duration_sec" schema:"rtc_total_pauses_duration_sec"`
+	RtcTotalPausesDurationSec *float64 `json:"rtc_total_pauses_duration_sec,omitempty" yaml:"rtc_total_pauses_duration_sec,omitempty" schema:"rtc_total_pauses_duration_sec,omitempty"`
 }
 
 // ClosePath: The response from the `ClosePath` command.
@@ -1649,7 +2813,7 @@ type ClosePath struct {
 // ClosestEdge: The response from the 'ClosestEdge'.
 type ClosestEdge struct {
 	// EdgeID: The ID of the edge closest to the point given in the request. If there are no edges in the scene, returns None.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 }
 
 // CodeLanguage: The language code is written in.
@@ -1667,6 +2831,45 @@ const (
 	CodeLanguageNode CodeLanguage = "node"
 )
 
+// AllCodeLanguages returns all the known values of CodeLanguage.
+func AllCodeLanguages() []CodeLanguage {
+	return []CodeLanguage{
+		CodeLanguageGo,
+		CodeLanguagePython,
+		CodeLanguageNode,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CodeLanguage.
+func (v CodeLanguage) IsValid() bool {
+	switch v {
+	case CodeLanguageGo, CodeLanguagePython, CodeLanguageNode:
+		return true
+	}
+	return false
+}
+
+// ParseCodeLanguage parses a CodeLanguage from a string, returning an error if the value is not known.
+func ParseCodeLanguage(s string) (CodeLanguage, error) {
+	v := CodeLanguage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CodeLanguage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CodeLanguage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CodeLanguage) UnmarshalText(text []byte) error {
+	*v = CodeLanguage(text)
+	return nil
+}
+
 // CodeOption: `CodeOption`
 // <details><summary>JSON schema</summary>
 //
@@ -1682,17 +2885,56 @@ const (
 	CodeOptionExecute CodeOption = "execute"
 )
 
+// AllCodeOptions returns all the known values of CodeOption.
+func AllCodeOptions() []CodeOption {
+	return []CodeOption{
+		CodeOptionParse,
+		CodeOptionMockExecute,
+		CodeOptionExecute,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CodeOption.
+func (v CodeOption) IsValid() bool {
+	switch v {
+	case CodeOptionParse, CodeOptionMockExecute, CodeOptionExecute:
+		return true
+	}
+	return false
+}
+
+// ParseCodeOption parses a CodeOption from a string, returning an error if the value is not known.
+func ParseCodeOption(s string) (CodeOption, error) {
+	v := CodeOption(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CodeOption %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CodeOption) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CodeOption) UnmarshalText(text []byte) error {
+	*v = CodeOption(text)
+	return nil
+}
+
 // CodeOutput: Output of the code being executed.
 // <details><summary>JSON schema</summary>
 //
 // ```json { "description": "Output of the code being executed.", "type": "object", "properties": { "output_files": { "description": "The contents of the files requested if they were passed.", "type": "array", "items": { "$ref": "#/components/schemas/OutputFile" } }, "stderr": { "description": "The stderr of the code.", "default": "", "type": "string" }, "stdout": { "description": "The stdout of the code.", "default": "", "type": "string" } } } ``` </details>
 type CodeOutput struct {
 	// OutputFiles: The contents of the files requested if they were passed.
-	OutputFiles []OutputFile `json:"output_files" yaml:"output_files" schema:"output_files"`
+	OutputFiles []OutputFile `json:"output_files,omitempty" yaml:"output_files,omitempty" schema:"output_files,omitempty"`
 	// Stderr: The stderr of the code.
-	Stderr string `json:"stderr" yaml:"stderr" schema:"stderr"`
+	Stderr *string `json:"stderr,omitempty" yaml:"stderr,omitempty" schema:"stderr,omitempty"`
 	// Stdout: The stdout of the code.
-	Stdout string `json:"stdout" yaml:"stdout" schema:"stdout"`
+	Stdout *string `json:"stdout,omitempty" yaml:"stdout,omitempty" schema:"stdout,omitempty"`
 }
 
 // Color: An RGBA color
@@ -1727,24 +2969,67 @@ const (
 	CompanySizeOther CompanySize = "other"
 )
 
+// AllCompanySizes returns all the known values of CompanySize.
+func AllCompanySizes() []CompanySize {
+	return []CompanySize{
+		CompanySizeOneToTen,
+		CompanySizeElevenToFifty,
+		CompanySizeFiftyOneToTwoHundred,
+		CompanySizeTwoHundredOneToFiveHundred,
+		CompanySizeFiveHundredOneToOneThousand,
+		CompanySizeOneThousandPlus,
+		CompanySizeOther,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CompanySize.
+func (v CompanySize) IsValid() bool {
+	switch v {
+	case CompanySizeOneToTen, CompanySizeElevenToFifty, CompanySizeFiftyOneToTwoHundred, CompanySizeTwoHundredOneToFiveHundred, CompanySizeFiveHundredOneToOneThousand, CompanySizeOneThousandPlus, CompanySizeOther:
+		return true
+	}
+	return false
+}
+
+// ParseCompanySize parses a CompanySize from a string, returning an error if the value is not known.
+func ParseCompanySize(s string) (CompanySize, error) {
+	v := CompanySize(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CompanySize %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CompanySize) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CompanySize) UnmarshalText(text []byte) error {
+	*v = CompanySize(text)
+	return nil
+}
+
 // ComplementaryEdges: Struct to contain the edge information of a wall of an extrude/rotate/loft/sweep.
 type ComplementaryEdges struct {
 	// AdjacentIds: Every edge that shared one common vertex with the original edge.
 	AdjacentIds []UUID `json:"adjacent_ids" yaml:"adjacent_ids" schema:"adjacent_ids,required"`
 	// OppositeID: The opposite edge has no common vertices with the original edge. A wall may not have an opposite edge (i.e. a revolve that touches the axis of rotation).
-	OppositeID UUID `json:"opposite_id" yaml:"opposite_id" schema:"opposite_id"`
+	OppositeID *UUID `json:"opposite_id,omitempty" yaml:"opposite_id,omitempty" schema:"opposite_id,omitempty"`
 }
 
 // ComponentTransform: Container that holds a translate, rotate and scale. Defaults to no change, everything stays the same (i.e. the identity function).
 type ComponentTransform struct {
 	// RotateAngleAxis: Rotate component of the transform. The rotation is specified as an axis and an angle (xyz are the components of the axis, w is the angle in degrees).
-	RotateAngleAxis TransformByForPoint4D `json:"rotate_angle_axis" yaml:"rotate_angle_axis" schema:"rotate_angle_axis"`
+	RotateAngleAxis *TransformByForPoint4D `json:"rotate_angle_axis,omitempty" yaml:"rotate_angle_axis,omitempty" schema:"rotate_angle_axis,omitempty"`
 	// RotateRpy: Rotate component of the transform. The rotation is specified as a roll, pitch, yaw.
-	RotateRpy TransformByForPoint3D `json:"rotate_rpy" yaml:"rotate_rpy" schema:"rotate_rpy"`
+	RotateRpy *TransformByForPoint3D `json:"rotate_rpy,omitempty" yaml:"rotate_rpy,omitempty" schema:"rotate_rpy,omitempty"`
 	// Scale: Scale component of the transform.
-	Scale TransformByForPoint3D `json:"scale" yaml:"scale" schema:"scale"`
+	Scale *TransformByForPoint3D `json:"scale,omitempty" yaml:"scale,omitempty" schema:"scale,omitempty"`
 	// Translate: Translate component of the transform.
-	Translate TransformByForPoint3D `json:"translate" yaml:"translate" schema:"translate"`
+	Translate *TransformByForPoint3D `json:"translate,omitempty" yaml:"translate,omitempty" schema:"translate,omitempty"`
 }
 
 // Conversation: A conversation composed of many ML prompts.
@@ -1772,7 +3057,7 @@ type ConversationResultsPage struct {
 	// Items: list of items on this page of results
 	Items []Conversation `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // ConversionParams: Describes the file to convert (src) and what it should be converted into (output).
@@ -1805,22 +3090,66 @@ const (
 	ConversionSortModeUpdatedAtDescending ConversionSortMode = "updated_at_descending"
 )
 
+// AllConversionSortModes returns all the known values of ConversionSortMode.
+func AllConversionSortModes() []ConversionSortMode {
+	return []ConversionSortMode{
+		ConversionSortModeCreatedAtAscending,
+		ConversionSortModeCreatedAtDescending,
+		ConversionSortModeStatusAscending,
+		ConversionSortModeStatusDescending,
+		ConversionSortModePhaseAscending,
+		ConversionSortModePhaseDescending,
+		ConversionSortModeUpdatedAtAscending,
+		ConversionSortModeUpdatedAtDescending,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ConversionSortMode.
+func (v ConversionSortMode) IsValid() bool {
+	switch v {
+	case ConversionSortModeCreatedAtAscending, ConversionSortModeCreatedAtDescending, ConversionSortModeStatusAscending, ConversionSortModeStatusDescending, ConversionSortModePhaseAscending, ConversionSortModePhaseDescending, ConversionSortModeUpdatedAtAscending, ConversionSortModeUpdatedAtDescending:
+		return true
+	}
+	return false
+}
+
+// ParseConversionSortMode parses a ConversionSortMode from a string, returning an error if the value is not known.
+func ParseConversionSortMode(s string) (ConversionSortMode, error) {
+	v := ConversionSortMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ConversionSortMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ConversionSortMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ConversionSortMode) UnmarshalText(text []byte) error {
+	*v = ConversionSortMode(text)
+	return nil
+}
+
 // Coupon: The resource representing a Coupon.
 type Coupon struct {
 	// AmountOff: Amount (in the `currency` specified) that will be taken off the subtotal of any invoices for this customer.
-	AmountOff float64 `json:"amount_off" yaml:"amount_off" schema:"amount_off"`
+	AmountOff *float64 `json:"amount_off,omitempty" yaml:"amount_off,omitempty" schema:"amount_off,omitempty"`
 	// ID: Unique identifier for the object.
-	ID string `json:"id" yaml:"id" schema:"id"`
+	ID *string `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// Metadata: Set of key-value pairs.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Name: Name of the coupon displayed to customers on, for instance invoices, or receipts.
 	//
 	// By default the `id` is shown if `name` is not set.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// PercentOff: Percent that will be taken off the subtotal of any invoices for this customer for the duration of the coupon.
 	//
 	// For example, a coupon with percent_off of 50 will make a %s100 invoice %s50 instead.
-	PercentOff float64 `json:"percent_off" yaml:"percent_off" schema:"percent_off"`
+	PercentOff *float64 `json:"percent_off,omitempty" yaml:"percent_off,omitempty" schema:"percent_off,omitempty"`
 }
 
 // CreateCustomModel: Body for creating a custom ML model.
@@ -1830,29 +3159,29 @@ type CreateCustomModel struct {
 	// Name: The model's display name.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// SystemPrompt: The model's system prompt.
-	SystemPrompt string `json:"system_prompt" yaml:"system_prompt" schema:"system_prompt"`
+	SystemPrompt *string `json:"system_prompt,omitempty" yaml:"system_prompt,omitempty" schema:"system_prompt,omitempty"`
 }
 
 // CreateOauth2AppRequest: Request body for creating a public OAuth app.
 type CreateOauth2AppRequest struct {
 	// GrantTypes: The OAuth grant types this app can use.
-	GrantTypes []Oauth2AppGrantType `json:"grant_types" yaml:"grant_types" schema:"grant_types"`
+	GrantTypes []Oauth2AppGrantType `json:"grant_types,omitempty" yaml:"grant_types,omitempty" schema:"grant_types,omitempty"`
 	// Mode: The deployment mode for this app.
-	Mode Oauth2AppMode `json:"mode" yaml:"mode" schema:"mode"`
+	Mode *Oauth2AppMode `json:"mode,omitempty" yaml:"mode,omitempty" schema:"mode,omitempty"`
 	// Name: The display name of the app.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// RedirectUris: The redirect URIs registered for this app.
-	RedirectUris []URL `json:"redirect_uris" yaml:"redirect_uris" schema:"redirect_uris"`
+	RedirectUris []URL `json:"redirect_uris,omitempty" yaml:"redirect_uris,omitempty" schema:"redirect_uris,omitempty"`
 }
 
 // CreateOrgDataset: Payload for creating an org dataset.
 type CreateOrgDataset struct {
 	// Description: Optional human-readable notes about the dataset.
-	Description string `json:"description" yaml:"description" schema:"description"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty" schema:"description,omitempty"`
 	// Name: The dataset's display name.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// RequireRawKclSimilarityScoreForSuccess: Whether a low raw-KCL similarity score should block conversion success.
-	RequireRawKclSimilarityScoreForSuccess bool `json:"require_raw_kcl_similarity_score_for_success" yaml:"require_raw_kcl_similarity_score_for_success" schema:"require_raw_kcl_similarity_score_for_success"`
+	RequireRawKclSimilarityScoreForSuccess *bool `json:"require_raw_kcl_similarity_score_for_success,omitempty" yaml:"require_raw_kcl_similarity_score_for_success,omitempty" schema:"require_raw_kcl_similarity_score_for_success,omitempty"`
 	// Source: Details for accessing the dataset.
 	Source OrgDatasetSource `json:"source" yaml:"source" schema:"source,required"`
 }
@@ -1866,7 +3195,7 @@ type CreatePlanarSurface struct {
 // CreateProjectShareLinkRequest: Request payload for creating a new project share link.
 type CreateProjectShareLinkRequest struct {
 	// AccessMode: Access policy for the generated share link.
-	AccessMode KclProjectShareLinkAccessMode `json:"access_mode" yaml:"access_mode" schema:"access_mode"`
+	AccessMode *KclProjectShareLinkAccessMode `json:"access_mode,omitempty" yaml:"access_mode,omitempty" schema:"access_mode,omitempty"`
 }
 
 // CreateRegion: The response from the 'CreateRegion'. The region should have an ID taken from the ID of the 'CreateRegion' modeling command.
@@ -1884,9 +3213,9 @@ type CreateRegionFromQueryPoint struct {
 // CreateShortlinkRequest: Request to create a shortlink.
 type CreateShortlinkRequest struct {
 	// Password: The password for the shortlink, if you want to restrict access to it. This can only be set if your subscription allows for it. Otherwise, it will return an error. When you access the link it will be required to enter this password through basic auth. The username will be `{anything}` and the password will be the password you set here.
-	Password string `json:"password" yaml:"password" schema:"password"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty" schema:"password,omitempty"`
 	// RestrictToOrg: If the shortlink should be restricted to the user's organization to view. This only applies to org shortlinks. If you are creating a user shortlink and you are not a member of a team or enterprise and you try to set this to true, it will fail.
-	RestrictToOrg bool `json:"restrict_to_org" yaml:"restrict_to_org" schema:"restrict_to_org"`
+	RestrictToOrg *bool `json:"restrict_to_org,omitempty" yaml:"restrict_to_org,omitempty" schema:"restrict_to_org,omitempty"`
 	// Url: The URL to redirect back to.
 	Url URL `json:"url" yaml:"url" schema:"url,required"`
 }
@@ -1910,20 +3239,58 @@ const (
 	CreatedAtSortModeCreatedAtDescending CreatedAtSortMode = "created_at_descending"
 )
 
+// AllCreatedAtSortModes returns all the known values of CreatedAtSortMode.
+func AllCreatedAtSortModes() []CreatedAtSortMode {
+	return []CreatedAtSortMode{
+		CreatedAtSortModeCreatedAtAscending,
+		CreatedAtSortModeCreatedAtDescending,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CreatedAtSortMode.
+func (v CreatedAtSortMode) IsValid() bool {
+	switch v {
+	case CreatedAtSortModeCreatedAtAscending, CreatedAtSortModeCreatedAtDescending:
+		return true
+	}
+	return false
+}
+
+// ParseCreatedAtSortMode parses a CreatedAtSortMode from a string, returning an error if the value is not known.
+func ParseCreatedAtSortMode(s string) (CreatedAtSortMode, error) {
+	v := CreatedAtSortMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CreatedAtSortMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CreatedAtSortMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CreatedAtSortMode) UnmarshalText(text []byte) error {
+	*v = CreatedAtSortMode(text)
+	return nil
+}
+
 // CurveDebug: A debug-view of the segment of a curve.
 type CurveDebug struct {
 	// Center: Center point of segment.
-	Center Point2D `json:"center" yaml:"center" schema:"center"`
+	Center *Point2D `json:"center,omitempty" yaml:"center,omitempty" schema:"center,omitempty"`
 	// End: End point of segment.
-	End Point2D `json:"end" yaml:"end" schema:"end"`
+	End *Point2D `json:"end,omitempty" yaml:"end,omitempty" schema:"end,omitempty"`
 	// ID: ID for this segment.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Mid: Midpoint on a three point arc
-	Mid Point2D `json:"mid" yaml:"mid" schema:"mid"`
+	Mid *Point2D `json:"mid,omitempty" yaml:"mid,omitempty" schema:"mid,omitempty"`
 	// SegmentType: What kind of segment is it (line, arc, etc)
 	SegmentType CurveTypeDebug `json:"segment_type" yaml:"segment_type" schema:"segment_type,required"`
 	// Start: Start point of segment or circle.
-	Start Point2D `json:"start" yaml:"start" schema:"start"`
+	Start *Point2D `json:"start,omitempty" yaml:"start,omitempty" schema:"start,omitempty"`
 }
 
 // CurveGetControlPoints: The response from the `CurveGetControlPoints` command.
@@ -1962,6 +3329,45 @@ const (
 	CurveTypeNurbs CurveType = "nurbs"
 )
 
+// AllCurveTypes returns all the known values of CurveType.
+func AllCurveTypes() []CurveType {
+	return []CurveType{
+		CurveTypeLine,
+		CurveTypeArc,
+		CurveTypeNurbs,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CurveType.
+func (v CurveType) IsValid() bool {
+	switch v {
+	case CurveTypeLine, CurveTypeArc, CurveTypeNurbs:
+		return true
+	}
+	return false
+}
+
+// ParseCurveType parses a CurveType from a string, returning an error if the value is not known.
+func ParseCurveType(s string) (CurveType, error) {
+	v := CurveType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CurveType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CurveType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CurveType) UnmarshalText(text []byte) error {
+	*v = CurveType(text)
+	return nil
+}
+
 // CurveTypeDebug: What type of curve is being viewed?
 type CurveTypeDebug string
 
@@ -1974,6 +3380,45 @@ const (
 	CurveTypeDebugCircle CurveTypeDebug = "circle"
 )
 
+// AllCurveTypeDebugs returns all the known values of CurveTypeDebug.
+func AllCurveTypeDebugs() []CurveTypeDebug {
+	return []CurveTypeDebug{
+		CurveTypeDebugLine,
+		CurveTypeDebugThreePointArc,
+		CurveTypeDebugCircle,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CurveTypeDebug.
+func (v CurveTypeDebug) IsValid() bool {
+	switch v {
+	case CurveTypeDebugLine, CurveTypeDebugThreePointArc, CurveTypeDebugCircle:
+		return true
+	}
+	return false
+}
+
+// ParseCurveTypeDebug parses a CurveTypeDebug from a string, returning an error if the value is not known.
+func ParseCurveTypeDebug(s string) (CurveTypeDebug, error) {
+	v := CurveTypeDebug(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CurveTypeDebug %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CurveTypeDebug) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CurveTypeDebug) UnmarshalText(text []byte) error {
+	*v = CurveTypeDebug(text)
+	return nil
+}
+
 // Custom is the type definition for a Custom.
 type Custom struct {
 	// Path: The path that will be used for the custom profile.
@@ -1999,29 +3444,29 @@ type CustomModel struct {
 // Customer: The resource representing a payment "Customer".
 type Customer struct {
 	// Address: The customer's address.
-	Address AddressDetails `json:"address" yaml:"address" schema:"address"`
+	Address *AddressDetails `json:"address,omitempty" yaml:"address,omitempty" schema:"address,omitempty"`
 	// Balance: Current balance, if any, being stored on the customer in the payments service.
 	//
 	// If negative, the customer has credit to apply to their next invoice. If positive, the customer has an amount owed that will be added to their next invoice. The balance does not refer to any unpaid invoices; it solely takes into account amounts that have yet to be successfully applied to any invoice. This balance is only taken into account as invoices are finalized.
-	Balance float64 `json:"balance" yaml:"balance" schema:"balance"`
+	Balance *float64 `json:"balance,omitempty" yaml:"balance,omitempty" schema:"balance,omitempty"`
 	// CreatedAt: Time at which the object was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Currency: Three-letter ISO code for the currency the customer can be charged in for recurring billing purposes.
-	Currency string `json:"currency" yaml:"currency" schema:"currency"`
+	Currency *string `json:"currency,omitempty" yaml:"currency,omitempty" schema:"currency,omitempty"`
 	// Delinquent: When the customer's latest invoice is billed by charging automatically, `delinquent` is `true` if the invoice's latest charge failed.
 	//
 	// When the customer's latest invoice is billed by sending an invoice, `delinquent` is `true` if the invoice isn't paid by its due date.  If an invoice is marked uncollectible by dunning, `delinquent` doesn't get reset to `false`.
-	Delinquent bool `json:"delinquent" yaml:"delinquent" schema:"delinquent"`
+	Delinquent *bool `json:"delinquent,omitempty" yaml:"delinquent,omitempty" schema:"delinquent,omitempty"`
 	// Email: The customer's email address.
-	Email string `json:"email" yaml:"email" schema:"email"`
+	Email *string `json:"email,omitempty" yaml:"email,omitempty" schema:"email,omitempty"`
 	// ID: Unique identifier for the object.
-	ID string `json:"id" yaml:"id" schema:"id"`
+	ID *string `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// Metadata: Set of key-value pairs.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Name: The customer's full name or business name.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The customer's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 }
 
 // CustomerBalance: A balance for a customer.
@@ -2034,7 +3479,7 @@ type CustomerBalance struct {
 	// When a refresh is due but has not been applied yet, this is the due timestamp in the past. Clients can use that to show the refresh as pending rather than incorrectly advancing by a month.
 	//
 	// This is `null` when the customer does not have an active standard billing schedule, such as before their billing account is established or while a contract owns billing.
-	MonthlyAPICreditsRefreshAt Time `json:"monthly_api_credits_refresh_at" yaml:"monthly_api_credits_refresh_at" schema:"monthly_api_credits_refresh_at"`
+	MonthlyAPICreditsRefreshAt *Time `json:"monthly_api_credits_refresh_at,omitempty" yaml:"monthly_api_credits_refresh_at,omitempty" schema:"monthly_api_credits_refresh_at,omitempty"`
 	// MonthlyAPICreditsRemaining: The number of monthly API credits remaining in the balance. This is the number of credits remaining in the balance.
 	//
 	// Both the monetary value and the number of credits are returned, but they reflect the same value in the database.
@@ -2052,11 +3497,11 @@ type CustomerBalance struct {
 	// Both the monetary value and the number of credits are returned, but they reflect the same value in the database.
 	StableAPICreditsRemainingMonetaryValue float64 `json:"stable_api_credits_remaining_monetary_value" yaml:"stable_api_credits_remaining_monetary_value" schema:"stable_api_credits_remaining_monetary_value,required"`
 	// SubscriptionDetails: Details about the subscription.
-	SubscriptionDetails ZooProductSubscriptions `json:"subscription_details" yaml:"subscription_details" schema:"subscription_details"`
+	SubscriptionDetails *ZooProductSubscriptions `json:"subscription_details,omitempty" yaml:"subscription_details,omitempty" schema:"subscription_details,omitempty"`
 	// SubscriptionID: The subscription ID for the user.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" schema:"subscription_id"`
+	SubscriptionID *string `json:"subscription_id,omitempty" yaml:"subscription_id,omitempty" schema:"subscription_id,omitempty"`
 	// TotalDue: This includes any outstanding, draft, or open invoices and any pending invoice items. This does not include any credits the customer has on their account. This amount is only returned if requested from the api.
-	TotalDue float64 `json:"total_due" yaml:"total_due" schema:"total_due"`
+	TotalDue *float64 `json:"total_due,omitempty" yaml:"total_due,omitempty" schema:"total_due,omitempty"`
 	// UpdatedAt: The date and time the balance was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -2081,6 +3526,45 @@ const (
 	CutStrategyAutomatic CutStrategy = "automatic"
 )
 
+// AllCutStrategies returns all the known values of CutStrategy.
+func AllCutStrategies() []CutStrategy {
+	return []CutStrategy{
+		CutStrategyBasic,
+		CutStrategyCsg,
+		CutStrategyAutomatic,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CutStrategy.
+func (v CutStrategy) IsValid() bool {
+	switch v {
+	case CutStrategyBasic, CutStrategyCsg, CutStrategyAutomatic:
+		return true
+	}
+	return false
+}
+
+// ParseCutStrategy parses a CutStrategy from a string, returning an error if the value is not known.
+func ParseCutStrategy(s string) (CutStrategy, error) {
+	v := CutStrategy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CutStrategy %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CutStrategy) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CutStrategy) UnmarshalText(text []byte) error {
+	*v = CutStrategy(text)
+	return nil
+}
+
 // CutType: What kind of cut to do
 type CutType string
 
@@ -2091,6 +3575,44 @@ const (
 	CutTypeChamfer CutType = "chamfer"
 )
 
+// AllCutTypes returns all the known values of CutType.
+func AllCutTypes() []CutType {
+	return []CutType{
+		CutTypeFillet,
+		CutTypeChamfer,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of CutType.
+func (v CutType) IsValid() bool {
+	switch v {
+	case CutTypeFillet, CutTypeChamfer:
+		return true
+	}
+	return false
+}
+
+// ParseCutType parses a CutType from a string, returning an error if the value is not known.
+func ParseCutType(s string) (CutType, error) {
+	v := CutType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid CutType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v CutType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *CutType) UnmarshalText(text []byte) error {
+	*v = CutType(text)
+	return nil
+}
+
 // CutTypeV2: CutTypeV2: What kind of cut to perform when cutting an edge.
 type CutTypeV2 any
 
@@ -2232,6 +3754,44 @@ const (
 	DirectionNegative Direction = "negative"
 )
 
+// AllDirections returns all the known values of Direction.
+func AllDirections() []Direction {
+	return []Direction{
+		DirectionPositive,
+		DirectionNegative,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Direction.
+func (v Direction) IsValid() bool {
+	switch v {
+	case DirectionPositive, DirectionNegative:
+		return true
+	}
+	return false
+}
+
+// ParseDirection parses a Direction from a string, returning an error if the value is not known.
+func ParseDirection(s string) (Direction, error) {
+	v := Direction(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Direction %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Direction) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Direction) UnmarshalText(text []byte) error {
+	*v = Direction(text)
+	return nil
+}
+
 // DirectionType: DirectionType: What to use as a direction when one is needed (e.g. for an extrusion).
 type DirectionType any
 
@@ -2258,7 +3818,7 @@ type DiscountCode struct {
 	// Code: The code for the discount.
 	Code string `json:"code" yaml:"code" schema:"code,required"`
 	// ExpiresAt: The date the discount code expires.
-	ExpiresAt Time `json:"expires_at" yaml:"expires_at" schema:"expires_at"`
+	ExpiresAt *Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty" schema:"expires_at,omitempty"`
 	// PercentOff: The percent off for the discount.
 	PercentOff int `json:"percent_off" yaml:"percent_off" schema:"percent_off,required"`
 }
@@ -2292,6 +3852,44 @@ const (
 	DxfStorageBinary DxfStorage = "binary"
 )
 
+// AllDxfStorages returns all the known values of DxfStorage.
+func AllDxfStorages() []DxfStorage {
+	return []DxfStorage{
+		DxfStorageAscii,
+		DxfStorageBinary,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of DxfStorage.
+func (v DxfStorage) IsValid() bool {
+	switch v {
+	case DxfStorageAscii, DxfStorageBinary:
+		return true
+	}
+	return false
+}
+
+// ParseDxfStorage parses a DxfStorage from a string, returning an error if the value is not known.
+func ParseDxfStorage(s string) (DxfStorage, error) {
+	v := DxfStorage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid DxfStorage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v DxfStorage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *DxfStorage) UnmarshalText(text []byte) error {
+	*v = DxfStorage(text)
+	return nil
+}
+
 // Edge is the type definition for a Edge.
 type Edge struct {
 	// ID: Edge ID.
@@ -2310,6 +3908,45 @@ const (
 	EdgeCutVersionV2 EdgeCutVersion = "v2"
 )
 
+// AllEdgeCutVersions returns all the known values of EdgeCutVersion.
+func AllEdgeCutVersions() []EdgeCutVersion {
+	return []EdgeCutVersion{
+		EdgeCutVersionV0,
+		EdgeCutVersionV1,
+		EdgeCutVersionV2,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of EdgeCutVersion.
+func (v EdgeCutVersion) IsValid() bool {
+	switch v {
+	case EdgeCutVersionV0, EdgeCutVersionV1, EdgeCutVersionV2:
+		return true
+	}
+	return false
+}
+
+// ParseEdgeCutVersion parses a EdgeCutVersion from a string, returning an error if the value is not known.
+func ParseEdgeCutVersion(s string) (EdgeCutVersion, error) {
+	v := EdgeCutVersion(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EdgeCutVersion %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v EdgeCutVersion) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *EdgeCutVersion) UnmarshalText(text []byte) error {
+	*v = EdgeCutVersion(text)
+	return nil
+}
+
 // EdgeGetLength: The response from the `EdgeGetLength` command.
 type EdgeGetLength struct {
 	// Length: The length of the edge.
@@ -2337,9 +3974,9 @@ type EdgeReference struct {
 // EdgeSpecifier: An edge can be referenced by its uuid or by the faces that uniquely define it.
 type EdgeSpecifier struct {
 	// EndFaces: Optional end face ids for ambiguous edge matches.
-	EndFaces []UUID `json:"end_faces" yaml:"end_faces" schema:"end_faces"`
+	EndFaces []UUID `json:"end_faces,omitempty" yaml:"end_faces,omitempty" schema:"end_faces,omitempty"`
 	// Index: Optional index for disambiguation when multiple edges share the same faces. If not provided (None), all matching edges will be used. If provided (Some(n)), only the edge at index n will be used.
-	Index int `json:"index" yaml:"index" schema:"index"`
+	Index *int `json:"index,omitempty" yaml:"index,omitempty" schema:"index,omitempty"`
 	// SideFaces: Side face ids that uniquely identify the edge.
 	SideFaces []UUID `json:"side_faces" yaml:"side_faces" schema:"side_faces,required"`
 }
@@ -2347,7 +3984,7 @@ type EdgeSpecifier struct {
 // EmailAuthenticationForm: The body of the form for email authentication.
 type EmailAuthenticationForm struct {
 	// CallbackUrl: The URL to redirect back to after we have authenticated.
-	CallbackUrl URL `json:"callback_url" yaml:"callback_url" schema:"callback_url"`
+	CallbackUrl *URL `json:"callback_url,omitempty" yaml:"callback_url,omitempty" schema:"callback_url,omitempty"`
 	// Email: The user's email.
 	Email string `json:"email" yaml:"email" schema:"email,required"`
 }
@@ -2361,13 +3998,13 @@ type EmailMarketingConfirmTokenBody struct {
 // EmailMarketingConsentState: Public view of an authenticated user's email marketing consent state.
 type EmailMarketingConsentState struct {
 	// ConfirmedAt: Time the double opt-in confirmation completed.
-	ConfirmedAt Time `json:"confirmed_at" yaml:"confirmed_at" schema:"confirmed_at"`
+	ConfirmedAt *Time `json:"confirmed_at,omitempty" yaml:"confirmed_at,omitempty" schema:"confirmed_at,omitempty"`
 	// IsSubscribed: Whether the user is currently subscribed to marketing email.
 	IsSubscribed bool `json:"is_subscribed" yaml:"is_subscribed" schema:"is_subscribed,required"`
 	// PromptSeenAt: First time the in-app prompt was seen.
-	PromptSeenAt Time `json:"prompt_seen_at" yaml:"prompt_seen_at" schema:"prompt_seen_at"`
+	PromptSeenAt *Time `json:"prompt_seen_at,omitempty" yaml:"prompt_seen_at,omitempty" schema:"prompt_seen_at,omitempty"`
 	// RequestedAt: Time the latest opt-in request email was sent.
-	RequestedAt Time `json:"requested_at" yaml:"requested_at" schema:"requested_at"`
+	RequestedAt *Time `json:"requested_at,omitempty" yaml:"requested_at,omitempty" schema:"requested_at,omitempty"`
 	// ShouldShowPrompt: Whether the website should show the modal for this user.
 	ShouldShowPrompt bool `json:"should_show_prompt" yaml:"should_show_prompt" schema:"should_show_prompt,required"`
 	// Status: Current consent state.
@@ -2392,6 +4029,48 @@ const (
 	EmailMarketingConsentStatusDeclined EmailMarketingConsentStatus = "declined"
 )
 
+// AllEmailMarketingConsentStatuses returns all the known values of EmailMarketingConsentStatus.
+func AllEmailMarketingConsentStatuses() []EmailMarketingConsentStatus {
+	return []EmailMarketingConsentStatus{
+		EmailMarketingConsentStatusUnknown,
+		EmailMarketingConsentStatusDismissed,
+		EmailMarketingConsentStatusPending,
+		EmailMarketingConsentStatusConfirmed,
+		EmailMarketingConsentStatusUnsubscribed,
+		EmailMarketingConsentStatusDeclined,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of EmailMarketingConsentStatus.
+func (v EmailMarketingConsentStatus) IsValid() bool {
+	switch v {
+	case EmailMarketingConsentStatusUnknown, EmailMarketingConsentStatusDismissed, EmailMarketingConsentStatusPending, EmailMarketingConsentStatusConfirmed, EmailMarketingConsentStatusUnsubscribed, EmailMarketingConsentStatusDeclined:
+		return true
+	}
+	return false
+}
+
+// ParseEmailMarketingConsentStatus parses a EmailMarketingConsentStatus from a string, returning an error if the value is not known.
+func ParseEmailMarketingConsentStatus(s string) (EmailMarketingConsentStatus, error) {
+	v := EmailMarketingConsentStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EmailMarketingConsentStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v EmailMarketingConsentStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *EmailMarketingConsentStatus) UnmarshalText(text []byte) error {
+	*v = EmailMarketingConsentStatus(text)
+	return nil
+}
+
 // EnableSketchMode: The response from the `EnableSketchMode` endpoint.
 type EnableSketchMode struct {
 }
@@ -2403,15 +4082,15 @@ type EndExecution struct {
 // EndOfStream is the type definition for a EndOfStream.
 type EndOfStream struct {
 	// CompletedAt: This indicates the time that the server has finished processing the request. This can be used by the client to measure the total time taken for the request. Although this might be passed in other contexts, outside of copilot mode, it is only relevant in copilot mode.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation id for this session.
-	ConversationID string `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id"`
+	ConversationID *string `json:"conversation_id,omitempty" yaml:"conversation_id,omitempty" schema:"conversation_id,omitempty"`
 	// ID: The ML prompt id for this turn.
-	ID UUID `json:"id" yaml:"id" schema:"id"`
+	ID *UUID `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// StartedAt: This indicates the time that the server had started processing the request. This can be used by the client to measure the total time taken for the request. Although this might be passed in other contexts, outside of copilot mode, it is only relevant in copilot mode.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// WholeResponse: The whole response text, which is the final output of the AI. This is only relevant if in copilot mode, where the AI is expected to return the whole response at once.
-	WholeResponse string `json:"whole_response" yaml:"whole_response" schema:"whole_response"`
+	WholeResponse *string `json:"whole_response,omitempty" yaml:"whole_response,omitempty" schema:"whole_response,omitempty"`
 }
 
 // EngineUtilEvaluatePath: The response of the `EngineUtilEvaluatePath` endpoint
@@ -2423,13 +4102,13 @@ type EngineUtilEvaluatePath struct {
 // EntityCircularPattern: The response from the `EntityCircularPattern` command.
 type EntityCircularPattern struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityClone: The response from the `EntityClone` command.
 type EntityClone struct {
 	// FaceEdgeIds: The Face and Edge Ids of the cloned entity.
-	FaceEdgeIds []FaceEdgeInfo `json:"face_edge_ids" yaml:"face_edge_ids" schema:"face_edge_ids"`
+	FaceEdgeIds []FaceEdgeInfo `json:"face_edge_ids,omitempty" yaml:"face_edge_ids,omitempty" schema:"face_edge_ids,omitempty"`
 }
 
 // EntityDeleteChildren: The response from the `EntityDeleteChildren` command.
@@ -2495,13 +4174,13 @@ type EntityGetSketchPaths struct {
 // EntityLinearPattern: The response from the `EntityLinearPattern` command.
 type EntityLinearPattern struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityLinearPatternTransform: The response from the `EntityLinearPatternTransform` command.
 type EntityLinearPatternTransform struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityMakeHelix: The response from the `EntityMakeHelix` endpoint.
@@ -2519,27 +4198,27 @@ type EntityMakeHelixFromParams struct {
 // EntityMirror: The response from the `EntityMirror` endpoint.
 type EntityMirror struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityMirrorAcross: The response from the `EntityMirrorAcross` endpoint.
 type EntityMirrorAcross struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityMirrorAcrossEdge: The response from the `EntityMirrorAcrossEdge` endpoint.
 type EntityMirrorAcrossEdge struct {
 	// EntityFaceEdgeIds: The Face, edge, and entity ids of the patterned entities.
-	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids" yaml:"entity_face_edge_ids" schema:"entity_face_edge_ids"`
+	EntityFaceEdgeIds []FaceEdgeInfo `json:"entity_face_edge_ids,omitempty" yaml:"entity_face_edge_ids,omitempty" schema:"entity_face_edge_ids,omitempty"`
 }
 
 // EntityReference is the type definition for a EntityReference.
 type EntityReference struct {
 	// EntityID: Legacy UUID of the entity to extrude to. If both `entity_id` and `entity_reference` are provided, `entity_reference` takes precedence.
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 	// EntityReference: Entity reference (e.g. edge by side_faces). If both `entity_id` and `entity_reference` are provided, `entity_reference` takes precedence.
-	EntityReference any `json:"entity_reference" yaml:"entity_reference" schema:"entity_reference"`
+	EntityReference any `json:"entity_reference,omitempty" yaml:"entity_reference,omitempty" schema:"entity_reference,omitempty"`
 }
 
 // EntityReferenceEndFaces: A uuid referencing an edge on a solid2d (profile) - used for raw sketch/profile edges. This is distinct from the face-based Edge reference which is used for BRep/swept body edges.
@@ -2547,7 +4226,7 @@ type EntityReferenceEndFaces struct {
 	// EdgeID: Id of the edge being referenced.
 	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id,required"`
 	// TopologyFallback: Fallback: solid2d UUID + curve index in that profile.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2557,7 +4236,7 @@ type EntityReferenceEntityReferenceTopologyFallback struct {
 	// Solid2DID: Id of the solid2d being referenced.
 	Solid2DID UUID `json:"solid2d_id" yaml:"solid2d_id" schema:"solid2d_id,required"`
 	// TopologyFallback: Typically omitted: `solid2d_id` is already the owning profile. Present for schema parity with other variants.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2567,7 +4246,7 @@ type EntityReferenceFace struct {
 	// Solid3DID: Id of the solid3d being referenced.
 	Solid3DID UUID `json:"solid3d_id" yaml:"solid3d_id" schema:"solid3d_id,required"`
 	// TopologyFallback: Typically omitted: `solid3d_id` is already the owning body. Present for schema parity with other variants.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2575,11 +4254,11 @@ type EntityReferenceFace struct {
 // EntityReferenceFaceID: A collection of ids that uniquely identify an vertex.
 type EntityReferenceFaceID struct {
 	// Index: Optional index among the filtered candidates.
-	Index int `json:"index" yaml:"index" schema:"index"`
+	Index *int `json:"index,omitempty" yaml:"index,omitempty" schema:"index,omitempty"`
 	// SideFaces: Side face ids that identify the vertex.
 	SideFaces []UUID `json:"side_faces" yaml:"side_faces" schema:"side_faces,required"`
 	// TopologyFallback: Fallback: solid3d UUID + vertex index on that body.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2591,7 +4270,7 @@ type EntityReferenceIndex struct {
 	// SegmentID: Id of the segment (curve) being referenced.
 	SegmentID UUID `json:"segment_id" yaml:"segment_id" schema:"segment_id,required"`
 	// TopologyFallback: Fallback: path UUID + segment curve index.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2599,13 +4278,13 @@ type EntityReferenceIndex struct {
 // EntityReferencePlane: A collection of ids that uniquely identify an edge.
 type EntityReferencePlane struct {
 	// EndFaces: Optional end face ids for ambiguous edge matches.
-	EndFaces []UUID `json:"end_faces" yaml:"end_faces" schema:"end_faces"`
+	EndFaces []UUID `json:"end_faces,omitempty" yaml:"end_faces,omitempty" schema:"end_faces,omitempty"`
 	// Index: Optional index for disambiguation when multiple edges share the same faces. If not provided (None), all matching edges will be used. If provided (Some(n)), only the edge at index n will be used.
-	Index int `json:"index" yaml:"index" schema:"index"`
+	Index *int `json:"index,omitempty" yaml:"index,omitempty" schema:"index,omitempty"`
 	// SideFaces: Side face ids that uniquely identify the edge.
 	SideFaces []UUID `json:"side_faces" yaml:"side_faces" schema:"side_faces,required"`
 	// TopologyFallback: Fallback: solid3d UUID + edge index on that body for 3D BREP edges (distinct from `inner.index`).
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2615,7 +4294,7 @@ type EntityReferencePlaneID struct {
 	// PlaneID: Id of the plane being referenced.
 	PlaneID UUID `json:"plane_id" yaml:"plane_id" schema:"plane_id,required"`
 	// TopologyFallback: Optional primitive topology on a parent (not used for planes today).
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2625,7 +4304,7 @@ type EntityReferenceSideFaces struct {
 	// RegionID: Id of the region being referenced.
 	RegionID UUID `json:"region_id" yaml:"region_id" schema:"region_id,required"`
 	// TopologyFallback: Fallback: path UUID + region index on that path.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2635,7 +4314,7 @@ type EntityReferenceTopologyFallback struct {
 	// FaceID: Id of the face being referenced.
 	FaceID UUID `json:"face_id" yaml:"face_id" schema:"face_id,required"`
 	// TopologyFallback: Fallback: solid3d UUID + face index on that body when `face_id` cannot be resolved client-side.
-	TopologyFallback PrimitiveTopologyFallback `json:"topology_fallback" yaml:"topology_fallback" schema:"topology_fallback"`
+	TopologyFallback *PrimitiveTopologyFallback `json:"topology_fallback,omitempty" yaml:"topology_fallback,omitempty" schema:"topology_fallback,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -2674,10 +4353,58 @@ const (
 	EntityTypeRegion EntityType = "region"
 )
 
+// AllEntityTypes returns all the known values of EntityType.
+func AllEntityTypes() []EntityType {
+	return []EntityType{
+		EntityTypeEntity,
+		EntityTypeObject,
+		EntityTypePath,
+		EntityTypeSegment,
+		EntityTypeCurve,
+		EntityTypeSolid2D,
+		EntityTypeSolid3D,
+		EntityTypeEdge,
+		EntityTypeFace,
+		EntityTypePlane,
+		EntityTypeVertex,
+		EntityTypeRegion,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of EntityType.
+func (v EntityType) IsValid() bool {
+	switch v {
+	case EntityTypeEntity, EntityTypeObject, EntityTypePath, EntityTypeSegment, EntityTypeCurve, EntityTypeSolid2D, EntityTypeSolid3D, EntityTypeEdge, EntityTypeFace, EntityTypePlane, EntityTypeVertex, EntityTypeRegion:
+		return true
+	}
+	return false
+}
+
+// ParseEntityType parses a EntityType from a string, returning an error if the value is not known.
+func ParseEntityType(s string) (EntityType, error) {
+	v := EntityType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid EntityType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v EntityType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *EntityType) UnmarshalText(text []byte) error {
+	*v = EntityType(text)
+	return nil
+}
+
 // Error: Error information from a response.
 type Error struct {
 	// ErrorCode:
-	ErrorCode string `json:"error_code" yaml:"error_code" schema:"error_code"`
+	ErrorCode *string `json:"error_code,omitempty" yaml:"error_code,omitempty" schema:"error_code,omitempty"`
 	// Message:
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 	// RequestID:
@@ -2712,6 +4439,53 @@ const (
 	ErrorCodeMessageTypeNotAcceptedForWebRTC ErrorCode = "message_type_not_accepted_for_web_r_t_c"
 )
 
+// AllErrorCodes returns all the known values of ErrorCode.
+func AllErrorCodes() []ErrorCode {
+	return []ErrorCode{
+		ErrorCodeInternalEngine,
+		ErrorCodeInternalAPI,
+		ErrorCodeBadRequest,
+		ErrorCodeAuthTokenMissing,
+		ErrorCodeAuthTokenInvalid,
+		ErrorCodeInvalidJson,
+		ErrorCodeInvalidBson,
+		ErrorCodeWrongProtocol,
+		ErrorCodeConnectionProblem,
+		ErrorCodeMessageTypeNotAccepted,
+		ErrorCodeMessageTypeNotAcceptedForWebRTC,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ErrorCode.
+func (v ErrorCode) IsValid() bool {
+	switch v {
+	case ErrorCodeInternalEngine, ErrorCodeInternalAPI, ErrorCodeBadRequest, ErrorCodeAuthTokenMissing, ErrorCodeAuthTokenInvalid, ErrorCodeInvalidJson, ErrorCodeInvalidBson, ErrorCodeWrongProtocol, ErrorCodeConnectionProblem, ErrorCodeMessageTypeNotAccepted, ErrorCodeMessageTypeNotAcceptedForWebRTC:
+		return true
+	}
+	return false
+}
+
+// ParseErrorCode parses a ErrorCode from a string, returning an error if the value is not known.
+func ParseErrorCode(s string) (ErrorCode, error) {
+	v := ErrorCode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ErrorCode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ErrorCode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ErrorCode) UnmarshalText(text []byte) error {
+	*v = ErrorCode(text)
+	return nil
+}
+
 // Export: The response from the `Export` endpoint.
 type Export struct {
 	// Files: The files that were exported.
@@ -2746,55 +4520,55 @@ type ExtendPath struct {
 // This is mostly used for internal purposes. It returns a mapping of the user's information, including that of our third party services we use for users: Stripe
 type ExtendedUser struct {
 	// AllowPayAsYouGo: Whether the user has opted into pay-as-you-go charges after using their included credits.
-	AllowPayAsYouGo bool `json:"allow_pay_as_you_go" yaml:"allow_pay_as_you_go" schema:"allow_pay_as_you_go"`
+	AllowPayAsYouGo *bool `json:"allow_pay_as_you_go,omitempty" yaml:"allow_pay_as_you_go,omitempty" schema:"allow_pay_as_you_go,omitempty"`
 	// Block: If the user should be blocked and the reason why.
-	Block BlockReason `json:"block" yaml:"block" schema:"block"`
+	Block *BlockReason `json:"block,omitempty" yaml:"block,omitempty" schema:"block,omitempty"`
 	// CanTrainOnData: If we can train on the user's data. If the user is a member of an organization, the organization's setting will override this.
-	CanTrainOnData bool `json:"can_train_on_data" yaml:"can_train_on_data" schema:"can_train_on_data"`
+	CanTrainOnData *bool `json:"can_train_on_data,omitempty" yaml:"can_train_on_data,omitempty" schema:"can_train_on_data,omitempty"`
 	// Company: The user's company.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// CreatedAt: The date and time the user was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// DeletionScheduled: If the user is scheduled for deletion
-	DeletionScheduled bool `json:"deletion_scheduled" yaml:"deletion_scheduled" schema:"deletion_scheduled"`
+	DeletionScheduled *bool `json:"deletion_scheduled,omitempty" yaml:"deletion_scheduled,omitempty" schema:"deletion_scheduled,omitempty"`
 	// Discord: The user's Discord handle.
-	Discord string `json:"discord" yaml:"discord" schema:"discord"`
+	Discord *string `json:"discord,omitempty" yaml:"discord,omitempty" schema:"discord,omitempty"`
 	// Email: The email address of the user.
-	Email string `json:"email" yaml:"email" schema:"email"`
+	Email *string `json:"email,omitempty" yaml:"email,omitempty" schema:"email,omitempty"`
 	// EmailVerified: The date and time the email address was verified.
-	EmailVerified Time `json:"email_verified" yaml:"email_verified" schema:"email_verified"`
+	EmailVerified *Time `json:"email_verified,omitempty" yaml:"email_verified,omitempty" schema:"email_verified,omitempty"`
 	// FirstName: The user's first name.
-	FirstName string `json:"first_name" yaml:"first_name" schema:"first_name"`
+	FirstName *string `json:"first_name,omitempty" yaml:"first_name,omitempty" schema:"first_name,omitempty"`
 	// Github: The user's GitHub handle.
-	Github string `json:"github" yaml:"github" schema:"github"`
+	Github *string `json:"github,omitempty" yaml:"github,omitempty" schema:"github,omitempty"`
 	// ID: The unique identifier for the user.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Image: The image avatar for the user. This is a URL.
 	Image URL `json:"image" yaml:"image" schema:"image,required"`
 	// IsOnboarded: If the user has finished onboarding.
-	IsOnboarded bool `json:"is_onboarded" yaml:"is_onboarded" schema:"is_onboarded"`
+	IsOnboarded *bool `json:"is_onboarded,omitempty" yaml:"is_onboarded,omitempty" schema:"is_onboarded,omitempty"`
 	// IsServiceAccount: If the user is tied to a service account.
-	IsServiceAccount bool `json:"is_service_account" yaml:"is_service_account" schema:"is_service_account"`
+	IsServiceAccount *bool `json:"is_service_account,omitempty" yaml:"is_service_account,omitempty" schema:"is_service_account,omitempty"`
 	// LastName: The user's last name.
-	LastName string `json:"last_name" yaml:"last_name" schema:"last_name"`
+	LastName *string `json:"last_name,omitempty" yaml:"last_name,omitempty" schema:"last_name,omitempty"`
 	// Name: The name of the user. This is auto populated at first from the authentication provider (if there was a name). It can be updated by the user by updating their `first_name` and `last_name` fields.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The user's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// StripeID: The user's Stripe ID. This is mostly used for internal mapping.
-	StripeID string `json:"stripe_id" yaml:"stripe_id" schema:"stripe_id"`
+	StripeID *string `json:"stripe_id,omitempty" yaml:"stripe_id,omitempty" schema:"stripe_id,omitempty"`
 	// UpdatedAt: The date and time the user was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 	// Username: Public username/handle for community-facing features.
-	Username string `json:"username" yaml:"username" schema:"username"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty" schema:"username,omitempty"`
 }
 
 // Extrude: The response from the `Extrude` endpoint.
 type Extrude struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // ExtrudeMethod: Extrusion method determining if the extrusion will be part of the existing object or an entirely new object.
@@ -2807,6 +4581,44 @@ const (
 	ExtrudeMethodMerge ExtrudeMethod = "merge"
 )
 
+// AllExtrudeMethods returns all the known values of ExtrudeMethod.
+func AllExtrudeMethods() []ExtrudeMethod {
+	return []ExtrudeMethod{
+		ExtrudeMethodNew,
+		ExtrudeMethodMerge,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ExtrudeMethod.
+func (v ExtrudeMethod) IsValid() bool {
+	switch v {
+	case ExtrudeMethodNew, ExtrudeMethodMerge:
+		return true
+	}
+	return false
+}
+
+// ParseExtrudeMethod parses a ExtrudeMethod from a string, returning an error if the value is not known.
+func ParseExtrudeMethod(s string) (ExtrudeMethod, error) {
+	v := ExtrudeMethod(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ExtrudeMethod %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ExtrudeMethod) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ExtrudeMethod) UnmarshalText(text []byte) error {
+	*v = ExtrudeMethod(text)
+	return nil
+}
+
 // ExtrudeReference: ExtrudeReference: Type of reference geometry to extrude to.
 type ExtrudeReference any
 
@@ -2831,15 +4643,15 @@ type ExtrudeReferencePoint struct {
 // ExtrudeToReference: The response from the `ExtrudeToReference` endpoint.
 type ExtrudeToReference struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // ExtrudedFaceInfo: IDs for the extruded faces.
 type ExtrudedFaceInfo struct {
 	// Bottom: The face made from the original 2D shape being extruded. If the solid is extruded from a shape which already has an ID (e.g. extruding something which was sketched on a face), this doesn't need to be sent.
-	Bottom UUID `json:"bottom" yaml:"bottom" schema:"bottom"`
+	Bottom *UUID `json:"bottom,omitempty" yaml:"bottom,omitempty" schema:"bottom,omitempty"`
 	// Sides: Any intermediate sides between the top and bottom.
 	Sides []SideFace `json:"sides" yaml:"sides" schema:"sides,required"`
 	// Top: Top face of the extrusion (parallel and further away from the original 2D shape being extruded).
@@ -2860,14 +4672,54 @@ const (
 	ExtrusionFaceCapTypeBoth ExtrusionFaceCapType = "both"
 )
 
+// AllExtrusionFaceCapTypes returns all the known values of ExtrusionFaceCapType.
+func AllExtrusionFaceCapTypes() []ExtrusionFaceCapType {
+	return []ExtrusionFaceCapType{
+		ExtrusionFaceCapTypeNone,
+		ExtrusionFaceCapTypeTop,
+		ExtrusionFaceCapTypeBottom,
+		ExtrusionFaceCapTypeBoth,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ExtrusionFaceCapType.
+func (v ExtrusionFaceCapType) IsValid() bool {
+	switch v {
+	case ExtrusionFaceCapTypeNone, ExtrusionFaceCapTypeTop, ExtrusionFaceCapTypeBottom, ExtrusionFaceCapTypeBoth:
+		return true
+	}
+	return false
+}
+
+// ParseExtrusionFaceCapType parses a ExtrusionFaceCapType from a string, returning an error if the value is not known.
+func ParseExtrusionFaceCapType(s string) (ExtrusionFaceCapType, error) {
+	v := ExtrusionFaceCapType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ExtrusionFaceCapType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ExtrusionFaceCapType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ExtrusionFaceCapType) UnmarshalText(text []byte) error {
+	*v = ExtrusionFaceCapType(text)
+	return nil
+}
+
 // ExtrusionFaceInfo: Extrusion face info struct (useful for maintaining mappings between source path segment ids and extrusion faces)
 type ExtrusionFaceInfo struct {
 	// Cap: Whether or not this extrusion face is a top/bottom cap face or not. Note that top/bottom cap faces will not have associated curve IDs.
 	Cap ExtrusionFaceCapType `json:"cap" yaml:"cap" schema:"cap,required"`
 	// CurveID: Path component (curve) UUID.
-	CurveID UUID `json:"curve_id" yaml:"curve_id" schema:"curve_id"`
+	CurveID *UUID `json:"curve_id,omitempty" yaml:"curve_id,omitempty" schema:"curve_id,omitempty"`
 	// FaceID: Face uuid.
-	FaceID UUID `json:"face_id" yaml:"face_id" schema:"face_id"`
+	FaceID *UUID `json:"face_id,omitempty" yaml:"face_id,omitempty" schema:"face_id,omitempty"`
 }
 
 // FaceEdgeInfo: Faces and edges id info (most used in identifying geometry in patterned and mirrored objects).
@@ -2905,13 +4757,13 @@ type FaceGetPosition struct {
 // FaceIsPlanar: Surface-local planar axes (if available)
 type FaceIsPlanar struct {
 	// Origin: plane's origin
-	Origin Point3D `json:"origin" yaml:"origin" schema:"origin"`
+	Origin *Point3D `json:"origin,omitempty" yaml:"origin,omitempty" schema:"origin,omitempty"`
 	// XAxis: plane's local x-axis
-	XAxis Point3D `json:"x_axis" yaml:"x_axis" schema:"x_axis"`
+	XAxis *Point3D `json:"x_axis,omitempty" yaml:"x_axis,omitempty" schema:"x_axis,omitempty"`
 	// YAxis: plane's local y-axis
-	YAxis Point3D `json:"y_axis" yaml:"y_axis" schema:"y_axis"`
+	YAxis *Point3D `json:"y_axis,omitempty" yaml:"y_axis,omitempty" schema:"y_axis,omitempty"`
 	// ZAxis: plane's local z-axis (normal)
-	ZAxis Point3D `json:"z_axis" yaml:"z_axis" schema:"z_axis"`
+	ZAxis *Point3D `json:"z_axis,omitempty" yaml:"z_axis,omitempty" schema:"z_axis,omitempty"`
 }
 
 // FactoryCustomerCatalogOption: One customer-selectable Factory catalog entry.
@@ -2923,7 +4775,7 @@ type FactoryCustomerCatalogOption struct {
 // FactoryJobResponse: Response returned when a Factory job is created. Only customer-facing ids are exposed: the job id (the customer's reference) and its current version id. The internal Help Desk thread id is deliberately NOT returned (internal-only per the ERD).
 type FactoryJobResponse struct {
 	// CurrentVersionID: The current (first) version id of the job.
-	CurrentVersionID UUID `json:"current_version_id" yaml:"current_version_id" schema:"current_version_id"`
+	CurrentVersionID *UUID `json:"current_version_id,omitempty" yaml:"current_version_id,omitempty" schema:"current_version_id,omitempty"`
 	// ID: The stable, customer-facing job id.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Status: The initial workflow status (`new`).
@@ -2935,7 +4787,7 @@ type FailureWebSocketResponse struct {
 	// Errors: The errors that occurred.
 	Errors []APIError `json:"errors" yaml:"errors" schema:"errors,required"`
 	// RequestID: Which request this is a response to. If the request was a modeling command, this is the modeling command ID. If no request ID was sent, this will be null.
-	RequestID UUID `json:"request_id" yaml:"request_id" schema:"request_id"`
+	RequestID *UUID `json:"request_id,omitempty" yaml:"request_id,omitempty" schema:"request_id,omitempty"`
 	// Success: Always false
 	Success bool `json:"success" yaml:"success" schema:"success,required"`
 }
@@ -2950,6 +4802,44 @@ const (
 	FbxStorageBinary FbxStorage = "binary"
 )
 
+// AllFbxStorages returns all the known values of FbxStorage.
+func AllFbxStorages() []FbxStorage {
+	return []FbxStorage{
+		FbxStorageAscii,
+		FbxStorageBinary,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of FbxStorage.
+func (v FbxStorage) IsValid() bool {
+	switch v {
+	case FbxStorageAscii, FbxStorageBinary:
+		return true
+	}
+	return false
+}
+
+// ParseFbxStorage parses a FbxStorage from a string, returning an error if the value is not known.
+func ParseFbxStorage(s string) (FbxStorage, error) {
+	v := FbxStorage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FbxStorage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v FbxStorage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *FbxStorage) UnmarshalText(text []byte) error {
+	*v = FbxStorage(text)
+	return nil
+}
+
 // Feature: Feature-like switches determined by environment, rules, and overrides.
 type Feature string
 
@@ -3014,16 +4904,81 @@ const (
 	FeatureZooCorpAuth Feature = "zoo_corp_auth"
 )
 
+// AllFeatures returns all the known values of Feature.
+func AllFeatures() []Feature {
+	return []Feature{
+		FeatureAuthRestrictedToEmployees,
+		FeatureBigQueryTelemetry,
+		FeatureBilling,
+		FeatureDisallowSelfSignup,
+		FeatureEmailWithSES,
+		FeatureEnableZ0006Lint,
+		FeatureFactoryPortal,
+		FeatureKclCekExecutor,
+		FeatureKclNewLexerParser,
+		FeatureRedirectToGovcloud,
+		FeatureRequireSamlAuth,
+		FeatureLocalDev,
+		FeatureNewsletter,
+		FeaturePrefixEmailSubject,
+		FeatureEnterpriseCockroach,
+		FeatureSameSiteNoneCookies,
+		FeatureValidateTaxInfo,
+		FeatureModelingDialogs,
+		FeatureNamedViewsUi,
+		FeaturePlugins,
+		FeatureProprietaryToKclConversionBeta,
+		FeatureSegmentsBasedRegions,
+		FeatureSketchExperimentalFeatures,
+		FeatureWebAppFileBrowser,
+		FeatureZookeeperProMode,
+		FeatureZookeeperUltraMode,
+		FeatureUnsafeAllowAPIKeyAuth,
+		FeatureUnsafeAllowLocalhostShortlinks,
+		FeatureZooCorpAuth,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Feature.
+func (v Feature) IsValid() bool {
+	switch v {
+	case FeatureAuthRestrictedToEmployees, FeatureBigQueryTelemetry, FeatureBilling, FeatureDisallowSelfSignup, FeatureEmailWithSES, FeatureEnableZ0006Lint, FeatureFactoryPortal, FeatureKclCekExecutor, FeatureKclNewLexerParser, FeatureRedirectToGovcloud, FeatureRequireSamlAuth, FeatureLocalDev, FeatureNewsletter, FeaturePrefixEmailSubject, FeatureEnterpriseCockroach, FeatureSameSiteNoneCookies, FeatureValidateTaxInfo, FeatureModelingDialogs, FeatureNamedViewsUi, FeaturePlugins, FeatureProprietaryToKclConversionBeta, FeatureSegmentsBasedRegions, FeatureSketchExperimentalFeatures, FeatureWebAppFileBrowser, FeatureZookeeperProMode, FeatureZookeeperUltraMode, FeatureUnsafeAllowAPIKeyAuth, FeatureUnsafeAllowLocalhostShortlinks, FeatureZooCorpAuth:
+		return true
+	}
+	return false
+}
+
+// ParseFeature parses a Feature from a string, returning an error if the value is not known.
+func ParseFeature(s string) (Feature, error) {
+	v := Feature(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Feature %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Feature) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Feature) UnmarshalText(text []byte) error {
+	*v = Feature(text)
+	return nil
+}
+
 // FileCenterOfMass: A file center of mass result.
 type FileCenterOfMass struct {
 	// CenterOfMass: The resulting center of mass.
-	CenterOfMass Point3D `json:"center_of_mass" yaml:"center_of_mass" schema:"center_of_mass"`
+	CenterOfMass *Point3D `json:"center_of_mass,omitempty" yaml:"center_of_mass,omitempty" schema:"center_of_mass,omitempty"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -3033,7 +4988,7 @@ type FileCenterOfMass struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -3045,11 +5000,11 @@ type FileCenterOfMass struct {
 // FileConversion: A file conversion.
 type FileConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -3057,15 +5012,15 @@ type FileConversion struct {
 	// OutputFormat: The output format of the file conversion.
 	OutputFormat FileExportFormat `json:"output_format" yaml:"output_format" schema:"output_format,required"`
 	// OutputFormatOptions: The output format options of the file conversion.
-	OutputFormatOptions any `json:"output_format_options" yaml:"output_format_options" schema:"output_format_options"`
+	OutputFormatOptions any `json:"output_format_options,omitempty" yaml:"output_format_options,omitempty" schema:"output_format_options,omitempty"`
 	// Outputs: The converted files (if multiple file conversion), if completed, base64 encoded. The key of the map is the path of the output file.
-	Outputs map[string]Base64 `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]Base64 `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// SrcFormat: The source format of the file conversion.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// SrcFormatOptions: The source format options of the file conversion.
-	SrcFormatOptions any `json:"src_format_options" yaml:"src_format_options" schema:"src_format_options"`
+	SrcFormatOptions any `json:"src_format_options,omitempty" yaml:"src_format_options,omitempty" schema:"src_format_options,omitempty"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -3077,19 +5032,19 @@ type FileConversion struct {
 // FileDensity: A file density result.
 type FileDensity struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Density: The resulting density.
-	Density float64 `json:"density" yaml:"density" schema:"density"`
+	Density *float64 `json:"density,omitempty" yaml:"density,omitempty" schema:"density,omitempty"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// MaterialMass: The material mass as denoted by the user.
-	MaterialMass float64 `json:"material_mass" yaml:"material_mass" schema:"material_mass"`
+	MaterialMass *float64 `json:"material_mass,omitempty" yaml:"material_mass,omitempty" schema:"material_mass,omitempty"`
 	// MaterialMassUnit: The material mass unit.
 	MaterialMassUnit UnitMas `json:"material_mass_unit" yaml:"material_mass_unit" schema:"material_mass_unit,required"`
 	// OutputUnit: The output unit for the density.
@@ -3097,7 +5052,7 @@ type FileDensity struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -3136,6 +5091,49 @@ const (
 	FileExportFormatStl FileExportFormat = "stl"
 )
 
+// AllFileExportFormats returns all the known values of FileExportFormat.
+func AllFileExportFormats() []FileExportFormat {
+	return []FileExportFormat{
+		FileExportFormatFbx,
+		FileExportFormatGlb,
+		FileExportFormatGltf,
+		FileExportFormatObj,
+		FileExportFormatPly,
+		FileExportFormatStep,
+		FileExportFormatStl,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of FileExportFormat.
+func (v FileExportFormat) IsValid() bool {
+	switch v {
+	case FileExportFormatFbx, FileExportFormatGlb, FileExportFormatGltf, FileExportFormatObj, FileExportFormatPly, FileExportFormatStep, FileExportFormatStl:
+		return true
+	}
+	return false
+}
+
+// ParseFileExportFormat parses a FileExportFormat from a string, returning an error if the value is not known.
+func ParseFileExportFormat(s string) (FileExportFormat, error) {
+	v := FileExportFormat(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FileExportFormat %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v FileExportFormat) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *FileExportFormat) UnmarshalText(text []byte) error {
+	*v = FileExportFormat(text)
+	return nil
+}
+
 // FileImportFormat: The valid types of source file formats.
 type FileImportFormat string
 
@@ -3168,22 +5166,71 @@ const (
 	FileImportFormatStl FileImportFormat = "stl"
 )
 
+// AllFileImportFormats returns all the known values of FileImportFormat.
+func AllFileImportFormats() []FileImportFormat {
+	return []FileImportFormat{
+		FileImportFormatAcis,
+		FileImportFormatCatia,
+		FileImportFormatCreo,
+		FileImportFormatFbx,
+		FileImportFormatGltf,
+		FileImportFormatInventor,
+		FileImportFormatNx,
+		FileImportFormatObj,
+		FileImportFormatParasolid,
+		FileImportFormatPly,
+		FileImportFormatSldprt,
+		FileImportFormatStep,
+		FileImportFormatStl,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of FileImportFormat.
+func (v FileImportFormat) IsValid() bool {
+	switch v {
+	case FileImportFormatAcis, FileImportFormatCatia, FileImportFormatCreo, FileImportFormatFbx, FileImportFormatGltf, FileImportFormatInventor, FileImportFormatNx, FileImportFormatObj, FileImportFormatParasolid, FileImportFormatPly, FileImportFormatSldprt, FileImportFormatStep, FileImportFormatStl:
+		return true
+	}
+	return false
+}
+
+// ParseFileImportFormat parses a FileImportFormat from a string, returning an error if the value is not known.
+func ParseFileImportFormat(s string) (FileImportFormat, error) {
+	v := FileImportFormat(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid FileImportFormat %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v FileImportFormat) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *FileImportFormat) UnmarshalText(text []byte) error {
+	*v = FileImportFormat(text)
+	return nil
+}
+
 // FileMass: A file mass result.
 type FileMass struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Mass: The resulting mass.
-	Mass float64 `json:"mass" yaml:"mass" schema:"mass"`
+	Mass *float64 `json:"mass,omitempty" yaml:"mass,omitempty" schema:"mass,omitempty"`
 	// MaterialDensity: The material density as denoted by the user.
-	MaterialDensity float64 `json:"material_density" yaml:"material_density" schema:"material_density"`
+	MaterialDensity *float64 `json:"material_density,omitempty" yaml:"material_density,omitempty" schema:"material_density,omitempty"`
 	// MaterialDensityUnit: The material density unit.
 	MaterialDensityUnit UnitDensity `json:"material_density_unit" yaml:"material_density_unit" schema:"material_density_unit,required"`
 	// OutputUnit: The output unit for the mass.
@@ -3191,7 +5238,7 @@ type FileMass struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -3203,11 +5250,11 @@ type FileMass struct {
 // FileSurfaceArea: A file surface area result.
 type FileSurfaceArea struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -3217,11 +5264,11 @@ type FileSurfaceArea struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// SurfaceArea: The resulting surface area.
-	SurfaceArea float64 `json:"surface_area" yaml:"surface_area" schema:"surface_area"`
+	SurfaceArea *float64 `json:"surface_area,omitempty" yaml:"surface_area,omitempty" schema:"surface_area,omitempty"`
 	// UpdatedAt: The time and date the API call was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 	// UserID: The user ID of the user who created the API call.
@@ -3231,11 +5278,11 @@ type FileSurfaceArea struct {
 // FileVolume: A file volume result.
 type FileVolume struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -3245,7 +5292,7 @@ type FileVolume struct {
 	// SrcFormat: The source format of the file.
 	SrcFormat FileImportFormat `json:"src_format" yaml:"src_format" schema:"src_format,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -3253,7 +5300,7 @@ type FileVolume struct {
 	// UserID: The user ID of the user who created the API call.
 	UserID UUID `json:"user_id" yaml:"user_id" schema:"user_id,required"`
 	// Volume: The resulting volume.
-	Volume float64 `json:"volume" yaml:"volume" schema:"volume"`
+	Volume *float64 `json:"volume,omitempty" yaml:"volume,omitempty" schema:"volume,omitempty"`
 }
 
 // Files is the type definition for a Files.
@@ -3267,19 +5314,19 @@ type Fillet struct {
 	// Radius: The radius of the fillet.
 	Radius float64 `json:"radius" yaml:"radius" schema:"radius,required"`
 	// SecondLength: The second length affects the edge length of the second face of the cut. This will cause the fillet to take on the shape of a conic section, instead of an arc.
-	SecondLength float64 `json:"second_length" yaml:"second_length" schema:"second_length"`
+	SecondLength *float64 `json:"second_length,omitempty" yaml:"second_length,omitempty" schema:"second_length,omitempty"`
 }
 
 // FractionOfEdge: An edge id and an upper and lower percentage bound of the edge.
 type FractionOfEdge struct {
 	// EdgeID: The id of the edge (legacy). If both `edge_id` and `edge_specifier` are provided, `edge_specifier` takes precedence.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// EdgeSpecifier: Edge specifier (side_faces, end_faces, index) identifying the edge. If both `edge_id` and `edge_specifier` are provided, `edge_specifier` takes precedence.
-	EdgeSpecifier EdgeSpecifier `json:"edge_specifier" yaml:"edge_specifier" schema:"edge_specifier"`
+	EdgeSpecifier *EdgeSpecifier `json:"edge_specifier,omitempty" yaml:"edge_specifier,omitempty" schema:"edge_specifier,omitempty"`
 	// LowerBound: A value between [0.0, 1.0] (default 0.0) that is a percentage along the edge. This bound will control how much of the edge is used during the blend. If lower_bound is larger than upper_bound, the edge is effectively "flipped".
-	LowerBound float64 `json:"lower_bound" yaml:"lower_bound" schema:"lower_bound"`
+	LowerBound *float64 `json:"lower_bound,omitempty" yaml:"lower_bound,omitempty" schema:"lower_bound,omitempty"`
 	// UpperBound: A value between [0.0, 1.0] (default 1.0) that is a percentage along the edge. This bound will control how much of the edge is used during the blend. If lower_bound is larger than upper_bound, the edge is effectively "flipped".
-	UpperBound float64 `json:"upper_bound" yaml:"upper_bound" schema:"upper_bound"`
+	UpperBound *float64 `json:"upper_bound,omitempty" yaml:"upper_bound,omitempty" schema:"upper_bound,omitempty"`
 }
 
 // GetEntityType: The response from the `GetEntityType` command.
@@ -3318,6 +5365,45 @@ const (
 	GlobalAxiZ GlobalAxi = "z"
 )
 
+// AllGlobalAxis returns all the known values of GlobalAxi.
+func AllGlobalAxis() []GlobalAxi {
+	return []GlobalAxi{
+		GlobalAxiX,
+		GlobalAxiY,
+		GlobalAxiZ,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of GlobalAxi.
+func (v GlobalAxi) IsValid() bool {
+	switch v {
+	case GlobalAxiX, GlobalAxiY, GlobalAxiZ:
+		return true
+	}
+	return false
+}
+
+// ParseGlobalAxi parses a GlobalAxi from a string, returning an error if the value is not known.
+func ParseGlobalAxi(s string) (GlobalAxi, error) {
+	v := GlobalAxi(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid GlobalAxi %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v GlobalAxi) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *GlobalAxi) UnmarshalText(text []byte) error {
+	*v = GlobalAxi(text)
+	return nil
+}
+
 // GltfPresentation: Describes the presentation style of the glTF JSON.
 type GltfPresentation string
 
@@ -3330,6 +5416,44 @@ const (
 	GltfPresentationPretty GltfPresentation = "pretty"
 )
 
+// AllGltfPresentations returns all the known values of GltfPresentation.
+func AllGltfPresentations() []GltfPresentation {
+	return []GltfPresentation{
+		GltfPresentationCompact,
+		GltfPresentationPretty,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of GltfPresentation.
+func (v GltfPresentation) IsValid() bool {
+	switch v {
+	case GltfPresentationCompact, GltfPresentationPretty:
+		return true
+	}
+	return false
+}
+
+// ParseGltfPresentation parses a GltfPresentation from a string, returning an error if the value is not known.
+func ParseGltfPresentation(s string) (GltfPresentation, error) {
+	v := GltfPresentation(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid GltfPresentation %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v GltfPresentation) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *GltfPresentation) UnmarshalText(text []byte) error {
+	*v = GltfPresentation(text)
+	return nil
+}
+
 // GltfStorage: Describes the storage format of a glTF 2.0 scene.
 type GltfStorage string
 
@@ -3350,6 +5474,45 @@ const (
 	GltfStorageEmbedded GltfStorage = "embedded"
 )
 
+// AllGltfStorages returns all the known values of GltfStorage.
+func AllGltfStorages() []GltfStorage {
+	return []GltfStorage{
+		GltfStorageBinary,
+		GltfStorageStandard,
+		GltfStorageEmbedded,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of GltfStorage.
+func (v GltfStorage) IsValid() bool {
+	switch v {
+	case GltfStorageBinary, GltfStorageStandard, GltfStorageEmbedded:
+		return true
+	}
+	return false
+}
+
+// ParseGltfStorage parses a GltfStorage from a string, returning an error if the value is not known.
+func ParseGltfStorage(s string) (GltfStorage, error) {
+	v := GltfStorage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid GltfStorage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v GltfStorage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *GltfStorage) UnmarshalText(text []byte) error {
+	*v = GltfStorage(text)
+	return nil
+}
+
 // HandleMouseDragEnd: The response from the `HandleMouseDragEnd` endpoint.
 type HandleMouseDragEnd struct {
 }
@@ -3369,19 +5532,19 @@ type HighlightSetEntities struct {
 // HighlightSetEntity: The response from the `HighlightSetEntity` command.
 type HighlightSetEntity struct {
 	// EntityID: The UUID of the entity that was highlighted.
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 	// Sequence: If the client sent a sequence ID with its request, the backend sends it back.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 }
 
 // IceServer: Representation of an ICE server used for STUN/TURN Used to initiate WebRTC connections based on <https://developer.mozilla.org/en-US/docs/Web/API/RTCIceServer>
 type IceServer struct {
 	// Credential: Credentials for a given TURN server.
-	Credential string `json:"credential" yaml:"credential" schema:"credential"`
+	Credential *string `json:"credential,omitempty" yaml:"credential,omitempty" schema:"credential,omitempty"`
 	// Urls: URLs for a given STUN/TURN server. IceServer urls can either be a string or an array of strings But, we choose to always convert to an array of strings for consistency
 	Urls []string `json:"urls" yaml:"urls" schema:"urls,required"`
 	// Username: Username for a given TURN server.
-	Username string `json:"username" yaml:"username" schema:"username"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty" schema:"username,omitempty"`
 }
 
 // IdpMetadataSource: IdpMetadataSource: The source of an identity provider metadata descriptor.
@@ -3413,6 +5576,44 @@ const (
 	ImageFormatJpeg ImageFormat = "jpeg"
 )
 
+// AllImageFormats returns all the known values of ImageFormat.
+func AllImageFormats() []ImageFormat {
+	return []ImageFormat{
+		ImageFormatPng,
+		ImageFormatJpeg,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ImageFormat.
+func (v ImageFormat) IsValid() bool {
+	switch v {
+	case ImageFormatPng, ImageFormatJpeg:
+		return true
+	}
+	return false
+}
+
+// ParseImageFormat parses a ImageFormat from a string, returning an error if the value is not known.
+func ParseImageFormat(s string) (ImageFormat, error) {
+	v := ImageFormat(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ImageFormat %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ImageFormat) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ImageFormat) UnmarshalText(text []byte) error {
+	*v = ImageFormat(text)
+	return nil
+}
+
 // ImportFile: File to import into the current model. If you are sending binary data for a file, be sure to send the WebSocketRequest as binary/bson, not text/json.
 type ImportFile struct {
 	// Data: The raw bytes of the file
@@ -3447,11 +5648,11 @@ type InputFormat3D any
 // InputFormat3Dacis: PTC Creo part format.
 type InputFormat3Dacis struct {
 	// Coords: Co-ordinate system of input data.
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// SplitClosedFaces: Splits all closed faces into two open faces.
 	//
 	// Defaults to `false` but is implicitly `true` when importing into the engine.
-	SplitClosedFaces bool `json:"split_closed_faces" yaml:"split_closed_faces" schema:"split_closed_faces"`
+	SplitClosedFaces *bool `json:"split_closed_faces,omitempty" yaml:"split_closed_faces,omitempty" schema:"split_closed_faces,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -3459,11 +5660,11 @@ type InputFormat3Dacis struct {
 // InputFormat3Dcatia: Autodesk Inventor part format.
 type InputFormat3Dcatia struct {
 	// Coords: Co-ordinate system of input data.
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// SplitClosedFaces: Splits all closed faces into two open faces.
 	//
 	// Defaults to `false` but is implicitly `true` when importing into the engine.
-	SplitClosedFaces bool `json:"split_closed_faces" yaml:"split_closed_faces" schema:"split_closed_faces"`
+	SplitClosedFaces *bool `json:"split_closed_faces,omitempty" yaml:"split_closed_faces,omitempty" schema:"split_closed_faces,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -3475,11 +5676,11 @@ type InputFormat3Dcoords struct {
 	// Defaults to the [KittyCAD co-ordinate system].
 	//
 	// [KittyCAD co-ordinate system]: ../coord/constant.KITTYCAD.html
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// SplitClosedFaces: Splits all closed faces into two open faces.
 	//
 	// Defaults to `false` but is implicitly `true` when importing into the engine.
-	SplitClosedFaces bool `json:"split_closed_faces" yaml:"split_closed_faces" schema:"split_closed_faces"`
+	SplitClosedFaces *bool `json:"split_closed_faces,omitempty" yaml:"split_closed_faces,omitempty" schema:"split_closed_faces,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -3487,11 +5688,11 @@ type InputFormat3Dcoords struct {
 // InputFormat3Dcreo: Parasolid part format.
 type InputFormat3Dcreo struct {
 	// Coords: Co-ordinate system of input data.
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// SplitClosedFaces: Splits all closed faces into two open faces.
 	//
 	// Defaults to `false` but is implicitly `true` when importing into the engine.
-	SplitClosedFaces bool `json:"split_closed_faces" yaml:"split_closed_faces" schema:"split_closed_faces"`
+	SplitClosedFaces *bool `json:"split_closed_faces,omitempty" yaml:"split_closed_faces,omitempty" schema:"split_closed_faces,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -3517,11 +5718,11 @@ type InputFormat3Dfbx struct {
 // InputFormat3Dgltf: SolidWorks part (SLDPRT) format.
 type InputFormat3Dgltf struct {
 	// Coords: Co-ordinate system of input data.
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// SplitClosedFaces: Splits all closed faces into two open faces.
 	//
 	// Defaults to `false` but is implicitly `true` when importing into the engine.
-	SplitClosedFaces bool `json:"split_closed_faces" yaml:"split_closed_faces" schema:"split_closed_faces"`
+	SplitClosedFaces *bool `json:"split_closed_faces,omitempty" yaml:"split_closed_faces,omitempty" schema:"split_closed_faces,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -3549,93 +5750,93 @@ type Invoice struct {
 	// AmountDue: Final amount due at this time for this invoice.
 	//
 	// If the invoice's total is smaller than the minimum charge amount, for example, or if there is account credit that can be applied to the invoice, the `amount_due` may be 0. If there is a positive `starting_balance` for the invoice (the customer owes money), the `amount_due` will also take that into account. The charge that gets generated for the invoice will be for the amount specified in `amount_due`.
-	AmountDue float64 `json:"amount_due" yaml:"amount_due" schema:"amount_due"`
+	AmountDue *float64 `json:"amount_due,omitempty" yaml:"amount_due,omitempty" schema:"amount_due,omitempty"`
 	// AmountPaid: The amount, in USD, that was paid.
-	AmountPaid float64 `json:"amount_paid" yaml:"amount_paid" schema:"amount_paid"`
+	AmountPaid *float64 `json:"amount_paid,omitempty" yaml:"amount_paid,omitempty" schema:"amount_paid,omitempty"`
 	// AmountRefunded: Total amount refunded across all captured charges linked to this invoice.
-	AmountRefunded float64 `json:"amount_refunded" yaml:"amount_refunded" schema:"amount_refunded"`
+	AmountRefunded *float64 `json:"amount_refunded,omitempty" yaml:"amount_refunded,omitempty" schema:"amount_refunded,omitempty"`
 	// AmountRemaining: The amount remaining, in USD, that is due.
-	AmountRemaining float64 `json:"amount_remaining" yaml:"amount_remaining" schema:"amount_remaining"`
+	AmountRemaining *float64 `json:"amount_remaining,omitempty" yaml:"amount_remaining,omitempty" schema:"amount_remaining,omitempty"`
 	// AttemptCount: Number of payment attempts made for this invoice, from the perspective of the payment retry schedule.
 	//
 	// Any payment attempt counts as the first attempt, and subsequently only automatic retries increment the attempt count. In other words, manual payment attempts after the first attempt do not affect the retry schedule.
-	AttemptCount int `json:"attempt_count" yaml:"attempt_count" schema:"attempt_count"`
+	AttemptCount *int `json:"attempt_count,omitempty" yaml:"attempt_count,omitempty" schema:"attempt_count,omitempty"`
 	// Attempted: Whether an attempt has been made to pay the invoice.
 	//
 	// An invoice is not attempted until 1 hour after the `invoice.created` webhook, for example, so you might not want to display that invoice as unpaid to your users.
-	Attempted bool `json:"attempted" yaml:"attempted" schema:"attempted"`
+	Attempted *bool `json:"attempted,omitempty" yaml:"attempted,omitempty" schema:"attempted,omitempty"`
 	// BillingReason: Why this invoice was created (e.g. `subscription_cycle`).
-	BillingReason string `json:"billing_reason" yaml:"billing_reason" schema:"billing_reason"`
+	BillingReason *string `json:"billing_reason,omitempty" yaml:"billing_reason,omitempty" schema:"billing_reason,omitempty"`
 	// CollectionMethod: Invoice collection method as returned by Stripe.
-	CollectionMethod string `json:"collection_method" yaml:"collection_method" schema:"collection_method"`
+	CollectionMethod *string `json:"collection_method,omitempty" yaml:"collection_method,omitempty" schema:"collection_method,omitempty"`
 	// CreatedAt: Time at which the object was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Currency: Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html).
-	Currency string `json:"currency" yaml:"currency" schema:"currency"`
+	Currency *string `json:"currency,omitempty" yaml:"currency,omitempty" schema:"currency,omitempty"`
 	// CustomerEmail: The email address for the customer. Until the invoice is finalized, this field will equal customer.email. Once the invoice is finalized, this field will no longer be updated.
-	CustomerEmail string `json:"customer_email" yaml:"customer_email" schema:"customer_email"`
+	CustomerEmail *string `json:"customer_email,omitempty" yaml:"customer_email,omitempty" schema:"customer_email,omitempty"`
 	// CustomerID: Customer ID. The unique identifier for the customer this invoice belongs to. This is the customer ID in the payments service, not our database customer ID.
-	CustomerID string `json:"customer_id" yaml:"customer_id" schema:"customer_id"`
+	CustomerID *string `json:"customer_id,omitempty" yaml:"customer_id,omitempty" schema:"customer_id,omitempty"`
 	// DefaultPaymentMethod: Default payment method.
-	DefaultPaymentMethod string `json:"default_payment_method" yaml:"default_payment_method" schema:"default_payment_method"`
+	DefaultPaymentMethod *string `json:"default_payment_method,omitempty" yaml:"default_payment_method,omitempty" schema:"default_payment_method,omitempty"`
 	// Description: Description of the invoice.
-	Description string `json:"description" yaml:"description" schema:"description"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty" schema:"description,omitempty"`
 	// Discounts: The discounts applied to the invoice. This is an array of discount objects.
-	Discounts []Discount `json:"discounts" yaml:"discounts" schema:"discounts"`
+	Discounts []Discount `json:"discounts,omitempty" yaml:"discounts,omitempty" schema:"discounts,omitempty"`
 	// ID: Unique identifier for the object.
-	ID string `json:"id" yaml:"id" schema:"id"`
+	ID *string `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// Lines: The individual line items that make up the invoice.
 	//
 	// `lines` is sorted as follows: invoice items in reverse chronological order, followed by the subscription, if any.
-	Lines []InvoiceLineItem `json:"lines" yaml:"lines" schema:"lines"`
+	Lines []InvoiceLineItem `json:"lines,omitempty" yaml:"lines,omitempty" schema:"lines,omitempty"`
 	// Metadata: Set of key-value pairs.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Number: A unique, identifying string that appears on emails sent to the customer for this invoice.
-	Number string `json:"number" yaml:"number" schema:"number"`
+	Number *string `json:"number,omitempty" yaml:"number,omitempty" schema:"number,omitempty"`
 	// Paid: Whether payment was successfully collected for this invoice.
 	//
 	// An invoice can be paid (most commonly) with a charge or with credit from the customer's account balance.
-	Paid bool `json:"paid" yaml:"paid" schema:"paid"`
+	Paid *bool `json:"paid,omitempty" yaml:"paid,omitempty" schema:"paid,omitempty"`
 	// Pdf: The link to download the PDF for the invoice.
-	Pdf URL `json:"pdf" yaml:"pdf" schema:"pdf"`
+	Pdf *URL `json:"pdf,omitempty" yaml:"pdf,omitempty" schema:"pdf,omitempty"`
 	// ReceiptNumber: This is the transaction number that appears on email receipts sent for this invoice.
-	ReceiptNumber string `json:"receipt_number" yaml:"receipt_number" schema:"receipt_number"`
+	ReceiptNumber *string `json:"receipt_number,omitempty" yaml:"receipt_number,omitempty" schema:"receipt_number,omitempty"`
 	// RefundStatus: Refund summary for the invoice's payments, when any refunds have posted.
-	RefundStatus InvoiceRefundStatus `json:"refund_status" yaml:"refund_status" schema:"refund_status"`
+	RefundStatus *InvoiceRefundStatus `json:"refund_status,omitempty" yaml:"refund_status,omitempty" schema:"refund_status,omitempty"`
 	// StatementDescriptor: Extra information about an invoice for the customer's credit card statement.
-	StatementDescriptor string `json:"statement_descriptor" yaml:"statement_descriptor" schema:"statement_descriptor"`
+	StatementDescriptor *string `json:"statement_descriptor,omitempty" yaml:"statement_descriptor,omitempty" schema:"statement_descriptor,omitempty"`
 	// Status: The status of the invoice, one of `draft`, `open`, `paid`, `uncollectible`, or `void`.
-	Status InvoiceStatus `json:"status" yaml:"status" schema:"status"`
+	Status *InvoiceStatus `json:"status,omitempty" yaml:"status,omitempty" schema:"status,omitempty"`
 	// SubscriptionID: Subscription ID tied to this invoice, when available.
-	SubscriptionID string `json:"subscription_id" yaml:"subscription_id" schema:"subscription_id"`
+	SubscriptionID *string `json:"subscription_id,omitempty" yaml:"subscription_id,omitempty" schema:"subscription_id,omitempty"`
 	// Subtotal: Total of all subscriptions, invoice items, and prorations on the invoice before any invoice level discount or tax is applied.
 	//
 	// Item discounts are already incorporated.
-	Subtotal float64 `json:"subtotal" yaml:"subtotal" schema:"subtotal"`
+	Subtotal *float64 `json:"subtotal,omitempty" yaml:"subtotal,omitempty" schema:"subtotal,omitempty"`
 	// Tax: The amount of tax on this invoice.
 	//
 	// This is the sum of all the tax amounts on this invoice.
-	Tax float64 `json:"tax" yaml:"tax" schema:"tax"`
+	Tax *float64 `json:"tax,omitempty" yaml:"tax,omitempty" schema:"tax,omitempty"`
 	// Total: Total after discounts and taxes.
-	Total float64 `json:"total" yaml:"total" schema:"total"`
+	Total *float64 `json:"total,omitempty" yaml:"total,omitempty" schema:"total,omitempty"`
 	// Url: The URL for the hosted invoice page, which allows customers to view and pay an invoice.
-	Url URL `json:"url" yaml:"url" schema:"url"`
+	Url *URL `json:"url,omitempty" yaml:"url,omitempty" schema:"url,omitempty"`
 }
 
 // InvoiceLineItem: An invoice line item.
 type InvoiceLineItem struct {
 	// Amount: The amount, in USD.
-	Amount float64 `json:"amount" yaml:"amount" schema:"amount"`
+	Amount *float64 `json:"amount,omitempty" yaml:"amount,omitempty" schema:"amount,omitempty"`
 	// Currency: Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html).
-	Currency string `json:"currency" yaml:"currency" schema:"currency"`
+	Currency *string `json:"currency,omitempty" yaml:"currency,omitempty" schema:"currency,omitempty"`
 	// Description: The description.
-	Description string `json:"description" yaml:"description" schema:"description"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty" schema:"description,omitempty"`
 	// ID: Unique identifier for the object.
-	ID string `json:"id" yaml:"id" schema:"id"`
+	ID *string `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// InvoiceItem: The ID of the invoice item associated with this line item if any.
-	InvoiceItem string `json:"invoice_item" yaml:"invoice_item" schema:"invoice_item"`
+	InvoiceItem *string `json:"invoice_item,omitempty" yaml:"invoice_item,omitempty" schema:"invoice_item,omitempty"`
 	// Metadata: Set of key-value pairs.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 }
 
 // InvoiceRefundStatus: Summary of the refund state for an invoice's captured payments.
@@ -3648,12 +5849,50 @@ const (
 	InvoiceRefundStatusRefunded InvoiceRefundStatus = "refunded"
 )
 
+// AllInvoiceRefundStatuses returns all the known values of InvoiceRefundStatus.
+func AllInvoiceRefundStatuses() []InvoiceRefundStatus {
+	return []InvoiceRefundStatus{
+		InvoiceRefundStatusPartiallyRefunded,
+		InvoiceRefundStatusRefunded,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of InvoiceRefundStatus.
+func (v InvoiceRefundStatus) IsValid() bool {
+	switch v {
+	case InvoiceRefundStatusPartiallyRefunded, InvoiceRefundStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// ParseInvoiceRefundStatus parses a InvoiceRefundStatus from a string, returning an error if the value is not known.
+func ParseInvoiceRefundStatus(s string) (InvoiceRefundStatus, error) {
+	v := InvoiceRefundStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InvoiceRefundStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v InvoiceRefundStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *InvoiceRefundStatus) UnmarshalText(text []byte) error {
+	*v = InvoiceRefundStatus(text)
+	return nil
+}
+
 // InvoiceResultsPage: A single page of results
 type InvoiceResultsPage struct {
 	// Items: list of items on this page of results
 	Items []Invoice `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // InvoiceStatus: An enum representing the possible values of an `Invoice`'s `status` field.
@@ -3674,80 +5913,122 @@ const (
 	InvoiceStatusUnknown InvoiceStatus = "unknown"
 )
 
+// AllInvoiceStatuses returns all the known values of InvoiceStatus.
+func AllInvoiceStatuses() []InvoiceStatus {
+	return []InvoiceStatus{
+		InvoiceStatusDraft,
+		InvoiceStatusOpen,
+		InvoiceStatusPaid,
+		InvoiceStatusUncollectible,
+		InvoiceStatusVoid,
+		InvoiceStatusUnknown,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of InvoiceStatus.
+func (v InvoiceStatus) IsValid() bool {
+	switch v {
+	case InvoiceStatusDraft, InvoiceStatusOpen, InvoiceStatusPaid, InvoiceStatusUncollectible, InvoiceStatusVoid, InvoiceStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseInvoiceStatus parses a InvoiceStatus from a string, returning an error if the value is not known.
+func ParseInvoiceStatus(s string) (InvoiceStatus, error) {
+	v := InvoiceStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid InvoiceStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v InvoiceStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *InvoiceStatus) UnmarshalText(text []byte) error {
+	*v = InvoiceStatus(text)
+	return nil
+}
+
 // IpAddrInfo: Information about an ip address. Represents geographical and network-related information.
 type IpAddrInfo struct {
 	// Asn: Autonomous System Number.
-	Asn int `json:"asn" yaml:"asn" schema:"asn"`
+	Asn *int `json:"asn,omitempty" yaml:"asn,omitempty" schema:"asn,omitempty"`
 	// City: City name.
-	City string `json:"city" yaml:"city" schema:"city"`
+	City *string `json:"city,omitempty" yaml:"city,omitempty" schema:"city,omitempty"`
 	// ContinentCode: Continent code (e.g., "EU" for Europe).
-	ContinentCode string `json:"continent_code" yaml:"continent_code" schema:"continent_code"`
+	ContinentCode *string `json:"continent_code,omitempty" yaml:"continent_code,omitempty" schema:"continent_code,omitempty"`
 	// Country: Country name.
-	Country string `json:"country" yaml:"country" schema:"country"`
+	Country *string `json:"country,omitempty" yaml:"country,omitempty" schema:"country,omitempty"`
 	// CountryCode: Two-letter country code (e.g., "NL" for Netherlands).
-	CountryCode string `json:"country_code" yaml:"country_code" schema:"country_code"`
+	CountryCode *string `json:"country_code,omitempty" yaml:"country_code,omitempty" schema:"country_code,omitempty"`
 	// CountryCode3: Three-letter country code (e.g., "NLD" for Netherlands).
-	CountryCode3 string `json:"country_code3" yaml:"country_code3" schema:"country_code3"`
+	CountryCode3 *string `json:"country_code3,omitempty" yaml:"country_code3,omitempty" schema:"country_code3,omitempty"`
 	// Ip: IP address of the user.
-	Ip IP `json:"ip" yaml:"ip" schema:"ip"`
+	Ip *IP `json:"ip,omitempty" yaml:"ip,omitempty" schema:"ip,omitempty"`
 	// IsInEuropeanUnion: Flag indicating whether the country is in the European Union.
-	IsInEuropeanUnion bool `json:"is_in_european_union" yaml:"is_in_european_union" schema:"is_in_european_union"`
+	IsInEuropeanUnion *bool `json:"is_in_european_union,omitempty" yaml:"is_in_european_union,omitempty" schema:"is_in_european_union,omitempty"`
 	// Latitude: Geographic latitude.
-	Latitude float64 `json:"latitude" yaml:"latitude" schema:"latitude"`
+	Latitude *float64 `json:"latitude,omitempty" yaml:"latitude,omitempty" schema:"latitude,omitempty"`
 	// Longitude: Geographic longitude.
-	Longitude float64 `json:"longitude" yaml:"longitude" schema:"longitude"`
+	Longitude *float64 `json:"longitude,omitempty" yaml:"longitude,omitempty" schema:"longitude,omitempty"`
 	// Offset: Time offset in seconds from UTC.
-	Offset int `json:"offset" yaml:"offset" schema:"offset"`
+	Offset *int `json:"offset,omitempty" yaml:"offset,omitempty" schema:"offset,omitempty"`
 	// Organization: Organization name (e.g., "RIPE NCC").
-	Organization string `json:"organization" yaml:"organization" schema:"organization"`
+	Organization *string `json:"organization,omitempty" yaml:"organization,omitempty" schema:"organization,omitempty"`
 	// PostalCode: Postal code.
-	PostalCode string `json:"postal_code" yaml:"postal_code" schema:"postal_code"`
+	PostalCode *string `json:"postal_code,omitempty" yaml:"postal_code,omitempty" schema:"postal_code,omitempty"`
 	// Region: Name of the region (e.g., "North Holland").
-	Region string `json:"region" yaml:"region" schema:"region"`
+	Region *string `json:"region,omitempty" yaml:"region,omitempty" schema:"region,omitempty"`
 	// RegionCode: Region code (e.g., "NH" for North Holland).
-	RegionCode string `json:"region_code" yaml:"region_code" schema:"region_code"`
+	RegionCode *string `json:"region_code,omitempty" yaml:"region_code,omitempty" schema:"region_code,omitempty"`
 	// Timezone: Timezone (e.g., "Europe/Amsterdam").
-	Timezone string `json:"timezone" yaml:"timezone" schema:"timezone"`
+	Timezone *string `json:"timezone,omitempty" yaml:"timezone,omitempty" schema:"timezone,omitempty"`
 }
 
 // KclCodeCompletionParams: Extra params for the completions.
 type KclCodeCompletionParams struct {
 	// Language: The language of the code.
-	Language string `json:"language" yaml:"language" schema:"language"`
+	Language *string `json:"language,omitempty" yaml:"language,omitempty" schema:"language,omitempty"`
 	// NextIndent: The next indent of the code.
-	NextIndent int `json:"next_indent" yaml:"next_indent" schema:"next_indent"`
+	NextIndent *int `json:"next_indent,omitempty" yaml:"next_indent,omitempty" schema:"next_indent,omitempty"`
 	// PromptTokens: The prompt tokens for the completions.
-	PromptTokens int `json:"prompt_tokens" yaml:"prompt_tokens" schema:"prompt_tokens"`
+	PromptTokens *int `json:"prompt_tokens,omitempty" yaml:"prompt_tokens,omitempty" schema:"prompt_tokens,omitempty"`
 	// SuffixTokens: The suffix tokens for the completions.
-	SuffixTokens int `json:"suffix_tokens" yaml:"suffix_tokens" schema:"suffix_tokens"`
+	SuffixTokens *int `json:"suffix_tokens,omitempty" yaml:"suffix_tokens,omitempty" schema:"suffix_tokens,omitempty"`
 	// TrimByIndentation: If we should trim by indentation.
-	TrimByIndentation bool `json:"trim_by_indentation" yaml:"trim_by_indentation" schema:"trim_by_indentation"`
+	TrimByIndentation *bool `json:"trim_by_indentation,omitempty" yaml:"trim_by_indentation,omitempty" schema:"trim_by_indentation,omitempty"`
 }
 
 // KclCodeCompletionRequest: A request to generate KCL code completions.
 type KclCodeCompletionRequest struct {
 	// Extra: Extra parameters for the completions.
-	Extra KclCodeCompletionParams `json:"extra" yaml:"extra" schema:"extra"`
+	Extra *KclCodeCompletionParams `json:"extra,omitempty" yaml:"extra,omitempty" schema:"extra,omitempty"`
 	// MaxTokens: The maximum number of tokens that can be generated for the completions. The total length of input tokens and generated tokens is limited by the model’s context length.
-	MaxTokens int `json:"max_tokens" yaml:"max_tokens" schema:"max_tokens"`
+	MaxTokens *int `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty" schema:"max_tokens,omitempty"`
 	// ModelVersion: Zoo provided model, or custom model which should be used to process this request.
-	ModelVersion string `json:"model_version" yaml:"model_version" schema:"model_version"`
+	ModelVersion *string `json:"model_version,omitempty" yaml:"model_version,omitempty" schema:"model_version,omitempty"`
 	// N: How many completion choices to generate for each input message.
-	N int `json:"n" yaml:"n" schema:"n"`
+	N *int `json:"n,omitempty" yaml:"n,omitempty" schema:"n,omitempty"`
 	// Nwo: For GitHub copilot this is the `{org}/{repo}`. This does not do anything yet. But we wanted the same API as GitHub Copilot. It might be used in the future.
-	Nwo string `json:"nwo" yaml:"nwo" schema:"nwo"`
+	Nwo *string `json:"nwo,omitempty" yaml:"nwo,omitempty" schema:"nwo,omitempty"`
 	// Prompt: The prompt for the desired part.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// Stop: Up to 4 sequences where the API will stop generating further tokens.
-	Stop []string `json:"stop" yaml:"stop" schema:"stop"`
+	Stop []string `json:"stop,omitempty" yaml:"stop,omitempty" schema:"stop,omitempty"`
 	// Stream: If set, partial message deltas will be sent, like in ChatGPT or OpenAPI. Tokens will be sent as data-only server-sent events as they become available, with the stream terminated by a data: [DONE] message.
-	Stream bool `json:"stream" yaml:"stream" schema:"stream"`
+	Stream *bool `json:"stream,omitempty" yaml:"stream,omitempty" schema:"stream,omitempty"`
 	// Suffix: The suffix for the desired part.
-	Suffix string `json:"suffix" yaml:"suffix" schema:"suffix"`
+	Suffix *string `json:"suffix,omitempty" yaml:"suffix,omitempty" schema:"suffix,omitempty"`
 	// Temperature: The temperature for the model.
-	Temperature float64 `json:"temperature" yaml:"temperature" schema:"temperature"`
+	Temperature *float64 `json:"temperature,omitempty" yaml:"temperature,omitempty" schema:"temperature,omitempty"`
 	// TopP: The top p for the model.
-	TopP float64 `json:"top_p" yaml:"top_p" schema:"top_p"`
+	TopP *float64 `json:"top_p,omitempty" yaml:"top_p,omitempty" schema:"top_p,omitempty"`
 }
 
 // KclCodeCompletionResponse: A response with KCL code completions.
@@ -3774,6 +6055,45 @@ const (
 	KclProjectFileRolePreviewImage KclProjectFileRole = "preview_image"
 )
 
+// AllKclProjectFileRoles returns all the known values of KclProjectFileRole.
+func AllKclProjectFileRoles() []KclProjectFileRole {
+	return []KclProjectFileRole{
+		KclProjectFileRoleProjectToml,
+		KclProjectFileRoleProjectFile,
+		KclProjectFileRolePreviewImage,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of KclProjectFileRole.
+func (v KclProjectFileRole) IsValid() bool {
+	switch v {
+	case KclProjectFileRoleProjectToml, KclProjectFileRoleProjectFile, KclProjectFileRolePreviewImage:
+		return true
+	}
+	return false
+}
+
+// ParseKclProjectFileRole parses a KclProjectFileRole from a string, returning an error if the value is not known.
+func ParseKclProjectFileRole(s string) (KclProjectFileRole, error) {
+	v := KclProjectFileRole(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid KclProjectFileRole %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v KclProjectFileRole) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *KclProjectFileRole) UnmarshalText(text []byte) error {
+	*v = KclProjectFileRole(text)
+	return nil
+}
+
 // KclProjectPreviewStatus: Preview generation status for a KCL project.
 type KclProjectPreviewStatus string
 
@@ -3786,6 +6106,45 @@ const (
 	KclProjectPreviewStatusFailed KclProjectPreviewStatus = "failed"
 )
 
+// AllKclProjectPreviewStatuses returns all the known values of KclProjectPreviewStatus.
+func AllKclProjectPreviewStatuses() []KclProjectPreviewStatus {
+	return []KclProjectPreviewStatus{
+		KclProjectPreviewStatusPending,
+		KclProjectPreviewStatusReady,
+		KclProjectPreviewStatusFailed,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of KclProjectPreviewStatus.
+func (v KclProjectPreviewStatus) IsValid() bool {
+	switch v {
+	case KclProjectPreviewStatusPending, KclProjectPreviewStatusReady, KclProjectPreviewStatusFailed:
+		return true
+	}
+	return false
+}
+
+// ParseKclProjectPreviewStatus parses a KclProjectPreviewStatus from a string, returning an error if the value is not known.
+func ParseKclProjectPreviewStatus(s string) (KclProjectPreviewStatus, error) {
+	v := KclProjectPreviewStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid KclProjectPreviewStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v KclProjectPreviewStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *KclProjectPreviewStatus) UnmarshalText(text []byte) error {
+	*v = KclProjectPreviewStatus(text)
+	return nil
+}
+
 // KclProjectPublicationStatus: Publication workflow state for a KCL project.
 type KclProjectPublicationStatus string
 
@@ -3806,6 +6165,49 @@ const (
 	KclProjectPublicationStatusChangesRequested KclProjectPublicationStatus = "changes_requested"
 )
 
+// AllKclProjectPublicationStatuses returns all the known values of KclProjectPublicationStatus.
+func AllKclProjectPublicationStatuses() []KclProjectPublicationStatus {
+	return []KclProjectPublicationStatus{
+		KclProjectPublicationStatusPrivate,
+		KclProjectPublicationStatusDraft,
+		KclProjectPublicationStatusPendingReview,
+		KclProjectPublicationStatusPublished,
+		KclProjectPublicationStatusRejected,
+		KclProjectPublicationStatusDeleted,
+		KclProjectPublicationStatusChangesRequested,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of KclProjectPublicationStatus.
+func (v KclProjectPublicationStatus) IsValid() bool {
+	switch v {
+	case KclProjectPublicationStatusPrivate, KclProjectPublicationStatusDraft, KclProjectPublicationStatusPendingReview, KclProjectPublicationStatusPublished, KclProjectPublicationStatusRejected, KclProjectPublicationStatusDeleted, KclProjectPublicationStatusChangesRequested:
+		return true
+	}
+	return false
+}
+
+// ParseKclProjectPublicationStatus parses a KclProjectPublicationStatus from a string, returning an error if the value is not known.
+func ParseKclProjectPublicationStatus(s string) (KclProjectPublicationStatus, error) {
+	v := KclProjectPublicationStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid KclProjectPublicationStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v KclProjectPublicationStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *KclProjectPublicationStatus) UnmarshalText(text []byte) error {
+	*v = KclProjectPublicationStatus(text)
+	return nil
+}
+
 // KclProjectShareLinkAccessMode: Access policy for a shared project download link.
 type KclProjectShareLinkAccessMode string
 
@@ -3816,6 +6218,44 @@ const (
 	KclProjectShareLinkAccessModeOrganizationOnly KclProjectShareLinkAccessMode = "organization_only"
 )
 
+// AllKclProjectShareLinkAccessModes returns all the known values of KclProjectShareLinkAccessMode.
+func AllKclProjectShareLinkAccessModes() []KclProjectShareLinkAccessMode {
+	return []KclProjectShareLinkAccessMode{
+		KclProjectShareLinkAccessModeAnyoneWithLink,
+		KclProjectShareLinkAccessModeOrganizationOnly,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of KclProjectShareLinkAccessMode.
+func (v KclProjectShareLinkAccessMode) IsValid() bool {
+	switch v {
+	case KclProjectShareLinkAccessModeAnyoneWithLink, KclProjectShareLinkAccessModeOrganizationOnly:
+		return true
+	}
+	return false
+}
+
+// ParseKclProjectShareLinkAccessMode parses a KclProjectShareLinkAccessMode from a string, returning an error if the value is not known.
+func ParseKclProjectShareLinkAccessMode(s string) (KclProjectShareLinkAccessMode, error) {
+	v := KclProjectShareLinkAccessMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid KclProjectShareLinkAccessMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v KclProjectShareLinkAccessMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *KclProjectShareLinkAccessMode) UnmarshalText(text []byte) error {
+	*v = KclProjectShareLinkAccessMode(text)
+	return nil
+}
+
 // Loft: The response from the `Loft` command.
 type Loft struct {
 	// SolidID: The UUID of the newly created solid loft.
@@ -3976,6 +6416,105 @@ const (
 	MbdSymbolModifierspace MbdSymbol = "modifierspace"
 )
 
+// AllMbdSymbols returns all the known values of MbdSymbol.
+func AllMbdSymbols() []MbdSymbol {
+	return []MbdSymbol{
+		MbdSymbolNone,
+		MbdSymbolArclength,
+		MbdSymbolBetween,
+		MbdSymbolDegrees,
+		MbdSymbolPlusminus,
+		MbdSymbolAngularity,
+		MbdSymbolCylindricity,
+		MbdSymbolRoundness,
+		MbdSymbolConcentricity,
+		MbdSymbolStraightness,
+		MbdSymbolParallelism,
+		MbdSymbolFlatness,
+		MbdSymbolProfileofline,
+		MbdSymbolSurfaceprofile,
+		MbdSymbolSymmetry,
+		MbdSymbolPerpendicularity,
+		MbdSymbolRunout,
+		MbdSymbolTotalrunout,
+		MbdSymbolPosition,
+		MbdSymbolCenterline,
+		MbdSymbolPartingline,
+		MbdSymbolIsoenvelope,
+		MbdSymbolIsoenvelopenony145M,
+		MbdSymbolFreestate,
+		MbdSymbolStatisticaltolerance,
+		MbdSymbolContinuousfeature,
+		MbdSymbolIndependency,
+		MbdSymbolDepth,
+		MbdSymbolStart,
+		MbdSymbolLeastcondition,
+		MbdSymbolMaxcondition,
+		MbdSymbolConicaltaper,
+		MbdSymbolProjected,
+		MbdSymbolSlope,
+		MbdSymbolMicro,
+		MbdSymbolTangentplane,
+		MbdSymbolUnilateral,
+		MbdSymbolSquarefeature,
+		MbdSymbolCountersink,
+		MbdSymbolSpotface,
+		MbdSymbolTarget,
+		MbdSymbolDiameter,
+		MbdSymbolRadius,
+		MbdSymbolSphericalradius,
+		MbdSymbolSphericaldiameter,
+		MbdSymbolControlledradius,
+		MbdSymbolBoxstart,
+		MbdSymbolBoxbar,
+		MbdSymbolBoxbarbetween,
+		MbdSymbolLetterbackwardunderline,
+		MbdSymbolPunctuationbackwardunderline,
+		MbdSymbolModifierbackwardunderline,
+		MbdSymbolNumericbackwardunderline,
+		MbdSymbolBoxend,
+		MbdSymbolDatumup,
+		MbdSymbolDatumleft,
+		MbdSymbolDatumright,
+		MbdSymbolDatumdown,
+		MbdSymbolDatumtriangle,
+		MbdSymbolHalfspace,
+		MbdSymbolQuarterspace,
+		MbdSymbolEighthspace,
+		MbdSymbolModifierspace,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MbdSymbol.
+func (v MbdSymbol) IsValid() bool {
+	switch v {
+	case MbdSymbolNone, MbdSymbolArclength, MbdSymbolBetween, MbdSymbolDegrees, MbdSymbolPlusminus, MbdSymbolAngularity, MbdSymbolCylindricity, MbdSymbolRoundness, MbdSymbolConcentricity, MbdSymbolStraightness, MbdSymbolParallelism, MbdSymbolFlatness, MbdSymbolProfileofline, MbdSymbolSurfaceprofile, MbdSymbolSymmetry, MbdSymbolPerpendicularity, MbdSymbolRunout, MbdSymbolTotalrunout, MbdSymbolPosition, MbdSymbolCenterline, MbdSymbolPartingline, MbdSymbolIsoenvelope, MbdSymbolIsoenvelopenony145M, MbdSymbolFreestate, MbdSymbolStatisticaltolerance, MbdSymbolContinuousfeature, MbdSymbolIndependency, MbdSymbolDepth, MbdSymbolStart, MbdSymbolLeastcondition, MbdSymbolMaxcondition, MbdSymbolConicaltaper, MbdSymbolProjected, MbdSymbolSlope, MbdSymbolMicro, MbdSymbolTangentplane, MbdSymbolUnilateral, MbdSymbolSquarefeature, MbdSymbolCountersink, MbdSymbolSpotface, MbdSymbolTarget, MbdSymbolDiameter, MbdSymbolRadius, MbdSymbolSphericalradius, MbdSymbolSphericaldiameter, MbdSymbolControlledradius, MbdSymbolBoxstart, MbdSymbolBoxbar, MbdSymbolBoxbarbetween, MbdSymbolLetterbackwardunderline, MbdSymbolPunctuationbackwardunderline, MbdSymbolModifierbackwardunderline, MbdSymbolNumericbackwardunderline, MbdSymbolBoxend, MbdSymbolDatumup, MbdSymbolDatumleft, MbdSymbolDatumright, MbdSymbolDatumdown, MbdSymbolDatumtriangle, MbdSymbolHalfspace, MbdSymbolQuarterspace, MbdSymbolEighthspace, MbdSymbolModifierspace:
+		return true
+	}
+	return false
+}
+
+// ParseMbdSymbol parses a MbdSymbol from a string, returning an error if the value is not known.
+func ParseMbdSymbol(s string) (MbdSymbol, error) {
+	v := MbdSymbol(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MbdSymbol %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MbdSymbol) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MbdSymbol) UnmarshalText(text []byte) error {
+	*v = MbdSymbol(text)
+	return nil
+}
+
 // Method: The Request Method (VERB)
 // This type also contains constants for a number of common HTTP methods such as GET, POST, etc.
 //
@@ -4005,6 +6544,52 @@ const (
 	MethodExtension Method = "EXTENSION"
 )
 
+// AllMethods returns all the known values of Method.
+func AllMethods() []Method {
+	return []Method{
+		MethodOptions,
+		MethodGet,
+		MethodPost,
+		MethodPut,
+		MethodDelete,
+		MethodHead,
+		MethodTrace,
+		MethodConnect,
+		MethodPatch,
+		MethodExtension,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Method.
+func (v Method) IsValid() bool {
+	switch v {
+	case MethodOptions, MethodGet, MethodPost, MethodPut, MethodDelete, MethodHead, MethodTrace, MethodConnect, MethodPatch, MethodExtension:
+		return true
+	}
+	return false
+}
+
+// ParseMethod parses a Method from a string, returning an error if the value is not known.
+func ParseMethod(s string) (Method, error) {
+	v := Method(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Method %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Method) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Method) UnmarshalText(text []byte) error {
+	*v = Method(text)
+	return nil
+}
+
 // MirrorAcross: MirrorAcross: What to reflect mirrored geometry across
 type MirrorAcross any
 
@@ -4038,27 +6623,27 @@ type MlCopilotClientMessage any
 // MlCopilotClientMessageCurrentFiles: The user message, which contains the content of the user's input.
 type MlCopilotClientMessageCurrentFiles struct {
 	// AdditionalFiles: The user can send additional files like images or PDFs to provide more context.
-	AdditionalFiles []MlCopilotFile `json:"additional_files" yaml:"additional_files" schema:"additional_files"`
+	AdditionalFiles []MlCopilotFile `json:"additional_files,omitempty" yaml:"additional_files,omitempty" schema:"additional_files,omitempty"`
 	// Content: The content of the user's message.
 	Content string `json:"content" yaml:"content" schema:"content,required"`
 	// CorrelationID: Stable identifier used to correlate this user request across services.
-	CorrelationID UUID `json:"correlation_id" yaml:"correlation_id" schema:"correlation_id"`
+	CorrelationID *UUID `json:"correlation_id,omitempty" yaml:"correlation_id,omitempty" schema:"correlation_id,omitempty"`
 	// CurrentFiles: The current files in the project, if any. This can be used to provide context for the AI. This should be sent in binary format, if the files are not text files, like an imported binary file.
-	CurrentFiles map[string][]int `json:"current_files" yaml:"current_files" schema:"current_files"`
+	CurrentFiles map[string][]int `json:"current_files,omitempty" yaml:"current_files,omitempty" schema:"current_files,omitempty"`
 	// EngineAPICallID: API call ID for the active Engine modeling session, when available.
-	EngineAPICallID UUID `json:"engine_api_call_id" yaml:"engine_api_call_id" schema:"engine_api_call_id"`
+	EngineAPICallID *UUID `json:"engine_api_call_id,omitempty" yaml:"engine_api_call_id,omitempty" schema:"engine_api_call_id,omitempty"`
 	// ForcedTools: The user can force specific tools to be used for this message.
-	ForcedTools []MlCopilotTool `json:"forced_tools" yaml:"forced_tools" schema:"forced_tools"`
+	ForcedTools []MlCopilotTool `json:"forced_tools,omitempty" yaml:"forced_tools,omitempty" schema:"forced_tools,omitempty"`
 	// Mode: Pick a mode for the agent to operate in. Defaults to a fast mode.
-	Mode MlCopilotMode `json:"mode" yaml:"mode" schema:"mode"`
+	Mode *MlCopilotMode `json:"mode,omitempty" yaml:"mode,omitempty" schema:"mode,omitempty"`
 	// Model: Override the default or mode model with another.
-	Model MlCopilotSupportedModel `json:"model" yaml:"model" schema:"model"`
+	Model *MlCopilotSupportedModel `json:"model,omitempty" yaml:"model,omitempty" schema:"model,omitempty"`
 	// ProjectName: The project name, if any. This can be used to associate the message with a specific project.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// ReasoningEffort: Change the default or mode reasoning effort.
-	ReasoningEffort MlReasoningEffort `json:"reasoning_effort" yaml:"reasoning_effort" schema:"reasoning_effort"`
+	ReasoningEffort *MlReasoningEffort `json:"reasoning_effort,omitempty" yaml:"reasoning_effort,omitempty" schema:"reasoning_effort,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change. If empty, the content (prompt) will be used and is required.
-	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges"`
+	SourceRanges []SourceRangePrompt `json:"source_ranges,omitempty" yaml:"source_ranges,omitempty" schema:"source_ranges,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4080,9 +6665,9 @@ type MlCopilotClientMessageListModes struct {
 // MlCopilotClientMessageMlCopilotClientMessageHeaders: Updates the active project context without creating a new prompt.
 type MlCopilotClientMessageMlCopilotClientMessageHeaders struct {
 	// CurrentFiles: The current files in the project, if any. This can be used to provide context for the AI. This should be sent in binary format if the files are not text files, like an imported binary file.
-	CurrentFiles map[string][]int `json:"current_files" yaml:"current_files" schema:"current_files"`
+	CurrentFiles map[string][]int `json:"current_files,omitempty" yaml:"current_files,omitempty" schema:"current_files,omitempty"`
 	// ProjectName: The project name, if any.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4096,15 +6681,15 @@ type MlCopilotClientMessagePing struct {
 // MlCopilotClientMessageProjectContext: Attachments returned by API in response to a backend `RequestAttachments` message.
 type MlCopilotClientMessageProjectContext struct {
 	// Error: Error encountered while loading attachments, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Files: Loaded attachment files. Empty when no matching attachments were found.
-	Files []MlCopilotFile `json:"files" yaml:"files" schema:"files"`
+	Files []MlCopilotFile `json:"files,omitempty" yaml:"files,omitempty" schema:"files,omitempty"`
 	// PromptID: Prompt the attachments were loaded from.
-	PromptID UUID `json:"prompt_id" yaml:"prompt_id" schema:"prompt_id"`
+	PromptID *UUID `json:"prompt_id,omitempty" yaml:"prompt_id,omitempty" schema:"prompt_id,omitempty"`
 	// RequestID: Optional backend-provided identifier to correlate request/response pairs.
-	RequestID string `json:"request_id" yaml:"request_id" schema:"request_id"`
+	RequestID *string `json:"request_id,omitempty" yaml:"request_id,omitempty" schema:"request_id,omitempty"`
 	// Seq: Specific client message sequence, when requested.
-	Seq int `json:"seq" yaml:"seq" schema:"seq"`
+	Seq *int `json:"seq,omitempty" yaml:"seq,omitempty" schema:"seq,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4122,9 +6707,9 @@ type MlCopilotFile struct {
 	// Data: The file contents as binary data.
 	Data []int `json:"data" yaml:"data" schema:"data,required"`
 	// DataRef: Optional blob storage path for the file contents.
-	DataRef string `json:"data_ref" yaml:"data_ref" schema:"data_ref"`
+	DataRef *string `json:"data_ref,omitempty" yaml:"data_ref,omitempty" schema:"data_ref,omitempty"`
 	// Metadata: Optional metadata associated with the file.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Mimetype: The MIME type of the file (e.g., "image/png", "application/pdf", "model/stl").
 	Mimetype string `json:"mimetype" yaml:"mimetype" schema:"mimetype,required"`
 	// Name: The name of the file.
@@ -4147,12 +6732,53 @@ const (
 	MlCopilotModeZookeeperUltra MlCopilotMode = "zookeeper_ultra"
 )
 
+// AllMlCopilotModes returns all the known values of MlCopilotMode.
+func AllMlCopilotModes() []MlCopilotMode {
+	return []MlCopilotMode{
+		MlCopilotModeFast,
+		MlCopilotModeThoughtful,
+		MlCopilotModeAuto,
+		MlCopilotModeZookeeperPro,
+		MlCopilotModeZookeeperUltra,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlCopilotMode.
+func (v MlCopilotMode) IsValid() bool {
+	switch v {
+	case MlCopilotModeFast, MlCopilotModeThoughtful, MlCopilotModeAuto, MlCopilotModeZookeeperPro, MlCopilotModeZookeeperUltra:
+		return true
+	}
+	return false
+}
+
+// ParseMlCopilotMode parses a MlCopilotMode from a string, returning an error if the value is not known.
+func ParseMlCopilotMode(s string) (MlCopilotMode, error) {
+	v := MlCopilotMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlCopilotMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlCopilotMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlCopilotMode) UnmarshalText(text []byte) error {
+	*v = MlCopilotMode(text)
+	return nil
+}
+
 // MlCopilotModeOption: A client-facing ML copilot mode option.
 type MlCopilotModeOption struct {
 	// Description: Human-readable display description.
 	Description string `json:"description" yaml:"description" schema:"description,required"`
 	// Disabled: Whether this mode is unavailable for the user's current plan.
-	Disabled bool `json:"disabled" yaml:"disabled" schema:"disabled"`
+	Disabled *bool `json:"disabled,omitempty" yaml:"disabled,omitempty" schema:"disabled,omitempty"`
 	// Icon: Client icon identifier.
 	Icon string `json:"icon" yaml:"icon" schema:"icon,required"`
 	// ID: Stable mode identifier to send in user messages.
@@ -4323,6 +6949,50 @@ const (
 	MlCopilotSupportedModelAzureGpt4OMini MlCopilotSupportedModel = "azure_gpt4o_mini"
 )
 
+// AllMlCopilotSupportedModels returns all the known values of MlCopilotSupportedModel.
+func AllMlCopilotSupportedModels() []MlCopilotSupportedModel {
+	return []MlCopilotSupportedModel{
+		MlCopilotSupportedModelGpt5Nano,
+		MlCopilotSupportedModelGpt5Mini,
+		MlCopilotSupportedModelGpt5Codex,
+		MlCopilotSupportedModelGpt5,
+		MlCopilotSupportedModelO3Mini,
+		MlCopilotSupportedModelAzureO3Mini,
+		MlCopilotSupportedModelAzureGpt4O,
+		MlCopilotSupportedModelAzureGpt4OMini,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlCopilotSupportedModel.
+func (v MlCopilotSupportedModel) IsValid() bool {
+	switch v {
+	case MlCopilotSupportedModelGpt5Nano, MlCopilotSupportedModelGpt5Mini, MlCopilotSupportedModelGpt5Codex, MlCopilotSupportedModelGpt5, MlCopilotSupportedModelO3Mini, MlCopilotSupportedModelAzureO3Mini, MlCopilotSupportedModelAzureGpt4O, MlCopilotSupportedModelAzureGpt4OMini:
+		return true
+	}
+	return false
+}
+
+// ParseMlCopilotSupportedModel parses a MlCopilotSupportedModel from a string, returning an error if the value is not known.
+func ParseMlCopilotSupportedModel(s string) (MlCopilotSupportedModel, error) {
+	v := MlCopilotSupportedModel(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlCopilotSupportedModel %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlCopilotSupportedModel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlCopilotSupportedModel) UnmarshalText(text []byte) error {
+	*v = MlCopilotSupportedModel(text)
+	return nil
+}
+
 // MlCopilotSystemCommand: The type of system command that can be sent to the ML Copilot.
 type MlCopilotSystemCommand string
 
@@ -4341,6 +7011,48 @@ const (
 	MlCopilotSystemCommandAnswerNow MlCopilotSystemCommand = "answer_now"
 )
 
+// AllMlCopilotSystemCommands returns all the known values of MlCopilotSystemCommand.
+func AllMlCopilotSystemCommands() []MlCopilotSystemCommand {
+	return []MlCopilotSystemCommand{
+		MlCopilotSystemCommandNew,
+		MlCopilotSystemCommandBye,
+		MlCopilotSystemCommandContinue,
+		MlCopilotSystemCommandInterrupt,
+		MlCopilotSystemCommandCancel,
+		MlCopilotSystemCommandAnswerNow,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlCopilotSystemCommand.
+func (v MlCopilotSystemCommand) IsValid() bool {
+	switch v {
+	case MlCopilotSystemCommandNew, MlCopilotSystemCommandBye, MlCopilotSystemCommandContinue, MlCopilotSystemCommandInterrupt, MlCopilotSystemCommandCancel, MlCopilotSystemCommandAnswerNow:
+		return true
+	}
+	return false
+}
+
+// ParseMlCopilotSystemCommand parses a MlCopilotSystemCommand from a string, returning an error if the value is not known.
+func ParseMlCopilotSystemCommand(s string) (MlCopilotSystemCommand, error) {
+	v := MlCopilotSystemCommand(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlCopilotSystemCommand %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlCopilotSystemCommand) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlCopilotSystemCommand) UnmarshalText(text []byte) error {
+	*v = MlCopilotSystemCommand(text)
+	return nil
+}
+
 // MlCopilotTool: The tools that can be used by the ML Copilot.
 type MlCopilotTool string
 
@@ -4355,6 +7067,46 @@ const (
 	MlCopilotToolWebSearch MlCopilotTool = "web_search"
 )
 
+// AllMlCopilotTools returns all the known values of MlCopilotTool.
+func AllMlCopilotTools() []MlCopilotTool {
+	return []MlCopilotTool{
+		MlCopilotToolEditKclCode,
+		MlCopilotToolTextToCad,
+		MlCopilotToolMechanicalKnowledgeBase,
+		MlCopilotToolWebSearch,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlCopilotTool.
+func (v MlCopilotTool) IsValid() bool {
+	switch v {
+	case MlCopilotToolEditKclCode, MlCopilotToolTextToCad, MlCopilotToolMechanicalKnowledgeBase, MlCopilotToolWebSearch:
+		return true
+	}
+	return false
+}
+
+// ParseMlCopilotTool parses a MlCopilotTool from a string, returning an error if the value is not known.
+func ParseMlCopilotTool(s string) (MlCopilotTool, error) {
+	v := MlCopilotTool(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlCopilotTool %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlCopilotTool) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlCopilotTool) UnmarshalText(text []byte) error {
+	*v = MlCopilotTool(text)
+	return nil
+}
+
 // MlFeedback: Human feedback on an ML response.
 type MlFeedback string
 
@@ -4369,6 +7121,46 @@ const (
 	MlFeedbackRejected MlFeedback = "rejected"
 )
 
+// AllMlFeedbacks returns all the known values of MlFeedback.
+func AllMlFeedbacks() []MlFeedback {
+	return []MlFeedback{
+		MlFeedbackThumbsUp,
+		MlFeedbackThumbsDown,
+		MlFeedbackAccepted,
+		MlFeedbackRejected,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlFeedback.
+func (v MlFeedback) IsValid() bool {
+	switch v {
+	case MlFeedbackThumbsUp, MlFeedbackThumbsDown, MlFeedbackAccepted, MlFeedbackRejected:
+		return true
+	}
+	return false
+}
+
+// ParseMlFeedback parses a MlFeedback from a string, returning an error if the value is not known.
+func ParseMlFeedback(s string) (MlFeedback, error) {
+	v := MlFeedback(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlFeedback %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlFeedback) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlFeedback) UnmarshalText(text []byte) error {
+	*v = MlFeedback(text)
+	return nil
+}
+
 // MlReasoningEffort: Specify the amount of effort used in reasoning. Read the following for more info: https://platform.openai.com/docs/guides/reasoning#how-reasoning-works
 type MlReasoningEffort string
 
@@ -4383,17 +7175,57 @@ const (
 	MlReasoningEffortXhigh MlReasoningEffort = "xhigh"
 )
 
+// AllMlReasoningEfforts returns all the known values of MlReasoningEffort.
+func AllMlReasoningEfforts() []MlReasoningEffort {
+	return []MlReasoningEffort{
+		MlReasoningEffortLow,
+		MlReasoningEffortMedium,
+		MlReasoningEffortHigh,
+		MlReasoningEffortXhigh,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of MlReasoningEffort.
+func (v MlReasoningEffort) IsValid() bool {
+	switch v {
+	case MlReasoningEffortLow, MlReasoningEffortMedium, MlReasoningEffortHigh, MlReasoningEffortXhigh:
+		return true
+	}
+	return false
+}
+
+// ParseMlReasoningEffort parses a MlReasoningEffort from a string, returning an error if the value is not known.
+func ParseMlReasoningEffort(s string) (MlReasoningEffort, error) {
+	v := MlReasoningEffort(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid MlReasoningEffort %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v MlReasoningEffort) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *MlReasoningEffort) UnmarshalText(text []byte) error {
+	*v = MlReasoningEffort(text)
+	return nil
+}
+
 // MlToolResult: MlToolResult: Responses from tools.
 type MlToolResult any
 
 // MlToolResultError: Response from the `TextToCad` tool.
 type MlToolResultError struct {
 	// Error: Any error that occurred during the tool execution.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Outputs: The output files. Returns a map of the file name to the file contents. The file contents are not encoded since kcl files are not binary.
-	Outputs map[string]string `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// ProjectName: The name of the project, if any.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// StatusCode: The status code of the tool execution.
 	StatusCode int `json:"status_code" yaml:"status_code" schema:"status_code,required"`
 	// Type:
@@ -4403,17 +7235,17 @@ type MlToolResultError struct {
 // MlToolResultOutputs: Response from the `EditKclCode` tool.
 type MlToolResultOutputs struct {
 	// Error: Any error that occurred during the tool execution.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Outputs: The output files. Returns a map of the file name to the file contents. The file contents are not encoded since kcl files are not binary.
-	Outputs map[string]string `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// ProjectName: The name of the project, if any.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// StatusCode: The status code of the tool execution.
 	StatusCode int `json:"status_code" yaml:"status_code" schema:"status_code,required"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// ZookeeperEditPatch: Optional reversible delta for undoing/redoing a Zookeeper project edit locally. The `outputs` map remains the resulting project state.
-	ZookeeperEditPatch ZookeeperEditPatch `json:"zookeeper_edit_patch" yaml:"zookeeper_edit_patch" schema:"zookeeper_edit_patch"`
+	ZookeeperEditPatch *ZookeeperEditPatch `json:"zookeeper_edit_patch,omitempty" yaml:"zookeeper_edit_patch,omitempty" schema:"zookeeper_edit_patch,omitempty"`
 }
 
 // MlToolResultProjectName: Mechanical knowledge base response.
@@ -4436,34 +7268,73 @@ const (
 	ModelingAppShareLinksOrganizationOnly ModelingAppShareLinks = "organization_only"
 )
 
+// AllModelingAppShareLinks returns all the known values of ModelingAppShareLinks.
+func AllModelingAppShareLinks() []ModelingAppShareLinks {
+	return []ModelingAppShareLinks{
+		ModelingAppShareLinksPublic,
+		ModelingAppShareLinksPasswordProtected,
+		ModelingAppShareLinksOrganizationOnly,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ModelingAppShareLinks.
+func (v ModelingAppShareLinks) IsValid() bool {
+	switch v {
+	case ModelingAppShareLinksPublic, ModelingAppShareLinksPasswordProtected, ModelingAppShareLinksOrganizationOnly:
+		return true
+	}
+	return false
+}
+
+// ParseModelingAppShareLinks parses a ModelingAppShareLinks from a string, returning an error if the value is not known.
+func ParseModelingAppShareLinks(s string) (ModelingAppShareLinks, error) {
+	v := ModelingAppShareLinks(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ModelingAppShareLinks %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ModelingAppShareLinks) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ModelingAppShareLinks) UnmarshalText(text []byte) error {
+	*v = ModelingAppShareLinks(text)
+	return nil
+}
+
 // ModelingAppSubscriptionTier: Rich information about a Modeling App subscription tier.
 type ModelingAppSubscriptionTier struct {
 	// AnnualDiscount: Annual discount. The percentage off the monthly price if the user pays annually.
-	AnnualDiscount float64 `json:"annual_discount" yaml:"annual_discount" schema:"annual_discount"`
+	AnnualDiscount *float64 `json:"annual_discount,omitempty" yaml:"annual_discount,omitempty" schema:"annual_discount,omitempty"`
 	// BillingMode: Indicates how billing collection is routed for this plan.
-	BillingMode SubscriptionBillingMode `json:"billing_mode" yaml:"billing_mode" schema:"billing_mode"`
+	BillingMode *SubscriptionBillingMode `json:"billing_mode,omitempty" yaml:"billing_mode,omitempty" schema:"billing_mode,omitempty"`
 	// Description: A description of the tier.
 	Description string `json:"description" yaml:"description" schema:"description,required"`
 	// DisplayName: The display name of the tier.
-	DisplayName string `json:"display_name" yaml:"display_name" schema:"display_name"`
+	DisplayName *string `json:"display_name,omitempty" yaml:"display_name,omitempty" schema:"display_name,omitempty"`
 	// EndpointsIncluded: The Zoo API endpoints that are included when through an approved zoo tool.
-	EndpointsIncluded []APIEndpoint `json:"endpoints_included" yaml:"endpoints_included" schema:"endpoints_included"`
+	EndpointsIncluded []APIEndpoint `json:"endpoints_included,omitempty" yaml:"endpoints_included,omitempty" schema:"endpoints_included,omitempty"`
 	// Features: Features that are included in the subscription.
-	Features []SubscriptionTierFeature `json:"features" yaml:"features" schema:"features"`
+	Features []SubscriptionTierFeature `json:"features,omitempty" yaml:"features,omitempty" schema:"features,omitempty"`
 	// MlCustomModels: Indicates whether the plan enables custom ML models.
-	MlCustomModels bool `json:"ml_custom_models" yaml:"ml_custom_models" schema:"ml_custom_models"`
+	MlCustomModels *bool `json:"ml_custom_models,omitempty" yaml:"ml_custom_models,omitempty" schema:"ml_custom_models,omitempty"`
 	// MonthlyPayAsYouGoAPICredits: The amount of pay-as-you-go API credits the individual or org gets outside the modeling app per month. Credit replenishment remains calendar-month based while anniversary billing is rolled out. This is equivalent to the monetary value divided by the price of an API credit.
-	MonthlyPayAsYouGoAPICredits int `json:"monthly_pay_as_you_go_api_credits" yaml:"monthly_pay_as_you_go_api_credits" schema:"monthly_pay_as_you_go_api_credits"`
+	MonthlyPayAsYouGoAPICredits *int `json:"monthly_pay_as_you_go_api_credits,omitempty" yaml:"monthly_pay_as_you_go_api_credits,omitempty" schema:"monthly_pay_as_you_go_api_credits,omitempty"`
 	// MonthlyPayAsYouGoAPICreditsMonetaryValue: The monetary value of pay-as-you-go API credits the individual or org gets outside the modeling app per month. Credit replenishment remains calendar-month based while anniversary billing is rolled out.
-	MonthlyPayAsYouGoAPICreditsMonetaryValue float64 `json:"monthly_pay_as_you_go_api_credits_monetary_value" yaml:"monthly_pay_as_you_go_api_credits_monetary_value" schema:"monthly_pay_as_you_go_api_credits_monetary_value"`
+	MonthlyPayAsYouGoAPICreditsMonetaryValue *float64 `json:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty" yaml:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty" schema:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty"`
 	// Name: The name of the tier.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// PayAsYouGoAPICreditPrice: The price of an API credit.
-	PayAsYouGoAPICreditPrice float64 `json:"pay_as_you_go_api_credit_price" yaml:"pay_as_you_go_api_credit_price" schema:"pay_as_you_go_api_credit_price"`
+	PayAsYouGoAPICreditPrice *float64 `json:"pay_as_you_go_api_credit_price,omitempty" yaml:"pay_as_you_go_api_credit_price,omitempty" schema:"pay_as_you_go_api_credit_price,omitempty"`
 	// Price: The price of the tier per month. If this is for an individual, this is the price they pay. If this is for an organization, this is the price the organization pays per member in the org. This is in USD.
 	Price any `json:"price" yaml:"price" schema:"price,required"`
 	// ShareLinks: The options for sharable links through the modeling app.
-	ShareLinks []ModelingAppShareLinks `json:"share_links" yaml:"share_links" schema:"share_links"`
+	ShareLinks []ModelingAppShareLinks `json:"share_links,omitempty" yaml:"share_links,omitempty" schema:"share_links,omitempty"`
 	// SupportTier: The support tier the subscription provides.
 	SupportTier SupportTier `json:"support_tier" yaml:"support_tier" schema:"support_tier,required"`
 	// TrainingDataBehavior: The behavior of the users data (can it be used for training, etc).
@@ -4471,7 +7342,7 @@ type ModelingAppSubscriptionTier struct {
 	// Type: If the tier is offered for an individual or an org.
 	Type any `json:"type" yaml:"type" schema:"type,required"`
 	// ZooToolsIncluded: The Zoo tools that you can call unlimited times with this tier.
-	ZooToolsIncluded []ZooTool `json:"zoo_tools_included" yaml:"zoo_tools_included" schema:"zoo_tools_included"`
+	ZooToolsIncluded []ZooTool `json:"zoo_tools_included,omitempty" yaml:"zoo_tools_included,omitempty" schema:"zoo_tools_included,omitempty"`
 }
 
 // ModelingCmd: ModelingCmd: Commands that the KittyCAD engine can execute.
@@ -4480,9 +7351,9 @@ type ModelingCmd any
 // ModelingCmdAngle: Mirror the input entities over the specified edge. Deprecated; please use `EntityMirrorAcross`
 type ModelingCmdAngle struct {
 	// EdgeID: The edge to use as the mirror axis (legacy API). Must be linear and lie in the plane of the solid. If both `edge_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// EdgeReference: Edge reference to use as the mirror axis (new API). If both `edge_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeReference EdgeSpecifier `json:"edge_reference" yaml:"edge_reference" schema:"edge_reference"`
+	EdgeReference *EdgeSpecifier `json:"edge_reference,omitempty" yaml:"edge_reference,omitempty" schema:"edge_reference,omitempty"`
 	// Ids: ID of the mirror entities.
 	Ids []UUID `json:"ids" yaml:"ids" schema:"ids,required"`
 	// Type:
@@ -4578,13 +7449,13 @@ type ModelingCmdCameraDragMove struct {
 // ModelingCmdCameraDragStart: Set the default system properties used when a specific property isn't set.
 type ModelingCmdCameraDragStart struct {
 	// BackfaceColor: The default color to use for all backfaces
-	BackfaceColor Color `json:"backface_color" yaml:"backface_color" schema:"backface_color"`
+	BackfaceColor *Color `json:"backface_color,omitempty" yaml:"backface_color,omitempty" schema:"backface_color,omitempty"`
 	// Color: The default system color.
-	Color Color `json:"color" yaml:"color" schema:"color"`
+	Color *Color `json:"color,omitempty" yaml:"color,omitempty" schema:"color,omitempty"`
 	// HighlightColor: The default color to use for highlight
-	HighlightColor Color `json:"highlight_color" yaml:"highlight_color" schema:"highlight_color"`
+	HighlightColor *Color `json:"highlight_color,omitempty" yaml:"highlight_color,omitempty" schema:"highlight_color,omitempty"`
 	// SelectionColor: The default color to use for selection
-	SelectionColor Color `json:"selection_color" yaml:"selection_color" schema:"selection_color"`
+	SelectionColor *Color `json:"selection_color,omitempty" yaml:"selection_color,omitempty" schema:"selection_color,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4592,7 +7463,7 @@ type ModelingCmdCameraDragStart struct {
 // ModelingCmdCenter: Continue dragging the mouse.
 type ModelingCmdCenter struct {
 	// Sequence: Logical timestamp. The client should increment this with every event in the current mouse drag. That way, if the events are being sent over an unordered channel, the API can ignore the older events.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Window: The mouse position.
@@ -4610,7 +7481,7 @@ type ModelingCmdCenter2D struct {
 // ModelingCmdChildEntityIds: Make a new path by offsetting an object by a given distance. The new path's ID will be the ID of this command.
 type ModelingCmdChildEntityIds struct {
 	// FaceID: If the object is a solid, this is the ID of the face to base the offset on. If given, and `object_id` refers to a solid, then this face on the solid will be offset. If given but `object_id` doesn't refer to a solid, responds with an error. If not given, then `object_id` itself will be offset directly.
-	FaceID UUID `json:"face_id" yaml:"face_id" schema:"face_id"`
+	FaceID *UUID `json:"face_id,omitempty" yaml:"face_id,omitempty" schema:"face_id,omitempty"`
 	// ObjectID: The object that will be offset (can be a path, sketch, or a solid)
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Offset: The distance to offset the path (positive for outset, negative for inset)
@@ -4692,7 +7563,7 @@ type ModelingCmdDefaultCameraZoom struct {
 // ModelingCmdDirection: Command for creating a blend between the edge of two given surfaces
 type ModelingCmdDirection struct {
 	// BlendType: The type of blend to use.
-	BlendType BlendType `json:"blend_type" yaml:"blend_type" schema:"blend_type"`
+	BlendType *BlendType `json:"blend_type,omitempty" yaml:"blend_type,omitempty" schema:"blend_type,omitempty"`
 	// Surfaces: The two surfaces that the blend will span between
 	Surfaces []SurfaceEdgeReference `json:"surfaces" yaml:"surfaces" schema:"surfaces,required"`
 	// Type:
@@ -4742,7 +7613,7 @@ type ModelingCmdEdgeGetLength struct {
 	// EntityIds: IDs of the entities to be included in the box. If this is empty, then all entities are included (the entire scene).
 	EntityIds []UUID `json:"entity_ids" yaml:"entity_ids" schema:"entity_ids,required"`
 	// OutputUnit: The output unit for the box's dimensions. Defaults to millimeters.
-	OutputUnit UnitLength `json:"output_unit" yaml:"output_unit" schema:"output_unit"`
+	OutputUnit *UnitLength `json:"output_unit,omitempty" yaml:"output_unit,omitempty" schema:"output_unit,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4758,7 +7629,7 @@ type ModelingCmdEdgeID struct {
 // ModelingCmdEdgeIndex: Gets all edges which are opposite the given edge, across all possible faces.
 type ModelingCmdEdgeIndex struct {
 	// AlongVector: If given, only faces parallel to this vector will be considered.
-	AlongVector Point3D `json:"along_vector" yaml:"along_vector" schema:"along_vector"`
+	AlongVector *Point3D `json:"along_vector,omitempty" yaml:"along_vector,omitempty" schema:"along_vector,omitempty"`
 	// EdgeID: Which edge you want the opposites of.
 	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id,required"`
 	// ObjectID: Which object is being queried.
@@ -4832,7 +7703,7 @@ type ModelingCmdEntityGetDistance struct {
 // ModelingCmdEntityGetIndex: Create a new solid from intersecting several other solids. In other words, the part of the input solids where they all overlap will be the output solid.
 type ModelingCmdEntityGetIndex struct {
 	// SeparateBodies: If true, non-contiguous bodies in the result will be returned as separate objects
-	SeparateBodies bool `json:"separate_bodies" yaml:"separate_bodies" schema:"separate_bodies"`
+	SeparateBodies *bool `json:"separate_bodies,omitempty" yaml:"separate_bodies,omitempty" schema:"separate_bodies,omitempty"`
 	// SolidIds: Which solids to intersect together
 	SolidIds []UUID `json:"solid_ids" yaml:"solid_ids" schema:"solid_ids,required"`
 	// Tolerance: The maximum acceptable surface gap computed between the joined solids. Must be positive (i.e. greater than zero).
@@ -4840,7 +7711,7 @@ type ModelingCmdEntityGetIndex struct {
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UseLegacy: If true, use the legacy CSG algorithm.
-	UseLegacy bool `json:"use_legacy" yaml:"use_legacy" schema:"use_legacy"`
+	UseLegacy *bool `json:"use_legacy,omitempty" yaml:"use_legacy,omitempty" schema:"use_legacy,omitempty"`
 }
 
 // ModelingCmdEntityGetNumChildren: Set the selection to exactly these entities (replaces previous selection). Empty array clears the selection.
@@ -4854,7 +7725,7 @@ type ModelingCmdEntityGetNumChildren struct {
 // ModelingCmdEntityGetParentID: Get a concise description of all of solids edges.
 type ModelingCmdEntityGetParentID struct {
 	// EdgeID: Any edge that lies on the extrusion base path.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// ObjectID: The Solid3d object whose info is being queried.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Type:
@@ -4866,25 +7737,25 @@ type ModelingCmdEntityGetPrimitiveIndex struct {
 	// BodyIds: Which target input bodies to intersect. Inputs with non-solid body types are permitted
 	BodyIds []UUID `json:"body_ids" yaml:"body_ids" schema:"body_ids,required"`
 	// KeepTools: If true, the provided tool bodies will not be modified
-	KeepTools bool `json:"keep_tools" yaml:"keep_tools" schema:"keep_tools"`
+	KeepTools *bool `json:"keep_tools,omitempty" yaml:"keep_tools,omitempty" schema:"keep_tools,omitempty"`
 	// SeparateBodies: If true, target bodies will be separated into multiple objects at their intersection boundaries.
-	SeparateBodies bool `json:"separate_bodies" yaml:"separate_bodies" schema:"separate_bodies"`
+	SeparateBodies *bool `json:"separate_bodies,omitempty" yaml:"separate_bodies,omitempty" schema:"separate_bodies,omitempty"`
 	// Tolerance: The maximum acceptable surface gap between the intersected bodies. Must be positive (i.e. greater than zero).
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 	// ToolIds: If provided, only these bodies will be used to intersect with the target bodies in body_ids, Otherwise, all bodies in body_ids will be intersected with themselves.
-	ToolIds []UUID `json:"tool_ids" yaml:"tool_ids" schema:"tool_ids"`
+	ToolIds []UUID `json:"tool_ids,omitempty" yaml:"tool_ids,omitempty" schema:"tool_ids,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UseLegacy: If true, use the legacy CSG algorithm.
-	UseLegacy bool `json:"use_legacy" yaml:"use_legacy" schema:"use_legacy"`
+	UseLegacy *bool `json:"use_legacy,omitempty" yaml:"use_legacy,omitempty" schema:"use_legacy,omitempty"`
 }
 
 // ModelingCmdEntityGetSketchPaths: Create a region bounded by the intersection of various paths. The region should have an ID taken from the ID of the 'CreateRegion' modeling command.
 type ModelingCmdEntityGetSketchPaths struct {
 	// CurveClockwise: By default, curve counterclockwise at intersections. If this is true, instead curve clockwise.
-	CurveClockwise bool `json:"curve_clockwise" yaml:"curve_clockwise" schema:"curve_clockwise"`
+	CurveClockwise *bool `json:"curve_clockwise,omitempty" yaml:"curve_clockwise,omitempty" schema:"curve_clockwise,omitempty"`
 	// IntersectionIndex: At which intersection between `segment` and `intersection_segment` should we stop following the `segment` and start following `intersection_segment`? Defaults to -1, which means the last intersection.
-	IntersectionIndex int `json:"intersection_index" yaml:"intersection_index" schema:"intersection_index"`
+	IntersectionIndex *int `json:"intersection_index,omitempty" yaml:"intersection_index,omitempty" schema:"intersection_index,omitempty"`
 	// IntersectionSegment: Second segment to follow to find the region. Intersects the first segment.
 	IntersectionSegment UUID `json:"intersection_segment" yaml:"intersection_segment" schema:"intersection_segment,required"`
 	// ObjectID: Which sketch object to create the region from.
@@ -4894,13 +7765,13 @@ type ModelingCmdEntityGetSketchPaths struct {
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Version: Which version of the Region endpoint to call.
-	Version RegionVersion `json:"version" yaml:"version" schema:"version"`
+	Version *RegionVersion `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdEntityID: Get a concise description of all of an extrusion's faces.
 type ModelingCmdEntityID struct {
 	// EdgeID: Any edge that lies on the extrusion base path.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// ObjectID: The Solid3d object whose extrusion is being queried.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Type:
@@ -4924,7 +7795,7 @@ type ModelingCmdEntityId2 struct {
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Version: Which version of the Region endpoint to call.
-	Version RegionVersion `json:"version" yaml:"version" schema:"version"`
+	Version *RegionVersion `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdEntityIds: What kind of entities can be selected?
@@ -4938,7 +7809,7 @@ type ModelingCmdEntityIds struct {
 // ModelingCmdExport: Fit the view to the scene with an isometric view.
 type ModelingCmdExport struct {
 	// Padding: How much to pad the view frame by, as a fraction of the object(s) bounding box size. Negative padding will crop the view of the object proportionally. e.g. padding = 0.2 means the view will span 120% of the object(s) bounding box, and padding = -0.2 means the view will span 80% of the object(s) bounding box.
-	Padding float64 `json:"padding" yaml:"padding" schema:"padding"`
+	Padding *float64 `json:"padding,omitempty" yaml:"padding,omitempty" schema:"padding,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4952,7 +7823,7 @@ type ModelingCmdExport2D struct {
 // ModelingCmdExport3D: Updates the camera to center to the center of the current scene's bounds
 type ModelingCmdExport3D struct {
 	// CameraMovement: Dictates whether or not the camera position should be adjusted during this operation If no movement is requested, the camera will orbit around the new center from its current position
-	CameraMovement CameraMovement `json:"camera_movement" yaml:"camera_movement" schema:"camera_movement"`
+	CameraMovement *CameraMovement `json:"camera_movement,omitempty" yaml:"camera_movement,omitempty" schema:"camera_movement,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -4976,13 +7847,13 @@ type ModelingCmdExtrudeMethod struct {
 	// Angle: The signed angle of revolution (in degrees, must be <= 360 in either direction)
 	Angle Angle `json:"angle" yaml:"angle" schema:"angle,required"`
 	// BodyType: Should this extrude create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// EdgeID: The edge to use as the axis of revolution, must be linear and lie in the plane of the solid
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// EdgeReference: Edge reference to use as the axis of revolution (new API). If both `edge_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeReference EdgeSpecifier `json:"edge_reference" yaml:"edge_reference" schema:"edge_reference"`
+	EdgeReference *EdgeSpecifier `json:"edge_reference,omitempty" yaml:"edge_reference,omitempty" schema:"edge_reference,omitempty"`
 	// Opposite: Should the revolution also revolve in the opposite direction along the given axis? If so, this specifies its angle.
-	Opposite string `json:"opposite" yaml:"opposite" schema:"opposite"`
+	Opposite *string `json:"opposite,omitempty" yaml:"opposite,omitempty" schema:"opposite,omitempty"`
 	// Target: Which sketch to revolve. Must be a closed 2D solid.
 	Target UUID `json:"target" yaml:"target" schema:"target,required"`
 	// Tolerance: The maximum acceptable surface gap computed between the revolution surface joints. Must be positive (i.e. greater than zero).
@@ -5024,11 +7895,11 @@ type ModelingCmdFaceIndex struct {
 // ModelingCmdFaces: Command for lofting sections to create a solid
 type ModelingCmdFaces struct {
 	// BaseCurveIndex: This can be set to override the automatically determined topological base curve, which is usually the first section encountered.
-	BaseCurveIndex int `json:"base_curve_index" yaml:"base_curve_index" schema:"base_curve_index"`
+	BaseCurveIndex *int `json:"base_curve_index,omitempty" yaml:"base_curve_index,omitempty" schema:"base_curve_index,omitempty"`
 	// BezApproximateRational: Attempt to approximate rational curves (such as arcs) using a bezier. This will remove banding around interpolations between arcs and non-arcs.  It may produce errors in other scenarios Over time, this field won't be necessary.
 	BezApproximateRational bool `json:"bez_approximate_rational" yaml:"bez_approximate_rational" schema:"bez_approximate_rational,required"`
 	// BodyType: Should this loft create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// SectionIds: The closed section curves to create a lofted solid from. Currently, these must be Solid2Ds
 	SectionIds []UUID `json:"section_ids" yaml:"section_ids" schema:"section_ids,required"`
 	// Tolerance: Tolerance
@@ -5076,7 +7947,7 @@ type ModelingCmdHollow struct {
 // ModelingCmdInteraction: Set the properties of the tool lines for the scene.
 type ModelingCmdInteraction struct {
 	// Color: The color to set the tool line to.
-	Color Color `json:"color" yaml:"color" schema:"color"`
+	Color *Color `json:"color,omitempty" yaml:"color,omitempty" schema:"color,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5084,13 +7955,13 @@ type ModelingCmdInteraction struct {
 // ModelingCmdLabel: Extrude the object along a path.
 type ModelingCmdLabel struct {
 	// BodyType: Should this sweep create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// OrientProfilePerpendicular: If true, before the sweep starts, the profile will be re-oriented so that it is perpendicular to the path being swept along. If false, the profile is left in its current orientation. Defaults to false.
-	OrientProfilePerpendicular bool `json:"orient_profile_perpendicular" yaml:"orient_profile_perpendicular" schema:"orient_profile_perpendicular"`
+	OrientProfilePerpendicular *bool `json:"orient_profile_perpendicular,omitempty" yaml:"orient_profile_perpendicular,omitempty" schema:"orient_profile_perpendicular,omitempty"`
 	// ProjectedAxis: If orient_profile_perpendicular is true, the sketch shall be oriented such that the local Y axis of the sketch will be oriented to align with this element as much as possible. Defaults to +Z if not set
-	ProjectedAxis any `json:"projected_axis" yaml:"projected_axis" schema:"projected_axis"`
+	ProjectedAxis any `json:"projected_axis,omitempty" yaml:"projected_axis,omitempty" schema:"projected_axis,omitempty"`
 	// RelativeTo: What is this sweep relative to? Deprecated; please use `translate_profile_to_path` and `orient_profile_perpendicular` instead.
-	RelativeTo RelativeTo `json:"relative_to" yaml:"relative_to" schema:"relative_to"`
+	RelativeTo *RelativeTo `json:"relative_to,omitempty" yaml:"relative_to,omitempty" schema:"relative_to,omitempty"`
 	// Sectional: If true, the sweep will be broken up into sub-sweeps (extrusions, revolves, sweeps) based on the trajectory path components.
 	Sectional bool `json:"sectional" yaml:"sectional" schema:"sectional,required"`
 	// Target: Which sketch to sweep. Must be a closed 2D solid.
@@ -5100,11 +7971,11 @@ type ModelingCmdLabel struct {
 	// Trajectory: Path along which to sweep.
 	Trajectory UUID `json:"trajectory" yaml:"trajectory" schema:"trajectory,required"`
 	// TranslateProfileToPath: If true, the profile being swept will be moved to the path being swept along, before the sweep starts. If false, the profile stays where it is, and the sweep starts from there. Defaults to false.
-	TranslateProfileToPath bool `json:"translate_profile_to_path" yaml:"translate_profile_to_path" schema:"translate_profile_to_path"`
+	TranslateProfileToPath *bool `json:"translate_profile_to_path,omitempty" yaml:"translate_profile_to_path,omitempty" schema:"translate_profile_to_path,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Version: What version of the sweeping algorithm to use. If None, or zero, the engine's default algorithm will be used
-	Version int `json:"version" yaml:"version" schema:"version"`
+	Version *int `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdLoft: Get the plane for sketch mode.
@@ -5140,7 +8011,7 @@ type ModelingCmdModelingCmdAngle struct {
 // ModelingCmdModelingCmdBodyType: Send a mouse move event
 type ModelingCmdModelingCmdBodyType struct {
 	// Sequence: Logical timestamp. The client should increment this with every event in the current mouse drag. That way, if the events are being sent over an unordered channel, the API can ignore the older events.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Window: Where the mouse is
@@ -5150,7 +8021,7 @@ type ModelingCmdModelingCmdBodyType struct {
 // ModelingCmdModelingCmdCenter: Reconfigure the stream.
 type ModelingCmdModelingCmdCenter struct {
 	// Bitrate: Video feed's constant bitrate (CBR)
-	Bitrate int `json:"bitrate" yaml:"bitrate" schema:"bitrate"`
+	Bitrate *int `json:"bitrate,omitempty" yaml:"bitrate,omitempty" schema:"bitrate,omitempty"`
 	// Fps: Frames per second.
 	Fps int `json:"fps" yaml:"fps" schema:"fps,required"`
 	// Height: Height of the stream.
@@ -5188,11 +8059,11 @@ type ModelingCmdModelingCmdEntityID struct {
 // ModelingCmdModelingCmdEntityIds: Fit the view to the specified object(s).
 type ModelingCmdModelingCmdEntityIds struct {
 	// Animated: Whether or not to animate the camera movement.
-	Animated bool `json:"animated" yaml:"animated" schema:"animated"`
+	Animated *bool `json:"animated,omitempty" yaml:"animated,omitempty" schema:"animated,omitempty"`
 	// ObjectIds: Which objects to fit camera to; if empty, fit to all non-default objects. Defaults to empty vector.
-	ObjectIds []UUID `json:"object_ids" yaml:"object_ids" schema:"object_ids"`
+	ObjectIds []UUID `json:"object_ids,omitempty" yaml:"object_ids,omitempty" schema:"object_ids,omitempty"`
 	// Padding: How much to pad the view frame by, as a fraction of the object(s) bounding box size. Negative padding will crop the view of the object proportionally. e.g. padding = 0.2 means the view will span 120% of the object(s) bounding box, and padding = -0.2 means the view will span 80% of the object(s) bounding box.
-	Padding float64 `json:"padding" yaml:"padding" schema:"padding"`
+	Padding *float64 `json:"padding,omitempty" yaml:"padding,omitempty" schema:"padding,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5216,11 +8087,11 @@ type ModelingCmdModelingCmdFaces struct {
 // ModelingCmdModelingCmdFormat: Looks along the normal of the specified face (if it is planar!), and fits the view to it.
 type ModelingCmdModelingCmdFormat struct {
 	// Animated: Whether or not to animate the camera movement. (Animation is currently not supported.)
-	Animated bool `json:"animated" yaml:"animated" schema:"animated"`
+	Animated *bool `json:"animated,omitempty" yaml:"animated,omitempty" schema:"animated,omitempty"`
 	// FaceID: Which face to orient camera to. If the face is not planar, no action will occur.
 	FaceID UUID `json:"face_id" yaml:"face_id" schema:"face_id,required"`
 	// Padding: How much to pad the view frame by, as a fraction of the face bounding box size. Negative padding will crop the view of the face proportionally. e.g. padding = 0.2 means the view will span 120% of the face bounding box, and padding = -0.2 means the view will span 80% of the face bounding box.
-	Padding float64 `json:"padding" yaml:"padding" schema:"padding"`
+	Padding *float64 `json:"padding,omitempty" yaml:"padding,omitempty" schema:"padding,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5240,21 +8111,21 @@ type ModelingCmdModelingCmdObjectID struct {
 	// CutType: The cut type and information required to perform the cut.
 	CutType any `json:"cut_type" yaml:"cut_type" schema:"cut_type,required"`
 	// EdgesReferences: A struct containing the information required to reference an edge.
-	EdgesReferences []EdgeSpecifier `json:"edges_references" yaml:"edges_references" schema:"edges_references"`
+	EdgesReferences []EdgeSpecifier `json:"edges_references,omitempty" yaml:"edges_references,omitempty" schema:"edges_references,omitempty"`
 	// ExtraFaceIds: What IDs should the resulting faces have? If you've only passed one edge ID, its ID will be the command ID used to send this command, and this field should be empty. If you've passed `n` IDs (to cut `n` edges), then this should be length `n-1`, and the first edge will use the command ID used to send this command.
-	ExtraFaceIds []UUID `json:"extra_face_ids" yaml:"extra_face_ids" schema:"extra_face_ids"`
+	ExtraFaceIds []UUID `json:"extra_face_ids,omitempty" yaml:"extra_face_ids,omitempty" schema:"extra_face_ids,omitempty"`
 	// ObjectID: Which object is being cut.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Strategy: Which cutting algorithm to use.
-	Strategy CutStrategy `json:"strategy" yaml:"strategy" schema:"strategy"`
+	Strategy *CutStrategy `json:"strategy,omitempty" yaml:"strategy,omitempty" schema:"strategy,omitempty"`
 	// Tolerance: The maximum acceptable surface gap computed between the cut surfaces. Must be positive (i.e. greater than zero).
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UseLegacy: If true, use the legacy CSG algorithm.
-	UseLegacy bool `json:"use_legacy" yaml:"use_legacy" schema:"use_legacy"`
+	UseLegacy *bool `json:"use_legacy,omitempty" yaml:"use_legacy,omitempty" schema:"use_legacy,omitempty"`
 	// Version: Which version of the edge cut algorithm to use.
-	Version EdgeCutVersion `json:"version" yaml:"version" schema:"version"`
+	Version *EdgeCutVersion `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdModelingCmdOpposite: Send object to front or back.
@@ -5276,9 +8147,9 @@ type ModelingCmdModelingCmdPath struct {
 	// AxisIs2D: If true, the axis is interpreted within the 2D space of the solid 2D's plane
 	AxisIs2D bool `json:"axis_is_2d" yaml:"axis_is_2d" schema:"axis_is_2d,required"`
 	// BodyType: Should this extrude create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// Opposite: Should the revolution also revolve in the opposite direction along the given axis? If so, this specifies its angle.
-	Opposite string `json:"opposite" yaml:"opposite" schema:"opposite"`
+	Opposite *string `json:"opposite,omitempty" yaml:"opposite,omitempty" schema:"opposite,omitempty"`
 	// Origin: The origin of the extrusion axis
 	Origin Point3D `json:"origin" yaml:"origin" schema:"origin,required"`
 	// Target: Which sketch to revolve. Must be a closed 2D solid.
@@ -5360,15 +8231,15 @@ type ModelingCmdModelingCmdWindow struct {
 // ModelingCmdMovePathPen: Command for twist extruding a solid 2d.
 type ModelingCmdMovePathPen struct {
 	// AngleStepSize: Angle step interval (converted to whole number degrees and bounded between 4° and 90°)
-	AngleStepSize Angle `json:"angle_step_size" yaml:"angle_step_size" schema:"angle_step_size"`
+	AngleStepSize *Angle `json:"angle_step_size,omitempty" yaml:"angle_step_size,omitempty" schema:"angle_step_size,omitempty"`
 	// BodyType: Should this extrude create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// Center2D: Center to twist about (relative to plane's origin) Defaults to `[0, 0]` i.e. the plane's origin
-	Center2D Point2D `json:"center_2d" yaml:"center_2d" schema:"center_2d"`
+	Center2D *Point2D `json:"center_2d,omitempty" yaml:"center_2d,omitempty" schema:"center_2d,omitempty"`
 	// Distance: How far off the plane to extrude
 	Distance float64 `json:"distance" yaml:"distance" schema:"distance,required"`
 	// Faces: Which IDs should the new faces have? If this isn't given, the engine will generate IDs.
-	Faces ExtrudedFaceInfo `json:"faces" yaml:"faces" schema:"faces"`
+	Faces *ExtrudedFaceInfo `json:"faces,omitempty" yaml:"faces,omitempty" schema:"faces,omitempty"`
 	// Target: Which sketch to extrude. Must be a closed 2D solid.
 	Target UUID `json:"target" yaml:"target" schema:"target,required"`
 	// Tolerance: The twisted surface loft tolerance
@@ -5392,7 +8263,7 @@ type ModelingCmdObjectID struct {
 // ModelingCmdObjectIds: Set the name of an object
 type ModelingCmdObjectIds struct {
 	// Name: Name of the object. Using a zero-length name unsets the name.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// ObjectID: Which object to change
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Type:
@@ -5428,27 +8299,27 @@ type ModelingCmdOrigin struct {
 // ModelingCmdPath: Command for extruding a solid 2d.
 type ModelingCmdPath struct {
 	// BodyType: Should this extrude create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// Direction: What direction to extrude in. If None, the engine will extrude in the direction normal of the target's plane. Legacy field; if `direction_reference` is provided, the reference takes precedence.
-	Direction any `json:"direction" yaml:"direction" schema:"direction"`
+	Direction any `json:"direction,omitempty" yaml:"direction,omitempty" schema:"direction,omitempty"`
 	// DirectionReference: Edge specifier identifying the edge direction to use. If provided, this takes precedence over `direction`.
-	DirectionReference EdgeSpecifier `json:"direction_reference" yaml:"direction_reference" schema:"direction_reference"`
+	DirectionReference *EdgeSpecifier `json:"direction_reference,omitempty" yaml:"direction_reference,omitempty" schema:"direction_reference,omitempty"`
 	// Distance: How far off the plane to extrude
 	Distance float64 `json:"distance" yaml:"distance" schema:"distance,required"`
 	// DraftAngle: What draft angle should be used in this extrusion? Negative values indicate an outward draft, while positive values indicate an inward draft
-	DraftAngle Angle `json:"draft_angle" yaml:"draft_angle" schema:"draft_angle"`
+	DraftAngle *Angle `json:"draft_angle,omitempty" yaml:"draft_angle,omitempty" schema:"draft_angle,omitempty"`
 	// ExtrudeMethod: Should the extrusion create a new object or be part of the existing object.
-	ExtrudeMethod ExtrudeMethod `json:"extrude_method" yaml:"extrude_method" schema:"extrude_method"`
+	ExtrudeMethod *ExtrudeMethod `json:"extrude_method,omitempty" yaml:"extrude_method,omitempty" schema:"extrude_method,omitempty"`
 	// Faces: Which IDs should the new faces have? If this isn't given, the engine will generate IDs.
-	Faces ExtrudedFaceInfo `json:"faces" yaml:"faces" schema:"faces"`
+	Faces *ExtrudedFaceInfo `json:"faces,omitempty" yaml:"faces,omitempty" schema:"faces,omitempty"`
 	// MergeCoplanarFaces: Only used if the extrusion is created from a face and extrude_method = Merge If true, coplanar faces will be merged and seams will be hidden. Otherwise, seams between the extrusion and original body will be shown.
-	MergeCoplanarFaces bool `json:"merge_coplanar_faces" yaml:"merge_coplanar_faces" schema:"merge_coplanar_faces"`
+	MergeCoplanarFaces *bool `json:"merge_coplanar_faces,omitempty" yaml:"merge_coplanar_faces,omitempty" schema:"merge_coplanar_faces,omitempty"`
 	// Opposite: Should the extrusion also extrude in the opposite direction? If so, this specifies its distance.
-	Opposite string `json:"opposite" yaml:"opposite" schema:"opposite"`
+	Opposite *string `json:"opposite,omitempty" yaml:"opposite,omitempty" schema:"opposite,omitempty"`
 	// Target: Which sketch to extrude (legacy API). Must be a closed 2D solid. If `target_reference` is provided, the reference takes precedence.
-	Target UUID `json:"target" yaml:"target" schema:"target"`
+	Target *UUID `json:"target,omitempty" yaml:"target,omitempty" schema:"target,omitempty"`
 	// TargetReference: Edge specifier identifying the edge to extrude. If provided, this takes precedence over `target`.
-	TargetReference EdgeSpecifier `json:"target_reference" yaml:"target_reference" schema:"target_reference"`
+	TargetReference *EdgeSpecifier `json:"target_reference,omitempty" yaml:"target_reference,omitempty" schema:"target_reference,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5464,7 +8335,7 @@ type ModelingCmdPathID struct {
 	// Ortho: Should the camera use orthographic projection? In other words, should an object's size in the rendered image stay constant regardless of its distance from the camera.
 	Ortho bool `json:"ortho" yaml:"ortho" schema:"ortho,required"`
 	// PlanarNormal: If provided, ensures that the normal of the sketch plane must be aligned with this supplied normal (otherwise the camera position will be used to infer the normal to point towards the viewer)
-	PlanarNormal Point3D `json:"planar_normal" yaml:"planar_normal" schema:"planar_normal"`
+	PlanarNormal *Point3D `json:"planar_normal,omitempty" yaml:"planar_normal,omitempty" schema:"planar_normal,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5492,9 +8363,9 @@ type ModelingCmdReference struct {
 	// Center: What the camera is looking at. Center of the camera's field of vision
 	Center Point3D `json:"center" yaml:"center" schema:"center,required"`
 	// FovY: The field of view angle in the y direction, in degrees.
-	FovY float64 `json:"fov_y" yaml:"fov_y" schema:"fov_y"`
+	FovY *float64 `json:"fov_y,omitempty" yaml:"fov_y,omitempty" schema:"fov_y,omitempty"`
 	// Sequence: Logical timestamp. The client should increment this with every event in the current mouse drag. That way, if the events are being sent over an unordered channel, the API can ignore the older events.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Up: Which way is "up", from the camera's point of view.
@@ -5502,9 +8373,9 @@ type ModelingCmdReference struct {
 	// Vantage: Where the camera is positioned
 	Vantage Point3D `json:"vantage" yaml:"vantage" schema:"vantage,required"`
 	// ZFar: The distance to the far clipping plane.
-	ZFar float64 `json:"z_far" yaml:"z_far" schema:"z_far"`
+	ZFar *float64 `json:"z_far,omitempty" yaml:"z_far,omitempty" schema:"z_far,omitempty"`
 	// ZNear: The distance to the near clipping plane.
-	ZNear float64 `json:"z_near" yaml:"z_near" schema:"z_near"`
+	ZNear *float64 `json:"z_near,omitempty" yaml:"z_near,omitempty" schema:"z_near,omitempty"`
 }
 
 // ModelingCmdRelativeTo: Create a pattern using this entity by specifying the transform for each desired repetition. Transformations are performed in the following order (first applied to last applied): scale, rotate, translate.
@@ -5512,9 +8383,9 @@ type ModelingCmdRelativeTo struct {
 	// EntityID: ID of the entity being copied.
 	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id,required"`
 	// Transform: How to transform each repeated solid. The 0th transform will create the first copy of the entity. The total number of (optional) repetitions equals the size of this list.
-	Transform []Transform `json:"transform" yaml:"transform" schema:"transform"`
+	Transform []Transform `json:"transform,omitempty" yaml:"transform,omitempty" schema:"transform,omitempty"`
 	// Transforms: Alternatively, you could set this key instead. If you want to use multiple transforms per item. If this is non-empty then the `transform` key must be empty, and vice-versa.
-	Transforms [][]Transform `json:"transforms" yaml:"transforms" schema:"transforms"`
+	Transforms [][]Transform `json:"transforms,omitempty" yaml:"transforms,omitempty" schema:"transforms,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5532,7 +8403,7 @@ type ModelingCmdRevolve struct {
 	// SelectedAtWindow: Coordinates of the window being clicked
 	SelectedAtWindow Point2D `json:"selected_at_window" yaml:"selected_at_window" schema:"selected_at_window,required"`
 	// Sequence: Logical timestamp. The client should increment this with every event in the current mouse drag. That way, if the events are being sent over an unordered channel, the API can ignore the older events.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5542,7 +8413,7 @@ type ModelingCmdRevolveAboutEdge struct {
 	// Clobber: If true, any existing drawables within the obj will be replaced (the object will be reset)
 	Clobber bool `json:"clobber" yaml:"clobber" schema:"clobber,required"`
 	// Hide: If true, the plane will be created but hidden initially.
-	Hide bool `json:"hide" yaml:"hide" schema:"hide"`
+	Hide *bool `json:"hide,omitempty" yaml:"hide,omitempty" schema:"hide,omitempty"`
 	// Origin: Origin of the plane
 	Origin Point3D `json:"origin" yaml:"origin" schema:"origin,required"`
 	// Size: What should the plane's span/extent? When rendered visually, this is both the width and height along X and Y axis respectively.
@@ -5582,7 +8453,7 @@ type ModelingCmdSegment struct {
 	// FaceIds: Which faces to remove, leaving only the shell.
 	FaceIds []UUID `json:"face_ids" yaml:"face_ids" schema:"face_ids,required"`
 	// Hollow: If true, the Solid3D is made hollow instead of removing the selected faces
-	Hollow bool `json:"hollow" yaml:"hollow" schema:"hollow"`
+	Hollow *bool `json:"hollow,omitempty" yaml:"hollow,omitempty" schema:"hollow,omitempty"`
 	// ObjectID: Which Solid3D is being shelled.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// ShellThickness: How thick the shell should be. Smaller values mean a thinner shell.
@@ -5594,9 +8465,9 @@ type ModelingCmdSegment struct {
 // ModelingCmdSequence: Project an entity on to a plane.
 type ModelingCmdSequence struct {
 	// EntityID: Which entity to project (vertex or edge). Legacy; if both `entity_id` and `entity_reference` are provided, `entity_reference` takes precedence.
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 	// EntityReference: Entity reference (e.g. edge by side_faces, vertex, face) to project. If both `entity_id` and `entity_reference` are provided, `entity_reference` takes precedence.
-	EntityReference any `json:"entity_reference" yaml:"entity_reference" schema:"entity_reference"`
+	EntityReference any `json:"entity_reference,omitempty" yaml:"entity_reference,omitempty" schema:"entity_reference,omitempty"`
 	// PlaneID: Which plane to project the entity onto.
 	PlaneID UUID `json:"plane_id" yaml:"plane_id" schema:"plane_id,required"`
 	// Type:
@@ -5618,21 +8489,21 @@ type ModelingCmdSolid3DgetBodyType struct {
 	// CutType: The cut type and information required to perform the cut.
 	CutType any `json:"cut_type" yaml:"cut_type" schema:"cut_type,required"`
 	// EdgeIds: Which edges you want to cut.
-	EdgeIds []UUID `json:"edge_ids" yaml:"edge_ids" schema:"edge_ids"`
+	EdgeIds []UUID `json:"edge_ids,omitempty" yaml:"edge_ids,omitempty" schema:"edge_ids,omitempty"`
 	// ExtraFaceIds: What IDs should the resulting faces have? If you've only passed one edge ID, its ID will be the command ID used to send this command, and this field should be empty. If you've passed `n` IDs (to cut `n` edges), then this should be length `n-1`, and the first edge will use the command ID used to send this command.
-	ExtraFaceIds []UUID `json:"extra_face_ids" yaml:"extra_face_ids" schema:"extra_face_ids"`
+	ExtraFaceIds []UUID `json:"extra_face_ids,omitempty" yaml:"extra_face_ids,omitempty" schema:"extra_face_ids,omitempty"`
 	// ObjectID: Which object is being cut.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Strategy: Which cutting algorithm to use.
-	Strategy CutStrategy `json:"strategy" yaml:"strategy" schema:"strategy"`
+	Strategy *CutStrategy `json:"strategy,omitempty" yaml:"strategy,omitempty" schema:"strategy,omitempty"`
 	// Tolerance: The maximum acceptable surface gap computed between the cut surfaces. Must be positive (i.e. greater than zero).
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UseLegacy: If true, use the legacy CSG algorithm.
-	UseLegacy bool `json:"use_legacy" yaml:"use_legacy" schema:"use_legacy"`
+	UseLegacy *bool `json:"use_legacy,omitempty" yaml:"use_legacy,omitempty" schema:"use_legacy,omitempty"`
 	// Version: Which version of the edge cut algorithm to use.
-	Version EdgeCutVersion `json:"version" yaml:"version" schema:"version"`
+	Version *EdgeCutVersion `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdSolid3DgetEdgeUuid: Gets the next adjacent edge for the given edge, along the given face.
@@ -5650,29 +8521,29 @@ type ModelingCmdSolid3DgetEdgeUuid struct {
 // ModelingCmdSolid3DgetFaceUuid: Fillets the given edge with the specified radius.
 type ModelingCmdSolid3DgetFaceUuid struct {
 	// CutType: How to apply the cut.
-	CutType CutType `json:"cut_type" yaml:"cut_type" schema:"cut_type"`
+	CutType *CutType `json:"cut_type,omitempty" yaml:"cut_type,omitempty" schema:"cut_type,omitempty"`
 	// EdgeID: Which edge you want to fillet.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// EdgeIds: Which edges you want to fillet.
-	EdgeIds []UUID `json:"edge_ids" yaml:"edge_ids" schema:"edge_ids"`
+	EdgeIds []UUID `json:"edge_ids,omitempty" yaml:"edge_ids,omitempty" schema:"edge_ids,omitempty"`
 	// EdgesReferences: A struct containing the information required to reference an edge.
-	EdgesReferences []EdgeSpecifier `json:"edges_references" yaml:"edges_references" schema:"edges_references"`
+	EdgesReferences []EdgeSpecifier `json:"edges_references,omitempty" yaml:"edges_references,omitempty" schema:"edges_references,omitempty"`
 	// ExtraFaceIds: What IDs should the resulting faces have? If you've only passed one edge ID, its ID will be the command ID used to send this command, and this field should be empty. If you've passed `n` IDs (to fillet `n` edges), then this should be length `n-1`, and the first edge will use the command ID used to send this command.
-	ExtraFaceIds []UUID `json:"extra_face_ids" yaml:"extra_face_ids" schema:"extra_face_ids"`
+	ExtraFaceIds []UUID `json:"extra_face_ids,omitempty" yaml:"extra_face_ids,omitempty" schema:"extra_face_ids,omitempty"`
 	// ObjectID: Which object is being filletted.
 	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id,required"`
 	// Radius: The radius of the fillet. Measured in length (using the same units that the current sketch uses). Must be positive (i.e. greater than zero).
 	Radius float64 `json:"radius" yaml:"radius" schema:"radius,required"`
 	// Strategy: Which cutting algorithm to use.
-	Strategy CutStrategy `json:"strategy" yaml:"strategy" schema:"strategy"`
+	Strategy *CutStrategy `json:"strategy,omitempty" yaml:"strategy,omitempty" schema:"strategy,omitempty"`
 	// Tolerance: The maximum acceptable surface gap computed between the filleted surfaces. Must be positive (i.e. greater than zero).
 	Tolerance float64 `json:"tolerance" yaml:"tolerance" schema:"tolerance,required"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// UseLegacy: If true, use the legacy CSG algorithm.
-	UseLegacy bool `json:"use_legacy" yaml:"use_legacy" schema:"use_legacy"`
+	UseLegacy *bool `json:"use_legacy,omitempty" yaml:"use_legacy,omitempty" schema:"use_legacy,omitempty"`
 	// Version: Which version of the edge cut algorithm to use.
-	Version EdgeCutVersion `json:"version" yaml:"version" schema:"version"`
+	Version *EdgeCutVersion `json:"version,omitempty" yaml:"version,omitempty" schema:"version,omitempty"`
 }
 
 // ModelingCmdSolid3Djoin: Set the material properties of an object
@@ -5680,7 +8551,7 @@ type ModelingCmdSolid3Djoin struct {
 	// AmbientOcclusion: Ambient Occlusion of the new material
 	AmbientOcclusion float64 `json:"ambient_occlusion" yaml:"ambient_occlusion" schema:"ambient_occlusion,required"`
 	// BackfaceColor: Color of the backface
-	BackfaceColor Color `json:"backface_color" yaml:"backface_color" schema:"backface_color"`
+	BackfaceColor *Color `json:"backface_color,omitempty" yaml:"backface_color,omitempty" schema:"backface_color,omitempty"`
 	// Color: Color of the new material
 	Color Color `json:"color" yaml:"color" schema:"color,required"`
 	// Metalness: Metalness of the new material
@@ -5716,7 +8587,7 @@ type ModelingCmdSolid3DshellFace struct {
 // ModelingCmdStartPath: Extend a path by adding a new segment which starts at the path's "pen". If no "pen" location has been set before (via `MovePen`), then the pen is at the origin.
 type ModelingCmdStartPath struct {
 	// Label: Optional label to associate with the new path segment.
-	Label string `json:"label" yaml:"label" schema:"label"`
+	Label *string `json:"label,omitempty" yaml:"label,omitempty" schema:"label,omitempty"`
 	// Path: The ID of the command which created the path.
 	Path UUID `json:"path" yaml:"path" schema:"path,required"`
 	// Segment: Segment to append to the path. This segment will implicitly begin at the current "pen" location.
@@ -5766,7 +8637,7 @@ type ModelingCmdTarget struct {
 	// Interaction: The type of camera drag interaction.
 	Interaction CameraDragInteractionType `json:"interaction" yaml:"interaction" schema:"interaction,required"`
 	// Sequence: Logical timestamp. The client should increment this with every event in the current mouse drag. That way, if the events are being sent over an unordered channel, the API can ignore the older events.
-	Sequence int `json:"sequence" yaml:"sequence" schema:"sequence"`
+	Sequence *int `json:"sequence,omitempty" yaml:"sequence,omitempty" schema:"sequence,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Window: The current mouse position.
@@ -5786,19 +8657,19 @@ type ModelingCmdTargetReference struct {
 // ModelingCmdTo: Command for extruding a solid 2d to a reference geometry.
 type ModelingCmdTo struct {
 	// BodyType: Should this extrude create a solid body or a surface?
-	BodyType BodyType `json:"body_type" yaml:"body_type" schema:"body_type"`
+	BodyType *BodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" schema:"body_type,omitempty"`
 	// ExtrudeMethod: Should the extrusion create a new object or be part of the existing object.
-	ExtrudeMethod ExtrudeMethod `json:"extrude_method" yaml:"extrude_method" schema:"extrude_method"`
+	ExtrudeMethod *ExtrudeMethod `json:"extrude_method,omitempty" yaml:"extrude_method,omitempty" schema:"extrude_method,omitempty"`
 	// Faces: Which IDs should the new faces have? If this isn't given, the engine will generate IDs.
-	Faces ExtrudedFaceInfo `json:"faces" yaml:"faces" schema:"faces"`
+	Faces *ExtrudedFaceInfo `json:"faces,omitempty" yaml:"faces,omitempty" schema:"faces,omitempty"`
 	// Reference: Reference to extrude to. Extrusion occurs along the target's normal until it is as close to the reference as possible.
 	Reference any `json:"reference" yaml:"reference" schema:"reference,required"`
 	// Target: Which sketch or edge to extrude (legacy API).
 	//
 	// Must be a closed 2D solid, or an edge for surface extrusions. Either `target` or `target_reference` must be provided.
-	Target UUID `json:"target" yaml:"target" schema:"target"`
+	Target *UUID `json:"target,omitempty" yaml:"target,omitempty" schema:"target,omitempty"`
 	// TargetReference: Edge specifier identifying the source edge to extrude. If provided, this takes precedence over `target`.
-	TargetReference EdgeSpecifier `json:"target_reference" yaml:"target_reference" schema:"target_reference"`
+	TargetReference *EdgeSpecifier `json:"target_reference,omitempty" yaml:"target_reference,omitempty" schema:"target_reference,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5836,7 +8707,7 @@ type ModelingCmdTrajectory struct {
 	// Revolutions: Number of revolutions.
 	Revolutions float64 `json:"revolutions" yaml:"revolutions" schema:"revolutions,required"`
 	// StartAngle: Start angle.
-	StartAngle Angle `json:"start_angle" yaml:"start_angle" schema:"start_angle"`
+	StartAngle *Angle `json:"start_angle,omitempty" yaml:"start_angle,omitempty" schema:"start_angle,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5846,7 +8717,7 @@ type ModelingCmdTransform struct {
 	// ClosestTo: Find the edge closest to this point. Assumed to be in absolute coordinates, relative to global (scene) origin.
 	ClosestTo Point3D `json:"closest_to" yaml:"closest_to" schema:"closest_to,required"`
 	// ObjectID: The body whose edges are being queried. If not given, will search all bodies in the scene.
-	ObjectID UUID `json:"object_id" yaml:"object_id" schema:"object_id"`
+	ObjectID *UUID `json:"object_id,omitempty" yaml:"object_id,omitempty" schema:"object_id,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -5862,19 +8733,19 @@ type ModelingCmdTransforms struct {
 // ModelingCmdTranslateProfileToPath: Create a helix using the specified parameters.
 type ModelingCmdTranslateProfileToPath struct {
 	// EdgeID: Edge ID about which to make the helix (legacy API, for backwards compatibility). If both `edge_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeID UUID `json:"edge_id" yaml:"edge_id" schema:"edge_id"`
+	EdgeID *UUID `json:"edge_id,omitempty" yaml:"edge_id,omitempty" schema:"edge_id,omitempty"`
 	// EdgeReference: Edge reference about which to make the helix (new API). If both `edge_id` and `edge_reference` are provided, `edge_reference` takes precedence.
-	EdgeReference EdgeSpecifier `json:"edge_reference" yaml:"edge_reference" schema:"edge_reference"`
+	EdgeReference *EdgeSpecifier `json:"edge_reference,omitempty" yaml:"edge_reference,omitempty" schema:"edge_reference,omitempty"`
 	// IsClockwise: Is the helix rotation clockwise?
 	IsClockwise bool `json:"is_clockwise" yaml:"is_clockwise" schema:"is_clockwise,required"`
 	// Length: Length of the helix. If None, the length of the edge will be used instead.
-	Length float64 `json:"length" yaml:"length" schema:"length"`
+	Length *float64 `json:"length,omitempty" yaml:"length,omitempty" schema:"length,omitempty"`
 	// Radius: Radius of the helix.
 	Radius float64 `json:"radius" yaml:"radius" schema:"radius,required"`
 	// Revolutions: Number of revolutions.
 	Revolutions float64 `json:"revolutions" yaml:"revolutions" schema:"revolutions,required"`
 	// StartAngle: Start angle.
-	StartAngle Angle `json:"start_angle" yaml:"start_angle" schema:"start_angle"`
+	StartAngle *Angle `json:"start_angle,omitempty" yaml:"start_angle,omitempty" schema:"start_angle,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -6009,6 +8880,44 @@ const (
 	Oauth2AppClientTypeConfidential Oauth2AppClientType = "confidential"
 )
 
+// AllOauth2AppClientTypes returns all the known values of Oauth2AppClientType.
+func AllOauth2AppClientTypes() []Oauth2AppClientType {
+	return []Oauth2AppClientType{
+		Oauth2AppClientTypePublic,
+		Oauth2AppClientTypeConfidential,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2AppClientType.
+func (v Oauth2AppClientType) IsValid() bool {
+	switch v {
+	case Oauth2AppClientTypePublic, Oauth2AppClientTypeConfidential:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2AppClientType parses a Oauth2AppClientType from a string, returning an error if the value is not known.
+func ParseOauth2AppClientType(s string) (Oauth2AppClientType, error) {
+	v := Oauth2AppClientType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2AppClientType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2AppClientType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2AppClientType) UnmarshalText(text []byte) error {
+	*v = Oauth2AppClientType(text)
+	return nil
+}
+
 // Oauth2AppGrantType: An OAuth 2.0 grant type allowed for an app.
 type Oauth2AppGrantType string
 
@@ -6023,6 +8932,46 @@ const (
 	Oauth2AppGrantTypeClientCredentials Oauth2AppGrantType = "client_credentials"
 )
 
+// AllOauth2AppGrantTypes returns all the known values of Oauth2AppGrantType.
+func AllOauth2AppGrantTypes() []Oauth2AppGrantType {
+	return []Oauth2AppGrantType{
+		Oauth2AppGrantTypeDeviceCode,
+		Oauth2AppGrantTypeAuthorizationCode,
+		Oauth2AppGrantTypeRefreshToken,
+		Oauth2AppGrantTypeClientCredentials,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2AppGrantType.
+func (v Oauth2AppGrantType) IsValid() bool {
+	switch v {
+	case Oauth2AppGrantTypeDeviceCode, Oauth2AppGrantTypeAuthorizationCode, Oauth2AppGrantTypeRefreshToken, Oauth2AppGrantTypeClientCredentials:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2AppGrantType parses a Oauth2AppGrantType from a string, returning an error if the value is not known.
+func ParseOauth2AppGrantType(s string) (Oauth2AppGrantType, error) {
+	v := Oauth2AppGrantType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2AppGrantType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2AppGrantType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2AppGrantType) UnmarshalText(text []byte) error {
+	*v = Oauth2AppGrantType(text)
+	return nil
+}
+
 // Oauth2AppMode: The deployment mode for an OAuth 2.0 app.
 type Oauth2AppMode string
 
@@ -6033,6 +8982,44 @@ const (
 	Oauth2AppModeProduction Oauth2AppMode = "production"
 )
 
+// AllOauth2AppModes returns all the known values of Oauth2AppMode.
+func AllOauth2AppModes() []Oauth2AppMode {
+	return []Oauth2AppMode{
+		Oauth2AppModeDevelopment,
+		Oauth2AppModeProduction,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2AppMode.
+func (v Oauth2AppMode) IsValid() bool {
+	switch v {
+	case Oauth2AppModeDevelopment, Oauth2AppModeProduction:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2AppMode parses a Oauth2AppMode from a string, returning an error if the value is not known.
+func ParseOauth2AppMode(s string) (Oauth2AppMode, error) {
+	v := Oauth2AppMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2AppMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2AppMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2AppMode) UnmarshalText(text []byte) error {
+	*v = Oauth2AppMode(text)
+	return nil
+}
+
 // Oauth2AppResponse: API response for a managed OAuth app.
 type Oauth2AppResponse struct {
 	// ClientID: The OAuth 2.0 client identifier.
@@ -6062,7 +9049,7 @@ type Oauth2AppResponseResultsPage struct {
 	// Items: list of items on this page of results
 	Items []Oauth2AppResponse `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // Oauth2AuthorizationDecisionResponse: Result of approving or denying an OAuth authorization request.
@@ -6078,7 +9065,7 @@ type Oauth2AuthorizationRequestResponse struct {
 	// ExpiresAt: When this authorization request expires.
 	ExpiresAt Time `json:"expires_at" yaml:"expires_at" schema:"expires_at,required"`
 	// OwnerName: The OAuth app owner display name, if available.
-	OwnerName string `json:"owner_name" yaml:"owner_name" schema:"owner_name"`
+	OwnerName *string `json:"owner_name,omitempty" yaml:"owner_name,omitempty" schema:"owner_name,omitempty"`
 	// RedirectUri: The redirect URI that will receive the authorization result.
 	RedirectUri URL `json:"redirect_uri" yaml:"redirect_uri" schema:"redirect_uri,required"`
 	// RequestID: The pending authorization request ID.
@@ -6087,24 +9074,61 @@ type Oauth2AuthorizationRequestResponse struct {
 	Scopes []Oauth2Scope `json:"scopes" yaml:"scopes" schema:"scopes,required"`
 }
 
-// Oauth2AuthorizationResponseType: The OAuth 2.0 authorization response type.
-type Oauth2AuthorizationResponseType string
+// Oauth2AuthorizationResponseType: The OAuth 2.0 authorization response type.
+type Oauth2AuthorizationResponseType string
+
+const (
+	// Oauth2AuthorizationResponseTypeCode: The authorization code response type.
+	Oauth2AuthorizationResponseTypeCode Oauth2AuthorizationResponseType = "code"
+)
+
+// AllOauth2AuthorizationResponseTypes returns all the known values of Oauth2AuthorizationResponseType.
+func AllOauth2AuthorizationResponseTypes() []Oauth2AuthorizationResponseType {
+	return []Oauth2AuthorizationResponseType{
+		Oauth2AuthorizationResponseTypeCode,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2AuthorizationResponseType.
+func (v Oauth2AuthorizationResponseType) IsValid() bool {
+	switch v {
+	case Oauth2AuthorizationResponseTypeCode:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2AuthorizationResponseType parses a Oauth2AuthorizationResponseType from a string, returning an error if the value is not known.
+func ParseOauth2AuthorizationResponseType(s string) (Oauth2AuthorizationResponseType, error) {
+	v := Oauth2AuthorizationResponseType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2AuthorizationResponseType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2AuthorizationResponseType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
 
-const (
-	// Oauth2AuthorizationResponseTypeCode: The authorization code response type.
-	Oauth2AuthorizationResponseTypeCode Oauth2AuthorizationResponseType = "code"
-)
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2AuthorizationResponseType) UnmarshalText(text []byte) error {
+	*v = Oauth2AuthorizationResponseType(text)
+	return nil
+}
 
 // Oauth2ClientInfo: Information about an OAuth 2.0 client.
 type Oauth2ClientInfo struct {
 	// CsrfToken: Value used for [CSRF](https://tools.ietf.org/html/rfc6749#section-10.12) protection via the `state` parameter.
-	CsrfToken string `json:"csrf_token" yaml:"csrf_token" schema:"csrf_token"`
+	CsrfToken *string `json:"csrf_token,omitempty" yaml:"csrf_token,omitempty" schema:"csrf_token,omitempty"`
 	// OidcNonce: Nonce required for OIDC flows.
-	OidcNonce string `json:"oidc_nonce" yaml:"oidc_nonce" schema:"oidc_nonce"`
+	OidcNonce *string `json:"oidc_nonce,omitempty" yaml:"oidc_nonce,omitempty" schema:"oidc_nonce,omitempty"`
 	// PkceCodeVerifier: Code Verifier used for [PKCE]((https://tools.ietf.org/html/rfc7636)) protection via the `code_verifier` parameter. The value must have a minimum length of 43 characters and a maximum length of 128 characters.  Each character must be ASCII alphanumeric or one of the characters "-" / "." / "_" / "~".
-	PkceCodeVerifier string `json:"pkce_code_verifier" yaml:"pkce_code_verifier" schema:"pkce_code_verifier"`
+	PkceCodeVerifier *string `json:"pkce_code_verifier,omitempty" yaml:"pkce_code_verifier,omitempty" schema:"pkce_code_verifier,omitempty"`
 	// Url: The URL for consent.
-	Url string `json:"url" yaml:"url" schema:"url"`
+	Url *string `json:"url,omitempty" yaml:"url,omitempty" schema:"url,omitempty"`
 }
 
 // Oauth2CodeChallengeMethod: The PKCE code challenge method.
@@ -6117,6 +9141,44 @@ const (
 	Oauth2CodeChallengeMethodS256 Oauth2CodeChallengeMethod = "S256"
 )
 
+// AllOauth2CodeChallengeMethods returns all the known values of Oauth2CodeChallengeMethod.
+func AllOauth2CodeChallengeMethods() []Oauth2CodeChallengeMethod {
+	return []Oauth2CodeChallengeMethod{
+		Oauth2CodeChallengeMethodPlain,
+		Oauth2CodeChallengeMethodS256,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2CodeChallengeMethod.
+func (v Oauth2CodeChallengeMethod) IsValid() bool {
+	switch v {
+	case Oauth2CodeChallengeMethodPlain, Oauth2CodeChallengeMethodS256:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2CodeChallengeMethod parses a Oauth2CodeChallengeMethod from a string, returning an error if the value is not known.
+func ParseOauth2CodeChallengeMethod(s string) (Oauth2CodeChallengeMethod, error) {
+	v := Oauth2CodeChallengeMethod(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2CodeChallengeMethod %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2CodeChallengeMethod) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2CodeChallengeMethod) UnmarshalText(text []byte) error {
+	*v = Oauth2CodeChallengeMethod(text)
+	return nil
+}
+
 // Oauth2GrantType: An OAuth 2.0 Grant Type. These are documented here: <https://oauth.net/2/grant-types/>.
 type Oauth2GrantType string
 
@@ -6125,6 +9187,43 @@ const (
 	Oauth2GrantTypeUrnietfparamsoauthgrantTypedeviceCode Oauth2GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
 
+// AllOauth2GrantTypes returns all the known values of Oauth2GrantType.
+func AllOauth2GrantTypes() []Oauth2GrantType {
+	return []Oauth2GrantType{
+		Oauth2GrantTypeUrnietfparamsoauthgrantTypedeviceCode,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2GrantType.
+func (v Oauth2GrantType) IsValid() bool {
+	switch v {
+	case Oauth2GrantTypeUrnietfparamsoauthgrantTypedeviceCode:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2GrantType parses a Oauth2GrantType from a string, returning an error if the value is not known.
+func ParseOauth2GrantType(s string) (Oauth2GrantType, error) {
+	v := Oauth2GrantType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2GrantType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2GrantType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2GrantType) UnmarshalText(text []byte) error {
+	*v = Oauth2GrantType(text)
+	return nil
+}
+
 // Oauth2Scope: Supported OAuth 2.0 scopes.
 type Oauth2Scope string
 
@@ -6137,6 +9236,45 @@ const (
 	Oauth2ScopeAdminwrite Oauth2Scope = "admin:write"
 )
 
+// AllOauth2Scopes returns all the known values of Oauth2Scope.
+func AllOauth2Scopes() []Oauth2Scope {
+	return []Oauth2Scope{
+		Oauth2ScopeUserread,
+		Oauth2ScopeModeling,
+		Oauth2ScopeAdminwrite,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2Scope.
+func (v Oauth2Scope) IsValid() bool {
+	switch v {
+	case Oauth2ScopeUserread, Oauth2ScopeModeling, Oauth2ScopeAdminwrite:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2Scope parses a Oauth2Scope from a string, returning an error if the value is not known.
+func ParseOauth2Scope(s string) (Oauth2Scope, error) {
+	v := Oauth2Scope(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2Scope %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2Scope) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2Scope) UnmarshalText(text []byte) error {
+	*v = Oauth2Scope(text)
+	return nil
+}
+
 // Oauth2TokenGrantType: The OAuth 2.0 token endpoint grant types supported by the general token endpoint.
 type Oauth2TokenGrantType string
 
@@ -6147,20 +9285,58 @@ const (
 	Oauth2TokenGrantTypeRefreshToken Oauth2TokenGrantType = "refresh_token"
 )
 
+// AllOauth2TokenGrantTypes returns all the known values of Oauth2TokenGrantType.
+func AllOauth2TokenGrantTypes() []Oauth2TokenGrantType {
+	return []Oauth2TokenGrantType{
+		Oauth2TokenGrantTypeAuthorizationCode,
+		Oauth2TokenGrantTypeRefreshToken,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of Oauth2TokenGrantType.
+func (v Oauth2TokenGrantType) IsValid() bool {
+	switch v {
+	case Oauth2TokenGrantTypeAuthorizationCode, Oauth2TokenGrantTypeRefreshToken:
+		return true
+	}
+	return false
+}
+
+// ParseOauth2TokenGrantType parses a Oauth2TokenGrantType from a string, returning an error if the value is not known.
+func ParseOauth2TokenGrantType(s string) (Oauth2TokenGrantType, error) {
+	v := Oauth2TokenGrantType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid Oauth2TokenGrantType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Oauth2TokenGrantType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *Oauth2TokenGrantType) UnmarshalText(text []byte) error {
+	*v = Oauth2TokenGrantType(text)
+	return nil
+}
+
 // Oauth2TokenRequestForm: Form body for `/oauth2/token`.
 type Oauth2TokenRequestForm struct {
 	// ClientID: The OAuth app client ID.
 	ClientID UUID `json:"client_id" yaml:"client_id" schema:"client_id,required"`
 	// Code: The authorization code, for `authorization_code`.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// CodeVerifier: The PKCE verifier, for `authorization_code`.
-	CodeVerifier string `json:"code_verifier" yaml:"code_verifier" schema:"code_verifier"`
+	CodeVerifier *string `json:"code_verifier,omitempty" yaml:"code_verifier,omitempty" schema:"code_verifier,omitempty"`
 	// GrantType: The OAuth token grant type.
 	GrantType Oauth2TokenGrantType `json:"grant_type" yaml:"grant_type" schema:"grant_type,required"`
 	// RedirectUri: The redirect URI, for `authorization_code`.
-	RedirectUri URL `json:"redirect_uri" yaml:"redirect_uri" schema:"redirect_uri"`
+	RedirectUri *URL `json:"redirect_uri,omitempty" yaml:"redirect_uri,omitempty" schema:"redirect_uri,omitempty"`
 	// RefreshToken: The refresh token, for `refresh_token`.
-	RefreshToken string `json:"refresh_token" yaml:"refresh_token" schema:"refresh_token"`
+	RefreshToken *string `json:"refresh_token,omitempty" yaml:"refresh_token,omitempty" schema:"refresh_token,omitempty"`
 }
 
 // ObjectBringToFront: The response from the `ObjectBringToFront` endpoint.
@@ -6950,31 +10126,31 @@ type OkWebSocketResponseDataTrickleIce struct {
 // Org: An organization.
 type Org struct {
 	// AllowUsersInDomainToAutoJoin: If we should allow all future users who are created with email addresses from this domain to join the org.
-	AllowUsersInDomainToAutoJoin bool `json:"allow_users_in_domain_to_auto_join" yaml:"allow_users_in_domain_to_auto_join" schema:"allow_users_in_domain_to_auto_join"`
+	AllowUsersInDomainToAutoJoin *bool `json:"allow_users_in_domain_to_auto_join,omitempty" yaml:"allow_users_in_domain_to_auto_join,omitempty" schema:"allow_users_in_domain_to_auto_join,omitempty"`
 	// AwsExternalID: ExternalId our workers supply when assuming customer roles, following AWS guidance for avoiding the [confused deputy problem](https://docs.aws.amazon.com/IAM/latest/UserGuide/confused-deputy.html). Required before an org can register external datasets.
-	AwsExternalID UUID `json:"aws_external_id" yaml:"aws_external_id" schema:"aws_external_id"`
+	AwsExternalID *UUID `json:"aws_external_id,omitempty" yaml:"aws_external_id,omitempty" schema:"aws_external_id,omitempty"`
 	// BillingEmail: The billing email address of the org.
 	BillingEmail string `json:"billing_email" yaml:"billing_email" schema:"billing_email,required"`
 	// BillingEmailVerified: The date and time the billing email address was verified.
-	BillingEmailVerified Time `json:"billing_email_verified" yaml:"billing_email_verified" schema:"billing_email_verified"`
+	BillingEmailVerified *Time `json:"billing_email_verified,omitempty" yaml:"billing_email_verified,omitempty" schema:"billing_email_verified,omitempty"`
 	// Block: If the org should be blocked and the reason why.
-	Block BlockReason `json:"block" yaml:"block" schema:"block"`
+	Block *BlockReason `json:"block,omitempty" yaml:"block,omitempty" schema:"block,omitempty"`
 	// CanTrainOnData: If we can train on the orgs's data. This value overrides any individual user's `can_train_on_data` value if they are a member of the org.
-	CanTrainOnData bool `json:"can_train_on_data" yaml:"can_train_on_data" schema:"can_train_on_data"`
+	CanTrainOnData *bool `json:"can_train_on_data,omitempty" yaml:"can_train_on_data,omitempty" schema:"can_train_on_data,omitempty"`
 	// CreatedAt: The date and time the org was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Domain: The org's domain.
-	Domain string `json:"domain" yaml:"domain" schema:"domain"`
+	Domain *string `json:"domain,omitempty" yaml:"domain,omitempty" schema:"domain,omitempty"`
 	// ID: The unique identifier for the org.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Image: The image for the org. This is a URL.
-	Image URL `json:"image" yaml:"image" schema:"image"`
+	Image *URL `json:"image,omitempty" yaml:"image,omitempty" schema:"image,omitempty"`
 	// Name: The name of the org.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The org's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// StripeID: The org's stripe id.
-	StripeID string `json:"stripe_id" yaml:"stripe_id" schema:"stripe_id"`
+	StripeID *string `json:"stripe_id,omitempty" yaml:"stripe_id,omitempty" schema:"stripe_id,omitempty"`
 	// UpdatedAt: The date and time the org was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -6986,13 +10162,13 @@ type OrgDataset struct {
 	// CreatedAt: The date and time the dataset was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Description: User-provided description for humans reviewing the dataset.
-	Description string `json:"description" yaml:"description" schema:"description"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty" schema:"description,omitempty"`
 	// ID: The unique identifier for the dataset.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// LastSyncError: Last recorded sync error message, if dataset access failed.
-	LastSyncError string `json:"last_sync_error" yaml:"last_sync_error" schema:"last_sync_error"`
+	LastSyncError *string `json:"last_sync_error,omitempty" yaml:"last_sync_error,omitempty" schema:"last_sync_error,omitempty"`
 	// LastSyncErrorAt: Timestamp for the last sync error.
-	LastSyncErrorAt Time `json:"last_sync_error_at" yaml:"last_sync_error_at" schema:"last_sync_error_at"`
+	LastSyncErrorAt *Time `json:"last_sync_error_at,omitempty" yaml:"last_sync_error_at,omitempty" schema:"last_sync_error_at,omitempty"`
 	// Name: User-provided display name. This is mutable; lookup by ID instead.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// OrgID: The ID of the org owning the dataset.
@@ -7026,7 +10202,7 @@ type OrgDatasetConversionStatsResponse struct {
 // OrgDatasetFileConversionDetails: Detailed response that bundles conversion metadata with converted file and snapshot contents.
 type OrgDatasetFileConversionDetails struct {
 	// CompletedAt: The date and time the conversion got its current `status`.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The date and time the conversion was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// DatasetID: The ID of the dataset this file is being converted from.
@@ -7040,39 +10216,39 @@ type OrgDatasetFileConversionDetails struct {
 	// ID: The unique identifier for the conversion.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// ImporterVersion: Tracks which version processed this file when available.
-	ImporterVersion string `json:"importer_version" yaml:"importer_version" schema:"importer_version"`
+	ImporterVersion *string `json:"importer_version,omitempty" yaml:"importer_version,omitempty" schema:"importer_version,omitempty"`
 	// ManualKclOverride: Plain-text contents of the admin-provided manual KCL override, when available.
-	ManualKclOverride string `json:"manual_kcl_override" yaml:"manual_kcl_override" schema:"manual_kcl_override"`
+	ManualKclOverride *string `json:"manual_kcl_override,omitempty" yaml:"manual_kcl_override,omitempty" schema:"manual_kcl_override,omitempty"`
 	// ManualKclOverrideActive: Indicates whether a persisted manual KCL override will be used instead of regenerating KCL automatically.
 	ManualKclOverrideActive bool `json:"manual_kcl_override_active" yaml:"manual_kcl_override_active" schema:"manual_kcl_override_active,required"`
 	// ManualKclOverrideUpdatedAt: Timestamp when the manual KCL override was last updated.
-	ManualKclOverrideUpdatedAt Time `json:"manual_kcl_override_updated_at" yaml:"manual_kcl_override_updated_at" schema:"manual_kcl_override_updated_at"`
+	ManualKclOverrideUpdatedAt *Time `json:"manual_kcl_override_updated_at,omitempty" yaml:"manual_kcl_override_updated_at,omitempty" schema:"manual_kcl_override_updated_at,omitempty"`
 	// Metadata: Additional per-conversion metadata as string key-value pairs.
-	Metadata any `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata any `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// OriginalSnapshotImages: Snapshot images for the original source model.
 	OriginalSnapshotImages []OrgDatasetSnapshotImage `json:"original_snapshot_images" yaml:"original_snapshot_images" schema:"original_snapshot_images,required"`
 	// Output: Plain-text contents of the converted artifact, when available.
-	Output string `json:"output" yaml:"output" schema:"output"`
+	Output *string `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// Phase: Current step in the conversion pipeline.
 	Phase OrgDatasetFileConversionPhase `json:"phase" yaml:"phase" schema:"phase,required"`
 	// RawKclOutput: Plain-text contents of the raw KCL artifact, when available.
-	RawKclOutput string `json:"raw_kcl_output" yaml:"raw_kcl_output" schema:"raw_kcl_output"`
+	RawKclOutput *string `json:"raw_kcl_output,omitempty" yaml:"raw_kcl_output,omitempty" schema:"raw_kcl_output,omitempty"`
 	// RawKclSimilarityScore: Score from `0.0` to `1.0` that quantifies how closely the raw KCL model matches the original model.
-	RawKclSimilarityScore float64 `json:"raw_kcl_similarity_score" yaml:"raw_kcl_similarity_score" schema:"raw_kcl_similarity_score"`
+	RawKclSimilarityScore *float64 `json:"raw_kcl_similarity_score,omitempty" yaml:"raw_kcl_similarity_score,omitempty" schema:"raw_kcl_similarity_score,omitempty"`
 	// RawKclSnapshotImages: Snapshot images for the raw KCL model.
 	RawKclSnapshotImages []OrgDatasetSnapshotImage `json:"raw_kcl_snapshot_images" yaml:"raw_kcl_snapshot_images" schema:"raw_kcl_snapshot_images,required"`
 	// SalonKclOutput: Plain-text contents of the salon/refactored KCL artifact, when available.
-	SalonKclOutput string `json:"salon_kcl_output" yaml:"salon_kcl_output" schema:"salon_kcl_output"`
+	SalonKclOutput *string `json:"salon_kcl_output,omitempty" yaml:"salon_kcl_output,omitempty" schema:"salon_kcl_output,omitempty"`
 	// SalonKclSimilarityScore: Score from `0.0` to `1.0` that quantifies how closely the salon KCL model matches the raw KCL model.
-	SalonKclSimilarityScore float64 `json:"salon_kcl_similarity_score" yaml:"salon_kcl_similarity_score" schema:"salon_kcl_similarity_score"`
+	SalonKclSimilarityScore *float64 `json:"salon_kcl_similarity_score,omitempty" yaml:"salon_kcl_similarity_score,omitempty" schema:"salon_kcl_similarity_score,omitempty"`
 	// SalonKclSnapshotImages: Snapshot images for the salon/refactored KCL model.
 	SalonKclSnapshotImages []OrgDatasetSnapshotImage `json:"salon_kcl_snapshot_images" yaml:"salon_kcl_snapshot_images" schema:"salon_kcl_snapshot_images,required"`
 	// StartedAt: The date and time the conversion started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: Conversion status.
 	Status OrgDatasetFileConversionStatus `json:"status" yaml:"status" schema:"status,required"`
 	// StatusMessage: Details associated with `status`.
-	StatusMessage string `json:"status_message" yaml:"status_message" schema:"status_message"`
+	StatusMessage *string `json:"status_message,omitempty" yaml:"status_message,omitempty" schema:"status_message,omitempty"`
 	// UpdatedAt: The date and time the conversion was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -7107,6 +10283,54 @@ const (
 	OrgDatasetFileConversionPhaseCompleted OrgDatasetFileConversionPhase = "completed"
 )
 
+// AllOrgDatasetFileConversionPhases returns all the known values of OrgDatasetFileConversionPhase.
+func AllOrgDatasetFileConversionPhases() []OrgDatasetFileConversionPhase {
+	return []OrgDatasetFileConversionPhase{
+		OrgDatasetFileConversionPhaseQueued,
+		OrgDatasetFileConversionPhaseZooGeneratedOriginalMetadata,
+		OrgDatasetFileConversionPhaseSnapshotOriginal,
+		OrgDatasetFileConversionPhaseUserProvidedMetadata,
+		OrgDatasetFileConversionPhaseManualKclOverride,
+		OrgDatasetFileConversionPhaseConvertRawKcl,
+		OrgDatasetFileConversionPhaseZooGeneratedRawKclMetadata,
+		OrgDatasetFileConversionPhaseSnapshotRawKcl,
+		OrgDatasetFileConversionPhaseSalon,
+		OrgDatasetFileConversionPhaseZooGeneratedSalonKclMetadata,
+		OrgDatasetFileConversionPhaseSnapshotSalonKcl,
+		OrgDatasetFileConversionPhaseCompleted,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of OrgDatasetFileConversionPhase.
+func (v OrgDatasetFileConversionPhase) IsValid() bool {
+	switch v {
+	case OrgDatasetFileConversionPhaseQueued, OrgDatasetFileConversionPhaseZooGeneratedOriginalMetadata, OrgDatasetFileConversionPhaseSnapshotOriginal, OrgDatasetFileConversionPhaseUserProvidedMetadata, OrgDatasetFileConversionPhaseManualKclOverride, OrgDatasetFileConversionPhaseConvertRawKcl, OrgDatasetFileConversionPhaseZooGeneratedRawKclMetadata, OrgDatasetFileConversionPhaseSnapshotRawKcl, OrgDatasetFileConversionPhaseSalon, OrgDatasetFileConversionPhaseZooGeneratedSalonKclMetadata, OrgDatasetFileConversionPhaseSnapshotSalonKcl, OrgDatasetFileConversionPhaseCompleted:
+		return true
+	}
+	return false
+}
+
+// ParseOrgDatasetFileConversionPhase parses a OrgDatasetFileConversionPhase from a string, returning an error if the value is not known.
+func ParseOrgDatasetFileConversionPhase(s string) (OrgDatasetFileConversionPhase, error) {
+	v := OrgDatasetFileConversionPhase(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid OrgDatasetFileConversionPhase %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v OrgDatasetFileConversionPhase) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *OrgDatasetFileConversionPhase) UnmarshalText(text []byte) error {
+	*v = OrgDatasetFileConversionPhase(text)
+	return nil
+}
+
 // OrgDatasetFileConversionStatus: `OrgDatasetFileConversion` status.
 type OrgDatasetFileConversionStatus string
 
@@ -7133,10 +10357,56 @@ const (
 	OrgDatasetFileConversionStatusErrorInternal OrgDatasetFileConversionStatus = "error_internal"
 )
 
+// AllOrgDatasetFileConversionStatuses returns all the known values of OrgDatasetFileConversionStatus.
+func AllOrgDatasetFileConversionStatuses() []OrgDatasetFileConversionStatus {
+	return []OrgDatasetFileConversionStatus{
+		OrgDatasetFileConversionStatusQueued,
+		OrgDatasetFileConversionStatusCanceled,
+		OrgDatasetFileConversionStatusInProgress,
+		OrgDatasetFileConversionStatusSuccess,
+		OrgDatasetFileConversionStatusErrorUser,
+		OrgDatasetFileConversionStatusErrorGeometryMismatch,
+		OrgDatasetFileConversionStatusErrorExecution,
+		OrgDatasetFileConversionStatusErrorConnection,
+		OrgDatasetFileConversionStatusErrorUnsupported,
+		OrgDatasetFileConversionStatusErrorInternal,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of OrgDatasetFileConversionStatus.
+func (v OrgDatasetFileConversionStatus) IsValid() bool {
+	switch v {
+	case OrgDatasetFileConversionStatusQueued, OrgDatasetFileConversionStatusCanceled, OrgDatasetFileConversionStatusInProgress, OrgDatasetFileConversionStatusSuccess, OrgDatasetFileConversionStatusErrorUser, OrgDatasetFileConversionStatusErrorGeometryMismatch, OrgDatasetFileConversionStatusErrorExecution, OrgDatasetFileConversionStatusErrorConnection, OrgDatasetFileConversionStatusErrorUnsupported, OrgDatasetFileConversionStatusErrorInternal:
+		return true
+	}
+	return false
+}
+
+// ParseOrgDatasetFileConversionStatus parses a OrgDatasetFileConversionStatus from a string, returning an error if the value is not known.
+func ParseOrgDatasetFileConversionStatus(s string) (OrgDatasetFileConversionStatus, error) {
+	v := OrgDatasetFileConversionStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid OrgDatasetFileConversionStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v OrgDatasetFileConversionStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *OrgDatasetFileConversionStatus) UnmarshalText(text []byte) error {
+	*v = OrgDatasetFileConversionStatus(text)
+	return nil
+}
+
 // OrgDatasetFileConversionSummary: Publicly exposed view of a dataset file conversion that omits storage-specific fields.
 type OrgDatasetFileConversionSummary struct {
 	// CompletedAt: The date and time the conversion got its current `status`.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The date and time the conversion was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// DatasetID: The ID of the dataset this file is being converted from.
@@ -7150,25 +10420,25 @@ type OrgDatasetFileConversionSummary struct {
 	// ID: The unique identifier for the conversion.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// ImporterVersion: Tracks which version processed this file when available.
-	ImporterVersion string `json:"importer_version" yaml:"importer_version" schema:"importer_version"`
+	ImporterVersion *string `json:"importer_version,omitempty" yaml:"importer_version,omitempty" schema:"importer_version,omitempty"`
 	// ManualKclOverrideActive: Indicates whether a persisted manual KCL override will be used instead of regenerating KCL automatically.
 	ManualKclOverrideActive bool `json:"manual_kcl_override_active" yaml:"manual_kcl_override_active" schema:"manual_kcl_override_active,required"`
 	// ManualKclOverrideUpdatedAt: Timestamp when the manual KCL override was last updated.
-	ManualKclOverrideUpdatedAt Time `json:"manual_kcl_override_updated_at" yaml:"manual_kcl_override_updated_at" schema:"manual_kcl_override_updated_at"`
+	ManualKclOverrideUpdatedAt *Time `json:"manual_kcl_override_updated_at,omitempty" yaml:"manual_kcl_override_updated_at,omitempty" schema:"manual_kcl_override_updated_at,omitempty"`
 	// Metadata: Additional per-conversion metadata as string key-value pairs.
-	Metadata any `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata any `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Phase: Current step in the conversion pipeline.
 	Phase OrgDatasetFileConversionPhase `json:"phase" yaml:"phase" schema:"phase,required"`
 	// RawKclSimilarityScore: Score from `0.0` to `1.0` that quantifies how closely the raw KCL model matches the original model.
-	RawKclSimilarityScore float64 `json:"raw_kcl_similarity_score" yaml:"raw_kcl_similarity_score" schema:"raw_kcl_similarity_score"`
+	RawKclSimilarityScore *float64 `json:"raw_kcl_similarity_score,omitempty" yaml:"raw_kcl_similarity_score,omitempty" schema:"raw_kcl_similarity_score,omitempty"`
 	// SalonKclSimilarityScore: Score from `0.0` to `1.0` that quantifies how closely the salon KCL model matches the raw KCL model.
-	SalonKclSimilarityScore float64 `json:"salon_kcl_similarity_score" yaml:"salon_kcl_similarity_score" schema:"salon_kcl_similarity_score"`
+	SalonKclSimilarityScore *float64 `json:"salon_kcl_similarity_score,omitempty" yaml:"salon_kcl_similarity_score,omitempty" schema:"salon_kcl_similarity_score,omitempty"`
 	// StartedAt: The date and time the conversion started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: Conversion status.
 	Status OrgDatasetFileConversionStatus `json:"status" yaml:"status" schema:"status,required"`
 	// StatusMessage: Details associated with `status`.
-	StatusMessage string `json:"status_message" yaml:"status_message" schema:"status_message"`
+	StatusMessage *string `json:"status_message,omitempty" yaml:"status_message,omitempty" schema:"status_message,omitempty"`
 	// UpdatedAt: The date and time the conversion was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -7178,7 +10448,7 @@ type OrgDatasetFileConversionSummaryResultsPage struct {
 	// Items: list of items on this page of results
 	Items []OrgDatasetFileConversionSummary `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // OrgDatasetResultsPage: A single page of results
@@ -7186,7 +10456,7 @@ type OrgDatasetResultsPage struct {
 	// Items: list of items on this page of results
 	Items []OrgDataset `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // OrgDatasetSemanticSearchMatch: Semantic-search match returned for an org dataset chunk.
@@ -7214,11 +10484,11 @@ type OrgDatasetSnapshotImage struct {
 // OrgDatasetSource: Details for accessing an org dataset.
 type OrgDatasetSource struct {
 	// AccessRoleArn: Identity we assume when accessing the dataset. Required when `provider` is `s3`; ignored for Zoo-managed datasets. Must be configured with the org's `aws_external_id` per AWS confused deputy guidance. See <https://docs.aws.amazon.com/IAM/latest/UserGuide/confused-deputy.html>.
-	AccessRoleArn string `json:"access_role_arn" yaml:"access_role_arn" schema:"access_role_arn"`
+	AccessRoleArn *string `json:"access_role_arn,omitempty" yaml:"access_role_arn,omitempty" schema:"access_role_arn,omitempty"`
 	// Provider: Storage provider identifier.
 	Provider StorageProvider `json:"provider" yaml:"provider" schema:"provider,required"`
 	// Uri: Fully-qualified URI for the dataset contents. Required when `provider` is `s3`; ignored for Zoo-managed datasets.
-	Uri string `json:"uri" yaml:"uri" schema:"uri"`
+	Uri *string `json:"uri,omitempty" yaml:"uri,omitempty" schema:"uri,omitempty"`
 }
 
 // OrgDatasetStatus: Lifecycle status for org datasets.
@@ -7235,48 +10505,90 @@ const (
 	OrgDatasetStatusErrored OrgDatasetStatus = "errored"
 )
 
+// AllOrgDatasetStatuses returns all the known values of OrgDatasetStatus.
+func AllOrgDatasetStatuses() []OrgDatasetStatus {
+	return []OrgDatasetStatus{
+		OrgDatasetStatusActive,
+		OrgDatasetStatusPaused,
+		OrgDatasetStatusDeleting,
+		OrgDatasetStatusErrored,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of OrgDatasetStatus.
+func (v OrgDatasetStatus) IsValid() bool {
+	switch v {
+	case OrgDatasetStatusActive, OrgDatasetStatusPaused, OrgDatasetStatusDeleting, OrgDatasetStatusErrored:
+		return true
+	}
+	return false
+}
+
+// ParseOrgDatasetStatus parses a OrgDatasetStatus from a string, returning an error if the value is not known.
+func ParseOrgDatasetStatus(s string) (OrgDatasetStatus, error) {
+	v := OrgDatasetStatus(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid OrgDatasetStatus %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v OrgDatasetStatus) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *OrgDatasetStatus) UnmarshalText(text []byte) error {
+	*v = OrgDatasetStatus(text)
+	return nil
+}
+
 // OrgDetails: The user-modifiable parts of an organization.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type OrgDetails struct {
 	// AllowUsersInDomainToAutoJoin: If we should allow all future users who are created with email addresses from this domain to join the org.
-	AllowUsersInDomainToAutoJoin bool `json:"allow_users_in_domain_to_auto_join" yaml:"allow_users_in_domain_to_auto_join" schema:"allow_users_in_domain_to_auto_join"`
+	AllowUsersInDomainToAutoJoin *bool `json:"allow_users_in_domain_to_auto_join,omitempty" yaml:"allow_users_in_domain_to_auto_join,omitempty" schema:"allow_users_in_domain_to_auto_join,omitempty"`
 	// BillingEmail: The billing email address of the org.
-	BillingEmail string `json:"billing_email" yaml:"billing_email" schema:"billing_email"`
+	BillingEmail *string `json:"billing_email,omitempty" yaml:"billing_email,omitempty" schema:"billing_email,omitempty"`
 	// Domain: The org's domain.
-	Domain string `json:"domain" yaml:"domain" schema:"domain"`
+	Domain *string `json:"domain,omitempty" yaml:"domain,omitempty" schema:"domain,omitempty"`
 	// Image: The image for the org. This is a URL.
-	Image URL `json:"image" yaml:"image" schema:"image"`
+	Image *URL `json:"image,omitempty" yaml:"image,omitempty" schema:"image,omitempty"`
 	// Name: The name of the org.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The org's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 }
 
 // OrgMember: A member of an organization.
 type OrgMember struct {
 	// Company: The user's company.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// CreatedAt: The date and time the user was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Discord: The user's Discord handle.
-	Discord string `json:"discord" yaml:"discord" schema:"discord"`
+	Discord *string `json:"discord,omitempty" yaml:"discord,omitempty" schema:"discord,omitempty"`
 	// Email: The email address of the user.
-	Email string `json:"email" yaml:"email" schema:"email"`
+	Email *string `json:"email,omitempty" yaml:"email,omitempty" schema:"email,omitempty"`
 	// EmailVerified: The date and time the email address was verified.
-	EmailVerified Time `json:"email_verified" yaml:"email_verified" schema:"email_verified"`
+	EmailVerified *Time `json:"email_verified,omitempty" yaml:"email_verified,omitempty" schema:"email_verified,omitempty"`
 	// FirstName: The user's first name.
-	FirstName string `json:"first_name" yaml:"first_name" schema:"first_name"`
+	FirstName *string `json:"first_name,omitempty" yaml:"first_name,omitempty" schema:"first_name,omitempty"`
 	// Github: The user's GitHub handle.
-	Github string `json:"github" yaml:"github" schema:"github"`
+	Github *string `json:"github,omitempty" yaml:"github,omitempty" schema:"github,omitempty"`
 	// ID: The unique identifier for the user.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Image: The image avatar for the user. This is a URL.
 	Image URL `json:"image" yaml:"image" schema:"image,required"`
 	// LastName: The user's last name.
-	LastName string `json:"last_name" yaml:"last_name" schema:"last_name"`
+	LastName *string `json:"last_name,omitempty" yaml:"last_name,omitempty" schema:"last_name,omitempty"`
 	// Name: The name of the user. This is auto populated at first from the authentication provider (if there was a name). It can be updated by the user by updating their `first_name` and `last_name` fields.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The user's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// Role: The user's role in the org.
 	Role OrgRole `json:"role" yaml:"role" schema:"role,required"`
 	// UpdatedAt: The date and time the user was last updated.
@@ -7288,7 +10600,7 @@ type OrgMemberResultsPage struct {
 	// Items: list of items on this page of results
 	Items []OrgMember `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // OrgRole: The roles in an organization.
@@ -7303,6 +10615,45 @@ const (
 	OrgRoleServiceAccount OrgRole = "service_account"
 )
 
+// AllOrgRoles returns all the known values of OrgRole.
+func AllOrgRoles() []OrgRole {
+	return []OrgRole{
+		OrgRoleAdmin,
+		OrgRoleMember,
+		OrgRoleServiceAccount,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of OrgRole.
+func (v OrgRole) IsValid() bool {
+	switch v {
+	case OrgRoleAdmin, OrgRoleMember, OrgRoleServiceAccount:
+		return true
+	}
+	return false
+}
+
+// ParseOrgRole parses a OrgRole from a string, returning an error if the value is not known.
+func ParseOrgRole(s string) (OrgRole, error) {
+	v := OrgRole(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid OrgRole %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v OrgRole) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *OrgRole) UnmarshalText(text []byte) error {
+	*v = OrgRole(text)
+	return nil
+}
+
 // OrgSkillResponse: Public skill context available to the caller's organization.
 type OrgSkillResponse struct {
 	// Description: Short description injected into Zookeeper's initial prompt.
@@ -7350,15 +10701,15 @@ type OriginTypeOrigin struct {
 // ```json { "description": "Output file contents.", "type": "object", "properties": { "contents": { "description": "The contents of the file. This is base64 encoded so we can ensure it is UTF-8 for JSON.", "type": "string" }, "name": { "description": "The name of the file.", "default": "", "type": "string" } } } ``` </details>
 type OutputFile struct {
 	// Contents: The contents of the file. This is base64 encoded so we can ensure it is UTF-8 for JSON.
-	Contents string `json:"contents" yaml:"contents" schema:"contents"`
+	Contents *string `json:"contents,omitempty" yaml:"contents,omitempty" schema:"contents,omitempty"`
 	// Name: The name of the file.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 }
 
 // OutputFormat2D: AutoCAD drawing interchange format.
 type OutputFormat2D struct {
 	// Storage: Export storage.
-	Storage DxfStorage `json:"storage" yaml:"storage" schema:"storage"`
+	Storage *DxfStorage `json:"storage,omitempty" yaml:"storage,omitempty" schema:"storage,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -7369,7 +10720,7 @@ type OutputFormat3D any
 // OutputFormat3Dcreated: Autodesk Filmbox (FBX) format.
 type OutputFormat3Dcreated struct {
 	// Created: Timestamp override.
-	Created Time `json:"created" yaml:"created" schema:"created"`
+	Created *Time `json:"created,omitempty" yaml:"created,omitempty" schema:"created,omitempty"`
 	// Storage: Specifies which kind of FBX will be exported.
 	Storage FbxStorage `json:"storage" yaml:"storage" schema:"storage,required"`
 	// Type:
@@ -7439,17 +10790,17 @@ type OutputFormat3Dstorage struct {
 	// Defaults to the [KittyCAD co-ordinate system].
 	//
 	// [KittyCAD co-ordinate system]: ../coord/constant.KITTYCAD.html
-	Coords System `json:"coords" yaml:"coords" schema:"coords"`
+	Coords *System `json:"coords,omitempty" yaml:"coords,omitempty" schema:"coords,omitempty"`
 	// Created: Timestamp override.
-	Created Time `json:"created" yaml:"created" schema:"created"`
+	Created *Time `json:"created,omitempty" yaml:"created,omitempty" schema:"created,omitempty"`
 	// Presentation: Presentation style.
-	Presentation StepPresentation `json:"presentation" yaml:"presentation" schema:"presentation"`
+	Presentation *StepPresentation `json:"presentation,omitempty" yaml:"presentation,omitempty" schema:"presentation,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 	// Units: Export length unit.
 	//
 	// Defaults to meters.
-	Units UnitLength `json:"units" yaml:"units" schema:"units"`
+	Units *UnitLength `json:"units,omitempty" yaml:"units,omitempty" schema:"units,omitempty"`
 }
 
 // PathCommand: The path component command type (within a Path)
@@ -7468,6 +10819,47 @@ const (
 	PathCommandAddArc PathCommand = "add_arc"
 )
 
+// AllPathCommands returns all the known values of PathCommand.
+func AllPathCommands() []PathCommand {
+	return []PathCommand{
+		PathCommandMoveTo,
+		PathCommandLineTo,
+		PathCommandBezCurveTo,
+		PathCommandNurbsCurveTo,
+		PathCommandAddArc,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PathCommand.
+func (v PathCommand) IsValid() bool {
+	switch v {
+	case PathCommandMoveTo, PathCommandLineTo, PathCommandBezCurveTo, PathCommandNurbsCurveTo, PathCommandAddArc:
+		return true
+	}
+	return false
+}
+
+// ParsePathCommand parses a PathCommand from a string, returning an error if the value is not known.
+func ParsePathCommand(s string) (PathCommand, error) {
+	v := PathCommand(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PathCommand %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PathCommand) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PathCommand) UnmarshalText(text []byte) error {
+	*v = PathCommand(text)
+	return nil
+}
+
 // PathComponentConstraintBound: The path component constraint bounds type
 type PathComponentConstraintBound string
 
@@ -7480,6 +10872,45 @@ const (
 	PathComponentConstraintBoundFullyConstrained PathComponentConstraintBound = "fully_constrained"
 )
 
+// AllPathComponentConstraintBounds returns all the known values of PathComponentConstraintBound.
+func AllPathComponentConstraintBounds() []PathComponentConstraintBound {
+	return []PathComponentConstraintBound{
+		PathComponentConstraintBoundUnconstrained,
+		PathComponentConstraintBoundPartiallyConstrained,
+		PathComponentConstraintBoundFullyConstrained,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PathComponentConstraintBound.
+func (v PathComponentConstraintBound) IsValid() bool {
+	switch v {
+	case PathComponentConstraintBoundUnconstrained, PathComponentConstraintBoundPartiallyConstrained, PathComponentConstraintBoundFullyConstrained:
+		return true
+	}
+	return false
+}
+
+// ParsePathComponentConstraintBound parses a PathComponentConstraintBound from a string, returning an error if the value is not known.
+func ParsePathComponentConstraintBound(s string) (PathComponentConstraintBound, error) {
+	v := PathComponentConstraintBound(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PathComponentConstraintBound %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PathComponentConstraintBound) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PathComponentConstraintBound) UnmarshalText(text []byte) error {
+	*v = PathComponentConstraintBound(text)
+	return nil
+}
+
 // PathComponentConstraintType: The path component constraint type
 type PathComponentConstraintType string
 
@@ -7498,6 +10929,48 @@ const (
 	PathComponentConstraintTypeAngleBetween PathComponentConstraintType = "angle_between"
 )
 
+// AllPathComponentConstraintTypes returns all the known values of PathComponentConstraintType.
+func AllPathComponentConstraintTypes() []PathComponentConstraintType {
+	return []PathComponentConstraintType{
+		PathComponentConstraintTypeUnconstrained,
+		PathComponentConstraintTypeVertical,
+		PathComponentConstraintTypeHorizontal,
+		PathComponentConstraintTypeEqualLength,
+		PathComponentConstraintTypeParallel,
+		PathComponentConstraintTypeAngleBetween,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PathComponentConstraintType.
+func (v PathComponentConstraintType) IsValid() bool {
+	switch v {
+	case PathComponentConstraintTypeUnconstrained, PathComponentConstraintTypeVertical, PathComponentConstraintTypeHorizontal, PathComponentConstraintTypeEqualLength, PathComponentConstraintTypeParallel, PathComponentConstraintTypeAngleBetween:
+		return true
+	}
+	return false
+}
+
+// ParsePathComponentConstraintType parses a PathComponentConstraintType from a string, returning an error if the value is not known.
+func ParsePathComponentConstraintType(s string) (PathComponentConstraintType, error) {
+	v := PathComponentConstraintType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PathComponentConstraintType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PathComponentConstraintType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PathComponentConstraintType) UnmarshalText(text []byte) error {
+	*v = PathComponentConstraintType(text)
+	return nil
+}
+
 // PathGetCurveUuid: The response from the `PathGetCurveUuid` command.
 type PathGetCurveUuid struct {
 	// CurveID: The UUID of the curve entity.
@@ -7519,7 +10992,7 @@ type PathGetInfo struct {
 // PathGetSketchTargetUuid: The response from the `PathGetSketchTargetUuid` command.
 type PathGetSketchTargetUuid struct {
 	// TargetID: The UUID of the sketch target.
-	TargetID UUID `json:"target_id" yaml:"target_id" schema:"target_id"`
+	TargetID *UUID `json:"target_id,omitempty" yaml:"target_id,omitempty" schema:"target_id,omitempty"`
 }
 
 // PathGetVertexUuids: The response from the `PathGetVertexUuids` command.
@@ -7572,7 +11045,7 @@ type PathSegmentInfo struct {
 	// Command: What is the path segment?
 	Command PathCommand `json:"command" yaml:"command" schema:"command,required"`
 	// CommandID: Which command created this path? This field is absent if the path command is not actually creating a path segment, e.g. moving the pen doesn't create a path segment.
-	CommandID UUID `json:"command_id" yaml:"command_id" schema:"command_id"`
+	CommandID *UUID `json:"command_id,omitempty" yaml:"command_id,omitempty" schema:"command_id,omitempty"`
 	// Relative: Whether or not this segment is a relative offset
 	Relative bool `json:"relative" yaml:"relative" schema:"relative,required"`
 }
@@ -7594,7 +11067,7 @@ type PathSegmentLine struct {
 // PathSegmentPathSegmentEnd: Adds a tangent arc from current pen position to the new position. Arcs will choose a clockwise or counter-clockwise direction based on the arc end position.
 type PathSegmentPathSegmentEnd struct {
 	// AngleSnapIncrement: 0 will be interpreted as none/null.
-	AngleSnapIncrement Angle `json:"angle_snap_increment" yaml:"angle_snap_increment" schema:"angle_snap_increment"`
+	AngleSnapIncrement *Angle `json:"angle_snap_increment,omitempty" yaml:"angle_snap_increment,omitempty" schema:"angle_snap_increment,omitempty"`
 	// To: Where the arc should end. Must lie in the same plane as the current path pen position. Must not be colinear with current path pen position.
 	To Point3D `json:"to" yaml:"to" schema:"to,required"`
 	// Type:
@@ -7670,13 +11143,13 @@ type PaymentMethod struct {
 	// BillingInfo: The billing info for the payment method.
 	BillingInfo BillingInfo `json:"billing_info" yaml:"billing_info" schema:"billing_info,required"`
 	// Card: The card, if it is one. For our purposes, this is the only type of payment method that we support.
-	Card CardDetails `json:"card" yaml:"card" schema:"card"`
+	Card *CardDetails `json:"card,omitempty" yaml:"card,omitempty" schema:"card,omitempty"`
 	// CreatedAt: Time at which the object was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// ID: Unique identifier for the object.
-	ID string `json:"id" yaml:"id" schema:"id"`
+	ID *string `json:"id,omitempty" yaml:"id,omitempty" schema:"id,omitempty"`
 	// Metadata: Set of key-value pairs.
-	Metadata map[string]string `json:"metadata" yaml:"metadata" schema:"metadata"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" schema:"metadata,omitempty"`
 	// Type: The type of payment method.
 	Type PaymentMethodType `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -7684,11 +11157,11 @@ type PaymentMethod struct {
 // PaymentMethodCardChecks: Card checks.
 type PaymentMethodCardChecks struct {
 	// AddressLine1Check: If a address line1 was provided, results of the check, one of `pass`, `fail`, `unavailable`, or `unchecked`.
-	AddressLine1Check string `json:"address_line1_check" yaml:"address_line1_check" schema:"address_line1_check"`
+	AddressLine1Check *string `json:"address_line1_check,omitempty" yaml:"address_line1_check,omitempty" schema:"address_line1_check,omitempty"`
 	// AddressPostalCodeCheck: If a address postal code was provided, results of the check, one of `pass`, `fail`, `unavailable`, or `unchecked`.
-	AddressPostalCodeCheck string `json:"address_postal_code_check" yaml:"address_postal_code_check" schema:"address_postal_code_check"`
+	AddressPostalCodeCheck *string `json:"address_postal_code_check,omitempty" yaml:"address_postal_code_check,omitempty" schema:"address_postal_code_check,omitempty"`
 	// CvcCheck: If a CVC was provided, results of the check, one of `pass`, `fail`, `unavailable`, or `unchecked`.
-	CvcCheck string `json:"cvc_check" yaml:"cvc_check" schema:"cvc_check"`
+	CvcCheck *string `json:"cvc_check,omitempty" yaml:"cvc_check,omitempty" schema:"cvc_check,omitempty"`
 }
 
 // PaymentMethodType: An enum representing the possible values of an `PaymentMethod`'s `type` field.
@@ -7699,14 +11172,51 @@ const (
 	PaymentMethodTypeCard PaymentMethodType = "card"
 )
 
+// AllPaymentMethodTypes returns all the known values of PaymentMethodType.
+func AllPaymentMethodTypes() []PaymentMethodType {
+	return []PaymentMethodType{
+		PaymentMethodTypeCard,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PaymentMethodType.
+func (v PaymentMethodType) IsValid() bool {
+	switch v {
+	case PaymentMethodTypeCard:
+		return true
+	}
+	return false
+}
+
+// ParsePaymentMethodType parses a PaymentMethodType from a string, returning an error if the value is not known.
+func ParsePaymentMethodType(s string) (PaymentMethodType, error) {
+	v := PaymentMethodType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PaymentMethodType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PaymentMethodType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PaymentMethodType) UnmarshalText(text []byte) error {
+	*v = PaymentMethodType(text)
+	return nil
+}
+
 // PerspectiveCameraParameters: Defines a perspective view.
 type PerspectiveCameraParameters struct {
 	// FovY: Camera frustum vertical field of view.
-	FovY float64 `json:"fov_y" yaml:"fov_y" schema:"fov_y"`
+	FovY *float64 `json:"fov_y,omitempty" yaml:"fov_y,omitempty" schema:"fov_y,omitempty"`
 	// ZFar: Camera frustum far plane.
-	ZFar float64 `json:"z_far" yaml:"z_far" schema:"z_far"`
+	ZFar *float64 `json:"z_far,omitempty" yaml:"z_far,omitempty" schema:"z_far,omitempty"`
 	// ZNear: Camera frustum near plane.
-	ZNear float64 `json:"z_near" yaml:"z_near" schema:"z_near"`
+	ZNear *float64 `json:"z_near,omitempty" yaml:"z_near,omitempty" schema:"z_near,omitempty"`
 }
 
 // PivotPosition: A point in 3D space
@@ -7747,6 +11257,47 @@ const (
 	PlanIntervalUnknown PlanInterval = "unknown"
 )
 
+// AllPlanIntervals returns all the known values of PlanInterval.
+func AllPlanIntervals() []PlanInterval {
+	return []PlanInterval{
+		PlanIntervalDay,
+		PlanIntervalMonth,
+		PlanIntervalWeek,
+		PlanIntervalYear,
+		PlanIntervalUnknown,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PlanInterval.
+func (v PlanInterval) IsValid() bool {
+	switch v {
+	case PlanIntervalDay, PlanIntervalMonth, PlanIntervalWeek, PlanIntervalYear, PlanIntervalUnknown:
+		return true
+	}
+	return false
+}
+
+// ParsePlanInterval parses a PlanInterval from a string, returning an error if the value is not known.
+func ParsePlanInterval(s string) (PlanInterval, error) {
+	v := PlanInterval(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PlanInterval %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PlanInterval) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PlanInterval) UnmarshalText(text []byte) error {
+	*v = PlanInterval(text)
+	return nil
+}
+
 // PlanStep: A step in the design plan.
 type PlanStep struct {
 	// EditInstructions: The edit instructions for the step.
@@ -7764,7 +11315,7 @@ type Plane struct {
 // PlaneIntersectAndProject: Corresponding coordinates of given window coordinates, intersected on given plane.
 type PlaneIntersectAndProject struct {
 	// PlaneCoordinates: Corresponding coordinates of given window coordinates, intersected on given plane.
-	PlaneCoordinates Point2D `json:"plane_coordinates" yaml:"plane_coordinates" schema:"plane_coordinates"`
+	PlaneCoordinates *Point2D `json:"plane_coordinates,omitempty" yaml:"plane_coordinates,omitempty" schema:"plane_coordinates,omitempty"`
 }
 
 // PlaneSetColor: The response from the `PlaneSetColor` endpoint.
@@ -7783,6 +11334,45 @@ const (
 	PlyStorageBinaryBigEndian PlyStorage = "binary_big_endian"
 )
 
+// AllPlyStorages returns all the known values of PlyStorage.
+func AllPlyStorages() []PlyStorage {
+	return []PlyStorage{
+		PlyStorageAscii,
+		PlyStorageBinaryLittleEndian,
+		PlyStorageBinaryBigEndian,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PlyStorage.
+func (v PlyStorage) IsValid() bool {
+	switch v {
+	case PlyStorageAscii, PlyStorageBinaryLittleEndian, PlyStorageBinaryBigEndian:
+		return true
+	}
+	return false
+}
+
+// ParsePlyStorage parses a PlyStorage from a string, returning an error if the value is not known.
+func ParsePlyStorage(s string) (PlyStorage, error) {
+	v := PlyStorage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PlyStorage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PlyStorage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PlyStorage) UnmarshalText(text []byte) error {
+	*v = PlyStorage(text)
+	return nil
+}
+
 // Point is the type definition for a Point.
 type Point struct {
 	// Point: The point to extrude to.
@@ -7837,10 +11427,49 @@ const (
 	PostEffectTypeNoeffect PostEffectType = "noeffect"
 )
 
+// AllPostEffectTypes returns all the known values of PostEffectType.
+func AllPostEffectTypes() []PostEffectType {
+	return []PostEffectType{
+		PostEffectTypePhosphor,
+		PostEffectTypeSsao,
+		PostEffectTypeNoeffect,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of PostEffectType.
+func (v PostEffectType) IsValid() bool {
+	switch v {
+	case PostEffectTypePhosphor, PostEffectTypeSsao, PostEffectTypeNoeffect:
+		return true
+	}
+	return false
+}
+
+// ParsePostEffectType parses a PostEffectType from a string, returning an error if the value is not known.
+func ParsePostEffectType(s string) (PostEffectType, error) {
+	v := PostEffectType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid PostEffectType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v PostEffectType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *PostEffectType) UnmarshalText(text []byte) error {
+	*v = PostEffectType(text)
+	return nil
+}
+
 // PriceUpsertRequest: Create or update a price row for a subscription plan.
 type PriceUpsertRequest struct {
 	// Active: Whether the price should be active.
-	Active bool `json:"active" yaml:"active" schema:"active"`
+	Active *bool `json:"active,omitempty" yaml:"active,omitempty" schema:"active,omitempty"`
 	// BillingModel: Billing model (flat or per-user).
 	BillingModel SubscriptionPlanBillingModel `json:"billing_model" yaml:"billing_model" schema:"billing_model,required"`
 	// Cadence: Cadence for billing (day, week, month, year).
@@ -7878,6 +11507,44 @@ const (
 	ProjectArchiveFormatZip ProjectArchiveFormat = "zip"
 )
 
+// AllProjectArchiveFormats returns all the known values of ProjectArchiveFormat.
+func AllProjectArchiveFormats() []ProjectArchiveFormat {
+	return []ProjectArchiveFormat{
+		ProjectArchiveFormatTar,
+		ProjectArchiveFormatZip,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ProjectArchiveFormat.
+func (v ProjectArchiveFormat) IsValid() bool {
+	switch v {
+	case ProjectArchiveFormatTar, ProjectArchiveFormatZip:
+		return true
+	}
+	return false
+}
+
+// ParseProjectArchiveFormat parses a ProjectArchiveFormat from a string, returning an error if the value is not known.
+func ParseProjectArchiveFormat(s string) (ProjectArchiveFormat, error) {
+	v := ProjectArchiveFormat(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ProjectArchiveFormat %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ProjectArchiveFormat) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ProjectArchiveFormat) UnmarshalText(text []byte) error {
+	*v = ProjectArchiveFormat(text)
+	return nil
+}
+
 // ProjectCategoryResponse: Active category metadata available for project submission flows.
 type ProjectCategoryResponse struct {
 	// Description: Optional helper text shown to users choosing a category.
@@ -7913,7 +11580,7 @@ type ProjectFileResponse struct {
 	// RelativePath: Relative path as seen inside the project.
 	RelativePath string `json:"relative_path" yaml:"relative_path" schema:"relative_path,required"`
 	// Sha256: Hex-encoded SHA-256 of the stored contents, when known.
-	Sha256 string `json:"sha256" yaml:"sha256" schema:"sha256"`
+	Sha256 *string `json:"sha256,omitempty" yaml:"sha256,omitempty" schema:"sha256,omitempty"`
 	// SortOrder: Stable ordering hint.
 	SortOrder int `json:"sort_order" yaml:"sort_order" schema:"sort_order,required"`
 	// UpdatedAt: File last update timestamp.
@@ -7929,15 +11596,15 @@ type ProjectPointsToPlane struct {
 // ProjectPublicationInfoResponse: Owner-facing publication metadata for a project.
 type ProjectPublicationInfoResponse struct {
 	// Feedback: Moderator feedback when changes have been requested, if any.
-	Feedback string `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *string `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// HasUnpublishedChanges: Whether the current editable draft differs from the last live version.
 	HasUnpublishedChanges bool `json:"has_unpublished_changes" yaml:"has_unpublished_changes" schema:"has_unpublished_changes,required"`
 	// LastPublishedAt: When a version of this project most recently became public.
-	LastPublishedAt Time `json:"last_published_at" yaml:"last_published_at" schema:"last_published_at"`
+	LastPublishedAt *Time `json:"last_published_at,omitempty" yaml:"last_published_at,omitempty" schema:"last_published_at,omitempty"`
 	// LastPublishedVersionID: Last version that successfully went live for this project.
-	LastPublishedVersionID UUID `json:"last_published_version_id" yaml:"last_published_version_id" schema:"last_published_version_id"`
+	LastPublishedVersionID *UUID `json:"last_published_version_id,omitempty" yaml:"last_published_version_id,omitempty" schema:"last_published_version_id,omitempty"`
 	// SubmittedAt: When the current version was most recently submitted for review.
-	SubmittedAt Time `json:"submitted_at" yaml:"submitted_at" schema:"submitted_at"`
+	SubmittedAt *Time `json:"submitted_at,omitempty" yaml:"submitted_at,omitempty" schema:"submitted_at,omitempty"`
 }
 
 // ProjectResponse: Owner-visible project detail payload.
@@ -7957,7 +11624,7 @@ type ProjectResponse struct {
 	// PreviewStatus: Current preview generation state.
 	PreviewStatus KclProjectPreviewStatus `json:"preview_status" yaml:"preview_status" schema:"preview_status,required"`
 	// PreviewUrl: Stable authenticated thumbnail URL, when one exists.
-	PreviewUrl string `json:"preview_url" yaml:"preview_url" schema:"preview_url"`
+	PreviewUrl *string `json:"preview_url,omitempty" yaml:"preview_url,omitempty" schema:"preview_url,omitempty"`
 	// ProjectTomlPath: Relative path to the project's manifest file.
 	ProjectTomlPath string `json:"project_toml_path" yaml:"project_toml_path" schema:"project_toml_path,required"`
 	// Publication: Owner-facing publication metadata for the current and last live version.
@@ -8001,7 +11668,7 @@ type ProjectSummaryResponse struct {
 	// PreviewStatus: Current preview generation state.
 	PreviewStatus KclProjectPreviewStatus `json:"preview_status" yaml:"preview_status" schema:"preview_status,required"`
 	// PreviewUrl: Stable authenticated thumbnail URL, when one exists.
-	PreviewUrl string `json:"preview_url" yaml:"preview_url" schema:"preview_url"`
+	PreviewUrl *string `json:"preview_url,omitempty" yaml:"preview_url,omitempty" schema:"preview_url,omitempty"`
 	// ProjectTomlPath: Relative path to the project's manifest file.
 	ProjectTomlPath string `json:"project_toml_path" yaml:"project_toml_path" schema:"project_toml_path,required"`
 	// Publication: Owner-facing publication metadata for the current and last live version.
@@ -8051,11 +11718,11 @@ type PublicProjectResponse struct {
 	// LikeCount: Current total public like count for the project.
 	LikeCount int `json:"like_count" yaml:"like_count" schema:"like_count,required"`
 	// Liked: Whether the authenticated viewer currently likes the project.
-	Liked bool `json:"liked" yaml:"liked" schema:"liked"`
+	Liked *bool `json:"liked,omitempty" yaml:"liked,omitempty" schema:"liked,omitempty"`
 	// Owner: Public creator metadata.
 	Owner PublicProjectOwnerResponse `json:"owner" yaml:"owner" schema:"owner,required"`
 	// PreviewUrl: Stable public thumbnail URL, when one exists.
-	PreviewUrl string `json:"preview_url" yaml:"preview_url" schema:"preview_url"`
+	PreviewUrl *string `json:"preview_url,omitempty" yaml:"preview_url,omitempty" schema:"preview_url,omitempty"`
 	// PublishedAt: When the project was published publicly.
 	PublishedAt Time `json:"published_at" yaml:"published_at" schema:"published_at,required"`
 	// Title: Public project title.
@@ -8079,7 +11746,7 @@ type QueryEntityType struct {
 // QueryEntityTypeWithPoint: The response from the `QueryEntityTypeWithPoint` command.
 type QueryEntityTypeWithPoint struct {
 	// Reference: How to reference the selected entity using face ids. None if no entity was found at the given point (e.g. clicked in empty space).
-	Reference any `json:"reference" yaml:"reference" schema:"reference"`
+	Reference any `json:"reference,omitempty" yaml:"reference,omitempty" schema:"reference,omitempty"`
 }
 
 // RawFile: A raw file with unencoded contents to be passed over binary websockets. When raw files come back for exports it is sent as binary/bson, not text/json.
@@ -8223,6 +11890,44 @@ const (
 	RegionVersionV1 RegionVersion = "V1"
 )
 
+// AllRegionVersions returns all the known values of RegionVersion.
+func AllRegionVersions() []RegionVersion {
+	return []RegionVersion{
+		RegionVersionV0,
+		RegionVersionV1,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of RegionVersion.
+func (v RegionVersion) IsValid() bool {
+	switch v {
+	case RegionVersionV0, RegionVersionV1:
+		return true
+	}
+	return false
+}
+
+// ParseRegionVersion parses a RegionVersion from a string, returning an error if the value is not known.
+func ParseRegionVersion(s string) (RegionVersion, error) {
+	v := RegionVersion(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid RegionVersion %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v RegionVersion) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *RegionVersion) UnmarshalText(text []byte) error {
+	*v = RegionVersion(text)
+	return nil
+}
+
 // RelativeTo: What is the given geometry relative to?
 type RelativeTo string
 
@@ -8233,6 +11938,44 @@ const (
 	RelativeToTrajectoryCurve RelativeTo = "trajectory_curve"
 )
 
+// AllRelativeTos returns all the known values of RelativeTo.
+func AllRelativeTos() []RelativeTo {
+	return []RelativeTo{
+		RelativeToSketchPlane,
+		RelativeToTrajectoryCurve,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of RelativeTo.
+func (v RelativeTo) IsValid() bool {
+	switch v {
+	case RelativeToSketchPlane, RelativeToTrajectoryCurve:
+		return true
+	}
+	return false
+}
+
+// ParseRelativeTo parses a RelativeTo from a string, returning an error if the value is not known.
+func ParseRelativeTo(s string) (RelativeTo, error) {
+	v := RelativeTo(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid RelativeTo %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v RelativeTo) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *RelativeTo) UnmarshalText(text []byte) error {
+	*v = RelativeTo(text)
+	return nil
+}
+
 // RemoveSceneObjects: The response from the `RemoveSceneObjects` endpoint.
 type RemoveSceneObjects struct {
 }
@@ -8246,23 +11989,23 @@ type Replay struct {
 // RequestAttachments is the type definition for a RequestAttachments.
 type RequestAttachments struct {
 	// ConversationID: Conversation to search for attachments. Used when prompt_id is not known.
-	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id"`
+	ConversationID *UUID `json:"conversation_id,omitempty" yaml:"conversation_id,omitempty" schema:"conversation_id,omitempty"`
 	// Names: Attachment names to load. Empty means load all attachments from the selected message(s).
-	Names []string `json:"names" yaml:"names" schema:"names"`
+	Names []string `json:"names,omitempty" yaml:"names,omitempty" schema:"names,omitempty"`
 	// OnlyMetadata: If true, return attachment metadata without loading file contents.
-	OnlyMetadata bool `json:"only_metadata" yaml:"only_metadata" schema:"only_metadata"`
+	OnlyMetadata *bool `json:"only_metadata,omitempty" yaml:"only_metadata,omitempty" schema:"only_metadata,omitempty"`
 	// PromptID: Prompt to load attachments from. Defaults to the active/resumed prompt.
-	PromptID UUID `json:"prompt_id" yaml:"prompt_id" schema:"prompt_id"`
+	PromptID *UUID `json:"prompt_id,omitempty" yaml:"prompt_id,omitempty" schema:"prompt_id,omitempty"`
 	// RequestID: Optional backend-provided identifier to correlate request/response pairs.
-	RequestID string `json:"request_id" yaml:"request_id" schema:"request_id"`
+	RequestID *string `json:"request_id,omitempty" yaml:"request_id,omitempty" schema:"request_id,omitempty"`
 	// Seq: Specific client message sequence to inspect. Defaults to recent client messages.
-	Seq int `json:"seq" yaml:"seq" schema:"seq"`
+	Seq *int `json:"seq,omitempty" yaml:"seq,omitempty" schema:"seq,omitempty"`
 }
 
 // ResponseError: Error information from a response.
 type ResponseError struct {
 	// ErrorCode:
-	ErrorCode string `json:"error_code" yaml:"error_code" schema:"error_code"`
+	ErrorCode *string `json:"error_code,omitempty" yaml:"error_code,omitempty" schema:"error_code,omitempty"`
 	// Message:
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 	// RequestID:
@@ -8272,17 +12015,17 @@ type ResponseError struct {
 // Revolve: The response from the `Revolve` endpoint.
 type Revolve struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // RevolveAboutEdge: The response from the `RevolveAboutEdge` endpoint.
 type RevolveAboutEdge struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // Rotation: A rotation defined by an axis, origin of rotation, and an angle.
@@ -8300,11 +12043,11 @@ type RtcIceCandidateInit struct {
 	// Candidate: The candidate string associated with the object.
 	Candidate string `json:"candidate" yaml:"candidate" schema:"candidate,required"`
 	// SdpMlineIndex: The index (starting at zero) of the m-line in the SDP this candidate is associated with.
-	SdpMlineIndex int `json:"sdpMLineIndex" yaml:"sdpMLineIndex" schema:"sdpMLineIndex"`
+	SdpMlineIndex *int `json:"sdpMLineIndex,omitempty" yaml:"sdpMLineIndex,omitempty" schema:"sdpMLineIndex,omitempty"`
 	// SdpMid: The identifier of the "media stream identification" as defined in [RFC 8841](https://tools.ietf.org/html/rfc8841).
-	SdpMid string `json:"sdpMid" yaml:"sdpMid" schema:"sdpMid"`
+	SdpMid *string `json:"sdpMid,omitempty" yaml:"sdpMid,omitempty" schema:"sdpMid,omitempty"`
 	// UsernameFragment: The username fragment (as defined in [RFC 8445](https://tools.ietf.org/html/rfc8445#section-5.2.1)) associated with the object.
-	UsernameFragment string `json:"usernameFragment" yaml:"usernameFragment" schema:"usernameFragment"`
+	UsernameFragment *string `json:"usernameFragment,omitempty" yaml:"usernameFragment,omitempty" schema:"usernameFragment,omitempty"`
 }
 
 // RtcSdpType: SDPType describes the type of an SessionDescription.
@@ -8323,6 +12066,47 @@ const (
 	RtcSdpTypeRollback RtcSdpType = "rollback"
 )
 
+// AllRtcSdpTypes returns all the known values of RtcSdpType.
+func AllRtcSdpTypes() []RtcSdpType {
+	return []RtcSdpType{
+		RtcSdpTypeUnspecified,
+		RtcSdpTypeOffer,
+		RtcSdpTypePranswer,
+		RtcSdpTypeAnswer,
+		RtcSdpTypeRollback,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of RtcSdpType.
+func (v RtcSdpType) IsValid() bool {
+	switch v {
+	case RtcSdpTypeUnspecified, RtcSdpTypeOffer, RtcSdpTypePranswer, RtcSdpTypeAnswer, RtcSdpTypeRollback:
+		return true
+	}
+	return false
+}
+
+// ParseRtcSdpType parses a RtcSdpType from a string, returning an error if the value is not known.
+func ParseRtcSdpType(s string) (RtcSdpType, error) {
+	v := RtcSdpType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid RtcSdpType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v RtcSdpType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *RtcSdpType) UnmarshalText(text []byte) error {
+	*v = RtcSdpType(text)
+	return nil
+}
+
 // RtcSessionDescription: SessionDescription is used to expose local and remote session descriptions.
 type RtcSessionDescription struct {
 	// Sdp: SDP string.
@@ -8349,6 +12133,48 @@ const (
 	SalesInquiryTypeOtherSalesInquiry SalesInquiryType = "other_sales_inquiry"
 )
 
+// AllSalesInquiryTypes returns all the known values of SalesInquiryType.
+func AllSalesInquiryTypes() []SalesInquiryType {
+	return []SalesInquiryType{
+		SalesInquiryTypePilotInquiry,
+		SalesInquiryTypeGeneralInquiry,
+		SalesInquiryTypeSalesQuestion,
+		SalesInquiryTypeDeveloperInquiry,
+		SalesInquiryTypePartnershipOpportunity,
+		SalesInquiryTypeOtherSalesInquiry,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SalesInquiryType.
+func (v SalesInquiryType) IsValid() bool {
+	switch v {
+	case SalesInquiryTypePilotInquiry, SalesInquiryTypeGeneralInquiry, SalesInquiryTypeSalesQuestion, SalesInquiryTypeDeveloperInquiry, SalesInquiryTypePartnershipOpportunity, SalesInquiryTypeOtherSalesInquiry:
+		return true
+	}
+	return false
+}
+
+// ParseSalesInquiryType parses a SalesInquiryType from a string, returning an error if the value is not known.
+func ParseSalesInquiryType(s string) (SalesInquiryType, error) {
+	v := SalesInquiryType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SalesInquiryType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SalesInquiryType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SalesInquiryType) UnmarshalText(text []byte) error {
+	*v = SalesInquiryType(text)
+	return nil
+}
+
 // SamlIdentityProvider: A SAML identity provider.
 type SamlIdentityProvider struct {
 	// AcsUrl: The ACS (Assertion Consumer Service) URL.
@@ -8358,35 +12184,37 @@ type SamlIdentityProvider struct {
 	// ID: The unique identifier for the SAML identity provider.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// IdpEntityID: The entity ID of the SAML identity provider.
-	IdpEntityID string `json:"idp_entity_id" yaml:"idp_entity_id" schema:"idp_entity_id"`
+	IdpEntityID *string `json:"idp_entity_id,omitempty" yaml:"idp_entity_id,omitempty" schema:"idp_entity_id,omitempty"`
 	// IdpMetadataDocumentString: The metadata document as a string.
-	IdpMetadataDocumentString string `json:"idp_metadata_document_string" yaml:"idp_metadata_document_string" schema:"idp_metadata_document_string"`
+	IdpMetadataDocumentString *string `json:"idp_metadata_document_string,omitempty" yaml:"idp_metadata_document_string,omitempty" schema:"idp_metadata_document_string,omitempty"`
 	// OrgID: The organization ID the SAML identity provider belongs to.
 	OrgID UUID `json:"org_id" yaml:"org_id" schema:"org_id,required"`
 	// PrivateKey: The private key for the SAML identity provider. This is the PEM corresponding to the X509 pair.
 	//
 	// Never serialized in API responses: the SP signing key is a secret used internally to sign AuthnRequests, and would otherwise leak to admin GETs, audit logs, support tooling, and browser history. Input is accepted via the dedicated `SamlIdentityProviderCreate` body type, not by deserializing this struct.
-	PrivateKey Base64 `json:"private_key" yaml:"private_key" schema:"private_key"`
+	PrivateKey *Base64 `json:"private_key,omitempty" yaml:"private_key,omitempty" schema:"private_key,omitempty"`
 	// PublicCert: The public certificate for the SAML identity provider. This is the PEM corresponding to the X509 pair.
-	PublicCert Base64 `json:"public_cert" yaml:"public_cert" schema:"public_cert"`
+	PublicCert *Base64 `json:"public_cert,omitempty" yaml:"public_cert,omitempty" schema:"public_cert,omitempty"`
 	// SloUrl: The SLO (Single Logout) URL.
 	SloUrl URL `json:"slo_url" yaml:"slo_url" schema:"slo_url,required"`
 	// TechnicalContactEmail: The technical contact email address for the SAML identity provider.
-	TechnicalContactEmail string `json:"technical_contact_email" yaml:"technical_contact_email" schema:"technical_contact_email"`
+	TechnicalContactEmail *string `json:"technical_contact_email,omitempty" yaml:"technical_contact_email,omitempty" schema:"technical_contact_email,omitempty"`
 	// UpdatedAt: The date and time the SAML identity provider was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
 
 // SamlIdentityProviderCreate: Parameters for creating a SAML identity provider.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type SamlIdentityProviderCreate struct {
 	// IdpEntityID: The entity ID of the SAML identity provider.
-	IdpEntityID string `json:"idp_entity_id" yaml:"idp_entity_id" schema:"idp_entity_id"`
+	IdpEntityID *string `json:"idp_entity_id,omitempty" yaml:"idp_entity_id,omitempty" schema:"idp_entity_id,omitempty"`
 	// IdpMetadataSource: The source of an identity provider metadata descriptor.
 	IdpMetadataSource any `json:"idp_metadata_source" yaml:"idp_metadata_source" schema:"idp_metadata_source,required"`
 	// SigningKeypair: The request signing key pair.
-	SigningKeypair DerEncodedKeyPair `json:"signing_keypair" yaml:"signing_keypair" schema:"signing_keypair"`
+	SigningKeypair *DerEncodedKeyPair `json:"signing_keypair,omitempty" yaml:"signing_keypair,omitempty" schema:"signing_keypair,omitempty"`
 	// TechnicalContactEmail: The technical contact email address for the SAML identity provider.
-	TechnicalContactEmail string `json:"technical_contact_email" yaml:"technical_contact_email" schema:"technical_contact_email"`
+	TechnicalContactEmail *string `json:"technical_contact_email,omitempty" yaml:"technical_contact_email,omitempty" schema:"technical_contact_email,omitempty"`
 }
 
 // SceneClearAll: The response from the `SceneClearAll` endpoint.
@@ -8411,6 +12239,45 @@ const (
 	SceneSelectionTypeRemove SceneSelectionType = "remove"
 )
 
+// AllSceneSelectionTypes returns all the known values of SceneSelectionType.
+func AllSceneSelectionTypes() []SceneSelectionType {
+	return []SceneSelectionType{
+		SceneSelectionTypeReplace,
+		SceneSelectionTypeAdd,
+		SceneSelectionTypeRemove,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SceneSelectionType.
+func (v SceneSelectionType) IsValid() bool {
+	switch v {
+	case SceneSelectionTypeReplace, SceneSelectionTypeAdd, SceneSelectionTypeRemove:
+		return true
+	}
+	return false
+}
+
+// ParseSceneSelectionType parses a SceneSelectionType from a string, returning an error if the value is not known.
+func ParseSceneSelectionType(s string) (SceneSelectionType, error) {
+	v := SceneSelectionType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SceneSelectionType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SceneSelectionType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SceneSelectionType) UnmarshalText(text []byte) error {
+	*v = SceneSelectionType(text)
+	return nil
+}
+
 // SceneToolType: The type of scene's active tool
 type SceneToolType string
 
@@ -8431,6 +12298,49 @@ const (
 	SceneToolTypeSketchCurveMod SceneToolType = "sketch_curve_mod"
 )
 
+// AllSceneToolTypes returns all the known values of SceneToolType.
+func AllSceneToolTypes() []SceneToolType {
+	return []SceneToolType{
+		SceneToolTypeCameraRevolve,
+		SceneToolTypeSelect,
+		SceneToolTypeMove,
+		SceneToolTypeSketchLine,
+		SceneToolTypeSketchTangentialArc,
+		SceneToolTypeSketchCurve,
+		SceneToolTypeSketchCurveMod,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SceneToolType.
+func (v SceneToolType) IsValid() bool {
+	switch v {
+	case SceneToolTypeCameraRevolve, SceneToolTypeSelect, SceneToolTypeMove, SceneToolTypeSketchLine, SceneToolTypeSketchTangentialArc, SceneToolTypeSketchCurve, SceneToolTypeSketchCurveMod:
+		return true
+	}
+	return false
+}
+
+// ParseSceneToolType parses a SceneToolType from a string, returning an error if the value is not known.
+func ParseSceneToolType(s string) (SceneToolType, error) {
+	v := SceneToolType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SceneToolType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SceneToolType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SceneToolType) UnmarshalText(text []byte) error {
+	*v = SceneToolType(text)
+	return nil
+}
+
 // SelectAdd: The response from the `SelectAdd` endpoint.
 type SelectAdd struct {
 }
@@ -8452,7 +12362,7 @@ type SelectGet struct {
 // SelectRegionFromPoint: The response from the 'SelectRegionFromPoint'. If there are multiple ways to construct this region, this chooses arbitrarily.
 type SelectRegionFromPoint struct {
 	// Region: The region the user clicked on. If they clicked an open space which isn't a region, this returns None.
-	Region SelectedRegion `json:"region" yaml:"region" schema:"region"`
+	Region *SelectedRegion `json:"region,omitempty" yaml:"region,omitempty" schema:"region,omitempty"`
 }
 
 // SelectRemove: The response from the `SelectRemove` endpoint.
@@ -8466,15 +12376,15 @@ type SelectReplace struct {
 // SelectWithPoint: The response from the `SelectWithPoint` command.
 type SelectWithPoint struct {
 	// EntityID: The UUID of the entity that was selected.
-	EntityID UUID `json:"entity_id" yaml:"entity_id" schema:"entity_id"`
+	EntityID *UUID `json:"entity_id,omitempty" yaml:"entity_id,omitempty" schema:"entity_id,omitempty"`
 }
 
 // SelectedRegion: The region a user clicked on.
 type SelectedRegion struct {
 	// CurveClockwise: By default (when this is false), curve counterclockwise at intersections. If this is true, instead curve clockwise.
-	CurveClockwise bool `json:"curve_clockwise" yaml:"curve_clockwise" schema:"curve_clockwise"`
+	CurveClockwise *bool `json:"curve_clockwise,omitempty" yaml:"curve_clockwise,omitempty" schema:"curve_clockwise,omitempty"`
 	// IntersectionIndex: At which intersection between `segment` and `intersection_segment` should we stop following the `segment` and start following `intersection_segment`? Defaults to -1, which means the last intersection.
-	IntersectionIndex int `json:"intersection_index" yaml:"intersection_index" schema:"intersection_index"`
+	IntersectionIndex *int `json:"intersection_index,omitempty" yaml:"intersection_index,omitempty" schema:"intersection_index,omitempty"`
 	// IntersectionSegment: Second segment to follow to find the region. Intersects the first segment.
 	IntersectionSegment UUID `json:"intersection_segment" yaml:"intersection_segment" schema:"intersection_segment,required"`
 	// Segment: First segment to follow to find the region.
@@ -8538,7 +12448,7 @@ type ServiceAccount struct {
 	// IsValid: If the token is valid. We never delete API tokens, but we can mark them as invalid. We save them for ever to preserve the history of the API token.
 	IsValid bool `json:"is_valid" yaml:"is_valid" schema:"is_valid,required"`
 	// Label: An optional label for the API token.
-	Label string `json:"label" yaml:"label" schema:"label"`
+	Label *string `json:"label,omitempty" yaml:"label,omitempty" schema:"label,omitempty"`
 	// OrgID: The ID of the organization that owns the API token.
 	OrgID UUID `json:"org_id" yaml:"org_id" schema:"org_id,required"`
 	// Token: The API token itself.
@@ -8552,7 +12462,7 @@ type ServiceAccountResultsPage struct {
 	// Items: list of items on this page of results
 	Items []ServiceAccount `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // Session: An authentication session.
@@ -8636,11 +12546,11 @@ type Shortlink struct {
 	// Key: The key of the shortlink. This is the short part of the URL.
 	Key string `json:"key" yaml:"key" schema:"key,required"`
 	// OrgID: The organization ID of the shortlink.
-	OrgID UUID `json:"org_id" yaml:"org_id" schema:"org_id"`
+	OrgID *UUID `json:"org_id,omitempty" yaml:"org_id,omitempty" schema:"org_id,omitempty"`
 	// PasswordHash: The hash of the password for the shortlink.
-	PasswordHash string `json:"password_hash" yaml:"password_hash" schema:"password_hash"`
+	PasswordHash *string `json:"password_hash,omitempty" yaml:"password_hash,omitempty" schema:"password_hash,omitempty"`
 	// RestrictToOrg: If the shortlink should be restricted to the organization. This only applies to org shortlinks. If you are creating a user shortlink and you are not a member of a team or enterprise and you try to set this to true, it will fail.
-	RestrictToOrg bool `json:"restrict_to_org" yaml:"restrict_to_org" schema:"restrict_to_org"`
+	RestrictToOrg *bool `json:"restrict_to_org,omitempty" yaml:"restrict_to_org,omitempty" schema:"restrict_to_org,omitempty"`
 	// UpdatedAt: The date and time the shortlink was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 	// UserID: The ID of the user that made the shortlink.
@@ -8654,7 +12564,7 @@ type ShortlinkResultsPage struct {
 	// Items: list of items on this page of results
 	Items []Shortlink `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // SideFace: IDs for a side face, extruded from the path of some sketch/2D shape.
@@ -8730,7 +12640,7 @@ type Solid3DGetBodyType struct {
 // Solid3DGetCommonEdge: The response from the `Solid3DGetCommonEdge` command.
 type Solid3DGetCommonEdge struct {
 	// Edge: The UUID of the common edge, if any.
-	Edge UUID `json:"edge" yaml:"edge" schema:"edge"`
+	Edge *UUID `json:"edge,omitempty" yaml:"edge,omitempty" schema:"edge,omitempty"`
 }
 
 // Solid3DGetEdgeUuid: The response from the `Solid3dGetEdgeUuid` endpoint.
@@ -8754,7 +12664,7 @@ type Solid3DGetFaceUuid struct {
 // Solid3DGetNextAdjacentEdge: The response from the `Solid3dGetNextAdjacentEdge` command.
 type Solid3DGetNextAdjacentEdge struct {
 	// Edge: The UUID of the edge.
-	Edge UUID `json:"edge" yaml:"edge" schema:"edge"`
+	Edge *UUID `json:"edge,omitempty" yaml:"edge,omitempty" schema:"edge,omitempty"`
 }
 
 // Solid3DGetOppositeEdge: The response from the `Solid3dGetOppositeEdge` command.
@@ -8766,7 +12676,7 @@ type Solid3DGetOppositeEdge struct {
 // Solid3DGetPrevAdjacentEdge: The response from the `Solid3dGetPrevAdjacentEdge` command.
 type Solid3DGetPrevAdjacentEdge struct {
 	// Edge: The UUID of the edge.
-	Edge UUID `json:"edge" yaml:"edge" schema:"edge"`
+	Edge *UUID `json:"edge,omitempty" yaml:"edge,omitempty" schema:"edge,omitempty"`
 }
 
 // Solid3DJoin: The response from the `Solid3dJoin` endpoint.
@@ -8800,7 +12710,7 @@ type SourceRange struct {
 // SourceRangePrompt: A source range and prompt for a text to CAD iteration.
 type SourceRangePrompt struct {
 	// File: The name of the file the source range applies to. This is the relative path to the file from the root of the project. This only applies to multi-file iterations.
-	File string `json:"file" yaml:"file" schema:"file"`
+	File *string `json:"file,omitempty" yaml:"file,omitempty" schema:"file,omitempty"`
 	// Prompt: The prompt for the changes.
 	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt,required"`
 	// Range: The range of the source code to change. If you want to apply the prompt to the whole file, set the start to 0 and the end to the end of the file.
@@ -8823,6 +12733,44 @@ const (
 	StepPresentationPretty StepPresentation = "pretty"
 )
 
+// AllStepPresentations returns all the known values of StepPresentation.
+func AllStepPresentations() []StepPresentation {
+	return []StepPresentation{
+		StepPresentationCompact,
+		StepPresentationPretty,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of StepPresentation.
+func (v StepPresentation) IsValid() bool {
+	switch v {
+	case StepPresentationCompact, StepPresentationPretty:
+		return true
+	}
+	return false
+}
+
+// ParseStepPresentation parses a StepPresentation from a string, returning an error if the value is not known.
+func ParseStepPresentation(s string) (StepPresentation, error) {
+	v := StepPresentation(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid StepPresentation %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v StepPresentation) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *StepPresentation) UnmarshalText(text []byte) error {
+	*v = StepPresentation(text)
+	return nil
+}
+
 // StlStorage: Export storage.
 type StlStorage string
 
@@ -8835,6 +12783,44 @@ const (
 	StlStorageBinary StlStorage = "binary"
 )
 
+// AllStlStorages returns all the known values of StlStorage.
+func AllStlStorages() []StlStorage {
+	return []StlStorage{
+		StlStorageAscii,
+		StlStorageBinary,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of StlStorage.
+func (v StlStorage) IsValid() bool {
+	switch v {
+	case StlStorageAscii, StlStorageBinary:
+		return true
+	}
+	return false
+}
+
+// ParseStlStorage parses a StlStorage from a string, returning an error if the value is not known.
+func ParseStlStorage(s string) (StlStorage, error) {
+	v := StlStorage(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid StlStorage %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v StlStorage) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *StlStorage) UnmarshalText(text []byte) error {
+	*v = StlStorage(text)
+	return nil
+}
+
 // StorageProvider: Storage provider identifier for org datasets.
 type StorageProvider string
 
@@ -8845,6 +12831,44 @@ const (
 	StorageProviderZooManaged StorageProvider = "zoo_managed"
 )
 
+// AllStorageProviders returns all the known values of StorageProvider.
+func AllStorageProviders() []StorageProvider {
+	return []StorageProvider{
+		StorageProviderS3,
+		StorageProviderZooManaged,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of StorageProvider.
+func (v StorageProvider) IsValid() bool {
+	switch v {
+	case StorageProviderS3, StorageProviderZooManaged:
+		return true
+	}
+	return false
+}
+
+// ParseStorageProvider parses a StorageProvider from a string, returning an error if the value is not known.
+func ParseStorageProvider(s string) (StorageProvider, error) {
+	v := StorageProvider(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid StorageProvider %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v StorageProvider) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *StorageProvider) UnmarshalText(text []byte) error {
+	*v = StorageProvider(text)
+	return nil
+}
+
 // StoreCouponParams: The parameters for a new store coupon.
 type StoreCouponParams struct {
 	// PercentOff: The percentage off.
@@ -8861,6 +12885,44 @@ const (
 	SubscriptionActionTypeSetupIntent SubscriptionActionType = "setup_intent"
 )
 
+// AllSubscriptionActionTypes returns all the known values of SubscriptionActionType.
+func AllSubscriptionActionTypes() []SubscriptionActionType {
+	return []SubscriptionActionType{
+		SubscriptionActionTypePaymentIntent,
+		SubscriptionActionTypeSetupIntent,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SubscriptionActionType.
+func (v SubscriptionActionType) IsValid() bool {
+	switch v {
+	case SubscriptionActionTypePaymentIntent, SubscriptionActionTypeSetupIntent:
+		return true
+	}
+	return false
+}
+
+// ParseSubscriptionActionType parses a SubscriptionActionType from a string, returning an error if the value is not known.
+func ParseSubscriptionActionType(s string) (SubscriptionActionType, error) {
+	v := SubscriptionActionType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SubscriptionActionType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SubscriptionActionType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SubscriptionActionType) UnmarshalText(text []byte) error {
+	*v = SubscriptionActionType(text)
+	return nil
+}
+
 // SubscriptionBillingMode: How a subscription plan is billed operationally.
 type SubscriptionBillingMode string
 
@@ -8871,6 +12933,44 @@ const (
 	SubscriptionBillingModeContract SubscriptionBillingMode = "contract"
 )
 
+// AllSubscriptionBillingModes returns all the known values of SubscriptionBillingMode.
+func AllSubscriptionBillingModes() []SubscriptionBillingMode {
+	return []SubscriptionBillingMode{
+		SubscriptionBillingModeStandard,
+		SubscriptionBillingModeContract,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SubscriptionBillingMode.
+func (v SubscriptionBillingMode) IsValid() bool {
+	switch v {
+	case SubscriptionBillingModeStandard, SubscriptionBillingModeContract:
+		return true
+	}
+	return false
+}
+
+// ParseSubscriptionBillingMode parses a SubscriptionBillingMode from a string, returning an error if the value is not known.
+func ParseSubscriptionBillingMode(s string) (SubscriptionBillingMode, error) {
+	v := SubscriptionBillingMode(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SubscriptionBillingMode %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SubscriptionBillingMode) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SubscriptionBillingMode) UnmarshalText(text []byte) error {
+	*v = SubscriptionBillingMode(text)
+	return nil
+}
+
 // SubscriptionPlanBillingModel: Billing model for a modeling-app plan price.
 type SubscriptionPlanBillingModel string
 
@@ -8881,6 +12981,44 @@ const (
 	SubscriptionPlanBillingModelPerUser SubscriptionPlanBillingModel = "per_user"
 )
 
+// AllSubscriptionPlanBillingModels returns all the known values of SubscriptionPlanBillingModel.
+func AllSubscriptionPlanBillingModels() []SubscriptionPlanBillingModel {
+	return []SubscriptionPlanBillingModel{
+		SubscriptionPlanBillingModelFlat,
+		SubscriptionPlanBillingModelPerUser,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SubscriptionPlanBillingModel.
+func (v SubscriptionPlanBillingModel) IsValid() bool {
+	switch v {
+	case SubscriptionPlanBillingModelFlat, SubscriptionPlanBillingModelPerUser:
+		return true
+	}
+	return false
+}
+
+// ParseSubscriptionPlanBillingModel parses a SubscriptionPlanBillingModel from a string, returning an error if the value is not known.
+func ParseSubscriptionPlanBillingModel(s string) (SubscriptionPlanBillingModel, error) {
+	v := SubscriptionPlanBillingModel(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SubscriptionPlanBillingModel %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SubscriptionPlanBillingModel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SubscriptionPlanBillingModel) UnmarshalText(text []byte) error {
+	*v = SubscriptionPlanBillingModel(text)
+	return nil
+}
+
 // SubscriptionPlanPriceRecord: Diesel model representing a row in `subscription_plan_prices`.
 type SubscriptionPlanPriceRecord struct {
 	// Active: Whether this price is currently active.
@@ -8894,11 +13032,11 @@ type SubscriptionPlanPriceRecord struct {
 	// ID: Unique identifier for the plan price entry.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// StripePriceID: Stripe price identifier, when synchronized.
-	StripePriceID string `json:"stripe_price_id" yaml:"stripe_price_id" schema:"stripe_price_id"`
+	StripePriceID *string `json:"stripe_price_id,omitempty" yaml:"stripe_price_id,omitempty" schema:"stripe_price_id,omitempty"`
 	// SubscriptionPlanID: Foreign key referencing the parent plan.
 	SubscriptionPlanID UUID `json:"subscription_plan_id" yaml:"subscription_plan_id" schema:"subscription_plan_id,required"`
 	// UnitAmount: Optional monetary amount associated with the price row.
-	UnitAmount string `json:"unit_amount" yaml:"unit_amount" schema:"unit_amount"`
+	UnitAmount *string `json:"unit_amount,omitempty" yaml:"unit_amount,omitempty" schema:"unit_amount,omitempty"`
 	// UpdatedAt: Timestamp when the price row was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -8967,10 +13105,49 @@ const (
 	SubscriptionTrainingDataBehaviorDefaultOff SubscriptionTrainingDataBehavior = "default_off"
 )
 
+// AllSubscriptionTrainingDataBehaviors returns all the known values of SubscriptionTrainingDataBehavior.
+func AllSubscriptionTrainingDataBehaviors() []SubscriptionTrainingDataBehavior {
+	return []SubscriptionTrainingDataBehavior{
+		SubscriptionTrainingDataBehaviorAlways,
+		SubscriptionTrainingDataBehaviorDefaultOn,
+		SubscriptionTrainingDataBehaviorDefaultOff,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SubscriptionTrainingDataBehavior.
+func (v SubscriptionTrainingDataBehavior) IsValid() bool {
+	switch v {
+	case SubscriptionTrainingDataBehaviorAlways, SubscriptionTrainingDataBehaviorDefaultOn, SubscriptionTrainingDataBehaviorDefaultOff:
+		return true
+	}
+	return false
+}
+
+// ParseSubscriptionTrainingDataBehavior parses a SubscriptionTrainingDataBehavior from a string, returning an error if the value is not known.
+func ParseSubscriptionTrainingDataBehavior(s string) (SubscriptionTrainingDataBehavior, error) {
+	v := SubscriptionTrainingDataBehavior(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SubscriptionTrainingDataBehavior %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SubscriptionTrainingDataBehavior) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SubscriptionTrainingDataBehavior) UnmarshalText(text []byte) error {
+	*v = SubscriptionTrainingDataBehavior(text)
+	return nil
+}
+
 // SuccessWebSocketResponse: Successful Websocket response.
 type SuccessWebSocketResponse struct {
 	// RequestID: Which request this is a response to. If the request was a modeling command, this is the modeling command ID. If no request ID was sent, this will be null.
-	RequestID UUID `json:"request_id" yaml:"request_id" schema:"request_id"`
+	RequestID *UUID `json:"request_id,omitempty" yaml:"request_id,omitempty" schema:"request_id,omitempty"`
 	// Resp: The data sent with a successful response. This will be flattened into a 'type' and 'data' field.
 	Resp any `json:"resp" yaml:"resp" schema:"resp,required"`
 	// Success: Always true
@@ -8989,6 +13166,45 @@ const (
 	SupportInquiryTypeOtherSupportInquiry SupportInquiryType = "other_support_inquiry"
 )
 
+// AllSupportInquiryTypes returns all the known values of SupportInquiryType.
+func AllSupportInquiryTypes() []SupportInquiryType {
+	return []SupportInquiryType{
+		SupportInquiryTypeTechnicalSupport,
+		SupportInquiryTypeAccountManagement,
+		SupportInquiryTypeOtherSupportInquiry,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SupportInquiryType.
+func (v SupportInquiryType) IsValid() bool {
+	switch v {
+	case SupportInquiryTypeTechnicalSupport, SupportInquiryTypeAccountManagement, SupportInquiryTypeOtherSupportInquiry:
+		return true
+	}
+	return false
+}
+
+// ParseSupportInquiryType parses a SupportInquiryType from a string, returning an error if the value is not known.
+func ParseSupportInquiryType(s string) (SupportInquiryType, error) {
+	v := SupportInquiryType(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SupportInquiryType %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SupportInquiryType) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SupportInquiryType) UnmarshalText(text []byte) error {
+	*v = SupportInquiryType(text)
+	return nil
+}
+
 // SupportTier: The support tier the subscription provides.
 type SupportTier string
 
@@ -9003,6 +13219,46 @@ const (
 	SupportTierPremium SupportTier = "premium"
 )
 
+// AllSupportTiers returns all the known values of SupportTier.
+func AllSupportTiers() []SupportTier {
+	return []SupportTier{
+		SupportTierCommunity,
+		SupportTierStandardEmail,
+		SupportTierPriorityEmail,
+		SupportTierPremium,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of SupportTier.
+func (v SupportTier) IsValid() bool {
+	switch v {
+	case SupportTierCommunity, SupportTierStandardEmail, SupportTierPriorityEmail, SupportTierPremium:
+		return true
+	}
+	return false
+}
+
+// ParseSupportTier parses a SupportTier from a string, returning an error if the value is not known.
+func ParseSupportTier(s string) (SupportTier, error) {
+	v := SupportTier(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid SupportTier %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v SupportTier) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *SupportTier) UnmarshalText(text []byte) error {
+	*v = SupportTier(text)
+	return nil
+}
+
 // SurfaceArea: The surface area response.
 type SurfaceArea struct {
 	// OutputUnit: The output unit for the surface area.
@@ -9036,9 +13292,9 @@ type SurfaceEdgeReference struct {
 // Sweep: The response from the `Sweep` endpoint.
 type Sweep struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // System: Co-ordinate system definition.
@@ -9063,23 +13319,23 @@ type TakeSnapshot struct {
 // TextToCad: A response from a text to CAD prompt.
 type TextToCad struct {
 	// Code: The code for the model. This is optional but will be required in the future once we are at v1.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl requested.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
@@ -9087,11 +13343,11 @@ type TextToCad struct {
 	// OutputFormat: The output format of the model.
 	OutputFormat FileExportFormat `json:"output_format" yaml:"output_format" schema:"output_format,required"`
 	// Outputs: The output of the model in the given file format the user requested, base64 encoded. The key of the map is the path of the output file.
-	Outputs map[string]Base64 `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]Base64 `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// Prompt: The prompt.
 	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9103,11 +13359,11 @@ type TextToCad struct {
 // TextToCadCreateBody: Body for generating parts from text.
 type TextToCadCreateBody struct {
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// ModelVersion: Zoo provided model, or custom model which should be used to process this request.
-	ModelVersion string `json:"model_version" yaml:"model_version" schema:"model_version"`
+	ModelVersion *string `json:"model_version,omitempty" yaml:"model_version,omitempty" schema:"model_version,omitempty"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the desired part.
 	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt,required"`
 }
@@ -9117,15 +13373,15 @@ type TextToCadIteration struct {
 	// Code: The code for the new model.
 	Code string `json:"code" yaml:"code" schema:"code,required"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -9137,11 +13393,11 @@ type TextToCadIteration struct {
 	// OriginalSourceCode: The original source code for the model, previous to the changes.
 	OriginalSourceCode string `json:"original_source_code" yaml:"original_source_code" schema:"original_source_code,required"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9153,13 +13409,13 @@ type TextToCadIteration struct {
 // TextToCadIterationBody: Body for generating parts from text.
 type TextToCadIterationBody struct {
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// OriginalSourceCode: The source code for the model (in kcl) that is to be edited.
 	OriginalSourceCode string `json:"original_source_code" yaml:"original_source_code" schema:"original_source_code,required"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the model, if not using source ranges.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change. If empty, the prompt will be used and is required.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 }
@@ -9176,38 +13432,77 @@ const (
 	TextToCadModelKclIteration TextToCadModel = "kcl_iteration"
 )
 
+// AllTextToCadModels returns all the known values of TextToCadModel.
+func AllTextToCadModels() []TextToCadModel {
+	return []TextToCadModel{
+		TextToCadModelCad,
+		TextToCadModelKcl,
+		TextToCadModelKclIteration,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of TextToCadModel.
+func (v TextToCadModel) IsValid() bool {
+	switch v {
+	case TextToCadModelCad, TextToCadModelKcl, TextToCadModelKclIteration:
+		return true
+	}
+	return false
+}
+
+// ParseTextToCadModel parses a TextToCadModel from a string, returning an error if the value is not known.
+func ParseTextToCadModel(s string) (TextToCadModel, error) {
+	v := TextToCadModel(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid TextToCadModel %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v TextToCadModel) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *TextToCadModel) UnmarshalText(text []byte) error {
+	*v = TextToCadModel(text)
+	return nil
+}
+
 // TextToCadMultiFileIteration: A response from a text to CAD multi-file iteration.
 type TextToCadMultiFileIteration struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
 	ModelVersion string `json:"model_version" yaml:"model_version" schema:"model_version,required"`
 	// Outputs: The output files. Returns a map of the file name to the file contents. The file contents are not encoded since kcl files are not binary.
-	Outputs map[string]string `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges. This will apply to all the files.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9219,15 +13514,15 @@ type TextToCadMultiFileIteration struct {
 // TextToCadMultiFileIterationBody: Body for iterating on models from text prompts.
 type TextToCadMultiFileIterationBody struct {
 	// ConversationID: The conversation ID Conversations group different prompts together. This should be omitted when starting a new conversation. The conversation_id returned in the response should be used to link future messages in the same conversation.
-	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id"`
+	ConversationID *UUID `json:"conversation_id,omitempty" yaml:"conversation_id,omitempty" schema:"conversation_id,omitempty"`
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges. This will apply to all the files. If you want to apply a prompt to just a single file, use the source_ranges field and you can leave this empty.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change. If empty, the prompt will be used and is required.
-	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges"`
+	SourceRanges []SourceRangePrompt `json:"source_ranges,omitempty" yaml:"source_ranges,omitempty" schema:"source_ranges,omitempty"`
 }
 
 // TextToCadResponse: TextToCadResponse: Type that encompasses all Text-to-CAD response types, including iteration and multi-file iteration.
@@ -9236,23 +13531,23 @@ type TextToCadResponse any
 // TextToCadResponseCode: A response from a text to CAD prompt.
 type TextToCadResponseCode struct {
 	// Code: The code for the model. This is optional but will be required in the future once we are at v1.
-	Code string `json:"code" yaml:"code" schema:"code"`
+	Code *string `json:"code,omitempty" yaml:"code,omitempty" schema:"code,omitempty"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl requested.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
@@ -9260,11 +13555,11 @@ type TextToCadResponseCode struct {
 	// OutputFormat: The output format of the model.
 	OutputFormat FileExportFormat `json:"output_format" yaml:"output_format" schema:"output_format,required"`
 	// Outputs: The output of the model in the given file format the user requested, base64 encoded. The key of the map is the path of the output file.
-	Outputs map[string]Base64 `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]Base64 `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// Prompt: The prompt.
 	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -9280,15 +13575,15 @@ type TextToCadResponseCompletedAt struct {
 	// Code: The code for the new model.
 	Code string `json:"code" yaml:"code" schema:"code,required"`
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
@@ -9300,11 +13595,11 @@ type TextToCadResponseCompletedAt struct {
 	// OriginalSourceCode: The original source code for the model, previous to the changes.
 	OriginalSourceCode string `json:"original_source_code" yaml:"original_source_code" schema:"original_source_code,required"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -9318,35 +13613,35 @@ type TextToCadResponseCompletedAt struct {
 // TextToCadResponseConversationID: A response from a text to CAD multi-file iteration.
 type TextToCadResponseConversationID struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// ConversationID: The conversation ID Conversations group different prompts together.
 	ConversationID UUID `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id,required"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// Feedback: Feedback from the user, if any.
-	Feedback MlFeedback `json:"feedback" yaml:"feedback" schema:"feedback"`
+	Feedback *MlFeedback `json:"feedback,omitempty" yaml:"feedback,omitempty" schema:"feedback,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// KclVersion: The version of kcl to use. If empty, the latest version will be used.
-	KclVersion string `json:"kcl_version" yaml:"kcl_version" schema:"kcl_version"`
+	KclVersion *string `json:"kcl_version,omitempty" yaml:"kcl_version,omitempty" schema:"kcl_version,omitempty"`
 	// Model: The model being used.
 	Model TextToCadModel `json:"model" yaml:"model" schema:"model,required"`
 	// ModelVersion: The version of the model.
 	ModelVersion string `json:"model_version" yaml:"model_version" schema:"model_version,required"`
 	// Outputs: The output files. Returns a map of the file name to the file contents. The file contents are not encoded since kcl files are not binary.
-	Outputs map[string]string `json:"outputs" yaml:"outputs" schema:"outputs"`
+	Outputs map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty" schema:"outputs,omitempty"`
 	// ProjectName: The project name. This is used to tie the prompt to a project. Which helps us make our models better over time.
-	ProjectName string `json:"project_name" yaml:"project_name" schema:"project_name"`
+	ProjectName *string `json:"project_name,omitempty" yaml:"project_name,omitempty" schema:"project_name,omitempty"`
 	// Prompt: The prompt for the overall changes. This is optional if you only want changes on specific source ranges. This will apply to all the files.
-	Prompt string `json:"prompt" yaml:"prompt" schema:"prompt"`
+	Prompt *string `json:"prompt,omitempty" yaml:"prompt,omitempty" schema:"prompt,omitempty"`
 	// SourceRanges: The source ranges the user suggested to change.
 	SourceRanges []SourceRangePrompt `json:"source_ranges" yaml:"source_ranges" schema:"source_ranges,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// Type:
@@ -9362,7 +13657,7 @@ type TextToCadResponseResultsPage struct {
 	// Items: list of items on this page of results
 	Items []TextToCadResponse `json:"items" yaml:"items" schema:"items,required"`
 	// NextPage: token used to fetch the next page of results (if any)
-	NextPage string `json:"next_page" yaml:"next_page" schema:"next_page"`
+	NextPage *string `json:"next_page,omitempty" yaml:"next_page,omitempty" schema:"next_page,omitempty"`
 }
 
 // TokenRevokeRequestForm: The request parameters for the OAuth 2.0 token revocation flow.
@@ -9370,7 +13665,7 @@ type TokenRevokeRequestForm struct {
 	// ClientID: The client ID.
 	ClientID UUID `json:"client_id" yaml:"client_id" schema:"client_id,required"`
 	// ClientSecret: The client secret.
-	ClientSecret string `json:"client_secret" yaml:"client_secret" schema:"client_secret"`
+	ClientSecret *string `json:"client_secret,omitempty" yaml:"client_secret,omitempty" schema:"client_secret,omitempty"`
 	// Token: The token to revoke.
 	Token string `json:"token" yaml:"token" schema:"token,required"`
 }
@@ -9384,19 +13679,19 @@ type ToolOutput struct {
 // Transform: Ways to transform each solid being replicated in a repeating pattern.
 type Transform struct {
 	// Replicate: Whether to replicate the original solid in this instance.
-	Replicate bool `json:"replicate" yaml:"replicate" schema:"replicate"`
+	Replicate *bool `json:"replicate,omitempty" yaml:"replicate,omitempty" schema:"replicate,omitempty"`
 	// Rotation: Rotate the replica about the specified rotation axis and origin. Defaults to no rotation.
-	Rotation Rotation `json:"rotation" yaml:"rotation" schema:"rotation"`
+	Rotation *Rotation `json:"rotation,omitempty" yaml:"rotation,omitempty" schema:"rotation,omitempty"`
 	// Scale: Scale the replica's size along each axis. Defaults to (1, 1, 1) (i.e. the same size as the original).
-	Scale Point3D `json:"scale" yaml:"scale" schema:"scale"`
+	Scale *Point3D `json:"scale,omitempty" yaml:"scale,omitempty" schema:"scale,omitempty"`
 	// Translate: Translate the replica this far along each dimension. Defaults to zero vector (i.e. same position as the original).
-	Translate Point3D `json:"translate" yaml:"translate" schema:"translate"`
+	Translate *Point3D `json:"translate,omitempty" yaml:"translate,omitempty" schema:"translate,omitempty"`
 }
 
 // TransformByForPoint3D: How a property of an object should be transformed.
 type TransformByForPoint3D struct {
 	// Origin: What to use as the origin for the transformation.
-	Origin any `json:"origin" yaml:"origin" schema:"origin"`
+	Origin any `json:"origin,omitempty" yaml:"origin,omitempty" schema:"origin,omitempty"`
 	// Property: The scale, or rotation, or translation.
 	Property Point3D `json:"property" yaml:"property" schema:"property,required"`
 	// Set: If true, overwrite the previous value with this. If false, the previous value will be modified. E.g. when translating, `set=true` will set a new location, and `set=false` will translate the current location by the given X/Y/Z.
@@ -9406,7 +13701,7 @@ type TransformByForPoint3D struct {
 // TransformByForPoint4D: How a property of an object should be transformed.
 type TransformByForPoint4D struct {
 	// Origin: What to use as the origin for the transformation.
-	Origin any `json:"origin" yaml:"origin" schema:"origin"`
+	Origin any `json:"origin,omitempty" yaml:"origin,omitempty" schema:"origin,omitempty"`
 	// Property: The scale, or rotation, or translation.
 	Property Point4D `json:"property" yaml:"property" schema:"property,required"`
 	// Set: If true, overwrite the previous value with this. If false, the previous value will be modified. E.g. when translating, `set=true` will set a new location, and `set=false` will translate the current location by the given X/Y/Z.
@@ -9416,9 +13711,9 @@ type TransformByForPoint4D struct {
 // TwistExtrude: The response from the `TwistExtrude` endpoint.
 type TwistExtrude struct {
 	// BodiesCreated: Any new bodies created by the request.
-	BodiesCreated BodiesCreated `json:"bodies_created" yaml:"bodies_created" schema:"bodies_created"`
+	BodiesCreated *BodiesCreated `json:"bodies_created,omitempty" yaml:"bodies_created,omitempty" schema:"bodies_created,omitempty"`
 	// BodiesUpdated: Any existing bodies updated by the request.
-	BodiesUpdated BodiesUpdated `json:"bodies_updated" yaml:"bodies_updated" schema:"bodies_updated"`
+	BodiesUpdated *BodiesUpdated `json:"bodies_updated,omitempty" yaml:"bodies_updated,omitempty" schema:"bodies_updated,omitempty"`
 }
 
 // UnitAngle: The valid types of angle formats.
@@ -9431,28 +13726,66 @@ const (
 	UnitAngleRadians UnitAngle = "radians"
 )
 
+// AllUnitAngles returns all the known values of UnitAngle.
+func AllUnitAngles() []UnitAngle {
+	return []UnitAngle{
+		UnitAngleDegrees,
+		UnitAngleRadians,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitAngle.
+func (v UnitAngle) IsValid() bool {
+	switch v {
+	case UnitAngleDegrees, UnitAngleRadians:
+		return true
+	}
+	return false
+}
+
+// ParseUnitAngle parses a UnitAngle from a string, returning an error if the value is not known.
+func ParseUnitAngle(s string) (UnitAngle, error) {
+	v := UnitAngle(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitAngle %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitAngle) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitAngle) UnmarshalText(text []byte) error {
+	*v = UnitAngle(text)
+	return nil
+}
+
 // UnitAngleConversion: Result of converting between units.
 type UnitAngleConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitAngle `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitAngle `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9483,28 +13816,72 @@ const (
 	UnitAreaYd2 UnitArea = "yd2"
 )
 
+// AllUnitAreas returns all the known values of UnitArea.
+func AllUnitAreas() []UnitArea {
+	return []UnitArea{
+		UnitAreaCm2,
+		UnitAreaDm2,
+		UnitAreaFt2,
+		UnitAreaIn2,
+		UnitAreaKm2,
+		UnitAreaM2,
+		UnitAreaMm2,
+		UnitAreaYd2,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitArea.
+func (v UnitArea) IsValid() bool {
+	switch v {
+	case UnitAreaCm2, UnitAreaDm2, UnitAreaFt2, UnitAreaIn2, UnitAreaKm2, UnitAreaM2, UnitAreaMm2, UnitAreaYd2:
+		return true
+	}
+	return false
+}
+
+// ParseUnitArea parses a UnitArea from a string, returning an error if the value is not known.
+func ParseUnitArea(s string) (UnitArea, error) {
+	v := UnitArea(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitArea %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitArea) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitArea) UnmarshalText(text []byte) error {
+	*v = UnitArea(text)
+	return nil
+}
+
 // UnitAreaConversion: Result of converting between units.
 type UnitAreaConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitArea `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitArea `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9527,28 +13904,68 @@ const (
 	UnitCurrentNanoamperes UnitCurrent = "nanoamperes"
 )
 
+// AllUnitCurrents returns all the known values of UnitCurrent.
+func AllUnitCurrents() []UnitCurrent {
+	return []UnitCurrent{
+		UnitCurrentAmperes,
+		UnitCurrentMicroamperes,
+		UnitCurrentMilliamperes,
+		UnitCurrentNanoamperes,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitCurrent.
+func (v UnitCurrent) IsValid() bool {
+	switch v {
+	case UnitCurrentAmperes, UnitCurrentMicroamperes, UnitCurrentMilliamperes, UnitCurrentNanoamperes:
+		return true
+	}
+	return false
+}
+
+// ParseUnitCurrent parses a UnitCurrent from a string, returning an error if the value is not known.
+func ParseUnitCurrent(s string) (UnitCurrent, error) {
+	v := UnitCurrent(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitCurrent %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitCurrent) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitCurrent) UnmarshalText(text []byte) error {
+	*v = UnitCurrent(text)
+	return nil
+}
+
 // UnitCurrentConversion: Result of converting between units.
 type UnitCurrentConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitCurrent `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitCurrent `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9567,6 +13984,44 @@ const (
 	UnitDensityKgm3 UnitDensity = "kg:m3"
 )
 
+// AllUnitDensities returns all the known values of UnitDensity.
+func AllUnitDensities() []UnitDensity {
+	return []UnitDensity{
+		UnitDensityLbft3,
+		UnitDensityKgm3,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitDensity.
+func (v UnitDensity) IsValid() bool {
+	switch v {
+	case UnitDensityLbft3, UnitDensityKgm3:
+		return true
+	}
+	return false
+}
+
+// ParseUnitDensity parses a UnitDensity from a string, returning an error if the value is not known.
+func ParseUnitDensity(s string) (UnitDensity, error) {
+	v := UnitDensity(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitDensity %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitDensity) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitDensity) UnmarshalText(text []byte) error {
+	*v = UnitDensity(text)
+	return nil
+}
+
 // UnitEnergy: The valid types of energy units.
 type UnitEnergy string
 
@@ -9585,28 +14040,70 @@ const (
 	UnitEnergyWattHours UnitEnergy = "watt_hours"
 )
 
+// AllUnitEnergies returns all the known values of UnitEnergy.
+func AllUnitEnergies() []UnitEnergy {
+	return []UnitEnergy{
+		UnitEnergyBtu,
+		UnitEnergyElectronvolts,
+		UnitEnergyJoules,
+		UnitEnergyKilocalories,
+		UnitEnergyKilowattHours,
+		UnitEnergyWattHours,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitEnergy.
+func (v UnitEnergy) IsValid() bool {
+	switch v {
+	case UnitEnergyBtu, UnitEnergyElectronvolts, UnitEnergyJoules, UnitEnergyKilocalories, UnitEnergyKilowattHours, UnitEnergyWattHours:
+		return true
+	}
+	return false
+}
+
+// ParseUnitEnergy parses a UnitEnergy from a string, returning an error if the value is not known.
+func ParseUnitEnergy(s string) (UnitEnergy, error) {
+	v := UnitEnergy(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitEnergy %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitEnergy) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitEnergy) UnmarshalText(text []byte) error {
+	*v = UnitEnergy(text)
+	return nil
+}
+
 // UnitEnergyConversion: Result of converting between units.
 type UnitEnergyConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitEnergy `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitEnergy `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9635,28 +14132,71 @@ const (
 	UnitForcePounds UnitForce = "pounds"
 )
 
+// AllUnitForces returns all the known values of UnitForce.
+func AllUnitForces() []UnitForce {
+	return []UnitForce{
+		UnitForceDynes,
+		UnitForceKiloponds,
+		UnitForceMicronewtons,
+		UnitForceMillinewtons,
+		UnitForceNewtons,
+		UnitForcePoundals,
+		UnitForcePounds,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitForce.
+func (v UnitForce) IsValid() bool {
+	switch v {
+	case UnitForceDynes, UnitForceKiloponds, UnitForceMicronewtons, UnitForceMillinewtons, UnitForceNewtons, UnitForcePoundals, UnitForcePounds:
+		return true
+	}
+	return false
+}
+
+// ParseUnitForce parses a UnitForce from a string, returning an error if the value is not known.
+func ParseUnitForce(s string) (UnitForce, error) {
+	v := UnitForce(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitForce %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitForce) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitForce) UnmarshalText(text []byte) error {
+	*v = UnitForce(text)
+	return nil
+}
+
 // UnitForceConversion: Result of converting between units.
 type UnitForceConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitForce `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitForce `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9687,28 +14227,72 @@ const (
 	UnitFrequencyTerahertz UnitFrequency = "terahertz"
 )
 
+// AllUnitFrequencies returns all the known values of UnitFrequency.
+func AllUnitFrequencies() []UnitFrequency {
+	return []UnitFrequency{
+		UnitFrequencyGigahertz,
+		UnitFrequencyHertz,
+		UnitFrequencyKilohertz,
+		UnitFrequencyMegahertz,
+		UnitFrequencyMicrohertz,
+		UnitFrequencyMillihertz,
+		UnitFrequencyNanohertz,
+		UnitFrequencyTerahertz,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitFrequency.
+func (v UnitFrequency) IsValid() bool {
+	switch v {
+	case UnitFrequencyGigahertz, UnitFrequencyHertz, UnitFrequencyKilohertz, UnitFrequencyMegahertz, UnitFrequencyMicrohertz, UnitFrequencyMillihertz, UnitFrequencyNanohertz, UnitFrequencyTerahertz:
+		return true
+	}
+	return false
+}
+
+// ParseUnitFrequency parses a UnitFrequency from a string, returning an error if the value is not known.
+func ParseUnitFrequency(s string) (UnitFrequency, error) {
+	v := UnitFrequency(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitFrequency %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitFrequency) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitFrequency) UnmarshalText(text []byte) error {
+	*v = UnitFrequency(text)
+	return nil
+}
+
 // UnitFrequencyConversion: Result of converting between units.
 type UnitFrequencyConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitFrequency `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitFrequency `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9735,28 +14319,70 @@ const (
 	UnitLengthYd UnitLength = "yd"
 )
 
+// AllUnitLengths returns all the known values of UnitLength.
+func AllUnitLengths() []UnitLength {
+	return []UnitLength{
+		UnitLengthCm,
+		UnitLengthFt,
+		UnitLengthIn,
+		UnitLengthM,
+		UnitLengthMm,
+		UnitLengthYd,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitLength.
+func (v UnitLength) IsValid() bool {
+	switch v {
+	case UnitLengthCm, UnitLengthFt, UnitLengthIn, UnitLengthM, UnitLengthMm, UnitLengthYd:
+		return true
+	}
+	return false
+}
+
+// ParseUnitLength parses a UnitLength from a string, returning an error if the value is not known.
+func ParseUnitLength(s string) (UnitLength, error) {
+	v := UnitLength(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitLength %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitLength) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitLength) UnmarshalText(text []byte) error {
+	*v = UnitLength(text)
+	return nil
+}
+
 // UnitLengthConversion: Result of converting between units.
 type UnitLengthConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitLength `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitLength `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9777,28 +14403,67 @@ const (
 	UnitMasLb UnitMas = "lb"
 )
 
+// AllUnitMas returns all the known values of UnitMas.
+func AllUnitMas() []UnitMas {
+	return []UnitMas{
+		UnitMasG,
+		UnitMasKg,
+		UnitMasLb,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitMas.
+func (v UnitMas) IsValid() bool {
+	switch v {
+	case UnitMasG, UnitMasKg, UnitMasLb:
+		return true
+	}
+	return false
+}
+
+// ParseUnitMas parses a UnitMas from a string, returning an error if the value is not known.
+func ParseUnitMas(s string) (UnitMas, error) {
+	v := UnitMas(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitMas %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitMas) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitMas) UnmarshalText(text []byte) error {
+	*v = UnitMas(text)
+	return nil
+}
+
 // UnitMassConversion: Result of converting between units.
 type UnitMassConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitMas `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitMas `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9827,28 +14492,71 @@ const (
 	UnitPowerWatts UnitPower = "watts"
 )
 
+// AllUnitPowers returns all the known values of UnitPower.
+func AllUnitPowers() []UnitPower {
+	return []UnitPower{
+		UnitPowerBtuPerMinute,
+		UnitPowerHorsepower,
+		UnitPowerKilowatts,
+		UnitPowerMetricHorsepower,
+		UnitPowerMicrowatts,
+		UnitPowerMilliwatts,
+		UnitPowerWatts,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitPower.
+func (v UnitPower) IsValid() bool {
+	switch v {
+	case UnitPowerBtuPerMinute, UnitPowerHorsepower, UnitPowerKilowatts, UnitPowerMetricHorsepower, UnitPowerMicrowatts, UnitPowerMilliwatts, UnitPowerWatts:
+		return true
+	}
+	return false
+}
+
+// ParseUnitPower parses a UnitPower from a string, returning an error if the value is not known.
+func ParseUnitPower(s string) (UnitPower, error) {
+	v := UnitPower(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitPower %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitPower) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitPower) UnmarshalText(text []byte) error {
+	*v = UnitPower(text)
+	return nil
+}
+
 // UnitPowerConversion: Result of converting between units.
 type UnitPowerConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitPower `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitPower `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9877,28 +14585,71 @@ const (
 	UnitPressurePsi UnitPressure = "psi"
 )
 
+// AllUnitPressures returns all the known values of UnitPressure.
+func AllUnitPressures() []UnitPressure {
+	return []UnitPressure{
+		UnitPressureAtmospheres,
+		UnitPressureBars,
+		UnitPressureHectopascals,
+		UnitPressureKilopascals,
+		UnitPressureMillibars,
+		UnitPressurePascals,
+		UnitPressurePsi,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitPressure.
+func (v UnitPressure) IsValid() bool {
+	switch v {
+	case UnitPressureAtmospheres, UnitPressureBars, UnitPressureHectopascals, UnitPressureKilopascals, UnitPressureMillibars, UnitPressurePascals, UnitPressurePsi:
+		return true
+	}
+	return false
+}
+
+// ParseUnitPressure parses a UnitPressure from a string, returning an error if the value is not known.
+func ParseUnitPressure(s string) (UnitPressure, error) {
+	v := UnitPressure(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitPressure %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitPressure) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitPressure) UnmarshalText(text []byte) error {
+	*v = UnitPressure(text)
+	return nil
+}
+
 // UnitPressureConversion: Result of converting between units.
 type UnitPressureConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitPressure `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitPressure `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9921,28 +14672,68 @@ const (
 	UnitTemperatureRankine UnitTemperature = "rankine"
 )
 
+// AllUnitTemperatures returns all the known values of UnitTemperature.
+func AllUnitTemperatures() []UnitTemperature {
+	return []UnitTemperature{
+		UnitTemperatureCelsius,
+		UnitTemperatureFahrenheit,
+		UnitTemperatureKelvin,
+		UnitTemperatureRankine,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitTemperature.
+func (v UnitTemperature) IsValid() bool {
+	switch v {
+	case UnitTemperatureCelsius, UnitTemperatureFahrenheit, UnitTemperatureKelvin, UnitTemperatureRankine:
+		return true
+	}
+	return false
+}
+
+// ParseUnitTemperature parses a UnitTemperature from a string, returning an error if the value is not known.
+func ParseUnitTemperature(s string) (UnitTemperature, error) {
+	v := UnitTemperature(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitTemperature %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitTemperature) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitTemperature) UnmarshalText(text []byte) error {
+	*v = UnitTemperature(text)
+	return nil
+}
+
 // UnitTemperatureConversion: Result of converting between units.
 type UnitTemperatureConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitTemperature `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitTemperature `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -9961,28 +14752,66 @@ const (
 	UnitTorquePoundFoot UnitTorque = "pound_foot"
 )
 
+// AllUnitTorques returns all the known values of UnitTorque.
+func AllUnitTorques() []UnitTorque {
+	return []UnitTorque{
+		UnitTorqueNewtonMetres,
+		UnitTorquePoundFoot,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitTorque.
+func (v UnitTorque) IsValid() bool {
+	switch v {
+	case UnitTorqueNewtonMetres, UnitTorquePoundFoot:
+		return true
+	}
+	return false
+}
+
+// ParseUnitTorque parses a UnitTorque from a string, returning an error if the value is not known.
+func ParseUnitTorque(s string) (UnitTorque, error) {
+	v := UnitTorque(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitTorque %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitTorque) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitTorque) UnmarshalText(text []byte) error {
+	*v = UnitTorque(text)
+	return nil
+}
+
 // UnitTorqueConversion: Result of converting between units.
 type UnitTorqueConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitTorque `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitTorque `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -10017,28 +14846,74 @@ const (
 	UnitVolumeMl UnitVolume = "ml"
 )
 
+// AllUnitVolumes returns all the known values of UnitVolume.
+func AllUnitVolumes() []UnitVolume {
+	return []UnitVolume{
+		UnitVolumeMm3,
+		UnitVolumeCm3,
+		UnitVolumeFt3,
+		UnitVolumeIn3,
+		UnitVolumeM3,
+		UnitVolumeYd3,
+		UnitVolumeUsfloz,
+		UnitVolumeUsgal,
+		UnitVolumeL,
+		UnitVolumeMl,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UnitVolume.
+func (v UnitVolume) IsValid() bool {
+	switch v {
+	case UnitVolumeMm3, UnitVolumeCm3, UnitVolumeFt3, UnitVolumeIn3, UnitVolumeM3, UnitVolumeYd3, UnitVolumeUsfloz, UnitVolumeUsgal, UnitVolumeL, UnitVolumeMl:
+		return true
+	}
+	return false
+}
+
+// ParseUnitVolume parses a UnitVolume from a string, returning an error if the value is not known.
+func ParseUnitVolume(s string) (UnitVolume, error) {
+	v := UnitVolume(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UnitVolume %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UnitVolume) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UnitVolume) UnmarshalText(text []byte) error {
+	*v = UnitVolume(text)
+	return nil
+}
+
 // UnitVolumeConversion: Result of converting between units.
 type UnitVolumeConversion struct {
 	// CompletedAt: The time and date the API call was completed.
-	CompletedAt Time `json:"completed_at" yaml:"completed_at" schema:"completed_at"`
+	CompletedAt *Time `json:"completed_at,omitempty" yaml:"completed_at,omitempty" schema:"completed_at,omitempty"`
 	// CreatedAt: The time and date the API call was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Error: The error the function returned, if any.
-	Error string `json:"error" yaml:"error" schema:"error"`
+	Error *string `json:"error,omitempty" yaml:"error,omitempty" schema:"error,omitempty"`
 	// ID: The unique identifier of the API call.
 	//
 	// This is the same as the API call ID.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Input: The input value.
-	Input float64 `json:"input" yaml:"input" schema:"input"`
+	Input *float64 `json:"input,omitempty" yaml:"input,omitempty" schema:"input,omitempty"`
 	// InputUnit: The source format of the unit conversion.
 	InputUnit UnitVolume `json:"input_unit" yaml:"input_unit" schema:"input_unit,required"`
 	// Output: The resulting value.
-	Output float64 `json:"output" yaml:"output" schema:"output"`
+	Output *float64 `json:"output,omitempty" yaml:"output,omitempty" schema:"output,omitempty"`
 	// OutputUnit: The output format of the unit conversion.
 	OutputUnit UnitVolume `json:"output_unit" yaml:"output_unit" schema:"output_unit,required"`
 	// StartedAt: The time and date the API call was started.
-	StartedAt Time `json:"started_at" yaml:"started_at" schema:"started_at"`
+	StartedAt *Time `json:"started_at,omitempty" yaml:"started_at,omitempty" schema:"started_at,omitempty"`
 	// Status: The status of the API call.
 	Status APICallStatus `json:"status" yaml:"status" schema:"status,required"`
 	// UpdatedAt: The time and date the API call was last updated.
@@ -10052,11 +14927,13 @@ type UpdateAnnotation struct {
 }
 
 // UpdateCustomModel: Body for updating a custom ML model.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type UpdateCustomModel struct {
 	// Name: The model's display name.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// SystemPrompt: The model's system prompt.
-	SystemPrompt string `json:"system_prompt" yaml:"system_prompt" schema:"system_prompt"`
+	SystemPrompt *string `json:"system_prompt,omitempty" yaml:"system_prompt,omitempty" schema:"system_prompt,omitempty"`
 }
 
 // UpdateMemberToOrgBody: Data for updating a member of an org.
@@ -10068,75 +14945,83 @@ type UpdateMemberToOrgBody struct {
 // UpdateOauth2AppRequest: Request body for updating a public OAuth app.
 type UpdateOauth2AppRequest struct {
 	// GrantTypes: The OAuth grant types this app can use.
-	GrantTypes []Oauth2AppGrantType `json:"grant_types" yaml:"grant_types" schema:"grant_types"`
+	GrantTypes []Oauth2AppGrantType `json:"grant_types,omitempty" yaml:"grant_types,omitempty" schema:"grant_types,omitempty"`
 	// Mode: The deployment mode for this app.
-	Mode Oauth2AppMode `json:"mode" yaml:"mode" schema:"mode"`
+	Mode *Oauth2AppMode `json:"mode,omitempty" yaml:"mode,omitempty" schema:"mode,omitempty"`
 	// Name: The new display name of the app.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// RedirectUris: The redirect URIs registered for this app.
-	RedirectUris []URL `json:"redirect_uris" yaml:"redirect_uris" schema:"redirect_uris"`
+	RedirectUris []URL `json:"redirect_uris,omitempty" yaml:"redirect_uris,omitempty" schema:"redirect_uris,omitempty"`
 }
 
 // UpdateOrgDataset: Payload for updating an org dataset.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type UpdateOrgDataset struct {
 	// Description: Optional description override. Null clears the description.
-	Description string `json:"description" yaml:"description" schema:"description"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty" schema:"description,omitempty"`
 	// Name: Optional new display name.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// RequireRawKclSimilarityScoreForSuccess: Optional override for whether a low raw-KCL similarity score should block conversion success.
-	RequireRawKclSimilarityScoreForSuccess bool `json:"require_raw_kcl_similarity_score_for_success" yaml:"require_raw_kcl_similarity_score_for_success" schema:"require_raw_kcl_similarity_score_for_success"`
+	RequireRawKclSimilarityScoreForSuccess *bool `json:"require_raw_kcl_similarity_score_for_success,omitempty" yaml:"require_raw_kcl_similarity_score_for_success,omitempty" schema:"require_raw_kcl_similarity_score_for_success,omitempty"`
 	// Source: Optional storage connection overrides.
-	Source UpdateOrgDatasetSource `json:"source" yaml:"source" schema:"source"`
+	Source *UpdateOrgDatasetSource `json:"source,omitempty" yaml:"source,omitempty" schema:"source,omitempty"`
 }
 
 // UpdateOrgDatasetSource: Partial update payload for dataset storage details.
 type UpdateOrgDatasetSource struct {
 	// AccessRoleArn: Updated identity Zoo should assume when reading the dataset.
-	AccessRoleArn string `json:"access_role_arn" yaml:"access_role_arn" schema:"access_role_arn"`
+	AccessRoleArn *string `json:"access_role_arn,omitempty" yaml:"access_role_arn,omitempty" schema:"access_role_arn,omitempty"`
 	// Provider: Updated storage provider identifier.
-	Provider StorageProvider `json:"provider" yaml:"provider" schema:"provider"`
+	Provider *StorageProvider `json:"provider,omitempty" yaml:"provider,omitempty" schema:"provider,omitempty"`
 	// Uri: Updated fully-qualified URI for the dataset contents.
-	Uri string `json:"uri" yaml:"uri" schema:"uri"`
+	Uri *string `json:"uri,omitempty" yaml:"uri,omitempty" schema:"uri,omitempty"`
 }
 
 // UpdatePaymentBalance: Payload for updating a user's balance.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type UpdatePaymentBalance struct {
 	// MonthlyAPICreditsRemainingMonetaryValue: The monetary value of the monthy API credits remaining in the balance. This gets re-upped every month,
-	MonthlyAPICreditsRemainingMonetaryValue float64 `json:"monthly_api_credits_remaining_monetary_value" yaml:"monthly_api_credits_remaining_monetary_value" schema:"monthly_api_credits_remaining_monetary_value"`
+	MonthlyAPICreditsRemainingMonetaryValue *float64 `json:"monthly_api_credits_remaining_monetary_value,omitempty" yaml:"monthly_api_credits_remaining_monetary_value,omitempty" schema:"monthly_api_credits_remaining_monetary_value,omitempty"`
 	// StableAPICreditsRemainingMonetaryValue: The monetary value of stable API credits remaining in the balance. These do not get reset or re-upped every month. This is separate from the monthly credits. Credits will first pull from the monthly credits, then the stable credits. Stable just means that they do not get reset every month. A user will have stable credits if a Zoo employee granted them credits.
-	StableAPICreditsRemainingMonetaryValue float64 `json:"stable_api_credits_remaining_monetary_value" yaml:"stable_api_credits_remaining_monetary_value" schema:"stable_api_credits_remaining_monetary_value"`
+	StableAPICreditsRemainingMonetaryValue *float64 `json:"stable_api_credits_remaining_monetary_value,omitempty" yaml:"stable_api_credits_remaining_monetary_value,omitempty" schema:"stable_api_credits_remaining_monetary_value,omitempty"`
 }
 
 // UpdateShortlinkRequest: Request to update a shortlink.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type UpdateShortlinkRequest struct {
 	// Password: The password for the shortlink, if you want to restrict access to it. This can only be set if your subscription allows for it. Otherwise, it will return an error. When you access the link it will be required to enter this password through basic auth. The username will be `{anything}` and the password will be the password you set here.
-	Password string `json:"password" yaml:"password" schema:"password"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty" schema:"password,omitempty"`
 	// RestrictToOrg: If the shortlink should be restricted to the user's organization to view. This only applies to org shortlinks. If you are creating a user shortlink and you are not a member of a team or enterprise and you try to set this to true, it will fail.
 	RestrictToOrg bool `json:"restrict_to_org" yaml:"restrict_to_org" schema:"restrict_to_org,required"`
 }
 
 // UpdateUser: The user-modifiable parts of a User.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type UpdateUser struct {
 	// AllowPayAsYouGo: Whether the user has opted into pay-as-you-go charges after using their included credits.
-	AllowPayAsYouGo bool `json:"allow_pay_as_you_go" yaml:"allow_pay_as_you_go" schema:"allow_pay_as_you_go"`
+	AllowPayAsYouGo *bool `json:"allow_pay_as_you_go,omitempty" yaml:"allow_pay_as_you_go,omitempty" schema:"allow_pay_as_you_go,omitempty"`
 	// Company: The user's company.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// Discord: The user's Discord handle.
-	Discord string `json:"discord" yaml:"discord" schema:"discord"`
+	Discord *string `json:"discord,omitempty" yaml:"discord,omitempty" schema:"discord,omitempty"`
 	// FirstName: The user's first name.
-	FirstName string `json:"first_name" yaml:"first_name" schema:"first_name"`
+	FirstName *string `json:"first_name,omitempty" yaml:"first_name,omitempty" schema:"first_name,omitempty"`
 	// Github: The user's GitHub handle.
-	Github string `json:"github" yaml:"github" schema:"github"`
+	Github *string `json:"github,omitempty" yaml:"github,omitempty" schema:"github,omitempty"`
 	// Image: The image URL for the user. NOTE: If the user uses an OAuth2 provider, this will be overwritten by the provider's image URL when the user logs in next.
 	Image URL `json:"image" yaml:"image" schema:"image,required"`
 	// IsOnboarded: If the user is now onboarded.
-	IsOnboarded bool `json:"is_onboarded" yaml:"is_onboarded" schema:"is_onboarded"`
+	IsOnboarded *bool `json:"is_onboarded,omitempty" yaml:"is_onboarded,omitempty" schema:"is_onboarded,omitempty"`
 	// LastName: The user's last name.
-	LastName string `json:"last_name" yaml:"last_name" schema:"last_name"`
+	LastName *string `json:"last_name,omitempty" yaml:"last_name,omitempty" schema:"last_name,omitempty"`
 	// Phone: The user's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// Username: Public username/handle for community-facing features. Empty clears it.
-	Username string `json:"username" yaml:"username" schema:"username"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty" schema:"username,omitempty"`
 }
 
 // UploadOrgDatasetFilesResponse: Response payload for uploading files into a Zoo-managed dataset.
@@ -10156,15 +15041,15 @@ type UserAdminDetails struct {
 	// ActiveSessionsCount: Count of active (non-expired) sessions.
 	ActiveSessionsCount int `json:"active_sessions_count" yaml:"active_sessions_count" schema:"active_sessions_count,required"`
 	// Address: Latest billing address stored for the user.
-	Address Address `json:"address" yaml:"address" schema:"address"`
+	Address *Address `json:"address,omitempty" yaml:"address,omitempty" schema:"address,omitempty"`
 	// AddressSummary: Readable billing address summary.
-	AddressSummary string `json:"address_summary" yaml:"address_summary" schema:"address_summary"`
+	AddressSummary *string `json:"address_summary,omitempty" yaml:"address_summary,omitempty" schema:"address_summary,omitempty"`
 	// Block: Block reason when the user is blocked.
-	Block BlockReason `json:"block" yaml:"block" schema:"block"`
+	Block *BlockReason `json:"block,omitempty" yaml:"block,omitempty" schema:"block,omitempty"`
 	// BlockMessage: Human-friendly block reason message.
-	BlockMessage string `json:"block_message" yaml:"block_message" schema:"block_message"`
+	BlockMessage *string `json:"block_message,omitempty" yaml:"block_message,omitempty" schema:"block_message,omitempty"`
 	// CadUserInfo: CAD user info collected from website onboarding/CRM form.
-	CadUserInfo UserCadInfoAdminDetails `json:"cad_user_info" yaml:"cad_user_info" schema:"cad_user_info"`
+	CadUserInfo *UserCadInfoAdminDetails `json:"cad_user_info,omitempty" yaml:"cad_user_info,omitempty" schema:"cad_user_info,omitempty"`
 	// NeverBlock: Whether this user is permanently exempt from blocking.
 	NeverBlock bool `json:"never_block" yaml:"never_block" schema:"never_block,required"`
 	// PaymentMethods: Known payment methods on file.
@@ -10172,39 +15057,39 @@ type UserAdminDetails struct {
 	// PaymentMethodsSummary: Summaries of the known payment methods.
 	PaymentMethodsSummary []string `json:"payment_methods_summary" yaml:"payment_methods_summary" schema:"payment_methods_summary,required"`
 	// StripeCustomerID: Stripe customer identifier if one exists.
-	StripeCustomerID string `json:"stripe_customer_id" yaml:"stripe_customer_id" schema:"stripe_customer_id"`
+	StripeCustomerID *string `json:"stripe_customer_id,omitempty" yaml:"stripe_customer_id,omitempty" schema:"stripe_customer_id,omitempty"`
 	// StripeDashboardUrl: Direct link to the Stripe customer dashboard.
-	StripeDashboardUrl string `json:"stripe_dashboard_url" yaml:"stripe_dashboard_url" schema:"stripe_dashboard_url"`
+	StripeDashboardUrl *string `json:"stripe_dashboard_url,omitempty" yaml:"stripe_dashboard_url,omitempty" schema:"stripe_dashboard_url,omitempty"`
 }
 
 // UserCadInfoAdminDetails: CAD user info details for admin surfaces.
 type UserCadInfoAdminDetails struct {
 	// CadExperienceLevel: CAD/API experience level.
-	CadExperienceLevel CadExperienceLevel `json:"cad_experience_level" yaml:"cad_experience_level" schema:"cad_experience_level"`
+	CadExperienceLevel *CadExperienceLevel `json:"cad_experience_level,omitempty" yaml:"cad_experience_level,omitempty" schema:"cad_experience_level,omitempty"`
 	// CadIndustry: CAD industry selection.
-	CadIndustry CadIndustry `json:"cad_industry" yaml:"cad_industry" schema:"cad_industry"`
+	CadIndustry *CadIndustry `json:"cad_industry,omitempty" yaml:"cad_industry,omitempty" schema:"cad_industry,omitempty"`
 	// CadUserType: CAD user persona/type.
-	CadUserType CadUserType `json:"cad_user_type" yaml:"cad_user_type" schema:"cad_user_type"`
+	CadUserType *CadUserType `json:"cad_user_type,omitempty" yaml:"cad_user_type,omitempty" schema:"cad_user_type,omitempty"`
 	// CompanySize: Company size selection.
-	CompanySize CompanySize `json:"company_size" yaml:"company_size" schema:"company_size"`
+	CompanySize *CompanySize `json:"company_size,omitempty" yaml:"company_size,omitempty" schema:"company_size,omitempty"`
 	// DesignWorkflow: Preferred design workflow.
-	DesignWorkflow CadDesignWorkflow `json:"design_workflow" yaml:"design_workflow" schema:"design_workflow"`
+	DesignWorkflow *CadDesignWorkflow `json:"design_workflow,omitempty" yaml:"design_workflow,omitempty" schema:"design_workflow,omitempty"`
 	// HasUsedZooDesignStudioOrAPIBefore: Whether the user has used Zoo Design Studio or the API before.
-	HasUsedZooDesignStudioOrAPIBefore bool `json:"has_used_zoo_design_studio_or_api_before" yaml:"has_used_zoo_design_studio_or_api_before" schema:"has_used_zoo_design_studio_or_api_before"`
+	HasUsedZooDesignStudioOrAPIBefore *bool `json:"has_used_zoo_design_studio_or_api_before,omitempty" yaml:"has_used_zoo_design_studio_or_api_before,omitempty" schema:"has_used_zoo_design_studio_or_api_before,omitempty"`
 	// HowDidYouFindUs: Acquisition source selection.
-	HowDidYouFindUs CadDiscoverySource `json:"how_did_you_find_us" yaml:"how_did_you_find_us" schema:"how_did_you_find_us"`
+	HowDidYouFindUs *CadDiscoverySource `json:"how_did_you_find_us,omitempty" yaml:"how_did_you_find_us,omitempty" schema:"how_did_you_find_us,omitempty"`
 	// HowDidYouFindUsOther: Free-text acquisition source when `other` was selected.
-	HowDidYouFindUsOther string `json:"how_did_you_find_us_other" yaml:"how_did_you_find_us_other" schema:"how_did_you_find_us_other"`
+	HowDidYouFindUsOther *string `json:"how_did_you_find_us_other,omitempty" yaml:"how_did_you_find_us_other,omitempty" schema:"how_did_you_find_us_other,omitempty"`
 	// LocationCity: Free-text city for the user's location.
-	LocationCity string `json:"location_city" yaml:"location_city" schema:"location_city"`
+	LocationCity *string `json:"location_city,omitempty" yaml:"location_city,omitempty" schema:"location_city,omitempty"`
 	// LocationCountry: Free-text country for the user's location.
-	LocationCountry string `json:"location_country" yaml:"location_country" schema:"location_country"`
+	LocationCountry *string `json:"location_country,omitempty" yaml:"location_country,omitempty" schema:"location_country,omitempty"`
 	// LocationState: Free-text state or region for the user's location.
-	LocationState string `json:"location_state" yaml:"location_state" schema:"location_state"`
+	LocationState *string `json:"location_state,omitempty" yaml:"location_state,omitempty" schema:"location_state,omitempty"`
 	// NumberOfCadUsers: Number of CAD users.
-	NumberOfCadUsers string `json:"number_of_cad_users" yaml:"number_of_cad_users" schema:"number_of_cad_users"`
+	NumberOfCadUsers *string `json:"number_of_cad_users,omitempty" yaml:"number_of_cad_users,omitempty" schema:"number_of_cad_users,omitempty"`
 	// WhatAreYouBuilding: Free-text description of what the user wants to build.
-	WhatAreYouBuilding string `json:"what_are_you_building" yaml:"what_are_you_building" schema:"what_are_you_building"`
+	WhatAreYouBuilding *string `json:"what_are_you_building,omitempty" yaml:"what_are_you_building,omitempty" schema:"what_are_you_building,omitempty"`
 }
 
 // UserFeatureEntry: Enabled features surfaced to end users.
@@ -10222,29 +15107,29 @@ type UserFeatureList struct {
 // UserOrgInfo: A user's information about an org, including their role.
 type UserOrgInfo struct {
 	// AllowUsersInDomainToAutoJoin: If we should allow all future users who are created with email addresses from this domain to join the org.
-	AllowUsersInDomainToAutoJoin bool `json:"allow_users_in_domain_to_auto_join" yaml:"allow_users_in_domain_to_auto_join" schema:"allow_users_in_domain_to_auto_join"`
+	AllowUsersInDomainToAutoJoin *bool `json:"allow_users_in_domain_to_auto_join,omitempty" yaml:"allow_users_in_domain_to_auto_join,omitempty" schema:"allow_users_in_domain_to_auto_join,omitempty"`
 	// BillingEmail: The billing email address of the org.
-	BillingEmail string `json:"billing_email" yaml:"billing_email" schema:"billing_email"`
+	BillingEmail *string `json:"billing_email,omitempty" yaml:"billing_email,omitempty" schema:"billing_email,omitempty"`
 	// BillingEmailVerified: The date and time the billing email address was verified.
-	BillingEmailVerified Time `json:"billing_email_verified" yaml:"billing_email_verified" schema:"billing_email_verified"`
+	BillingEmailVerified *Time `json:"billing_email_verified,omitempty" yaml:"billing_email_verified,omitempty" schema:"billing_email_verified,omitempty"`
 	// Block: If the org should be blocked and the reason why.
-	Block BlockReason `json:"block" yaml:"block" schema:"block"`
+	Block *BlockReason `json:"block,omitempty" yaml:"block,omitempty" schema:"block,omitempty"`
 	// CreatedAt: The date and time the org was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// Domain: The org's domain.
-	Domain string `json:"domain" yaml:"domain" schema:"domain"`
+	Domain *string `json:"domain,omitempty" yaml:"domain,omitempty" schema:"domain,omitempty"`
 	// ID: The unique identifier for the org.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Image: The image for the org. This is a URL.
-	Image URL `json:"image" yaml:"image" schema:"image"`
+	Image *URL `json:"image,omitempty" yaml:"image,omitempty" schema:"image,omitempty"`
 	// Name: The name of the org.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The org's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// Role: The user's role in the org.
 	Role OrgRole `json:"role" yaml:"role" schema:"role,required"`
 	// StripeID: The org's stripe id.
-	StripeID string `json:"stripe_id" yaml:"stripe_id" schema:"stripe_id"`
+	StripeID *string `json:"stripe_id,omitempty" yaml:"stripe_id,omitempty" schema:"stripe_id,omitempty"`
 	// UpdatedAt: The date and time the org was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -10259,50 +15144,88 @@ const (
 	UserOrgRoleMember UserOrgRole = "member"
 )
 
+// AllUserOrgRoles returns all the known values of UserOrgRole.
+func AllUserOrgRoles() []UserOrgRole {
+	return []UserOrgRole{
+		UserOrgRoleAdmin,
+		UserOrgRoleMember,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of UserOrgRole.
+func (v UserOrgRole) IsValid() bool {
+	switch v {
+	case UserOrgRoleAdmin, UserOrgRoleMember:
+		return true
+	}
+	return false
+}
+
+// ParseUserOrgRole parses a UserOrgRole from a string, returning an error if the value is not known.
+func ParseUserOrgRole(s string) (UserOrgRole, error) {
+	v := UserOrgRole(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid UserOrgRole %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v UserOrgRole) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *UserOrgRole) UnmarshalText(text []byte) error {
+	*v = UserOrgRole(text)
+	return nil
+}
+
 // UserResponse: Public user payload returned by the API.
 type UserResponse struct {
 	// AllowPayAsYouGo: Whether the user has opted into pay-as-you-go charges after using their included credits.
-	AllowPayAsYouGo bool `json:"allow_pay_as_you_go" yaml:"allow_pay_as_you_go" schema:"allow_pay_as_you_go"`
+	AllowPayAsYouGo *bool `json:"allow_pay_as_you_go,omitempty" yaml:"allow_pay_as_you_go,omitempty" schema:"allow_pay_as_you_go,omitempty"`
 	// Block: If the user should be blocked and the reason why.
-	Block BlockReason `json:"block" yaml:"block" schema:"block"`
+	Block *BlockReason `json:"block,omitempty" yaml:"block,omitempty" schema:"block,omitempty"`
 	// BlockMessage: Human-friendly block reason message.
-	BlockMessage string `json:"block_message" yaml:"block_message" schema:"block_message"`
+	BlockMessage *string `json:"block_message,omitempty" yaml:"block_message,omitempty" schema:"block_message,omitempty"`
 	// CanTrainOnData: If we can train on the user's data. If the user is a member of an organization, the organization's setting will override this.
-	CanTrainOnData bool `json:"can_train_on_data" yaml:"can_train_on_data" schema:"can_train_on_data"`
+	CanTrainOnData *bool `json:"can_train_on_data,omitempty" yaml:"can_train_on_data,omitempty" schema:"can_train_on_data,omitempty"`
 	// Company: The user's company.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// CreatedAt: The date and time the user was created.
 	CreatedAt Time `json:"created_at" yaml:"created_at" schema:"created_at,required"`
 	// DeletionScheduled: If the user is scheduled for deletion.
-	DeletionScheduled bool `json:"deletion_scheduled" yaml:"deletion_scheduled" schema:"deletion_scheduled"`
+	DeletionScheduled *bool `json:"deletion_scheduled,omitempty" yaml:"deletion_scheduled,omitempty" schema:"deletion_scheduled,omitempty"`
 	// Discord: The user's Discord handle.
-	Discord string `json:"discord" yaml:"discord" schema:"discord"`
+	Discord *string `json:"discord,omitempty" yaml:"discord,omitempty" schema:"discord,omitempty"`
 	// Email: The email address of the user.
-	Email string `json:"email" yaml:"email" schema:"email"`
+	Email *string `json:"email,omitempty" yaml:"email,omitempty" schema:"email,omitempty"`
 	// EmailVerified: The date and time the email address was verified.
-	EmailVerified Time `json:"email_verified" yaml:"email_verified" schema:"email_verified"`
+	EmailVerified *Time `json:"email_verified,omitempty" yaml:"email_verified,omitempty" schema:"email_verified,omitempty"`
 	// FirstName: The user's first name.
-	FirstName string `json:"first_name" yaml:"first_name" schema:"first_name"`
+	FirstName *string `json:"first_name,omitempty" yaml:"first_name,omitempty" schema:"first_name,omitempty"`
 	// Github: The user's GitHub handle.
-	Github string `json:"github" yaml:"github" schema:"github"`
+	Github *string `json:"github,omitempty" yaml:"github,omitempty" schema:"github,omitempty"`
 	// ID: The unique identifier for the user.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Image: The image avatar for the user. This is a URL.
 	Image URL `json:"image" yaml:"image" schema:"image,required"`
 	// IsOnboarded: If the user has finished onboarding.
-	IsOnboarded bool `json:"is_onboarded" yaml:"is_onboarded" schema:"is_onboarded"`
+	IsOnboarded *bool `json:"is_onboarded,omitempty" yaml:"is_onboarded,omitempty" schema:"is_onboarded,omitempty"`
 	// IsServiceAccount: If the user is tied to a service account.
-	IsServiceAccount bool `json:"is_service_account" yaml:"is_service_account" schema:"is_service_account"`
+	IsServiceAccount *bool `json:"is_service_account,omitempty" yaml:"is_service_account,omitempty" schema:"is_service_account,omitempty"`
 	// LastName: The user's last name.
-	LastName string `json:"last_name" yaml:"last_name" schema:"last_name"`
+	LastName *string `json:"last_name,omitempty" yaml:"last_name,omitempty" schema:"last_name,omitempty"`
 	// Name: The name of the user. This is auto populated at first from the authentication provider (if there was a name). It can be updated by the user by updating their `first_name` and `last_name` fields.
-	Name string `json:"name" yaml:"name" schema:"name"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty" schema:"name,omitempty"`
 	// Phone: The user's phone number.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 	// UpdatedAt: The date and time the user was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 	// Username: Public username/handle for community-facing features.
-	Username string `json:"username" yaml:"username" schema:"username"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty" schema:"username,omitempty"`
 }
 
 // VerificationTokenResponse: A verification token response.
@@ -10314,9 +15237,9 @@ type VerificationTokenResponse struct {
 	// ID: The token used for verification. This is used as the id for the table since it is unique per record.
 	ID UUID `json:"id" yaml:"id" schema:"id,required"`
 	// Identifier: The identifier for the user. This is typically the user's email address since that is what we are verifying.
-	Identifier string `json:"identifier" yaml:"identifier" schema:"identifier"`
+	Identifier *string `json:"identifier,omitempty" yaml:"identifier,omitempty" schema:"identifier,omitempty"`
 	// RedirectUrl: The URL to redirect to if the user requires SAML authentication or belongs somewhere else.
-	RedirectUrl URL `json:"redirect_url" yaml:"redirect_url" schema:"redirect_url"`
+	RedirectUrl *URL `json:"redirect_url,omitempty" yaml:"redirect_url,omitempty" schema:"redirect_url,omitempty"`
 	// UpdatedAt: The date and time the verification token was last updated.
 	UpdatedAt Time `json:"updated_at" yaml:"updated_at" schema:"updated_at,required"`
 }
@@ -10391,7 +15314,7 @@ type WebSocketRequestSdpOffer struct {
 	// Requests: A sequence of modeling requests. If any request fails, following requests will not be tried.
 	Requests []ModelingCmdReq `json:"requests" yaml:"requests" schema:"requests,required"`
 	// Responses: If false or omitted, responses to each batch command will just be Ok(()). If true, responses will be the actual response data for that modeling command.
-	Responses bool `json:"responses" yaml:"responses" schema:"responses"`
+	Responses *bool `json:"responses,omitempty" yaml:"responses,omitempty" schema:"responses,omitempty"`
 	// Type:
 	Type string `json:"type" yaml:"type" schema:"type,required"`
 }
@@ -10408,65 +15331,71 @@ type WebSocketRequestTrickleIce struct {
 type WebSocketResponse any
 
 // WebsiteCadUserInfoForm: Request body for authenticated website CAD user info form submissions.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type WebsiteCadUserInfoForm struct {
 	// CadExperienceLevel: Experience level with CAD software or APIs.
-	CadExperienceLevel CadExperienceLevel `json:"cad_experience_level" yaml:"cad_experience_level" schema:"cad_experience_level"`
+	CadExperienceLevel *CadExperienceLevel `json:"cad_experience_level,omitempty" yaml:"cad_experience_level,omitempty" schema:"cad_experience_level,omitempty"`
 	// CadIndustry: The industry of the user.
-	CadIndustry CadIndustry `json:"cad_industry" yaml:"cad_industry" schema:"cad_industry"`
+	CadIndustry *CadIndustry `json:"cad_industry,omitempty" yaml:"cad_industry,omitempty" schema:"cad_industry,omitempty"`
 	// CadUserType: The user type.
-	CadUserType CadUserType `json:"cad_user_type" yaml:"cad_user_type" schema:"cad_user_type"`
+	CadUserType *CadUserType `json:"cad_user_type,omitempty" yaml:"cad_user_type,omitempty" schema:"cad_user_type,omitempty"`
 	// CompanySize: Optional company size metadata.
-	CompanySize CompanySize `json:"company_size" yaml:"company_size" schema:"company_size"`
+	CompanySize *CompanySize `json:"company_size,omitempty" yaml:"company_size,omitempty" schema:"company_size,omitempty"`
 	// DesignWorkflow: Which design workflow the user is most excited about.
-	DesignWorkflow CadDesignWorkflow `json:"design_workflow" yaml:"design_workflow" schema:"design_workflow"`
+	DesignWorkflow *CadDesignWorkflow `json:"design_workflow,omitempty" yaml:"design_workflow,omitempty" schema:"design_workflow,omitempty"`
 	// HasUsedZooDesignStudioOrAPIBefore: Whether the user has used Zoo Design Studio or the API before.
-	HasUsedZooDesignStudioOrAPIBefore bool `json:"has_used_zoo_design_studio_or_api_before" yaml:"has_used_zoo_design_studio_or_api_before" schema:"has_used_zoo_design_studio_or_api_before"`
+	HasUsedZooDesignStudioOrAPIBefore *bool `json:"has_used_zoo_design_studio_or_api_before,omitempty" yaml:"has_used_zoo_design_studio_or_api_before,omitempty" schema:"has_used_zoo_design_studio_or_api_before,omitempty"`
 	// HowDidYouFindUs: How the user found Zoo.
-	HowDidYouFindUs CadDiscoverySource `json:"how_did_you_find_us" yaml:"how_did_you_find_us" schema:"how_did_you_find_us"`
+	HowDidYouFindUs *CadDiscoverySource `json:"how_did_you_find_us,omitempty" yaml:"how_did_you_find_us,omitempty" schema:"how_did_you_find_us,omitempty"`
 	// HowDidYouFindUsOther: Optional free-text value when "Other" is selected.
-	HowDidYouFindUsOther string `json:"how_did_you_find_us_other" yaml:"how_did_you_find_us_other" schema:"how_did_you_find_us_other"`
+	HowDidYouFindUsOther *string `json:"how_did_you_find_us_other,omitempty" yaml:"how_did_you_find_us_other,omitempty" schema:"how_did_you_find_us_other,omitempty"`
 	// LocationCity: Optional city for the user's location.
-	LocationCity string `json:"location_city" yaml:"location_city" schema:"location_city"`
+	LocationCity *string `json:"location_city,omitempty" yaml:"location_city,omitempty" schema:"location_city,omitempty"`
 	// LocationCountry: Optional country for the user's location.
-	LocationCountry string `json:"location_country" yaml:"location_country" schema:"location_country"`
+	LocationCountry *string `json:"location_country,omitempty" yaml:"location_country,omitempty" schema:"location_country,omitempty"`
 	// LocationState: Optional state or region for the user's location.
-	LocationState string `json:"location_state" yaml:"location_state" schema:"location_state"`
+	LocationState *string `json:"location_state,omitempty" yaml:"location_state,omitempty" schema:"location_state,omitempty"`
 	// NumberOfCadUsers: The number of CAD users.
-	NumberOfCadUsers string `json:"number_of_cad_users" yaml:"number_of_cad_users" schema:"number_of_cad_users"`
+	NumberOfCadUsers *string `json:"number_of_cad_users,omitempty" yaml:"number_of_cad_users,omitempty" schema:"number_of_cad_users,omitempty"`
 	// WhatAreYouBuilding: Optional free-text description of what the user wants to build.
-	WhatAreYouBuilding string `json:"what_are_you_building" yaml:"what_are_you_building" schema:"what_are_you_building"`
+	WhatAreYouBuilding *string `json:"what_are_you_building,omitempty" yaml:"what_are_you_building,omitempty" schema:"what_are_you_building,omitempty"`
 }
 
 // WebsiteSalesForm: Request body for website sales form submissions.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type WebsiteSalesForm struct {
 	// CadPlatforms: The CAD platforms (used for pilot inquiries).
-	CadPlatforms []string `json:"cad_platforms" yaml:"cad_platforms" schema:"cad_platforms"`
+	CadPlatforms []string `json:"cad_platforms,omitempty" yaml:"cad_platforms,omitempty" schema:"cad_platforms,omitempty"`
 	// Company: The company name.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// Email: The email address of the user.
 	Email string `json:"email" yaml:"email" schema:"email,required"`
 	// FirstName: The first name of the user.
 	FirstName string `json:"first_name" yaml:"first_name" schema:"first_name,required"`
 	// Industry: The industry of the user.
-	Industry string `json:"industry" yaml:"industry" schema:"industry"`
+	Industry *string `json:"industry,omitempty" yaml:"industry,omitempty" schema:"industry,omitempty"`
 	// InquiryType: The type of sales inquiry.
 	InquiryType SalesInquiryType `json:"inquiry_type" yaml:"inquiry_type" schema:"inquiry_type,required"`
 	// JobTitle: The job title (used for pilot inquiries).
-	JobTitle string `json:"job_title" yaml:"job_title" schema:"job_title"`
+	JobTitle *string `json:"job_title,omitempty" yaml:"job_title,omitempty" schema:"job_title,omitempty"`
 	// LastName: The last name of the user.
 	LastName string `json:"last_name" yaml:"last_name" schema:"last_name,required"`
 	// Message: The message content.
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 	// NumCadUsers: The number of CAD users (used for pilot inquiries).
-	NumCadUsers string `json:"num_cad_users" yaml:"num_cad_users" schema:"num_cad_users"`
+	NumCadUsers *string `json:"num_cad_users,omitempty" yaml:"num_cad_users,omitempty" schema:"num_cad_users,omitempty"`
 	// Phone: The phone number of the user.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 }
 
 // WebsiteSupportForm: Request body for website support form submissions.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type WebsiteSupportForm struct {
 	// Company: Optional company metadata.
-	Company string `json:"company" yaml:"company" schema:"company"`
+	Company *string `json:"company,omitempty" yaml:"company,omitempty" schema:"company,omitempty"`
 	// Email: The email address of the user.
 	Email string `json:"email" yaml:"email" schema:"email,required"`
 	// FirstName: The first name of the user.
@@ -10478,7 +15407,7 @@ type WebsiteSupportForm struct {
 	// Message: The message content.
 	Message string `json:"message" yaml:"message" schema:"message,required"`
 	// Phone: Optional phone metadata.
-	Phone string `json:"phone" yaml:"phone" schema:"phone"`
+	Phone *string `json:"phone,omitempty" yaml:"phone,omitempty" schema:"phone,omitempty"`
 }
 
 // WorldCoordinateSystem is the type definition for a WorldCoordinateSystem.
@@ -10491,34 +15420,72 @@ const (
 	WorldCoordinateSystemRightHandedUpY WorldCoordinateSystem = "right_handed_up_y"
 )
 
+// AllWorldCoordinateSystems returns all the known values of WorldCoordinateSystem.
+func AllWorldCoordinateSystems() []WorldCoordinateSystem {
+	return []WorldCoordinateSystem{
+		WorldCoordinateSystemRightHandedUpZ,
+		WorldCoordinateSystemRightHandedUpY,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of WorldCoordinateSystem.
+func (v WorldCoordinateSystem) IsValid() bool {
+	switch v {
+	case WorldCoordinateSystemRightHandedUpZ, WorldCoordinateSystemRightHandedUpY:
+		return true
+	}
+	return false
+}
+
+// ParseWorldCoordinateSystem parses a WorldCoordinateSystem from a string, returning an error if the value is not known.
+func ParseWorldCoordinateSystem(s string) (WorldCoordinateSystem, error) {
+	v := WorldCoordinateSystem(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid WorldCoordinateSystem %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v WorldCoordinateSystem) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *WorldCoordinateSystem) UnmarshalText(text []byte) error {
+	*v = WorldCoordinateSystem(text)
+	return nil
+}
+
 // ZooProductSubscription: A subscription to the modeling app.
 type ZooProductSubscription struct {
 	// AnnualDiscount: Annual discount. The percentage off the monthly price if the user pays annually.
-	AnnualDiscount float64 `json:"annual_discount" yaml:"annual_discount" schema:"annual_discount"`
+	AnnualDiscount *float64 `json:"annual_discount,omitempty" yaml:"annual_discount,omitempty" schema:"annual_discount,omitempty"`
 	// BillingMode: Indicates how billing collection is routed for this plan.
-	BillingMode SubscriptionBillingMode `json:"billing_mode" yaml:"billing_mode" schema:"billing_mode"`
+	BillingMode *SubscriptionBillingMode `json:"billing_mode,omitempty" yaml:"billing_mode,omitempty" schema:"billing_mode,omitempty"`
 	// Description: A description of the tier.
 	Description string `json:"description" yaml:"description" schema:"description,required"`
 	// DisplayName: The display name of the tier.
-	DisplayName string `json:"display_name" yaml:"display_name" schema:"display_name"`
+	DisplayName *string `json:"display_name,omitempty" yaml:"display_name,omitempty" schema:"display_name,omitempty"`
 	// EndpointsIncluded: The Zoo API endpoints that are included when through an approved zoo tool.
-	EndpointsIncluded []APIEndpoint `json:"endpoints_included" yaml:"endpoints_included" schema:"endpoints_included"`
+	EndpointsIncluded []APIEndpoint `json:"endpoints_included,omitempty" yaml:"endpoints_included,omitempty" schema:"endpoints_included,omitempty"`
 	// Features: Features that are included in the subscription.
-	Features []SubscriptionTierFeature `json:"features" yaml:"features" schema:"features"`
+	Features []SubscriptionTierFeature `json:"features,omitempty" yaml:"features,omitempty" schema:"features,omitempty"`
 	// MlCustomModels: Indicates whether the plan enables custom ML models.
-	MlCustomModels bool `json:"ml_custom_models" yaml:"ml_custom_models" schema:"ml_custom_models"`
+	MlCustomModels *bool `json:"ml_custom_models,omitempty" yaml:"ml_custom_models,omitempty" schema:"ml_custom_models,omitempty"`
 	// MonthlyPayAsYouGoAPICredits: The amount of pay-as-you-go API credits the individual or org gets outside the modeling app per month. Credit replenishment remains calendar-month based while anniversary billing is rolled out. This is equivalent to the monetary value divided by the price of an API credit.
-	MonthlyPayAsYouGoAPICredits int `json:"monthly_pay_as_you_go_api_credits" yaml:"monthly_pay_as_you_go_api_credits" schema:"monthly_pay_as_you_go_api_credits"`
+	MonthlyPayAsYouGoAPICredits *int `json:"monthly_pay_as_you_go_api_credits,omitempty" yaml:"monthly_pay_as_you_go_api_credits,omitempty" schema:"monthly_pay_as_you_go_api_credits,omitempty"`
 	// MonthlyPayAsYouGoAPICreditsMonetaryValue: The monetary value of pay-as-you-go API credits the individual or org gets outside the modeling app per month. Credit replenishment remains calendar-month based while anniversary billing is rolled out.
-	MonthlyPayAsYouGoAPICreditsMonetaryValue float64 `json:"monthly_pay_as_you_go_api_credits_monetary_value" yaml:"monthly_pay_as_you_go_api_credits_monetary_value" schema:"monthly_pay_as_you_go_api_credits_monetary_value"`
+	MonthlyPayAsYouGoAPICreditsMonetaryValue *float64 `json:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty" yaml:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty" schema:"monthly_pay_as_you_go_api_credits_monetary_value,omitempty"`
 	// Name: The name of the tier.
 	Name string `json:"name" yaml:"name" schema:"name,required"`
 	// PayAsYouGoAPICreditPrice: The price of an API credit.
-	PayAsYouGoAPICreditPrice float64 `json:"pay_as_you_go_api_credit_price" yaml:"pay_as_you_go_api_credit_price" schema:"pay_as_you_go_api_credit_price"`
+	PayAsYouGoAPICreditPrice *float64 `json:"pay_as_you_go_api_credit_price,omitempty" yaml:"pay_as_you_go_api_credit_price,omitempty" schema:"pay_as_you_go_api_credit_price,omitempty"`
 	// Price: The price of the tier per month. If this is for an individual, this is the price they pay. If this is for an organization, this is the price the organization pays per member in the org. This is in USD.
 	Price any `json:"price" yaml:"price" schema:"price,required"`
 	// ShareLinks: The options for sharable links through the modeling app.
-	ShareLinks []ModelingAppShareLinks `json:"share_links" yaml:"share_links" schema:"share_links"`
+	ShareLinks []ModelingAppShareLinks `json:"share_links,omitempty" yaml:"share_links,omitempty" schema:"share_links,omitempty"`
 	// SupportTier: The support tier the subscription provides.
 	SupportTier SupportTier `json:"support_tier" yaml:"support_tier" schema:"support_tier,required"`
 	// TrainingDataBehavior: The behavior of the users data (can it be used for training, etc).
@@ -10526,7 +15493,7 @@ type ZooProductSubscription struct {
 	// Type: If the tier is offered for an individual or an org.
 	Type any `json:"type" yaml:"type" schema:"type,required"`
 	// ZooToolsIncluded: The Zoo tools that you can call unlimited times with this tier.
-	ZooToolsIncluded []ZooTool `json:"zoo_tools_included" yaml:"zoo_tools_included" schema:"zoo_tools_included"`
+	ZooToolsIncluded []ZooTool `json:"zoo_tools_included,omitempty" yaml:"zoo_tools_included,omitempty" schema:"zoo_tools_included,omitempty"`
 }
 
 // ZooProductSubscriptionDowngradeReason: Customer-selected reason for downgrading an individual subscription.
@@ -10551,34 +15518,82 @@ const (
 	ZooProductSubscriptionDowngradeReasonOther ZooProductSubscriptionDowngradeReason = "other"
 )
 
+// AllZooProductSubscriptionDowngradeReasons returns all the known values of ZooProductSubscriptionDowngradeReason.
+func AllZooProductSubscriptionDowngradeReasons() []ZooProductSubscriptionDowngradeReason {
+	return []ZooProductSubscriptionDowngradeReason{
+		ZooProductSubscriptionDowngradeReasonCostTooHigh,
+		ZooProductSubscriptionDowngradeReasonErrorsOrBugs,
+		ZooProductSubscriptionDowngradeReasonMissingFeatures,
+		ZooProductSubscriptionDowngradeReasonAnotherAiProductBetter,
+		ZooProductSubscriptionDowngradeReasonZookeeperResultsLowQuality,
+		ZooProductSubscriptionDowngradeReasonReasoningTimeRunsOut,
+		ZooProductSubscriptionDowngradeReasonProductTooSlow,
+		ZooProductSubscriptionDowngradeReasonOther,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ZooProductSubscriptionDowngradeReason.
+func (v ZooProductSubscriptionDowngradeReason) IsValid() bool {
+	switch v {
+	case ZooProductSubscriptionDowngradeReasonCostTooHigh, ZooProductSubscriptionDowngradeReasonErrorsOrBugs, ZooProductSubscriptionDowngradeReasonMissingFeatures, ZooProductSubscriptionDowngradeReasonAnotherAiProductBetter, ZooProductSubscriptionDowngradeReasonZookeeperResultsLowQuality, ZooProductSubscriptionDowngradeReasonReasoningTimeRunsOut, ZooProductSubscriptionDowngradeReasonProductTooSlow, ZooProductSubscriptionDowngradeReasonOther:
+		return true
+	}
+	return false
+}
+
+// ParseZooProductSubscriptionDowngradeReason parses a ZooProductSubscriptionDowngradeReason from a string, returning an error if the value is not known.
+func ParseZooProductSubscriptionDowngradeReason(s string) (ZooProductSubscriptionDowngradeReason, error) {
+	v := ZooProductSubscriptionDowngradeReason(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ZooProductSubscriptionDowngradeReason %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ZooProductSubscriptionDowngradeReason) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ZooProductSubscriptionDowngradeReason) UnmarshalText(text []byte) error {
+	*v = ZooProductSubscriptionDowngradeReason(text)
+	return nil
+}
+
 // ZooProductSubscriptions: A struct of Zoo product subscriptions.
 type ZooProductSubscriptions struct {
 	// ActionClientSecret: Client secret to complete SCA/3DS for the current subscription change, when applicable.
-	ActionClientSecret string `json:"action_client_secret" yaml:"action_client_secret" schema:"action_client_secret"`
+	ActionClientSecret *string `json:"action_client_secret,omitempty" yaml:"action_client_secret,omitempty" schema:"action_client_secret,omitempty"`
 	// ActionType: Type of intent associated with `action_client_secret`.
-	ActionType SubscriptionActionType `json:"action_type" yaml:"action_type" schema:"action_type"`
+	ActionType *SubscriptionActionType `json:"action_type,omitempty" yaml:"action_type,omitempty" schema:"action_type,omitempty"`
 	// ModelingApp: A modeling app subscription.
 	ModelingApp ModelingAppSubscriptionTier `json:"modeling_app" yaml:"modeling_app" schema:"modeling_app,required"`
 }
 
 // ZooProductSubscriptionsOrgRequest: A struct of Zoo product subscriptions an organization can request.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type ZooProductSubscriptionsOrgRequest struct {
 	// ModelingApp: Slug of the modeling app subscription tier requested.
 	ModelingApp string `json:"modeling_app" yaml:"modeling_app" schema:"modeling_app,required"`
 	// PayAnnually: If the customer chooses to pay annually or monthly, we can add that here. The annual discount will apply if there is a discount for the subscription.
-	PayAnnually bool `json:"pay_annually" yaml:"pay_annually" schema:"pay_annually"`
+	PayAnnually *bool `json:"pay_annually,omitempty" yaml:"pay_annually,omitempty" schema:"pay_annually,omitempty"`
 }
 
 // ZooProductSubscriptionsUserRequest: A struct of Zoo product subscriptions a user can request.
+//
+// Optional fields are only sent when set: a nil pointer, slice or map leaves the field as it is. There is no way to send an explicit null or empty list to clear a field.
 type ZooProductSubscriptionsUserRequest struct {
 	// DowngradeReason: Customer-selected reason for downgrading back to the free tier.
-	DowngradeReason ZooProductSubscriptionDowngradeReason `json:"downgrade_reason" yaml:"downgrade_reason" schema:"downgrade_reason"`
+	DowngradeReason *ZooProductSubscriptionDowngradeReason `json:"downgrade_reason,omitempty" yaml:"downgrade_reason,omitempty" schema:"downgrade_reason,omitempty"`
 	// DowngradeReasonText: Optional customer-provided context for the downgrade reason.
-	DowngradeReasonText string `json:"downgrade_reason_text" yaml:"downgrade_reason_text" schema:"downgrade_reason_text"`
+	DowngradeReasonText *string `json:"downgrade_reason_text,omitempty" yaml:"downgrade_reason_text,omitempty" schema:"downgrade_reason_text,omitempty"`
 	// ModelingApp: Slug of the modeling app subscription tier requested.
 	ModelingApp string `json:"modeling_app" yaml:"modeling_app" schema:"modeling_app,required"`
 	// PayAnnually: If the customer chooses to pay annually or monthly, we can add that here. The annual discount will apply if there is a discount for the subscription.
-	PayAnnually bool `json:"pay_annually" yaml:"pay_annually" schema:"pay_annually"`
+	PayAnnually *bool `json:"pay_annually,omitempty" yaml:"pay_annually,omitempty" schema:"pay_annually,omitempty"`
 }
 
 // ZooTool: The Zoo tools that can make API calls.
@@ -10591,22 +15606,60 @@ const (
 	ZooToolTextToCad ZooTool = "text_to_cad"
 )
 
+// AllZooTools returns all the known values of ZooTool.
+func AllZooTools() []ZooTool {
+	return []ZooTool{
+		ZooToolModelingApp,
+		ZooToolTextToCad,
+	}
+}
+
+// IsValid reports whether the value is one of the known values of ZooTool.
+func (v ZooTool) IsValid() bool {
+	switch v {
+	case ZooToolModelingApp, ZooToolTextToCad:
+		return true
+	}
+	return false
+}
+
+// ParseZooTool parses a ZooTool from a string, returning an error if the value is not known.
+func ParseZooTool(s string) (ZooTool, error) {
+	v := ZooTool(s)
+	if !v.IsValid() {
+		return "", fmt.Errorf("invalid ZooTool %q", s)
+	}
+	return v, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v ZooTool) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Unknown values are accepted, and rejected by ValidateEnums or a client with strict enums.
+func (v *ZooTool) UnmarshalText(text []byte) error {
+	*v = ZooTool(text)
+	return nil
+}
+
 // ZookeeperAutoRouterMetadata: Zookeeper Auto-router decision metadata persisted on a copilot prompt.
 type ZookeeperAutoRouterMetadata struct {
 	// Bucket: Auto-router classifier bucket, e.g. "simple", "moderate", or "complex".
 	Bucket string `json:"bucket" yaml:"bucket" schema:"bucket,required"`
 	// PromptTemplate: Prompt template/config label used by the router.
-	PromptTemplate string `json:"prompt_template" yaml:"prompt_template" schema:"prompt_template"`
+	PromptTemplate *string `json:"prompt_template,omitempty" yaml:"prompt_template,omitempty" schema:"prompt_template,omitempty"`
 	// Reasons: Human-readable route reasons.
-	Reasons []string `json:"reasons" yaml:"reasons" schema:"reasons"`
+	Reasons []string `json:"reasons,omitempty" yaml:"reasons,omitempty" schema:"reasons,omitempty"`
 	// Stage: Selection stage, e.g. "classifier" or "replay".
-	Stage string `json:"stage" yaml:"stage" schema:"stage"`
+	Stage *string `json:"stage,omitempty" yaml:"stage,omitempty" schema:"stage,omitempty"`
 }
 
 // ZookeeperEditPatch: Local replay data for a single successful Zookeeper project edit.
 type ZookeeperEditPatch struct {
 	// ChangedFiles: Project files changed by this Zookeeper edit.
-	ChangedFiles []ZookeeperEditPatchFile `json:"changed_files" yaml:"changed_files" schema:"changed_files"`
+	ChangedFiles []ZookeeperEditPatchFile `json:"changed_files,omitempty" yaml:"changed_files,omitempty" schema:"changed_files,omitempty"`
 	// RunID: Stable id for the Zookeeper run that produced this edit.
 	RunID string `json:"run_id" yaml:"run_id" schema:"run_id,required"`
 }
@@ -10657,7 +15710,7 @@ type ZookeeperRecoveryToolOutput struct {
 	// Output: Bounded readable output derived from the completed tool result.
 	Output string `json:"output" yaml:"output" schema:"output,required"`
 	// ProjectUpdated: Whether the tool changed the current project.
-	ProjectUpdated bool `json:"project_updated" yaml:"project_updated" schema:"project_updated"`
+	ProjectUpdated *bool `json:"project_updated,omitempty" yaml:"project_updated,omitempty" schema:"project_updated,omitempty"`
 	// ToolName: Name of the completed tool.
 	ToolName string `json:"tool_name" yaml:"tool_name" schema:"tool_name,required"`
 }
@@ -10666,107 +15719,107 @@ type ZookeeperRecoveryToolOutput struct {
 // A turn is many model calls: the streamed agent loop, helper agents, conversation compaction, Auto-mode routing, and any retried attempts. This is the sum of all of them, with breakdowns by model and by stage.
 type ZookeeperTurnUsage struct {
 	// APICallID: API call the turn belongs to, as the backend knows it.
-	APICallID string `json:"api_call_id" yaml:"api_call_id" schema:"api_call_id"`
+	APICallID *string `json:"api_call_id,omitempty" yaml:"api_call_id,omitempty" schema:"api_call_id,omitempty"`
 	// CacheWriteInputTokens: Prompt tokens written to the provider's prompt cache.
-	CacheWriteInputTokens int `json:"cache_write_input_tokens" yaml:"cache_write_input_tokens" schema:"cache_write_input_tokens"`
+	CacheWriteInputTokens *int `json:"cache_write_input_tokens,omitempty" yaml:"cache_write_input_tokens,omitempty" schema:"cache_write_input_tokens,omitempty"`
 	// CachedInputTokens: Prompt tokens served from the provider's prompt cache.
-	CachedInputTokens int `json:"cached_input_tokens" yaml:"cached_input_tokens" schema:"cached_input_tokens"`
+	CachedInputTokens *int `json:"cached_input_tokens,omitempty" yaml:"cached_input_tokens,omitempty" schema:"cached_input_tokens,omitempty"`
 	// ConversationID: Conversation the turn belongs to, as the backend knows it.
-	ConversationID string `json:"conversation_id" yaml:"conversation_id" schema:"conversation_id"`
+	ConversationID *string `json:"conversation_id,omitempty" yaml:"conversation_id,omitempty" schema:"conversation_id,omitempty"`
 	// CostMicroUsd: Cost in millionths of a US dollar, rounded half-up.
-	CostMicroUsd int `json:"cost_micro_usd" yaml:"cost_micro_usd" schema:"cost_micro_usd"`
+	CostMicroUsd *int `json:"cost_micro_usd,omitempty" yaml:"cost_micro_usd,omitempty" schema:"cost_micro_usd,omitempty"`
 	// CostUsd: Exact cost as a decimal string, e.g. "0.02184000".
-	CostUsd string `json:"cost_usd" yaml:"cost_usd" schema:"cost_usd"`
+	CostUsd *string `json:"cost_usd,omitempty" yaml:"cost_usd,omitempty" schema:"cost_usd,omitempty"`
 	// FullyPriced: Whether every request was priced.
-	FullyPriced bool `json:"fully_priced" yaml:"fully_priced" schema:"fully_priced"`
+	FullyPriced *bool `json:"fully_priced,omitempty" yaml:"fully_priced,omitempty" schema:"fully_priced,omitempty"`
 	// InputTokens: Prompt tokens, including the cached and cache-write subsets below.
-	InputTokens int `json:"input_tokens" yaml:"input_tokens" schema:"input_tokens"`
+	InputTokens *int `json:"input_tokens,omitempty" yaml:"input_tokens,omitempty" schema:"input_tokens,omitempty"`
 	// Models: Usage split by model.
-	Models []ZookeeperTurnUsageModel `json:"models" yaml:"models" schema:"models"`
+	Models []ZookeeperTurnUsageModel `json:"models,omitempty" yaml:"models,omitempty" schema:"models,omitempty"`
 	// OutputTokens: Completion tokens, including the reasoning subset below.
-	OutputTokens int `json:"output_tokens" yaml:"output_tokens" schema:"output_tokens"`
+	OutputTokens *int `json:"output_tokens,omitempty" yaml:"output_tokens,omitempty" schema:"output_tokens,omitempty"`
 	// PricedRequests: Requests whose model had a known price.
-	PricedRequests int `json:"priced_requests" yaml:"priced_requests" schema:"priced_requests"`
+	PricedRequests *int `json:"priced_requests,omitempty" yaml:"priced_requests,omitempty" schema:"priced_requests,omitempty"`
 	// ReasoningTokens: Completion tokens spent on reasoning.
-	ReasoningTokens int `json:"reasoning_tokens" yaml:"reasoning_tokens" schema:"reasoning_tokens"`
+	ReasoningTokens *int `json:"reasoning_tokens,omitempty" yaml:"reasoning_tokens,omitempty" schema:"reasoning_tokens,omitempty"`
 	// Requests: Number of model requests.
-	Requests int `json:"requests" yaml:"requests" schema:"requests"`
+	Requests *int `json:"requests,omitempty" yaml:"requests,omitempty" schema:"requests,omitempty"`
 	// SchemaVersion: Payload shape identifier, e.g. "zookeeper_turn_usage.v1".
-	SchemaVersion string `json:"schema_version" yaml:"schema_version" schema:"schema_version"`
+	SchemaVersion *string `json:"schema_version,omitempty" yaml:"schema_version,omitempty" schema:"schema_version,omitempty"`
 	// Stages: Usage split by the part of the turn that spent it.
-	Stages []ZookeeperTurnUsageStage `json:"stages" yaml:"stages" schema:"stages"`
+	Stages []ZookeeperTurnUsageStage `json:"stages,omitempty" yaml:"stages,omitempty" schema:"stages,omitempty"`
 	// TotalTokens: Input plus output tokens.
-	TotalTokens int `json:"total_tokens" yaml:"total_tokens" schema:"total_tokens"`
+	TotalTokens *int `json:"total_tokens,omitempty" yaml:"total_tokens,omitempty" schema:"total_tokens,omitempty"`
 	// UncachedInputTokens: Prompt tokens billed at the full input rate.
-	UncachedInputTokens int `json:"uncached_input_tokens" yaml:"uncached_input_tokens" schema:"uncached_input_tokens"`
+	UncachedInputTokens *int `json:"uncached_input_tokens,omitempty" yaml:"uncached_input_tokens,omitempty" schema:"uncached_input_tokens,omitempty"`
 	// UnpricedRequests: Requests whose model had no known price; their tokens are counted but contribute no cost, so totals are a floor rather than an estimate.
-	UnpricedRequests int `json:"unpriced_requests" yaml:"unpriced_requests" schema:"unpriced_requests"`
+	UnpricedRequests *int `json:"unpriced_requests,omitempty" yaml:"unpriced_requests,omitempty" schema:"unpriced_requests,omitempty"`
 }
 
 // ZookeeperTurnUsageModel: Per-model usage within a Zookeeper turn.
 type ZookeeperTurnUsageModel struct {
 	// CacheWriteInputTokens: Prompt tokens written to the provider's prompt cache.
-	CacheWriteInputTokens int `json:"cache_write_input_tokens" yaml:"cache_write_input_tokens" schema:"cache_write_input_tokens"`
+	CacheWriteInputTokens *int `json:"cache_write_input_tokens,omitempty" yaml:"cache_write_input_tokens,omitempty" schema:"cache_write_input_tokens,omitempty"`
 	// CachedInputTokens: Prompt tokens served from the provider's prompt cache.
-	CachedInputTokens int `json:"cached_input_tokens" yaml:"cached_input_tokens" schema:"cached_input_tokens"`
+	CachedInputTokens *int `json:"cached_input_tokens,omitempty" yaml:"cached_input_tokens,omitempty" schema:"cached_input_tokens,omitempty"`
 	// CostMicroUsd: Cost in millionths of a US dollar, rounded half-up.
-	CostMicroUsd int `json:"cost_micro_usd" yaml:"cost_micro_usd" schema:"cost_micro_usd"`
+	CostMicroUsd *int `json:"cost_micro_usd,omitempty" yaml:"cost_micro_usd,omitempty" schema:"cost_micro_usd,omitempty"`
 	// CostUsd: Exact cost as a decimal string, e.g. "0.02184000".
-	CostUsd string `json:"cost_usd" yaml:"cost_usd" schema:"cost_usd"`
+	CostUsd *string `json:"cost_usd,omitempty" yaml:"cost_usd,omitempty" schema:"cost_usd,omitempty"`
 	// FullyPriced: Whether every request was priced.
-	FullyPriced bool `json:"fully_priced" yaml:"fully_priced" schema:"fully_priced"`
+	FullyPriced *bool `json:"fully_priced,omitempty" yaml:"fully_priced,omitempty" schema:"fully_priced,omitempty"`
 	// InputTokens: Prompt tokens, including the cached and cache-write subsets below.
-	InputTokens int `json:"input_tokens" yaml:"input_tokens" schema:"input_tokens"`
+	InputTokens *int `json:"input_tokens,omitempty" yaml:"input_tokens,omitempty" schema:"input_tokens,omitempty"`
 	// Model: Model name as the provider reported it.
 	Model string `json:"model" yaml:"model" schema:"model,required"`
 	// OutputTokens: Completion tokens, including the reasoning subset below.
-	OutputTokens int `json:"output_tokens" yaml:"output_tokens" schema:"output_tokens"`
+	OutputTokens *int `json:"output_tokens,omitempty" yaml:"output_tokens,omitempty" schema:"output_tokens,omitempty"`
 	// PricedRequests: Requests whose model had a known price.
-	PricedRequests int `json:"priced_requests" yaml:"priced_requests" schema:"priced_requests"`
+	PricedRequests *int `json:"priced_requests,omitempty" yaml:"priced_requests,omitempty" schema:"priced_requests,omitempty"`
 	// PricingSource: Where the price came from, e.g. "litellm", "litellm_alias", "override", or "unknown".
-	PricingSource string `json:"pricing_source" yaml:"pricing_source" schema:"pricing_source"`
+	PricingSource *string `json:"pricing_source,omitempty" yaml:"pricing_source,omitempty" schema:"pricing_source,omitempty"`
 	// ReasoningTokens: Completion tokens spent on reasoning.
-	ReasoningTokens int `json:"reasoning_tokens" yaml:"reasoning_tokens" schema:"reasoning_tokens"`
+	ReasoningTokens *int `json:"reasoning_tokens,omitempty" yaml:"reasoning_tokens,omitempty" schema:"reasoning_tokens,omitempty"`
 	// Requests: Number of model requests.
-	Requests int `json:"requests" yaml:"requests" schema:"requests"`
+	Requests *int `json:"requests,omitempty" yaml:"requests,omitempty" schema:"requests,omitempty"`
 	// TotalTokens: Input plus output tokens.
-	TotalTokens int `json:"total_tokens" yaml:"total_tokens" schema:"total_tokens"`
+	TotalTokens *int `json:"total_tokens,omitempty" yaml:"total_tokens,omitempty" schema:"total_tokens,omitempty"`
 	// UncachedInputTokens: Prompt tokens billed at the full input rate.
-	UncachedInputTokens int `json:"uncached_input_tokens" yaml:"uncached_input_tokens" schema:"uncached_input_tokens"`
+	UncachedInputTokens *int `json:"uncached_input_tokens,omitempty" yaml:"uncached_input_tokens,omitempty" schema:"uncached_input_tokens,omitempty"`
 	// UnpricedRequests: Requests whose model had no known price; their tokens are counted but contribute no cost, so totals are a floor rather than an estimate.
-	UnpricedRequests int `json:"unpriced_requests" yaml:"unpriced_requests" schema:"unpriced_requests"`
+	UnpricedRequests *int `json:"unpriced_requests,omitempty" yaml:"unpriced_requests,omitempty" schema:"unpriced_requests,omitempty"`
 }
 
 // ZookeeperTurnUsageStage: Per-stage usage within a Zookeeper turn.
 type ZookeeperTurnUsageStage struct {
 	// CacheWriteInputTokens: Prompt tokens written to the provider's prompt cache.
-	CacheWriteInputTokens int `json:"cache_write_input_tokens" yaml:"cache_write_input_tokens" schema:"cache_write_input_tokens"`
+	CacheWriteInputTokens *int `json:"cache_write_input_tokens,omitempty" yaml:"cache_write_input_tokens,omitempty" schema:"cache_write_input_tokens,omitempty"`
 	// CachedInputTokens: Prompt tokens served from the provider's prompt cache.
-	CachedInputTokens int `json:"cached_input_tokens" yaml:"cached_input_tokens" schema:"cached_input_tokens"`
+	CachedInputTokens *int `json:"cached_input_tokens,omitempty" yaml:"cached_input_tokens,omitempty" schema:"cached_input_tokens,omitempty"`
 	// CostMicroUsd: Cost in millionths of a US dollar, rounded half-up.
-	CostMicroUsd int `json:"cost_micro_usd" yaml:"cost_micro_usd" schema:"cost_micro_usd"`
+	CostMicroUsd *int `json:"cost_micro_usd,omitempty" yaml:"cost_micro_usd,omitempty" schema:"cost_micro_usd,omitempty"`
 	// CostUsd: Exact cost as a decimal string, e.g. "0.02184000".
-	CostUsd string `json:"cost_usd" yaml:"cost_usd" schema:"cost_usd"`
+	CostUsd *string `json:"cost_usd,omitempty" yaml:"cost_usd,omitempty" schema:"cost_usd,omitempty"`
 	// FullyPriced: Whether every request was priced.
-	FullyPriced bool `json:"fully_priced" yaml:"fully_priced" schema:"fully_priced"`
+	FullyPriced *bool `json:"fully_priced,omitempty" yaml:"fully_priced,omitempty" schema:"fully_priced,omitempty"`
 	// InputTokens: Prompt tokens, including the cached and cache-write subsets below.
-	InputTokens int `json:"input_tokens" yaml:"input_tokens" schema:"input_tokens"`
+	InputTokens *int `json:"input_tokens,omitempty" yaml:"input_tokens,omitempty" schema:"input_tokens,omitempty"`
 	// OutputTokens: Completion tokens, including the reasoning subset below.
-	OutputTokens int `json:"output_tokens" yaml:"output_tokens" schema:"output_tokens"`
+	OutputTokens *int `json:"output_tokens,omitempty" yaml:"output_tokens,omitempty" schema:"output_tokens,omitempty"`
 	// PricedRequests: Requests whose model had a known price.
-	PricedRequests int `json:"priced_requests" yaml:"priced_requests" schema:"priced_requests"`
+	PricedRequests *int `json:"priced_requests,omitempty" yaml:"priced_requests,omitempty" schema:"priced_requests,omitempty"`
 	// ReasoningTokens: Completion tokens spent on reasoning.
-	ReasoningTokens int `json:"reasoning_tokens" yaml:"reasoning_tokens" schema:"reasoning_tokens"`
+	ReasoningTokens *int `json:"reasoning_tokens,omitempty" yaml:"reasoning_tokens,omitempty" schema:"reasoning_tokens,omitempty"`
 	// Requests: Number of model requests.
-	Requests int `json:"requests" yaml:"requests" schema:"requests"`
+	Requests *int `json:"requests,omitempty" yaml:"requests,omitempty" schema:"requests,omitempty"`
 	// Stage: Which part of the turn spent the tokens, e.g. "agent_stream", "auto_router", "compaction", "sub_agent", or "prompt_validation".
 	Stage string `json:"stage" yaml:"stage" schema:"stage,required"`
 	// TotalTokens: Input plus output tokens.
-	TotalTokens int `json:"total_tokens" yaml:"total_tokens" schema:"total_tokens"`
+	TotalTokens *int `json:"total_tokens,omitempty" yaml:"total_tokens,omitempty" schema:"total_tokens,omitempty"`
 	// UncachedInputTokens: Prompt tokens billed at the full input rate.
-	UncachedInputTokens int `json:"uncached_input_tokens" yaml:"uncached_input_tokens" schema:"uncached_input_tokens"`
+	UncachedInputTokens *int `json:"uncached_input_tokens,omitempty" yaml:"uncached_input_tokens,omitempty" schema:"uncached_input_tokens,omitempty"`
 	// UnpricedRequests: Requests whose model had no known price; their tokens are counted but contribute no cost, so totals are a floor rather than an estimate.
-	UnpricedRequests int `json:"unpriced_requests" yaml:"unpriced_requests" schema:"unpriced_requests"`
+	UnpricedRequests *int `json:"unpriced_requests,omitempty" yaml:"unpriced_requests,omitempty" schema:"unpriced_requests,omitempty"`
 }
 
 // ZoomToFit: The response from the `ZoomToFit` command.
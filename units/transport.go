@@ -0,0 +1,169 @@
+package units
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kittycad/kittycad.go"
+)
+
+// conversionPrefix starts the paths of the UnitService endpoints.
+const conversionPrefix = "/unit/conversion/"
+
+// converters convert a value between two units of a quantity, by the name of
+// the quantity in the paths of the UnitService endpoints.
+var converters = map[string]func(value float64, from, to string) (float64, error){
+	"angle":       converter(Angle),
+	"area":        converter(Area),
+	"current":     converter(Current),
+	"energy":      converter(Energy),
+	"force":       converter(Force),
+	"frequency":   converter(Frequency),
+	"length":      converter(Length),
+	"mass":        converter(Mass),
+	"power":       converter(Power),
+	"pressure":    converter(Pressure),
+	"temperature": converter(Temperature),
+	"torque":      converter(Torque),
+	"volume":      converter(Volume),
+}
+
+func converter[U ~string](convert func(float64, U, U) (float64, error)) func(float64, string, string) (float64, error) {
+	return func(value float64, from, to string) (float64, error) {
+		return convert(value, U(from), U(to))
+	}
+}
+
+// conversion is the response of the UnitService endpoints, which all have
+// the shape of kittycad.UnitLengthConversion.
+type conversion struct {
+	ID          kittycad.UUID          `json:"id"`
+	Status      kittycad.APICallStatus `json:"status"`
+	Input       float64                `json:"input"`
+	InputUnit   string                 `json:"input_unit"`
+	Output      float64                `json:"output"`
+	OutputUnit  string                 `json:"output_unit"`
+	CreatedAt   kittycad.Time          `json:"created_at"`
+	StartedAt   kittycad.Time          `json:"started_at"`
+	CompletedAt kittycad.Time          `json:"completed_at"`
+	UpdatedAt   kittycad.Time          `json:"updated_at"`
+}
+
+// Transport is an http.RoundTripper which answers the requests of the
+// UnitService methods locally, in the response structs of the API like
+// kittycad.UnitLengthConversion, and sends the other requests to Base.
+// LocalUnits sets one up for a client.
+//
+// The responses have a random ID, and no user ID since no user made an API
+// call. Unknown units are answered with a 400 Bad Request, returned by the
+// client as a *kittycad.HTTPError like the errors of the API.
+type Transport struct {
+	// Base sends the requests which are not unit conversions. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+	// BaseURL is the base URL of the client, as set with
+	// kittycad.Client.WithBaseURL. Only the requests to the UnitService
+	// endpoints under it are answered locally. It defaults to
+	// kittycad.DefaultServerURL.
+	BaseURL string
+
+	// client is the client whose base URL is used instead of BaseURL, when
+	// set by LocalUnits.
+	client *kittycad.Client
+}
+
+// LocalUnits makes the client answer its UnitService calls locally, with a
+// Transport wrapping the transport of the client. The calls are matched
+// with the base URL of the client when they are made, so it may still be
+// changed with WithBaseURL, but setting another transport with
+// WithTransport sends them over the network again.
+func LocalUnits(client *kittycad.Client) {
+	client.WithTransport(&Transport{Base: client.Transport(), client: client})
+}
+
+// conversionPath returns the path of the request after the prefix of the
+// UnitService endpoints, if it is one of them.
+func (t *Transport) conversionPath(u *url.URL) (string, bool) {
+	baseURL := t.BaseURL
+	if t.client != nil {
+		baseURL = t.client.BaseURL()
+	}
+	if baseURL == "" {
+		baseURL = kittycad.DefaultServerURL
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil || !strings.EqualFold(base.Host, u.Host) {
+		return "", false
+	}
+	return strings.CutPrefix(u.Path, strings.TrimSuffix(base.Path, "/")+conversionPrefix)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rest, ok := t.conversionPath(req.URL)
+	if !ok || req.Method != http.MethodGet {
+		return t.base().RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	parts := strings.Split(rest, "/")
+	convert, ok := converters[parts[0]]
+	if !ok || len(parts) != 3 {
+		return response(req, http.StatusNotFound, map[string]string{"message": "unknown unit conversion " + req.URL.Path}), nil
+	}
+	value, err := strconv.ParseFloat(req.URL.Query().Get("value"), 64)
+	if err != nil {
+		return response(req, http.StatusBadRequest, map[string]string{"message": fmt.Sprintf("invalid value %q", req.URL.Query().Get("value"))}), nil
+	}
+	output, err := convert(value, parts[1], parts[2])
+	if err != nil {
+		return response(req, http.StatusBadRequest, map[string]string{"message": err.Error()}), nil
+	}
+
+	id := uuid.New()
+	now := kittycad.TimeNow()
+	return response(req, http.StatusOK, conversion{
+		ID:          kittycad.UUID{UUID: &id},
+		Status:      kittycad.APICallStatusCompleted,
+		Input:       value,
+		InputUnit:   parts[1],
+		Output:      output,
+		OutputUnit:  parts[2],
+		CreatedAt:   now,
+		StartedAt:   now,
+		CompletedAt: now,
+		UpdatedAt:   now,
+	}), nil
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+// response creates a JSON response to the request.
+func response(req *http.Request, status int, body any) *http.Response {
+	b, _ := json.Marshal(body)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}
+}
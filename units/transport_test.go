@@ -0,0 +1,136 @@
+package units
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+// newClient returns a client answering the unit conversions locally, and
+// the paths of the requests which reached the server.
+func newClient(t *testing.T) (*kittycad.Client, *[]string) {
+	t.Helper()
+
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := kittycad.NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	// The base URL of the client is used even when set afterwards.
+	LocalUnits(client)
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+	return client, &requests
+}
+
+func TestTransportAnswersConversions(t *testing.T) {
+	client, requests := newClient(t)
+
+	length, err := client.Unit.GetLengthConversion(kittycad.UnitLengthIn, kittycad.UnitLengthMm, 2)
+	if err != nil {
+		t.Fatalf("converting the length failed: %v", err)
+	}
+	if *length.Output != 50.8 || *length.Input != 2 || length.InputUnit != kittycad.UnitLengthIn || length.OutputUnit != kittycad.UnitLengthMm {
+		t.Fatalf("unexpected conversion %+v", length)
+	}
+	if length.Status != kittycad.APICallStatusCompleted || length.ID.UUID == nil || length.CompletedAt == nil {
+		t.Fatalf("expected a completed API call, got %+v", length)
+	}
+
+	temperature, err := client.Unit.GetTemperatureConversion(kittycad.UnitTemperatureCelsius, kittycad.UnitTemperatureFahrenheit, 37)
+	if err != nil {
+		t.Fatalf("converting the temperature failed: %v", err)
+	}
+	if *temperature.Output != 98.6 {
+		t.Fatalf("unexpected temperature %v", *temperature.Output)
+	}
+
+	if _, err := client.Unit.GetMassConversion("stone", kittycad.UnitMasKg, 1); err == nil {
+		t.Fatalf("expected the unknown unit to be refused")
+	} else if httpErr, ok := errors.AsType[*kittycad.HTTPError](err); !ok || httpErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a bad request, got %v", err)
+	}
+
+	if len(*requests) != 0 {
+		t.Fatalf("expected no request to reach the server, got %v", *requests)
+	}
+}
+
+func TestTransportMatchesTheBaseURL(t *testing.T) {
+	passed := []string{}
+	transport := &Transport{
+		BaseURL: "https://example.com/api/",
+		Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			passed = append(passed, req.URL.String())
+			return response(req, http.StatusOK, map[string]string{}), nil
+		}),
+	}
+
+	for url, local := range map[string]bool{
+		"https://example.com/api/unit/conversion/length/m/mm?value=1":       true,
+		"https://example.com/unit/conversion/length/m/mm?value=1":           false,
+		"https://example.com/api/files/unit/conversion/length/m/mm?value=1": false,
+		"https://other.example.com/api/unit/conversion/length/m/mm?value=1": false,
+	} {
+		passed = nil
+		resp, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, url, nil))
+		if err != nil {
+			t.Fatalf("requesting %s failed: %v", url, err)
+		}
+		resp.Body.Close()
+		if local != (len(passed) == 0) {
+			t.Errorf("expected %s to be answered locally: %v, passed on %v", url, local, passed)
+		}
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLocalUnitsWrapsTheTransport(t *testing.T) {
+	client, err := kittycad.NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	passed := []string{}
+	client.WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		passed = append(passed, req.URL.Path)
+		return response(req, http.StatusOK, map[string]string{"message": "pong"}), nil
+	}))
+	LocalUnits(client)
+
+	if _, err := client.Unit.GetLengthConversion(kittycad.UnitLengthIn, kittycad.UnitLengthMm, 2); err != nil {
+		t.Fatalf("converting the length failed: %v", err)
+	}
+	if _, err := client.Meta.Ping(); err != nil {
+		t.Fatalf("pinging failed: %v", err)
+	}
+	if len(passed) != 1 || passed[0] != "/ping" {
+		t.Fatalf("expected only the ping to reach the transport, got %v", passed)
+	}
+}
+
+func TestTransportSendsOtherRequests(t *testing.T) {
+	client, requests := newClient(t)
+
+	if _, err := client.Meta.Ping(); err != nil {
+		t.Fatalf("pinging failed: %v", err)
+	}
+	if len(*requests) != 1 || (*requests)[0] != "/ping" {
+		t.Fatalf("expected the ping to reach the server, got %v", *requests)
+	}
+}
@@ -0,0 +1,258 @@
+// Package units converts values between the units of the kittycad API
+// locally, with the same enums as kittycad.UnitService:
+//
+//	mm, err := units.Length(2, kittycad.UnitLengthIn, kittycad.UnitLengthMm)
+//
+// The conversions are exact to the precision of a float64, with the
+// international definitions of the imperial units. Temperatures are
+// converted with their offsets, so 0 Celsius is 32 Fahrenheit.
+//
+// To answer the UnitService calls of a client locally instead of over the
+// network, wrap the transport of the client with LocalUnits:
+//
+//	units.LocalUnits(client)
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+// ErrUnknownUnit is returned when converting from or to a unit which is not
+// one of the known values of its enum.
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// The factors of the units, to the SI unit of their quantity.
+var (
+	angleFactors = map[kittycad.UnitAngle]float64{
+		kittycad.UnitAngleDegrees: math.Pi / 180,
+		kittycad.UnitAngleRadians: 1,
+	}
+	areaFactors = map[kittycad.UnitArea]float64{
+		kittycad.UnitAreaCm2: 1e-4,
+		kittycad.UnitAreaDm2: 1e-2,
+		kittycad.UnitAreaFt2: 0.09290304,
+		kittycad.UnitAreaIn2: 0.00064516,
+		kittycad.UnitAreaKm2: 1e6,
+		kittycad.UnitAreaM2:  1,
+		kittycad.UnitAreaMm2: 1e-6,
+		kittycad.UnitAreaYd2: 0.83612736,
+	}
+	currentFactors = map[kittycad.UnitCurrent]float64{
+		kittycad.UnitCurrentAmperes:      1,
+		kittycad.UnitCurrentMicroamperes: 1e-6,
+		kittycad.UnitCurrentMilliamperes: 1e-3,
+		kittycad.UnitCurrentNanoamperes:  1e-9,
+	}
+	densityFactors = map[kittycad.UnitDensity]float64{
+		kittycad.UnitDensityKgm3:  1,
+		kittycad.UnitDensityLbft3: 0.45359237 / 0.028316846592,
+	}
+	energyFactors = map[kittycad.UnitEnergy]float64{
+		kittycad.UnitEnergyBtu:           1055.05585262,
+		kittycad.UnitEnergyElectronvolts: 1.602176634e-19,
+		kittycad.UnitEnergyJoules:        1,
+		kittycad.UnitEnergyKilocalories:  4186.8,
+		kittycad.UnitEnergyKilowattHours: 3.6e6,
+		kittycad.UnitEnergyWattHours:     3600,
+	}
+	forceFactors = map[kittycad.UnitForce]float64{
+		kittycad.UnitForceDynes:        1e-5,
+		kittycad.UnitForceKiloponds:    9.80665,
+		kittycad.UnitForceMicronewtons: 1e-6,
+		kittycad.UnitForceMillinewtons: 1e-3,
+		kittycad.UnitForceNewtons:      1,
+		kittycad.UnitForcePoundals:     0.138254954376,
+		kittycad.UnitForcePounds:       4.4482216152605,
+	}
+	frequencyFactors = map[kittycad.UnitFrequency]float64{
+		kittycad.UnitFrequencyGigahertz:  1e9,
+		kittycad.UnitFrequencyHertz:      1,
+		kittycad.UnitFrequencyKilohertz:  1e3,
+		kittycad.UnitFrequencyMegahertz:  1e6,
+		kittycad.UnitFrequencyMicrohertz: 1e-6,
+		kittycad.UnitFrequencyMillihertz: 1e-3,
+		kittycad.UnitFrequencyNanohertz:  1e-9,
+		kittycad.UnitFrequencyTerahertz:  1e12,
+	}
+	lengthFactors = map[kittycad.UnitLength]float64{
+		kittycad.UnitLengthCm: 0.01,
+		kittycad.UnitLengthFt: 0.3048,
+		kittycad.UnitLengthIn: 0.0254,
+		kittycad.UnitLengthM:  1,
+		kittycad.UnitLengthMm: 0.001,
+		kittycad.UnitLengthYd: 0.9144,
+	}
+	massFactors = map[kittycad.UnitMas]float64{
+		kittycad.UnitMasG:  0.001,
+		kittycad.UnitMasKg: 1,
+		kittycad.UnitMasLb: 0.45359237,
+	}
+	powerFactors = map[kittycad.UnitPower]float64{
+		kittycad.UnitPowerBtuPerMinute:     1055.05585262 / 60,
+		kittycad.UnitPowerHorsepower:       745.69987158227022,
+		kittycad.UnitPowerKilowatts:        1e3,
+		kittycad.UnitPowerMetricHorsepower: 735.49875,
+		kittycad.UnitPowerMicrowatts:       1e-6,
+		kittycad.UnitPowerMilliwatts:       1e-3,
+		kittycad.UnitPowerWatts:            1,
+	}
+	pressureFactors = map[kittycad.UnitPressure]float64{
+		kittycad.UnitPressureAtmospheres:  101325,
+		kittycad.UnitPressureBars:         1e5,
+		kittycad.UnitPressureHectopascals: 100,
+		kittycad.UnitPressureKilopascals:  1e3,
+		kittycad.UnitPressureMillibars:    100,
+		kittycad.UnitPressurePascals:      1,
+		kittycad.UnitPressurePsi:          6894.757293168361,
+	}
+	torqueFactors = map[kittycad.UnitTorque]float64{
+		kittycad.UnitTorqueNewtonMetres: 1,
+		kittycad.UnitTorquePoundFoot:    1.3558179483314004,
+	}
+	volumeFactors = map[kittycad.UnitVolume]float64{
+		kittycad.UnitVolumeMm3:    1e-9,
+		kittycad.UnitVolumeCm3:    1e-6,
+		kittycad.UnitVolumeFt3:    0.028316846592,
+		kittycad.UnitVolumeIn3:    1.6387064e-5,
+		kittycad.UnitVolumeM3:     1,
+		kittycad.UnitVolumeYd3:    0.764554857984,
+		kittycad.UnitVolumeUsfloz: 2.95735295625e-5,
+		kittycad.UnitVolumeUsgal:  3.785411784e-3,
+		kittycad.UnitVolumeL:      1e-3,
+		kittycad.UnitVolumeMl:     1e-6,
+	}
+)
+
+// convert converts the value with the factors of the units.
+func convert[U ~string](factors map[U]float64, value float64, from, to U) (float64, error) {
+	in, ok := factors[from]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownUnit, from)
+	}
+	out, ok := factors[to]
+	if !ok {
+		return 0, fmt.Errorf("%w %q", ErrUnknownUnit, to)
+	}
+	if from == to {
+		return value, nil
+	}
+	return round(value * in / out), nil
+}
+
+// round removes the error of the floating point operations, so 1 cm is
+// 10 mm rather than 9.999999999999998, by rounding the value to 15
+// significant digits if it is only a few units in the last place away.
+func round(value float64) float64 {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'g', 15, 64), 64)
+	ulp := math.Abs(math.Nextafter(value, math.Inf(1)) - value)
+	if math.Abs(rounded-value) <= 4*ulp {
+		return rounded
+	}
+	return value
+}
+
+// Angle converts the angle from one unit to another.
+func Angle(value float64, from, to kittycad.UnitAngle) (float64, error) {
+	return convert(angleFactors, value, from, to)
+}
+
+// Area converts the area from one unit to another.
+func Area(value float64, from, to kittycad.UnitArea) (float64, error) {
+	return convert(areaFactors, value, from, to)
+}
+
+// Current converts the current from one unit to another.
+func Current(value float64, from, to kittycad.UnitCurrent) (float64, error) {
+	return convert(currentFactors, value, from, to)
+}
+
+// Density converts the density from one unit to another.
+func Density(value float64, from, to kittycad.UnitDensity) (float64, error) {
+	return convert(densityFactors, value, from, to)
+}
+
+// Energy converts the energy from one unit to another.
+func Energy(value float64, from, to kittycad.UnitEnergy) (float64, error) {
+	return convert(energyFactors, value, from, to)
+}
+
+// Force converts the force from one unit to another.
+func Force(value float64, from, to kittycad.UnitForce) (float64, error) {
+	return convert(forceFactors, value, from, to)
+}
+
+// Frequency converts the frequency from one unit to another.
+func Frequency(value float64, from, to kittycad.UnitFrequency) (float64, error) {
+	return convert(frequencyFactors, value, from, to)
+}
+
+// Length converts the length from one unit to another.
+func Length(value float64, from, to kittycad.UnitLength) (float64, error) {
+	return convert(lengthFactors, value, from, to)
+}
+
+// Mass converts the mass from one unit to another.
+func Mass(value float64, from, to kittycad.UnitMas) (float64, error) {
+	return convert(massFactors, value, from, to)
+}
+
+// Power converts the power from one unit to another.
+func Power(value float64, from, to kittycad.UnitPower) (float64, error) {
+	return convert(powerFactors, value, from, to)
+}
+
+// Pressure converts the pressure from one unit to another.
+func Pressure(value float64, from, to kittycad.UnitPressure) (float64, error) {
+	return convert(pressureFactors, value, from, to)
+}
+
+// Torque converts the torque from one unit to another.
+func Torque(value float64, from, to kittycad.UnitTorque) (float64, error) {
+	return convert(torqueFactors, value, from, to)
+}
+
+// Volume converts the volume from one unit to another.
+func Volume(value float64, from, to kittycad.UnitVolume) (float64, error) {
+	return convert(volumeFactors, value, from, to)
+}
+
+// Temperature converts the temperature from one unit to another, with the
+// offsets of the units, through kelvins.
+func Temperature(value float64, from, to kittycad.UnitTemperature) (float64, error) {
+	var kelvin float64
+	switch from {
+	case kittycad.UnitTemperatureCelsius:
+		kelvin = value + 273.15
+	case kittycad.UnitTemperatureFahrenheit:
+		kelvin = (value + 459.67) * 5 / 9
+	case kittycad.UnitTemperatureKelvin:
+		kelvin = value
+	case kittycad.UnitTemperatureRankine:
+		kelvin = value * 5 / 9
+	default:
+		return 0, fmt.Errorf("%w %q", ErrUnknownUnit, from)
+	}
+
+	var out float64
+	switch to {
+	case kittycad.UnitTemperatureCelsius:
+		out = kelvin - 273.15
+	case kittycad.UnitTemperatureFahrenheit:
+		out = kelvin*9/5 - 459.67
+	case kittycad.UnitTemperatureKelvin:
+		out = kelvin
+	case kittycad.UnitTemperatureRankine:
+		out = kelvin * 9 / 5
+	default:
+		return 0, fmt.Errorf("%w %q", ErrUnknownUnit, to)
+	}
+	if from == to {
+		return value, nil
+	}
+	return round(out), nil
+}
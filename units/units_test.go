@@ -0,0 +1,88 @@
+package units
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kittycad/kittycad.go"
+)
+
+// converted is the result of a conversion, for tables of conversions.
+type converted struct {
+	value float64
+	err   error
+}
+
+func result(value float64, err error) converted {
+	return converted{value: value, err: err}
+}
+
+func TestConversions(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		got  converted
+		want float64
+	}{
+		{name: "in to mm", got: result(Length(2, kittycad.UnitLengthIn, kittycad.UnitLengthMm)), want: 50.8},
+		{name: "cm to mm", got: result(Length(1, kittycad.UnitLengthCm, kittycad.UnitLengthMm)), want: 10},
+		{name: "yd to ft", got: result(Length(1, kittycad.UnitLengthYd, kittycad.UnitLengthFt)), want: 3},
+		{name: "lb to kg", got: result(Mass(1, kittycad.UnitMasLb, kittycad.UnitMasKg)), want: 0.45359237},
+		{name: "degrees to radians", got: result(Angle(180, kittycad.UnitAngleDegrees, kittycad.UnitAngleRadians)), want: math.Pi},
+		{name: "ft2 to in2", got: result(Area(1, kittycad.UnitAreaFt2, kittycad.UnitAreaIn2)), want: 144},
+		{name: "mA to A", got: result(Current(250, kittycad.UnitCurrentMilliamperes, kittycad.UnitCurrentAmperes)), want: 0.25},
+		{name: "lb:ft3 to kg:m3", got: result(Density(1, kittycad.UnitDensityLbft3, kittycad.UnitDensityKgm3)), want: 16.018463373960138},
+		{name: "kWh to J", got: result(Energy(1, kittycad.UnitEnergyKilowattHours, kittycad.UnitEnergyJoules)), want: 3.6e6},
+		{name: "kp to N", got: result(Force(1, kittycad.UnitForceKiloponds, kittycad.UnitForceNewtons)), want: 9.80665},
+		{name: "MHz to kHz", got: result(Frequency(3, kittycad.UnitFrequencyMegahertz, kittycad.UnitFrequencyKilohertz)), want: 3000},
+		{name: "hp to W", got: result(Power(1, kittycad.UnitPowerHorsepower, kittycad.UnitPowerWatts)), want: 745.699871582270},
+		{name: "atm to bar", got: result(Pressure(1, kittycad.UnitPressureAtmospheres, kittycad.UnitPressureBars)), want: 1.01325},
+		{name: "lbf ft to N m", got: result(Torque(1, kittycad.UnitTorquePoundFoot, kittycad.UnitTorqueNewtonMetres)), want: 1.35581794833140},
+		{name: "gal to l", got: result(Volume(1, kittycad.UnitVolumeUsgal, kittycad.UnitVolumeL)), want: 3.785411784},
+		{name: "gal to fl oz", got: result(Volume(1, kittycad.UnitVolumeUsgal, kittycad.UnitVolumeUsfloz)), want: 128},
+		{name: "C to F", got: result(Temperature(100, kittycad.UnitTemperatureCelsius, kittycad.UnitTemperatureFahrenheit)), want: 212},
+		{name: "F to C", got: result(Temperature(-40, kittycad.UnitTemperatureFahrenheit, kittycad.UnitTemperatureCelsius)), want: -40},
+		{name: "C to K", got: result(Temperature(20, kittycad.UnitTemperatureCelsius, kittycad.UnitTemperatureKelvin)), want: 293.15},
+		{name: "K to R", got: result(Temperature(100, kittycad.UnitTemperatureKelvin, kittycad.UnitTemperatureRankine)), want: 180},
+		{name: "F to R", got: result(Temperature(0, kittycad.UnitTemperatureFahrenheit, kittycad.UnitTemperatureRankine)), want: 459.67},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got.err != nil {
+				t.Fatalf("converting failed: %v", test.got.err)
+			}
+			if test.got.value != test.want {
+				t.Fatalf("expected %v, got %v", test.want, test.got.value)
+			}
+		})
+	}
+}
+
+func TestConversionsRoundTrip(t *testing.T) {
+	for _, from := range kittycad.AllUnitVolumes() {
+		for _, to := range kittycad.AllUnitVolumes() {
+			converted, _ := Volume(12.5, from, to)
+			back, err := Volume(converted, to, from)
+			if err != nil || math.Abs(back-12.5) > 1e-12 {
+				t.Fatalf("converting 12.5 %s to %s and back gave %v, %v", from, to, back, err)
+			}
+		}
+	}
+	for _, from := range kittycad.AllUnitTemperatures() {
+		for _, to := range kittycad.AllUnitTemperatures() {
+			converted, _ := Temperature(300, from, to)
+			back, err := Temperature(converted, to, from)
+			if err != nil || math.Abs(back-300) > 1e-9 {
+				t.Fatalf("converting 300 %s to %s and back gave %v, %v", from, to, back, err)
+			}
+		}
+	}
+}
+
+func TestConversionsUnknownUnit(t *testing.T) {
+	if _, err := Length(1, "furlong", kittycad.UnitLengthM); !errors.Is(err, ErrUnknownUnit) {
+		t.Fatalf("expected ErrUnknownUnit, got %v", err)
+	}
+	if _, err := Temperature(1, kittycad.UnitTemperatureKelvin, "reaumur"); !errors.Is(err, ErrUnknownUnit) {
+		t.Fatalf("expected ErrUnknownUnit, got %v", err)
+	}
+}
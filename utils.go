@@ -35,8 +35,12 @@ func expandURL(u *url.URL, expansions map[string]string) error {
 	// set the parameters
 	u.Path = b.String()
 
-	// escape the expansions
+	// escape the expansions for the path, keeping the raw values for the
+	// query string: values.Encode escapes them, and escaping them here too
+	// made the server read "a b" as "a+b" and "kg:m3" as "kg%3Am3".
+	raw := map[string]string{}
 	for k, v := range expansions {
+		raw[k] = v
 		expansions[k] = url.QueryEscape(v)
 	}
 
@@ -51,7 +55,7 @@ func expandURL(u *url.URL, expansions map[string]string) error {
 	// For any extra arguments that were not in the template, parse the args
 	// as path paramters.
 	values := u.Query()
-	for k, v := range expansions {
+	for k, v := range raw {
 		if !strings.Contains(origPath, fmt.Sprintf("{{.%s}}", k)) {
 			values.Set(k, v)
 		}
@@ -60,7 +64,22 @@ func expandURL(u *url.URL, expansions map[string]string) error {
 	// Set the path parameters
 	u.RawQuery = values.Encode()
 	// We want colons in the query string to be unescaped.
-	u.RawQuery = strings.Replace(u.RawQuery, "%253A", ":", -1)
+	u.RawQuery = strings.Replace(u.RawQuery, "%3A", ":", -1)
+
+	return nil
+}
+
+// websocketURL changes the scheme of the url to the websocket scheme
+// matching it, so plain-HTTP servers are dialed without TLS.
+func websocketURL(u *url.URL) error {
+	switch u.Scheme {
+	case "https", "wss":
+		u.Scheme = "wss"
+	case "http", "ws":
+		u.Scheme = "ws"
+	default:
+		return fmt.Errorf("unsupported scheme %q for websocket url %q", u.Scheme, u.String())
+	}
 
 	return nil
 }
@@ -47,6 +47,15 @@ func TestExpandURL(t *testing.T) {
 			},
 			testServerURL + "/file/mass?unit=kg:m3",
 		},
+		// Query params are escaped once.
+		{
+			"file/execute/{{.lang}}",
+			map[string]string{
+				"lang":   "python",
+				"output": "out/result.txt,a b.txt",
+			},
+			testServerURL + "/file/execute/python?output=out%2Fresult.txt%2Ca+b.txt",
+		},
 	}
 
 	for i, test := range expandTests {
@@ -62,3 +71,18 @@ func TestExpandURL(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandURLQueryRoundTrip(t *testing.T) {
+	for _, value := range []string{"a b", "x+y", "100%", "out/result.txt", "kg:m3", "é,ü"} {
+		u, err := url.Parse(resolveRelative("http://example.com", "file/execute/{{.lang}}"))
+		if err != nil {
+			t.Fatalf("parsing the url failed: %v", err)
+		}
+		if err := expandURL(u, map[string]string{"lang": "python", "output": value}); err != nil {
+			t.Fatalf("expanding the url failed: %v", err)
+		}
+		if got := u.Query().Get("output"); got != value {
+			t.Errorf("the server would read %q as %q from %q", value, got, u.String())
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// WebsocketSent is the direction of messages sent by the client.
+	WebsocketSent = "sent"
+	// WebsocketReceived is the direction of messages received from the server.
+	WebsocketReceived = "received"
+)
+
+// WebsocketFrame is a message of a websocket, as recorded by a
+// RecordingDialer.
+type WebsocketFrame struct {
+	// Time is when the message was sent or received.
+	Time time.Time `json:"time"`
+	// Direction is WebsocketSent or WebsocketReceived.
+	Direction string `json:"direction"`
+	// Type is websocket.TextMessage or websocket.BinaryMessage.
+	Type int `json:"type"`
+	// Data is the message, as it was sent.
+	Data []byte `json:"data"`
+}
+
+// websocketFrameJSON is the JSON form of a WebsocketFrame.
+type websocketFrameJSON struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Type      int             `json:"type"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// MarshalJSON encodes the frame. Text messages holding a JSON object or
+// array are encoded as that JSON, so recordings can be read and diffed,
+// other text messages as strings, and binary messages in base64.
+func (f WebsocketFrame) MarshalJSON() ([]byte, error) {
+	v := websocketFrameJSON{Time: f.Time, Direction: f.Direction, Type: f.Type}
+	var err error
+	switch {
+	case f.Type == websocket.TextMessage && isJSONContainer(f.Data):
+		v.Data = f.Data
+	case f.Type == websocket.TextMessage:
+		v.Data, err = json.Marshal(string(f.Data))
+	default:
+		v.Data, err = json.Marshal(f.Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes a frame encoded by MarshalJSON.
+func (f *WebsocketFrame) UnmarshalJSON(data []byte) error {
+	var v websocketFrameJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = WebsocketFrame{Time: v.Time, Direction: v.Direction, Type: v.Type}
+
+	switch {
+	case v.Type == websocket.TextMessage && isJSONContainer(v.Data):
+		f.Data = v.Data
+	case v.Type == websocket.TextMessage:
+		var text string
+		if err := json.Unmarshal(v.Data, &text); err != nil {
+			return fmt.Errorf("decoding the text message failed: %v", err)
+		}
+		f.Data = []byte(text)
+	default:
+		if err := json.Unmarshal(v.Data, &f.Data); err != nil {
+			return fmt.Errorf("decoding the binary message failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// isJSONContainer returns whether the data is a JSON object or array.
+func isJSONContainer(data []byte) bool {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	return len(data) > 0 && (data[0] == '{' || data[0] == '[') && json.Valid(data)
+}
+
+// RecordingDialer returns a dialer opening websockets with dialer, or
+// websocket.DefaultDialer if it is nil, which records their messages. Set it
+// with Client.WithDialer, so the websocket methods of the client return
+// recorded connections.
+//
+// For every websocket opened, open is called with its URL, and the function
+// it returns with every message sent and received, in the order they went
+// through. It may return nil to leave the websocket unrecorded. Pings, pongs
+// and close messages are passed on without being recorded.
+func RecordingDialer(dialer *websocket.Dialer, open func(u *url.URL) func(WebsocketFrame)) *websocket.Dialer {
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
+	}
+
+	// The websocket of the client is connected in-process to a proxy, which
+	// dials the server with the dialer and passes the messages on.
+	return &websocket.Dialer{
+		NetDialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return dialRecordingProxy(ctx, "ws", dialer, open), nil
+		},
+		NetDialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return dialRecordingProxy(ctx, "wss", dialer, open), nil
+		},
+		HandshakeTimeout: dialer.HandshakeTimeout,
+		ReadBufferSize:   dialer.ReadBufferSize,
+		WriteBufferSize:  dialer.WriteBufferSize,
+		Subprotocols:     dialer.Subprotocols,
+		Jar:              dialer.Jar,
+	}
+}
+
+// dialRecordingProxy returns the client end of a connection to a recording
+// proxy dialing the server with the scheme.
+func dialRecordingProxy(ctx context.Context, scheme string, dialer *websocket.Dialer, open func(u *url.URL) func(WebsocketFrame)) net.Conn {
+	client, server := net.Pipe()
+	proxy := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxyWebsocket(ctx, w, r, scheme, dialer, open)
+	})}
+	go proxy.Serve(&pipeListener{conn: server})
+
+	return client
+}
+
+// proxyWebsocket dials the websocket requested by r on the server, and
+// passes the messages between the websockets until either is closed.
+func proxyWebsocket(ctx context.Context, w http.ResponseWriter, r *http.Request, scheme string, dialer *websocket.Dialer, open func(u *url.URL) func(WebsocketFrame)) {
+	u := *r.URL
+	u.Scheme = scheme
+	u.Host = r.Host
+
+	header := http.Header{}
+	for k, v := range r.Header {
+		switch k {
+		case "Upgrade", "Connection", "Sec-Websocket-Key", "Sec-Websocket-Version", "Sec-Websocket-Extensions":
+			// The dialer sets these itself.
+		case "Sec-Websocket-Protocol":
+			if len(dialer.Subprotocols) == 0 {
+				header[k] = v
+			}
+		default:
+			header[k] = v
+		}
+	}
+
+	server, resp, err := dialer.DialContext(ctx, u.String(), header)
+	if err != nil {
+		// Pass on the response, so the client sees why the handshake failed.
+		if resp == nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+		return
+	}
+	defer server.Close()
+
+	responseHeader := http.Header{}
+	if protocol := server.Subprotocol(); protocol != "" {
+		responseHeader.Set("Sec-Websocket-Protocol", protocol)
+	}
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	client, err := upgrader.Upgrade(w, r, responseHeader)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	record := open(&u)
+	var mu sync.Mutex
+	pass := func(from, to *websocket.Conn, direction string) {
+		passControl(from, to)
+		for {
+			messageType, data, err := from.ReadMessage()
+			if err != nil {
+				passClose(to, err)
+				return
+			}
+			if record != nil {
+				mu.Lock()
+				record(WebsocketFrame{Time: time.Now().UTC(), Direction: direction, Type: messageType, Data: data})
+				mu.Unlock()
+			}
+			if err := to.WriteMessage(messageType, data); err != nil {
+				from.Close()
+				return
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pass(server, client, WebsocketReceived)
+	}()
+	pass(client, server, WebsocketSent)
+	<-done
+}
+
+// passControl passes the pings and pongs received on from to to.
+func passControl(from, to *websocket.Conn) {
+	from.SetPingHandler(func(data string) error {
+		return to.WriteControl(websocket.PingMessage, []byte(data), time.Now().Add(time.Second))
+	})
+	from.SetPongHandler(func(data string) error {
+		return to.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(time.Second))
+	})
+}
+
+// passClose closes to for the reason reading from the other websocket failed.
+func passClose(to *websocket.Conn, err error) {
+	code, text := websocket.CloseGoingAway, err.Error()
+	if closeErr, ok := errors.AsType[*websocket.CloseError](err); ok && closeErr.Code != websocket.CloseAbnormalClosure {
+		code, text = closeErr.Code, closeErr.Text
+	}
+	// Close messages hold at most 125 bytes.
+	if len(text) > 120 {
+		text = text[:120]
+	}
+	to.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+	to.Close()
+}
+
+// pipeListener is a net.Listener accepting a single connection.
+type pipeListener struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil, net.ErrClosed
+	}
+	conn := l.conn
+	l.conn = nil
+	return conn, nil
+}
+
+func (l *pipeListener) Close() error {
+	return nil
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "pipe" }
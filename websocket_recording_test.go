@@ -0,0 +1,130 @@
+package kittycad
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestRecordingDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, `{"message":"unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(messageType, data)
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var opened []string
+	var frames []WebsocketFrame
+	dialer := RecordingDialer(nil, func(u *url.URL) func(WebsocketFrame) {
+		mu.Lock()
+		defer mu.Unlock()
+		opened = append(opened, u.String())
+		return func(frame WebsocketFrame) {
+			mu.Lock()
+			defer mu.Unlock()
+			frames = append(frames, frame)
+		}
+	})
+
+	client, err := NewClient("token", "kittycad.go/tests")
+	if err != nil {
+		t.Fatalf("creating the client failed: %v", err)
+	}
+	if err := client.WithBaseURL(server.URL); err != nil {
+		t.Fatalf("setting the base url failed: %v", err)
+	}
+	client.WithDialer(dialer)
+
+	conn, err := client.Modeling.CommandsWs(context.Background(), ModelingCommandsWsParams{Fps: new(30)}, nil)
+	if err != nil {
+		t.Fatalf("opening the websocket failed: %v", err)
+	}
+	binary := []byte{0xff, 0x00, 0xfe}
+	for _, message := range []struct {
+		messageType int
+		data        []byte
+	}{
+		{websocket.TextMessage, []byte(`{"type":"ping"}`)},
+		{websocket.BinaryMessage, binary},
+	} {
+		if err := conn.WriteMessage(message.messageType, message.data); err != nil {
+			t.Fatalf("writing failed: %v", err)
+		}
+		messageType, data, err := conn.ReadMessage()
+		if err != nil || messageType != message.messageType || !bytes.Equal(data, message.data) {
+			t.Fatalf("expected the message to be echoed, got %d %q, %v", messageType, data, err)
+		}
+	}
+	conn.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(opened) != 1 || opened[0] != "ws"+server.URL[len("http"):]+"/ws/modeling/commands?fps=30" {
+		t.Fatalf("unexpected websockets opened: %v", opened)
+	}
+	if len(frames) != 4 {
+		t.Fatalf("expected 4 frames, got %+v", frames)
+	}
+	last := frames[3]
+	if last.Direction != WebsocketReceived || last.Type != websocket.BinaryMessage || !bytes.Equal(last.Data, binary) {
+		t.Fatalf("expected the binary message to be recorded as it was, got %+v", last)
+	}
+
+	// Failed handshakes reach the client as they were.
+	client.token = "wrong"
+	_, err = client.Modeling.CommandsWs(context.Background(), ModelingCommandsWsParams{}, nil)
+	if httpErr, ok := errors.AsType[*HTTPError](err); !ok || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the handshake to fail with 401, got %v", err)
+	}
+}
+
+func TestWebsocketFrameJSON(t *testing.T) {
+	for _, test := range []struct {
+		frame WebsocketFrame
+		data  string
+	}{
+		{WebsocketFrame{Type: websocket.TextMessage, Data: []byte(`{"type":"ping"}`)}, `{"type":"ping"}`},
+		{WebsocketFrame{Type: websocket.TextMessage, Data: []byte(`"quoted"`)}, `"\"quoted\""`},
+		{WebsocketFrame{Type: websocket.TextMessage, Data: []byte(`{not json`)}, `"{not json"`},
+		{WebsocketFrame{Type: websocket.BinaryMessage, Data: []byte{0xff, 0x00}}, `"/wA="`},
+	} {
+		encoded, err := json.Marshal(test.frame)
+		if err != nil {
+			t.Fatalf("encoding %q failed: %v", test.frame.Data, err)
+		}
+		var fields struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil || string(fields.Data) != test.data {
+			t.Fatalf("expected %q to be encoded as %s, got %s", test.frame.Data, test.data, encoded)
+		}
+
+		var decoded WebsocketFrame
+		if err := json.Unmarshal(encoded, &decoded); err != nil || decoded.Type != test.frame.Type || !bytes.Equal(decoded.Data, test.frame.Data) {
+			t.Fatalf("expected %s to decode as %q, got %q (%v)", encoded, test.frame.Data, decoded.Data, err)
+		}
+	}
+}